@@ -12,8 +12,9 @@ import (
 	"era/booru/internal/config"
 	"era/booru/internal/db"
 	embed "era/booru/internal/embeddings"
-	"era/booru/internal/minio"
+	"era/booru/internal/embedrpc"
 	"era/booru/internal/queue"
+	"era/booru/internal/storage"
 	embedworker "era/booru/internal/workers/embedworker"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -65,17 +66,39 @@ func main() {
 		log.Fatal(err)
 	}
 
-	m, err := minio.New(cfg)
+	store, err := storage.New(cfg)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	river.AddWorker(workers, &embedworker.ImageEmbedWorker{Minio: m, DB: database, Cfg: cfg})
-	river.AddWorker(workers, &embedworker.TextEmbedWorker{})
+	// Vectors are stored per model and dimension so a new model never mixes
+	// its embeddings with those of the old one.
+	dim, err := embed.VisionDimension()
+	if err != nil {
+		log.Fatal(err)
+	}
+	visionVector, err := db.RegisterVisionVector(ctx, database, embed.ModelID(), dim)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Storing vision embeddings as %s", visionVector.Name)
+
+	river.AddWorker(workers, &embedworker.ImageEmbedWorker{Storage: store, DB: database, Cfg: cfg, Vector: visionVector})
+	river.AddWorker(workers, &embedworker.RegionEmbedWorker{Storage: store, DB: database, Vector: visionVector})
+	river.AddWorker(workers, &embedworker.TextEmbedWorker{Model: visionVector.Model})
+	river.AddWorker(workers, &embedworker.AutoTagWorker{DB: database, Vector: visionVector})
 
 	if err := client.Start(ctx); err != nil {
 		log.Fatal(err)
 	}
+	if cfg.EmbedGRPCListen != "" {
+		go func() {
+			if err := embedrpc.Serve(ctx, cfg.EmbedGRPCListen, embedrpc.NewServer(visionVector.Model)); err != nil {
+				log.Fatalf("serve gRPC: %v", err)
+			}
+		}()
+		log.Printf("Serving embeddings over gRPC on %s", cfg.EmbedGRPCListen)
+	}
 	<-ctx.Done()
 	client.Stop(context.Background())
 }
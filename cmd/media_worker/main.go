@@ -9,8 +9,8 @@ import (
 
 	"era/booru/internal/config"
 	"era/booru/internal/db"
-	"era/booru/internal/minio"
 	"era/booru/internal/queue"
+	"era/booru/internal/storage"
 	indexworker "era/booru/internal/workers/indexworker"
 	mediaworker "era/booru/internal/workers/mediaworker"
 
@@ -49,17 +49,23 @@ func main() {
 		log.Fatal(err)
 	}
 
-	// Initialize MinIO
-	m, err := minio.New(cfg)
+	// Initialize object storage
+	store, err := storage.New(cfg)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	// Register worker
 	river.AddWorker(workers, &mediaworker.ProcessWorker{
-		Minio: m,
-		DB:    database,
-		Cfg:   cfg,
+		Storage: store,
+		DB:      database,
+		Cfg:     cfg,
+	})
+
+	river.AddWorker(workers, &mediaworker.TranscodeWorker{
+		Storage: store,
+		DB:      database,
+		Cfg:     cfg,
 	})
 
 	river.AddWorker(workers, &indexworker.IndexWorker{
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/autotag"
+	"era/booru/ent/tag"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// AutoTag is the model entity for the AutoTag schema.
+type AutoTag struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID int `json:"tag_id,omitempty"`
+	// Text embedded for the tag, e.g. "a photo of a cat"
+	Prompt string `json:"prompt,omitempty"`
+	// Minimum cosine similarity for a suggestion
+	Threshold float64 `json:"threshold,omitempty"`
+	// Text embedding of the prompt; cleared when the prompt changes
+	Embedding *pgvector.Vector `json:"embedding,omitempty"`
+	// Model that produced the embedding
+	Model string `json:"model,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AutoTagQuery when eager-loading is set.
+	Edges        AutoTagEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AutoTagEdges holds the relations/edges for other nodes in the graph.
+type AutoTagEdges struct {
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AutoTagEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AutoTag) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case autotag.FieldEmbedding:
+			values[i] = &sql.NullScanner{S: new(pgvector.Vector)}
+		case autotag.FieldThreshold:
+			values[i] = new(sql.NullFloat64)
+		case autotag.FieldID, autotag.FieldTagID:
+			values[i] = new(sql.NullInt64)
+		case autotag.FieldPrompt, autotag.FieldModel:
+			values[i] = new(sql.NullString)
+		case autotag.FieldCreatedAt, autotag.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AutoTag fields.
+func (at *AutoTag) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case autotag.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			at.ID = int(value.Int64)
+		case autotag.FieldTagID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				at.TagID = int(value.Int64)
+			}
+		case autotag.FieldPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt", values[i])
+			} else if value.Valid {
+				at.Prompt = value.String
+			}
+		case autotag.FieldThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				at.Threshold = value.Float64
+			}
+		case autotag.FieldEmbedding:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value.Valid {
+				at.Embedding = new(pgvector.Vector)
+				*at.Embedding = *value.S.(*pgvector.Vector)
+			}
+		case autotag.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				at.Model = value.String
+			}
+		case autotag.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				at.CreatedAt = value.Time
+			}
+		case autotag.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				at.UpdatedAt = value.Time
+			}
+		default:
+			at.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AutoTag.
+// This includes values selected through modifiers, order, etc.
+func (at *AutoTag) Value(name string) (ent.Value, error) {
+	return at.selectValues.Get(name)
+}
+
+// QueryTag queries the "tag" edge of the AutoTag entity.
+func (at *AutoTag) QueryTag() *TagQuery {
+	return NewAutoTagClient(at.config).QueryTag(at)
+}
+
+// Update returns a builder for updating this AutoTag.
+// Note that you need to call AutoTag.Unwrap() before calling this method if this AutoTag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *AutoTag) Update() *AutoTagUpdateOne {
+	return NewAutoTagClient(at.config).UpdateOne(at)
+}
+
+// Unwrap unwraps the AutoTag entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *AutoTag) Unwrap() *AutoTag {
+	_tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AutoTag is not a transactional entity")
+	}
+	at.config.driver = _tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *AutoTag) String() string {
+	var builder strings.Builder
+	builder.WriteString("AutoTag(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", at.ID))
+	builder.WriteString("tag_id=")
+	builder.WriteString(fmt.Sprintf("%v", at.TagID))
+	builder.WriteString(", ")
+	builder.WriteString("prompt=")
+	builder.WriteString(at.Prompt)
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", at.Threshold))
+	builder.WriteString(", ")
+	if v := at.Embedding; v != nil {
+		builder.WriteString("embedding=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(at.Model)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(at.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AutoTags is a parsable slice of AutoTag.
+type AutoTags []*AutoTag
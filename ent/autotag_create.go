@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/autotag"
+	"era/booru/ent/tag"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// AutoTagCreate is the builder for creating a AutoTag entity.
+type AutoTagCreate struct {
+	config
+	mutation *AutoTagMutation
+	hooks    []Hook
+}
+
+// SetTagID sets the "tag_id" field.
+func (atc *AutoTagCreate) SetTagID(i int) *AutoTagCreate {
+	atc.mutation.SetTagID(i)
+	return atc
+}
+
+// SetPrompt sets the "prompt" field.
+func (atc *AutoTagCreate) SetPrompt(s string) *AutoTagCreate {
+	atc.mutation.SetPrompt(s)
+	return atc
+}
+
+// SetThreshold sets the "threshold" field.
+func (atc *AutoTagCreate) SetThreshold(f float64) *AutoTagCreate {
+	atc.mutation.SetThreshold(f)
+	return atc
+}
+
+// SetEmbedding sets the "embedding" field.
+func (atc *AutoTagCreate) SetEmbedding(pg pgvector.Vector) *AutoTagCreate {
+	atc.mutation.SetEmbedding(pg)
+	return atc
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (atc *AutoTagCreate) SetNillableEmbedding(pg *pgvector.Vector) *AutoTagCreate {
+	if pg != nil {
+		atc.SetEmbedding(*pg)
+	}
+	return atc
+}
+
+// SetModel sets the "model" field.
+func (atc *AutoTagCreate) SetModel(s string) *AutoTagCreate {
+	atc.mutation.SetModel(s)
+	return atc
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (atc *AutoTagCreate) SetNillableModel(s *string) *AutoTagCreate {
+	if s != nil {
+		atc.SetModel(*s)
+	}
+	return atc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *AutoTagCreate) SetCreatedAt(t time.Time) *AutoTagCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *AutoTagCreate) SetNillableCreatedAt(t *time.Time) *AutoTagCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atc *AutoTagCreate) SetUpdatedAt(t time.Time) *AutoTagCreate {
+	atc.mutation.SetUpdatedAt(t)
+	return atc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (atc *AutoTagCreate) SetNillableUpdatedAt(t *time.Time) *AutoTagCreate {
+	if t != nil {
+		atc.SetUpdatedAt(*t)
+	}
+	return atc
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (atc *AutoTagCreate) SetTag(t *Tag) *AutoTagCreate {
+	return atc.SetTagID(t.ID)
+}
+
+// Mutation returns the AutoTagMutation object of the builder.
+func (atc *AutoTagCreate) Mutation() *AutoTagMutation {
+	return atc.mutation
+}
+
+// Save creates the AutoTag in the database.
+func (atc *AutoTagCreate) Save(ctx context.Context) (*AutoTag, error) {
+	atc.defaults()
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *AutoTagCreate) SaveX(ctx context.Context) *AutoTag {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *AutoTagCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *AutoTagCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *AutoTagCreate) defaults() {
+	if _, ok := atc.mutation.Model(); !ok {
+		v := autotag.DefaultModel
+		atc.mutation.SetModel(v)
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		v := autotag.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		v := autotag.DefaultUpdatedAt()
+		atc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *AutoTagCreate) check() error {
+	if _, ok := atc.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "AutoTag.tag_id"`)}
+	}
+	if _, ok := atc.mutation.Prompt(); !ok {
+		return &ValidationError{Name: "prompt", err: errors.New(`ent: missing required field "AutoTag.prompt"`)}
+	}
+	if v, ok := atc.mutation.Prompt(); ok {
+		if err := autotag.PromptValidator(v); err != nil {
+			return &ValidationError{Name: "prompt", err: fmt.Errorf(`ent: validator failed for field "AutoTag.prompt": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "AutoTag.threshold"`)}
+	}
+	if v, ok := atc.mutation.Threshold(); ok {
+		if err := autotag.ThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "threshold", err: fmt.Errorf(`ent: validator failed for field "AutoTag.threshold": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "AutoTag.model"`)}
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AutoTag.created_at"`)}
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AutoTag.updated_at"`)}
+	}
+	if len(atc.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "AutoTag.tag"`)}
+	}
+	return nil
+}
+
+func (atc *AutoTagCreate) sqlSave(ctx context.Context) (*AutoTag, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *AutoTagCreate) createSpec() (*AutoTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AutoTag{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(autotag.Table, sqlgraph.NewFieldSpec(autotag.FieldID, field.TypeInt))
+	)
+	if value, ok := atc.mutation.Prompt(); ok {
+		_spec.SetField(autotag.FieldPrompt, field.TypeString, value)
+		_node.Prompt = value
+	}
+	if value, ok := atc.mutation.Threshold(); ok {
+		_spec.SetField(autotag.FieldThreshold, field.TypeFloat64, value)
+		_node.Threshold = value
+	}
+	if value, ok := atc.mutation.Embedding(); ok {
+		_spec.SetField(autotag.FieldEmbedding, field.TypeOther, value)
+		_node.Embedding = &value
+	}
+	if value, ok := atc.mutation.Model(); ok {
+		_spec.SetField(autotag.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(autotag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := atc.mutation.UpdatedAt(); ok {
+		_spec.SetField(autotag.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := atc.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   autotag.TagTable,
+			Columns: []string{autotag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AutoTagCreateBulk is the builder for creating many AutoTag entities in bulk.
+type AutoTagCreateBulk struct {
+	config
+	err      error
+	builders []*AutoTagCreate
+}
+
+// Save creates the AutoTag entities in the database.
+func (atcb *AutoTagCreateBulk) Save(ctx context.Context) ([]*AutoTag, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*AutoTag, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AutoTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *AutoTagCreateBulk) SaveX(ctx context.Context) []*AutoTag {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *AutoTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *AutoTagCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/autotag"
+	"era/booru/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// AutoTagUpdate is the builder for updating AutoTag entities.
+type AutoTagUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AutoTagMutation
+}
+
+// Where appends a list predicates to the AutoTagUpdate builder.
+func (atu *AutoTagUpdate) Where(ps ...predicate.AutoTag) *AutoTagUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetPrompt sets the "prompt" field.
+func (atu *AutoTagUpdate) SetPrompt(s string) *AutoTagUpdate {
+	atu.mutation.SetPrompt(s)
+	return atu
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (atu *AutoTagUpdate) SetNillablePrompt(s *string) *AutoTagUpdate {
+	if s != nil {
+		atu.SetPrompt(*s)
+	}
+	return atu
+}
+
+// SetThreshold sets the "threshold" field.
+func (atu *AutoTagUpdate) SetThreshold(f float64) *AutoTagUpdate {
+	atu.mutation.ResetThreshold()
+	atu.mutation.SetThreshold(f)
+	return atu
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (atu *AutoTagUpdate) SetNillableThreshold(f *float64) *AutoTagUpdate {
+	if f != nil {
+		atu.SetThreshold(*f)
+	}
+	return atu
+}
+
+// AddThreshold adds f to the "threshold" field.
+func (atu *AutoTagUpdate) AddThreshold(f float64) *AutoTagUpdate {
+	atu.mutation.AddThreshold(f)
+	return atu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (atu *AutoTagUpdate) SetEmbedding(pg pgvector.Vector) *AutoTagUpdate {
+	atu.mutation.SetEmbedding(pg)
+	return atu
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (atu *AutoTagUpdate) SetNillableEmbedding(pg *pgvector.Vector) *AutoTagUpdate {
+	if pg != nil {
+		atu.SetEmbedding(*pg)
+	}
+	return atu
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (atu *AutoTagUpdate) ClearEmbedding() *AutoTagUpdate {
+	atu.mutation.ClearEmbedding()
+	return atu
+}
+
+// SetModel sets the "model" field.
+func (atu *AutoTagUpdate) SetModel(s string) *AutoTagUpdate {
+	atu.mutation.SetModel(s)
+	return atu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (atu *AutoTagUpdate) SetNillableModel(s *string) *AutoTagUpdate {
+	if s != nil {
+		atu.SetModel(*s)
+	}
+	return atu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atu *AutoTagUpdate) SetUpdatedAt(t time.Time) *AutoTagUpdate {
+	atu.mutation.SetUpdatedAt(t)
+	return atu
+}
+
+// Mutation returns the AutoTagMutation object of the builder.
+func (atu *AutoTagUpdate) Mutation() *AutoTagMutation {
+	return atu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *AutoTagUpdate) Save(ctx context.Context) (int, error) {
+	atu.defaults()
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *AutoTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *AutoTagUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *AutoTagUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atu *AutoTagUpdate) defaults() {
+	if _, ok := atu.mutation.UpdatedAt(); !ok {
+		v := autotag.UpdateDefaultUpdatedAt()
+		atu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *AutoTagUpdate) check() error {
+	if v, ok := atu.mutation.Prompt(); ok {
+		if err := autotag.PromptValidator(v); err != nil {
+			return &ValidationError{Name: "prompt", err: fmt.Errorf(`ent: validator failed for field "AutoTag.prompt": %w`, err)}
+		}
+	}
+	if v, ok := atu.mutation.Threshold(); ok {
+		if err := autotag.ThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "threshold", err: fmt.Errorf(`ent: validator failed for field "AutoTag.threshold": %w`, err)}
+		}
+	}
+	if atu.mutation.TagCleared() && len(atu.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AutoTag.tag"`)
+	}
+	return nil
+}
+
+func (atu *AutoTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(autotag.Table, autotag.Columns, sqlgraph.NewFieldSpec(autotag.FieldID, field.TypeInt))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.Prompt(); ok {
+		_spec.SetField(autotag.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Threshold(); ok {
+		_spec.SetField(autotag.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := atu.mutation.AddedThreshold(); ok {
+		_spec.AddField(autotag.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := atu.mutation.Embedding(); ok {
+		_spec.SetField(autotag.FieldEmbedding, field.TypeOther, value)
+	}
+	if atu.mutation.EmbeddingCleared() {
+		_spec.ClearField(autotag.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := atu.mutation.Model(); ok {
+		_spec.SetField(autotag.FieldModel, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.UpdatedAt(); ok {
+		_spec.SetField(autotag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{autotag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// AutoTagUpdateOne is the builder for updating a single AutoTag entity.
+type AutoTagUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AutoTagMutation
+}
+
+// SetPrompt sets the "prompt" field.
+func (atuo *AutoTagUpdateOne) SetPrompt(s string) *AutoTagUpdateOne {
+	atuo.mutation.SetPrompt(s)
+	return atuo
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (atuo *AutoTagUpdateOne) SetNillablePrompt(s *string) *AutoTagUpdateOne {
+	if s != nil {
+		atuo.SetPrompt(*s)
+	}
+	return atuo
+}
+
+// SetThreshold sets the "threshold" field.
+func (atuo *AutoTagUpdateOne) SetThreshold(f float64) *AutoTagUpdateOne {
+	atuo.mutation.ResetThreshold()
+	atuo.mutation.SetThreshold(f)
+	return atuo
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (atuo *AutoTagUpdateOne) SetNillableThreshold(f *float64) *AutoTagUpdateOne {
+	if f != nil {
+		atuo.SetThreshold(*f)
+	}
+	return atuo
+}
+
+// AddThreshold adds f to the "threshold" field.
+func (atuo *AutoTagUpdateOne) AddThreshold(f float64) *AutoTagUpdateOne {
+	atuo.mutation.AddThreshold(f)
+	return atuo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (atuo *AutoTagUpdateOne) SetEmbedding(pg pgvector.Vector) *AutoTagUpdateOne {
+	atuo.mutation.SetEmbedding(pg)
+	return atuo
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (atuo *AutoTagUpdateOne) SetNillableEmbedding(pg *pgvector.Vector) *AutoTagUpdateOne {
+	if pg != nil {
+		atuo.SetEmbedding(*pg)
+	}
+	return atuo
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (atuo *AutoTagUpdateOne) ClearEmbedding() *AutoTagUpdateOne {
+	atuo.mutation.ClearEmbedding()
+	return atuo
+}
+
+// SetModel sets the "model" field.
+func (atuo *AutoTagUpdateOne) SetModel(s string) *AutoTagUpdateOne {
+	atuo.mutation.SetModel(s)
+	return atuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (atuo *AutoTagUpdateOne) SetNillableModel(s *string) *AutoTagUpdateOne {
+	if s != nil {
+		atuo.SetModel(*s)
+	}
+	return atuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atuo *AutoTagUpdateOne) SetUpdatedAt(t time.Time) *AutoTagUpdateOne {
+	atuo.mutation.SetUpdatedAt(t)
+	return atuo
+}
+
+// Mutation returns the AutoTagMutation object of the builder.
+func (atuo *AutoTagUpdateOne) Mutation() *AutoTagMutation {
+	return atuo.mutation
+}
+
+// Where appends a list predicates to the AutoTagUpdate builder.
+func (atuo *AutoTagUpdateOne) Where(ps ...predicate.AutoTag) *AutoTagUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *AutoTagUpdateOne) Select(field string, fields ...string) *AutoTagUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated AutoTag entity.
+func (atuo *AutoTagUpdateOne) Save(ctx context.Context) (*AutoTag, error) {
+	atuo.defaults()
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *AutoTagUpdateOne) SaveX(ctx context.Context) *AutoTag {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *AutoTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *AutoTagUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atuo *AutoTagUpdateOne) defaults() {
+	if _, ok := atuo.mutation.UpdatedAt(); !ok {
+		v := autotag.UpdateDefaultUpdatedAt()
+		atuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *AutoTagUpdateOne) check() error {
+	if v, ok := atuo.mutation.Prompt(); ok {
+		if err := autotag.PromptValidator(v); err != nil {
+			return &ValidationError{Name: "prompt", err: fmt.Errorf(`ent: validator failed for field "AutoTag.prompt": %w`, err)}
+		}
+	}
+	if v, ok := atuo.mutation.Threshold(); ok {
+		if err := autotag.ThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "threshold", err: fmt.Errorf(`ent: validator failed for field "AutoTag.threshold": %w`, err)}
+		}
+	}
+	if atuo.mutation.TagCleared() && len(atuo.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AutoTag.tag"`)
+	}
+	return nil
+}
+
+func (atuo *AutoTagUpdateOne) sqlSave(ctx context.Context) (_node *AutoTag, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(autotag.Table, autotag.Columns, sqlgraph.NewFieldSpec(autotag.FieldID, field.TypeInt))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AutoTag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, autotag.FieldID)
+		for _, f := range fields {
+			if !autotag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != autotag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.Prompt(); ok {
+		_spec.SetField(autotag.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Threshold(); ok {
+		_spec.SetField(autotag.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := atuo.mutation.AddedThreshold(); ok {
+		_spec.AddField(autotag.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := atuo.mutation.Embedding(); ok {
+		_spec.SetField(autotag.FieldEmbedding, field.TypeOther, value)
+	}
+	if atuo.mutation.EmbeddingCleared() {
+		_spec.ClearField(autotag.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := atuo.mutation.Model(); ok {
+		_spec.SetField(autotag.FieldModel, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(autotag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &AutoTag{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{autotag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
@@ -11,13 +11,28 @@ import (
 
 	"era/booru/ent/migrate"
 
+	"era/booru/ent/auditlog"
+	"era/booru/ent/autotag"
+	"era/booru/ent/comment"
 	"era/booru/ent/date"
+	"era/booru/ent/favorite"
 	"era/booru/ent/hiddentagfilter"
 	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/mediarevision"
 	"era/booru/ent/mediavector"
+	"era/booru/ent/mediavote"
+	"era/booru/ent/metafield"
+	"era/booru/ent/note"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"era/booru/ent/rendition"
 	"era/booru/ent/setting"
+	"era/booru/ent/source"
 	"era/booru/ent/tag"
+	"era/booru/ent/tagsuggestion"
+	"era/booru/ent/tagvector"
 	"era/booru/ent/vector"
 
 	"entgo.io/ent"
@@ -31,20 +46,50 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// AutoTag is the client for interacting with the AutoTag builders.
+	AutoTag *AutoTagClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
 	// Date is the client for interacting with the Date builders.
 	Date *DateClient
+	// Favorite is the client for interacting with the Favorite builders.
+	Favorite *FavoriteClient
 	// HiddenTagFilter is the client for interacting with the HiddenTagFilter builders.
 	HiddenTagFilter *HiddenTagFilterClient
 	// Media is the client for interacting with the Media builders.
 	Media *MediaClient
 	// MediaDate is the client for interacting with the MediaDate builders.
 	MediaDate *MediaDateClient
+	// MediaField is the client for interacting with the MediaField builders.
+	MediaField *MediaFieldClient
+	// MediaRevision is the client for interacting with the MediaRevision builders.
+	MediaRevision *MediaRevisionClient
 	// MediaVector is the client for interacting with the MediaVector builders.
 	MediaVector *MediaVectorClient
+	// MediaVote is the client for interacting with the MediaVote builders.
+	MediaVote *MediaVoteClient
+	// MetaField is the client for interacting with the MetaField builders.
+	MetaField *MetaFieldClient
+	// Note is the client for interacting with the Note builders.
+	Note *NoteClient
+	// Pool is the client for interacting with the Pool builders.
+	Pool *PoolClient
+	// PoolMedia is the client for interacting with the PoolMedia builders.
+	PoolMedia *PoolMediaClient
+	// Rendition is the client for interacting with the Rendition builders.
+	Rendition *RenditionClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// Source is the client for interacting with the Source builders.
+	Source *SourceClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// TagSuggestion is the client for interacting with the TagSuggestion builders.
+	TagSuggestion *TagSuggestionClient
+	// TagVector is the client for interacting with the TagVector builders.
+	TagVector *TagVectorClient
 	// Vector is the client for interacting with the Vector builders.
 	Vector *VectorClient
 }
@@ -58,13 +103,28 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.AutoTag = NewAutoTagClient(c.config)
+	c.Comment = NewCommentClient(c.config)
 	c.Date = NewDateClient(c.config)
+	c.Favorite = NewFavoriteClient(c.config)
 	c.HiddenTagFilter = NewHiddenTagFilterClient(c.config)
 	c.Media = NewMediaClient(c.config)
 	c.MediaDate = NewMediaDateClient(c.config)
+	c.MediaField = NewMediaFieldClient(c.config)
+	c.MediaRevision = NewMediaRevisionClient(c.config)
 	c.MediaVector = NewMediaVectorClient(c.config)
+	c.MediaVote = NewMediaVoteClient(c.config)
+	c.MetaField = NewMetaFieldClient(c.config)
+	c.Note = NewNoteClient(c.config)
+	c.Pool = NewPoolClient(c.config)
+	c.PoolMedia = NewPoolMediaClient(c.config)
+	c.Rendition = NewRenditionClient(c.config)
 	c.Setting = NewSettingClient(c.config)
+	c.Source = NewSourceClient(c.config)
 	c.Tag = NewTagClient(c.config)
+	c.TagSuggestion = NewTagSuggestionClient(c.config)
+	c.TagVector = NewTagVectorClient(c.config)
 	c.Vector = NewVectorClient(c.config)
 }
 
@@ -158,13 +218,28 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:             ctx,
 		config:          cfg,
+		AuditLog:        NewAuditLogClient(cfg),
+		AutoTag:         NewAutoTagClient(cfg),
+		Comment:         NewCommentClient(cfg),
 		Date:            NewDateClient(cfg),
+		Favorite:        NewFavoriteClient(cfg),
 		HiddenTagFilter: NewHiddenTagFilterClient(cfg),
 		Media:           NewMediaClient(cfg),
 		MediaDate:       NewMediaDateClient(cfg),
+		MediaField:      NewMediaFieldClient(cfg),
+		MediaRevision:   NewMediaRevisionClient(cfg),
 		MediaVector:     NewMediaVectorClient(cfg),
+		MediaVote:       NewMediaVoteClient(cfg),
+		MetaField:       NewMetaFieldClient(cfg),
+		Note:            NewNoteClient(cfg),
+		Pool:            NewPoolClient(cfg),
+		PoolMedia:       NewPoolMediaClient(cfg),
+		Rendition:       NewRenditionClient(cfg),
 		Setting:         NewSettingClient(cfg),
+		Source:          NewSourceClient(cfg),
 		Tag:             NewTagClient(cfg),
+		TagSuggestion:   NewTagSuggestionClient(cfg),
+		TagVector:       NewTagVectorClient(cfg),
 		Vector:          NewVectorClient(cfg),
 	}, nil
 }
@@ -185,13 +260,28 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:             ctx,
 		config:          cfg,
+		AuditLog:        NewAuditLogClient(cfg),
+		AutoTag:         NewAutoTagClient(cfg),
+		Comment:         NewCommentClient(cfg),
 		Date:            NewDateClient(cfg),
+		Favorite:        NewFavoriteClient(cfg),
 		HiddenTagFilter: NewHiddenTagFilterClient(cfg),
 		Media:           NewMediaClient(cfg),
 		MediaDate:       NewMediaDateClient(cfg),
+		MediaField:      NewMediaFieldClient(cfg),
+		MediaRevision:   NewMediaRevisionClient(cfg),
 		MediaVector:     NewMediaVectorClient(cfg),
+		MediaVote:       NewMediaVoteClient(cfg),
+		MetaField:       NewMetaFieldClient(cfg),
+		Note:            NewNoteClient(cfg),
+		Pool:            NewPoolClient(cfg),
+		PoolMedia:       NewPoolMediaClient(cfg),
+		Rendition:       NewRenditionClient(cfg),
 		Setting:         NewSettingClient(cfg),
+		Source:          NewSourceClient(cfg),
 		Tag:             NewTagClient(cfg),
+		TagSuggestion:   NewTagSuggestionClient(cfg),
+		TagVector:       NewTagVectorClient(cfg),
 		Vector:          NewVectorClient(cfg),
 	}, nil
 }
@@ -199,7 +289,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Date.
+//		AuditLog.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -222,8 +312,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Date, c.HiddenTagFilter, c.Media, c.MediaDate, c.MediaVector, c.Setting,
-		c.Tag, c.Vector,
+		c.AuditLog, c.AutoTag, c.Comment, c.Date, c.Favorite, c.HiddenTagFilter,
+		c.Media, c.MediaDate, c.MediaField, c.MediaRevision, c.MediaVector,
+		c.MediaVote, c.MetaField, c.Note, c.Pool, c.PoolMedia, c.Rendition, c.Setting,
+		c.Source, c.Tag, c.TagSuggestion, c.TagVector, c.Vector,
 	} {
 		n.Use(hooks...)
 	}
@@ -233,8 +325,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Date, c.HiddenTagFilter, c.Media, c.MediaDate, c.MediaVector, c.Setting,
-		c.Tag, c.Vector,
+		c.AuditLog, c.AutoTag, c.Comment, c.Date, c.Favorite, c.HiddenTagFilter,
+		c.Media, c.MediaDate, c.MediaField, c.MediaRevision, c.MediaVector,
+		c.MediaVote, c.MetaField, c.Note, c.Pool, c.PoolMedia, c.Rendition, c.Setting,
+		c.Source, c.Tag, c.TagSuggestion, c.TagVector, c.Vector,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -243,20 +337,50 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *AutoTagMutation:
+		return c.AutoTag.mutate(ctx, m)
+	case *CommentMutation:
+		return c.Comment.mutate(ctx, m)
 	case *DateMutation:
 		return c.Date.mutate(ctx, m)
+	case *FavoriteMutation:
+		return c.Favorite.mutate(ctx, m)
 	case *HiddenTagFilterMutation:
 		return c.HiddenTagFilter.mutate(ctx, m)
 	case *MediaMutation:
 		return c.Media.mutate(ctx, m)
 	case *MediaDateMutation:
 		return c.MediaDate.mutate(ctx, m)
+	case *MediaFieldMutation:
+		return c.MediaField.mutate(ctx, m)
+	case *MediaRevisionMutation:
+		return c.MediaRevision.mutate(ctx, m)
 	case *MediaVectorMutation:
 		return c.MediaVector.mutate(ctx, m)
+	case *MediaVoteMutation:
+		return c.MediaVote.mutate(ctx, m)
+	case *MetaFieldMutation:
+		return c.MetaField.mutate(ctx, m)
+	case *NoteMutation:
+		return c.Note.mutate(ctx, m)
+	case *PoolMutation:
+		return c.Pool.mutate(ctx, m)
+	case *PoolMediaMutation:
+		return c.PoolMedia.mutate(ctx, m)
+	case *RenditionMutation:
+		return c.Rendition.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
+	case *SourceMutation:
+		return c.Source.mutate(ctx, m)
 	case *TagMutation:
 		return c.Tag.mutate(ctx, m)
+	case *TagSuggestionMutation:
+		return c.TagSuggestion.mutate(ctx, m)
+	case *TagVectorMutation:
+		return c.TagVector.mutate(ctx, m)
 	case *VectorMutation:
 		return c.Vector.mutate(ctx, m)
 	default:
@@ -264,107 +388,107 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
-// DateClient is a client for the Date schema.
-type DateClient struct {
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
 	config
 }
 
-// NewDateClient returns a client for the Date from the given config.
-func NewDateClient(c config) *DateClient {
-	return &DateClient{config: c}
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `date.Hooks(f(g(h())))`.
-func (c *DateClient) Use(hooks ...Hook) {
-	c.hooks.Date = append(c.hooks.Date, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `date.Intercept(f(g(h())))`.
-func (c *DateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Date = append(c.inters.Date, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a Date entity.
-func (c *DateClient) Create() *DateCreate {
-	mutation := newDateMutation(c.config, OpCreate)
-	return &DateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Date entities.
-func (c *DateClient) CreateBulk(builders ...*DateCreate) *DateCreateBulk {
-	return &DateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DateClient) MapCreateBulk(slice any, setFunc func(*DateCreate, int)) *DateCreateBulk {
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DateCreateBulk{err: fmt.Errorf("calling to DateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DateCreate, rv.Len())
+	builders := make([]*AuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DateCreateBulk{config: c.config, builders: builders}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Date.
-func (c *DateClient) Update() *DateUpdate {
-	mutation := newDateMutation(c.config, OpUpdate)
-	return &DateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DateClient) UpdateOne(d *Date) *DateUpdateOne {
-	mutation := newDateMutation(c.config, OpUpdateOne, withDate(d))
-	return &DateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DateClient) UpdateOneID(id int) *DateUpdateOne {
-	mutation := newDateMutation(c.config, OpUpdateOne, withDateID(id))
-	return &DateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Date.
-func (c *DateClient) Delete() *DateDelete {
-	mutation := newDateMutation(c.config, OpDelete)
-	return &DateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DateClient) DeleteOne(d *Date) *DateDeleteOne {
-	return c.DeleteOneID(d.ID)
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DateClient) DeleteOneID(id int) *DateDeleteOne {
-	builder := c.Delete().Where(date.ID(id))
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DateDeleteOne{builder}
+	return &AuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for Date.
-func (c *DateClient) Query() *DateQuery {
-	return &DateQuery{
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDate},
+		ctx:    &QueryContext{Type: TypeAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Date entity by its id.
-func (c *DateClient) Get(ctx context.Context, id int) (*Date, error) {
-	return c.Query().Where(date.ID(id)).Only(ctx)
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DateClient) GetX(ctx context.Context, id int) *Date {
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -372,164 +496,132 @@ func (c *DateClient) GetX(ctx context.Context, id int) *Date {
 	return obj
 }
 
-// QueryMedia queries the media edge of a Date.
-func (c *DateClient) QueryMedia(d *Date) *MediaQuery {
-	query := (&MediaClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(date.Table, date.FieldID, id),
-			sqlgraph.To(media.Table, media.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, date.MediaTable, date.MediaPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMediaDates queries the media_dates edge of a Date.
-func (c *DateClient) QueryMediaDates(d *Date) *MediaDateQuery {
-	query := (&MediaDateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(date.Table, date.FieldID, id),
-			sqlgraph.To(mediadate.Table, mediadate.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, date.MediaDatesTable, date.MediaDatesColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DateClient) Hooks() []Hook {
-	return c.hooks.Date
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *DateClient) Interceptors() []Interceptor {
-	return c.inters.Date
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
 }
 
-func (c *DateClient) mutate(ctx context.Context, m *DateMutation) (Value, error) {
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Date mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
 	}
 }
 
-// HiddenTagFilterClient is a client for the HiddenTagFilter schema.
-type HiddenTagFilterClient struct {
+// AutoTagClient is a client for the AutoTag schema.
+type AutoTagClient struct {
 	config
 }
 
-// NewHiddenTagFilterClient returns a client for the HiddenTagFilter from the given config.
-func NewHiddenTagFilterClient(c config) *HiddenTagFilterClient {
-	return &HiddenTagFilterClient{config: c}
+// NewAutoTagClient returns a client for the AutoTag from the given config.
+func NewAutoTagClient(c config) *AutoTagClient {
+	return &AutoTagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `hiddentagfilter.Hooks(f(g(h())))`.
-func (c *HiddenTagFilterClient) Use(hooks ...Hook) {
-	c.hooks.HiddenTagFilter = append(c.hooks.HiddenTagFilter, hooks...)
+// A call to `Use(f, g, h)` equals to `autotag.Hooks(f(g(h())))`.
+func (c *AutoTagClient) Use(hooks ...Hook) {
+	c.hooks.AutoTag = append(c.hooks.AutoTag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `hiddentagfilter.Intercept(f(g(h())))`.
-func (c *HiddenTagFilterClient) Intercept(interceptors ...Interceptor) {
-	c.inters.HiddenTagFilter = append(c.inters.HiddenTagFilter, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `autotag.Intercept(f(g(h())))`.
+func (c *AutoTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AutoTag = append(c.inters.AutoTag, interceptors...)
 }
 
-// Create returns a builder for creating a HiddenTagFilter entity.
-func (c *HiddenTagFilterClient) Create() *HiddenTagFilterCreate {
-	mutation := newHiddenTagFilterMutation(c.config, OpCreate)
-	return &HiddenTagFilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AutoTag entity.
+func (c *AutoTagClient) Create() *AutoTagCreate {
+	mutation := newAutoTagMutation(c.config, OpCreate)
+	return &AutoTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of HiddenTagFilter entities.
-func (c *HiddenTagFilterClient) CreateBulk(builders ...*HiddenTagFilterCreate) *HiddenTagFilterCreateBulk {
-	return &HiddenTagFilterCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AutoTag entities.
+func (c *AutoTagClient) CreateBulk(builders ...*AutoTagCreate) *AutoTagCreateBulk {
+	return &AutoTagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *HiddenTagFilterClient) MapCreateBulk(slice any, setFunc func(*HiddenTagFilterCreate, int)) *HiddenTagFilterCreateBulk {
+func (c *AutoTagClient) MapCreateBulk(slice any, setFunc func(*AutoTagCreate, int)) *AutoTagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &HiddenTagFilterCreateBulk{err: fmt.Errorf("calling to HiddenTagFilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AutoTagCreateBulk{err: fmt.Errorf("calling to AutoTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*HiddenTagFilterCreate, rv.Len())
+	builders := make([]*AutoTagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &HiddenTagFilterCreateBulk{config: c.config, builders: builders}
+	return &AutoTagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for HiddenTagFilter.
-func (c *HiddenTagFilterClient) Update() *HiddenTagFilterUpdate {
-	mutation := newHiddenTagFilterMutation(c.config, OpUpdate)
-	return &HiddenTagFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AutoTag.
+func (c *AutoTagClient) Update() *AutoTagUpdate {
+	mutation := newAutoTagMutation(c.config, OpUpdate)
+	return &AutoTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *HiddenTagFilterClient) UpdateOne(htf *HiddenTagFilter) *HiddenTagFilterUpdateOne {
-	mutation := newHiddenTagFilterMutation(c.config, OpUpdateOne, withHiddenTagFilter(htf))
-	return &HiddenTagFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AutoTagClient) UpdateOne(at *AutoTag) *AutoTagUpdateOne {
+	mutation := newAutoTagMutation(c.config, OpUpdateOne, withAutoTag(at))
+	return &AutoTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *HiddenTagFilterClient) UpdateOneID(id int) *HiddenTagFilterUpdateOne {
-	mutation := newHiddenTagFilterMutation(c.config, OpUpdateOne, withHiddenTagFilterID(id))
-	return &HiddenTagFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AutoTagClient) UpdateOneID(id int) *AutoTagUpdateOne {
+	mutation := newAutoTagMutation(c.config, OpUpdateOne, withAutoTagID(id))
+	return &AutoTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for HiddenTagFilter.
-func (c *HiddenTagFilterClient) Delete() *HiddenTagFilterDelete {
-	mutation := newHiddenTagFilterMutation(c.config, OpDelete)
-	return &HiddenTagFilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AutoTag.
+func (c *AutoTagClient) Delete() *AutoTagDelete {
+	mutation := newAutoTagMutation(c.config, OpDelete)
+	return &AutoTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *HiddenTagFilterClient) DeleteOne(htf *HiddenTagFilter) *HiddenTagFilterDeleteOne {
-	return c.DeleteOneID(htf.ID)
+func (c *AutoTagClient) DeleteOne(at *AutoTag) *AutoTagDeleteOne {
+	return c.DeleteOneID(at.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *HiddenTagFilterClient) DeleteOneID(id int) *HiddenTagFilterDeleteOne {
-	builder := c.Delete().Where(hiddentagfilter.ID(id))
+func (c *AutoTagClient) DeleteOneID(id int) *AutoTagDeleteOne {
+	builder := c.Delete().Where(autotag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &HiddenTagFilterDeleteOne{builder}
+	return &AutoTagDeleteOne{builder}
 }
 
-// Query returns a query builder for HiddenTagFilter.
-func (c *HiddenTagFilterClient) Query() *HiddenTagFilterQuery {
-	return &HiddenTagFilterQuery{
+// Query returns a query builder for AutoTag.
+func (c *AutoTagClient) Query() *AutoTagQuery {
+	return &AutoTagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeHiddenTagFilter},
+		ctx:    &QueryContext{Type: TypeAutoTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a HiddenTagFilter entity by its id.
-func (c *HiddenTagFilterClient) Get(ctx context.Context, id int) (*HiddenTagFilter, error) {
-	return c.Query().Where(hiddentagfilter.ID(id)).Only(ctx)
+// Get returns a AutoTag entity by its id.
+func (c *AutoTagClient) Get(ctx context.Context, id int) (*AutoTag, error) {
+	return c.Query().Where(autotag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *HiddenTagFilterClient) GetX(ctx context.Context, id int) *HiddenTagFilter {
+func (c *AutoTagClient) GetX(ctx context.Context, id int) *AutoTag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -537,132 +629,148 @@ func (c *HiddenTagFilterClient) GetX(ctx context.Context, id int) *HiddenTagFilt
 	return obj
 }
 
+// QueryTag queries the tag edge of a AutoTag.
+func (c *AutoTagClient) QueryTag(at *AutoTag) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := at.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(autotag.Table, autotag.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, autotag.TagTable, autotag.TagColumn),
+		)
+		fromV = sqlgraph.Neighbors(at.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *HiddenTagFilterClient) Hooks() []Hook {
-	return c.hooks.HiddenTagFilter
+func (c *AutoTagClient) Hooks() []Hook {
+	return c.hooks.AutoTag
 }
 
 // Interceptors returns the client interceptors.
-func (c *HiddenTagFilterClient) Interceptors() []Interceptor {
-	return c.inters.HiddenTagFilter
+func (c *AutoTagClient) Interceptors() []Interceptor {
+	return c.inters.AutoTag
 }
 
-func (c *HiddenTagFilterClient) mutate(ctx context.Context, m *HiddenTagFilterMutation) (Value, error) {
+func (c *AutoTagClient) mutate(ctx context.Context, m *AutoTagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HiddenTagFilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutoTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HiddenTagFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutoTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HiddenTagFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutoTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HiddenTagFilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AutoTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown HiddenTagFilter mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AutoTag mutation op: %q", m.Op())
 	}
 }
 
-// MediaClient is a client for the Media schema.
-type MediaClient struct {
+// CommentClient is a client for the Comment schema.
+type CommentClient struct {
 	config
 }
 
-// NewMediaClient returns a client for the Media from the given config.
-func NewMediaClient(c config) *MediaClient {
-	return &MediaClient{config: c}
+// NewCommentClient returns a client for the Comment from the given config.
+func NewCommentClient(c config) *CommentClient {
+	return &CommentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
-func (c *MediaClient) Use(hooks ...Hook) {
-	c.hooks.Media = append(c.hooks.Media, hooks...)
+// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
+func (c *CommentClient) Use(hooks ...Hook) {
+	c.hooks.Comment = append(c.hooks.Comment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
-func (c *MediaClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Media = append(c.inters.Media, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
 }
 
-// Create returns a builder for creating a Media entity.
-func (c *MediaClient) Create() *MediaCreate {
-	mutation := newMediaMutation(c.config, OpCreate)
-	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Comment entity.
+func (c *CommentClient) Create() *CommentCreate {
+	mutation := newCommentMutation(c.config, OpCreate)
+	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Media entities.
-func (c *MediaClient) CreateBulk(builders ...*MediaCreate) *MediaCreateBulk {
-	return &MediaCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Comment entities.
+func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
+	return &CommentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
+func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MediaCreate, rv.Len())
+	builders := make([]*CommentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MediaCreateBulk{config: c.config, builders: builders}
+	return &CommentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Media.
-func (c *MediaClient) Update() *MediaUpdate {
-	mutation := newMediaMutation(c.config, OpUpdate)
-	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Comment.
+func (c *CommentClient) Update() *CommentUpdate {
+	mutation := newCommentMutation(c.config, OpUpdate)
+	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MediaClient) UpdateOne(m *Media) *MediaUpdateOne {
-	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(m))
-	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentClient) UpdateOne(co *Comment) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(co))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MediaClient) UpdateOneID(id string) *MediaUpdateOne {
-	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
-	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Media.
-func (c *MediaClient) Delete() *MediaDelete {
-	mutation := newMediaMutation(c.config, OpDelete)
-	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Comment.
+func (c *CommentClient) Delete() *CommentDelete {
+	mutation := newCommentMutation(c.config, OpDelete)
+	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MediaClient) DeleteOne(m *Media) *MediaDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *CommentClient) DeleteOne(co *Comment) *CommentDeleteOne {
+	return c.DeleteOneID(co.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MediaClient) DeleteOneID(id string) *MediaDeleteOne {
-	builder := c.Delete().Where(media.ID(id))
+func (c *CommentClient) DeleteOneID(id int) *CommentDeleteOne {
+	builder := c.Delete().Where(comment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MediaDeleteOne{builder}
+	return &CommentDeleteOne{builder}
 }
 
-// Query returns a query builder for Media.
-func (c *MediaClient) Query() *MediaQuery {
-	return &MediaQuery{
+// Query returns a query builder for Comment.
+func (c *CommentClient) Query() *CommentQuery {
+	return &CommentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMedia},
+		ctx:    &QueryContext{Type: TypeComment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Media entity by its id.
-func (c *MediaClient) Get(ctx context.Context, id string) (*Media, error) {
-	return c.Query().Where(media.ID(id)).Only(ctx)
+// Get returns a Comment entity by its id.
+func (c *CommentClient) Get(ctx context.Context, id int) (*Comment, error) {
+	return c.Query().Where(comment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MediaClient) GetX(ctx context.Context, id string) *Media {
+func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -670,212 +778,2405 @@ func (c *MediaClient) GetX(ctx context.Context, id string) *Media {
 	return obj
 }
 
-// QueryTags queries the tags edge of a Media.
-func (c *MediaClient) QueryTags(m *Media) *TagQuery {
-	query := (&TagClient{config: c.config}).Query()
+// QueryMedia queries the media edge of a Comment.
+func (c *CommentClient) QueryMedia(co *Comment) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := co.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, media.TagsTable, media.TagsPrimaryKey...),
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, comment.MediaTable, comment.MediaColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryDates queries the dates edge of a Media.
-func (c *MediaClient) QueryDates(m *Media) *DateQuery {
-	query := (&DateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(date.Table, date.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, media.DatesTable, media.DatesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *CommentClient) Hooks() []Hook {
+	return c.hooks.Comment
 }
 
-// QueryVectors queries the vectors edge of a Media.
-func (c *MediaClient) QueryVectors(m *Media) *VectorQuery {
-	query := (&VectorClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(vector.Table, vector.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, media.VectorsTable, media.VectorsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
-		return fromV, nil
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
+}
+
+func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryMediaDates queries the media_dates edge of a Media.
-func (c *MediaClient) QueryMediaDates(m *Media) *MediaDateQuery {
+// DateClient is a client for the Date schema.
+type DateClient struct {
+	config
+}
+
+// NewDateClient returns a client for the Date from the given config.
+func NewDateClient(c config) *DateClient {
+	return &DateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `date.Hooks(f(g(h())))`.
+func (c *DateClient) Use(hooks ...Hook) {
+	c.hooks.Date = append(c.hooks.Date, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `date.Intercept(f(g(h())))`.
+func (c *DateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Date = append(c.inters.Date, interceptors...)
+}
+
+// Create returns a builder for creating a Date entity.
+func (c *DateClient) Create() *DateCreate {
+	mutation := newDateMutation(c.config, OpCreate)
+	return &DateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Date entities.
+func (c *DateClient) CreateBulk(builders ...*DateCreate) *DateCreateBulk {
+	return &DateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DateClient) MapCreateBulk(slice any, setFunc func(*DateCreate, int)) *DateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DateCreateBulk{err: fmt.Errorf("calling to DateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Date.
+func (c *DateClient) Update() *DateUpdate {
+	mutation := newDateMutation(c.config, OpUpdate)
+	return &DateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DateClient) UpdateOne(d *Date) *DateUpdateOne {
+	mutation := newDateMutation(c.config, OpUpdateOne, withDate(d))
+	return &DateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DateClient) UpdateOneID(id int) *DateUpdateOne {
+	mutation := newDateMutation(c.config, OpUpdateOne, withDateID(id))
+	return &DateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Date.
+func (c *DateClient) Delete() *DateDelete {
+	mutation := newDateMutation(c.config, OpDelete)
+	return &DateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DateClient) DeleteOne(d *Date) *DateDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DateClient) DeleteOneID(id int) *DateDeleteOne {
+	builder := c.Delete().Where(date.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DateDeleteOne{builder}
+}
+
+// Query returns a query builder for Date.
+func (c *DateClient) Query() *DateQuery {
+	return &DateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Date entity by its id.
+func (c *DateClient) Get(ctx context.Context, id int) (*Date, error) {
+	return c.Query().Where(date.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DateClient) GetX(ctx context.Context, id int) *Date {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a Date.
+func (c *DateClient) QueryMedia(d *Date) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(date.Table, date.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, date.MediaTable, date.MediaPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMediaDates queries the media_dates edge of a Date.
+func (c *DateClient) QueryMediaDates(d *Date) *MediaDateQuery {
 	query := (&MediaDateClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(date.Table, date.FieldID, id),
+			sqlgraph.To(mediadate.Table, mediadate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, date.MediaDatesTable, date.MediaDatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DateClient) Hooks() []Hook {
+	return c.hooks.Date
+}
+
+// Interceptors returns the client interceptors.
+func (c *DateClient) Interceptors() []Interceptor {
+	return c.inters.Date
+}
+
+func (c *DateClient) mutate(ctx context.Context, m *DateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Date mutation op: %q", m.Op())
+	}
+}
+
+// FavoriteClient is a client for the Favorite schema.
+type FavoriteClient struct {
+	config
+}
+
+// NewFavoriteClient returns a client for the Favorite from the given config.
+func NewFavoriteClient(c config) *FavoriteClient {
+	return &FavoriteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `favorite.Hooks(f(g(h())))`.
+func (c *FavoriteClient) Use(hooks ...Hook) {
+	c.hooks.Favorite = append(c.hooks.Favorite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `favorite.Intercept(f(g(h())))`.
+func (c *FavoriteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Favorite = append(c.inters.Favorite, interceptors...)
+}
+
+// Create returns a builder for creating a Favorite entity.
+func (c *FavoriteClient) Create() *FavoriteCreate {
+	mutation := newFavoriteMutation(c.config, OpCreate)
+	return &FavoriteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Favorite entities.
+func (c *FavoriteClient) CreateBulk(builders ...*FavoriteCreate) *FavoriteCreateBulk {
+	return &FavoriteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FavoriteClient) MapCreateBulk(slice any, setFunc func(*FavoriteCreate, int)) *FavoriteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FavoriteCreateBulk{err: fmt.Errorf("calling to FavoriteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FavoriteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FavoriteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Favorite.
+func (c *FavoriteClient) Update() *FavoriteUpdate {
+	mutation := newFavoriteMutation(c.config, OpUpdate)
+	return &FavoriteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FavoriteClient) UpdateOne(f *Favorite) *FavoriteUpdateOne {
+	mutation := newFavoriteMutation(c.config, OpUpdateOne, withFavorite(f))
+	return &FavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FavoriteClient) UpdateOneID(id int) *FavoriteUpdateOne {
+	mutation := newFavoriteMutation(c.config, OpUpdateOne, withFavoriteID(id))
+	return &FavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Favorite.
+func (c *FavoriteClient) Delete() *FavoriteDelete {
+	mutation := newFavoriteMutation(c.config, OpDelete)
+	return &FavoriteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FavoriteClient) DeleteOne(f *Favorite) *FavoriteDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FavoriteClient) DeleteOneID(id int) *FavoriteDeleteOne {
+	builder := c.Delete().Where(favorite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FavoriteDeleteOne{builder}
+}
+
+// Query returns a query builder for Favorite.
+func (c *FavoriteClient) Query() *FavoriteQuery {
+	return &FavoriteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFavorite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Favorite entity by its id.
+func (c *FavoriteClient) Get(ctx context.Context, id int) (*Favorite, error) {
+	return c.Query().Where(favorite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FavoriteClient) GetX(ctx context.Context, id int) *Favorite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a Favorite.
+func (c *FavoriteClient) QueryMedia(f *Favorite) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(favorite.Table, favorite.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, favorite.MediaTable, favorite.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FavoriteClient) Hooks() []Hook {
+	return c.hooks.Favorite
+}
+
+// Interceptors returns the client interceptors.
+func (c *FavoriteClient) Interceptors() []Interceptor {
+	return c.inters.Favorite
+}
+
+func (c *FavoriteClient) mutate(ctx context.Context, m *FavoriteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FavoriteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FavoriteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FavoriteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Favorite mutation op: %q", m.Op())
+	}
+}
+
+// HiddenTagFilterClient is a client for the HiddenTagFilter schema.
+type HiddenTagFilterClient struct {
+	config
+}
+
+// NewHiddenTagFilterClient returns a client for the HiddenTagFilter from the given config.
+func NewHiddenTagFilterClient(c config) *HiddenTagFilterClient {
+	return &HiddenTagFilterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hiddentagfilter.Hooks(f(g(h())))`.
+func (c *HiddenTagFilterClient) Use(hooks ...Hook) {
+	c.hooks.HiddenTagFilter = append(c.hooks.HiddenTagFilter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hiddentagfilter.Intercept(f(g(h())))`.
+func (c *HiddenTagFilterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HiddenTagFilter = append(c.inters.HiddenTagFilter, interceptors...)
+}
+
+// Create returns a builder for creating a HiddenTagFilter entity.
+func (c *HiddenTagFilterClient) Create() *HiddenTagFilterCreate {
+	mutation := newHiddenTagFilterMutation(c.config, OpCreate)
+	return &HiddenTagFilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HiddenTagFilter entities.
+func (c *HiddenTagFilterClient) CreateBulk(builders ...*HiddenTagFilterCreate) *HiddenTagFilterCreateBulk {
+	return &HiddenTagFilterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HiddenTagFilterClient) MapCreateBulk(slice any, setFunc func(*HiddenTagFilterCreate, int)) *HiddenTagFilterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HiddenTagFilterCreateBulk{err: fmt.Errorf("calling to HiddenTagFilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HiddenTagFilterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HiddenTagFilterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HiddenTagFilter.
+func (c *HiddenTagFilterClient) Update() *HiddenTagFilterUpdate {
+	mutation := newHiddenTagFilterMutation(c.config, OpUpdate)
+	return &HiddenTagFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HiddenTagFilterClient) UpdateOne(htf *HiddenTagFilter) *HiddenTagFilterUpdateOne {
+	mutation := newHiddenTagFilterMutation(c.config, OpUpdateOne, withHiddenTagFilter(htf))
+	return &HiddenTagFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HiddenTagFilterClient) UpdateOneID(id int) *HiddenTagFilterUpdateOne {
+	mutation := newHiddenTagFilterMutation(c.config, OpUpdateOne, withHiddenTagFilterID(id))
+	return &HiddenTagFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HiddenTagFilter.
+func (c *HiddenTagFilterClient) Delete() *HiddenTagFilterDelete {
+	mutation := newHiddenTagFilterMutation(c.config, OpDelete)
+	return &HiddenTagFilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HiddenTagFilterClient) DeleteOne(htf *HiddenTagFilter) *HiddenTagFilterDeleteOne {
+	return c.DeleteOneID(htf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HiddenTagFilterClient) DeleteOneID(id int) *HiddenTagFilterDeleteOne {
+	builder := c.Delete().Where(hiddentagfilter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HiddenTagFilterDeleteOne{builder}
+}
+
+// Query returns a query builder for HiddenTagFilter.
+func (c *HiddenTagFilterClient) Query() *HiddenTagFilterQuery {
+	return &HiddenTagFilterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHiddenTagFilter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HiddenTagFilter entity by its id.
+func (c *HiddenTagFilterClient) Get(ctx context.Context, id int) (*HiddenTagFilter, error) {
+	return c.Query().Where(hiddentagfilter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HiddenTagFilterClient) GetX(ctx context.Context, id int) *HiddenTagFilter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *HiddenTagFilterClient) Hooks() []Hook {
+	return c.hooks.HiddenTagFilter
+}
+
+// Interceptors returns the client interceptors.
+func (c *HiddenTagFilterClient) Interceptors() []Interceptor {
+	return c.inters.HiddenTagFilter
+}
+
+func (c *HiddenTagFilterClient) mutate(ctx context.Context, m *HiddenTagFilterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HiddenTagFilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HiddenTagFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HiddenTagFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HiddenTagFilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HiddenTagFilter mutation op: %q", m.Op())
+	}
+}
+
+// MediaClient is a client for the Media schema.
+type MediaClient struct {
+	config
+}
+
+// NewMediaClient returns a client for the Media from the given config.
+func NewMediaClient(c config) *MediaClient {
+	return &MediaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
+func (c *MediaClient) Use(hooks ...Hook) {
+	c.hooks.Media = append(c.hooks.Media, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
+func (c *MediaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Media = append(c.inters.Media, interceptors...)
+}
+
+// Create returns a builder for creating a Media entity.
+func (c *MediaClient) Create() *MediaCreate {
+	mutation := newMediaMutation(c.config, OpCreate)
+	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Media entities.
+func (c *MediaClient) CreateBulk(builders ...*MediaCreate) *MediaCreateBulk {
+	return &MediaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Media.
+func (c *MediaClient) Update() *MediaUpdate {
+	mutation := newMediaMutation(c.config, OpUpdate)
+	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaClient) UpdateOne(m *Media) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(m))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaClient) UpdateOneID(id string) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Media.
+func (c *MediaClient) Delete() *MediaDelete {
+	mutation := newMediaMutation(c.config, OpDelete)
+	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaClient) DeleteOne(m *Media) *MediaDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaClient) DeleteOneID(id string) *MediaDeleteOne {
+	builder := c.Delete().Where(media.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaDeleteOne{builder}
+}
+
+// Query returns a query builder for Media.
+func (c *MediaClient) Query() *MediaQuery {
+	return &MediaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMedia},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Media entity by its id.
+func (c *MediaClient) Get(ctx context.Context, id string) (*Media, error) {
+	return c.Query().Where(media.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaClient) GetX(ctx context.Context, id string) *Media {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTags queries the tags edge of a Media.
+func (c *MediaClient) QueryTags(m *Media) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, media.TagsTable, media.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDates queries the dates edge of a Media.
+func (c *MediaClient) QueryDates(m *Media) *DateQuery {
+	query := (&DateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(date.Table, date.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, media.DatesTable, media.DatesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVectors queries the vectors edge of a Media.
+func (c *MediaClient) QueryVectors(m *Media) *VectorQuery {
+	query := (&VectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(vector.Table, vector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, media.VectorsTable, media.VectorsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMetaFields queries the meta_fields edge of a Media.
+func (c *MediaClient) QueryMetaFields(m *Media) *MetaFieldQuery {
+	query := (&MetaFieldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(metafield.Table, metafield.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, media.MetaFieldsTable, media.MetaFieldsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPools queries the pools edge of a Media.
+func (c *MediaClient) QueryPools(m *Media) *PoolQuery {
+	query := (&PoolClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(pool.Table, pool.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, media.PoolsTable, media.PoolsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFavorites queries the favorites edge of a Media.
+func (c *MediaClient) QueryFavorites(m *Media) *FavoriteQuery {
+	query := (&FavoriteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(favorite.Table, favorite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.FavoritesTable, media.FavoritesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a Media.
+func (c *MediaClient) QueryComments(m *Media) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.CommentsTable, media.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotes queries the notes edge of a Media.
+func (c *MediaClient) QueryNotes(m *Media) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.NotesTable, media.NotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySources queries the sources edge of a Media.
+func (c *MediaClient) QuerySources(m *Media) *SourceQuery {
+	query := (&SourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(source.Table, source.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.SourcesTable, media.SourcesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMediaDates queries the media_dates edge of a Media.
+func (c *MediaClient) QueryMediaDates(m *Media) *MediaDateQuery {
+	query := (&MediaDateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(mediadate.Table, mediadate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.MediaDatesTable, media.MediaDatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMediaVectors queries the media_vectors edge of a Media.
+func (c *MediaClient) QueryMediaVectors(m *Media) *MediaVectorQuery {
+	query := (&MediaVectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(mediavector.Table, mediavector.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.MediaVectorsTable, media.MediaVectorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMediaFields queries the media_fields edge of a Media.
+func (c *MediaClient) QueryMediaFields(m *Media) *MediaFieldQuery {
+	query := (&MediaFieldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(mediafield.Table, mediafield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.MediaFieldsTable, media.MediaFieldsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPoolMedia queries the pool_media edge of a Media.
+func (c *MediaClient) QueryPoolMedia(m *Media) *PoolMediaQuery {
+	query := (&PoolMediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(poolmedia.Table, poolmedia.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.PoolMediaTable, media.PoolMediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaClient) Hooks() []Hook {
+	return c.hooks.Media
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaClient) Interceptors() []Interceptor {
+	return c.inters.Media
+}
+
+func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
+	}
+}
+
+// MediaDateClient is a client for the MediaDate schema.
+type MediaDateClient struct {
+	config
+}
+
+// NewMediaDateClient returns a client for the MediaDate from the given config.
+func NewMediaDateClient(c config) *MediaDateClient {
+	return &MediaDateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mediadate.Hooks(f(g(h())))`.
+func (c *MediaDateClient) Use(hooks ...Hook) {
+	c.hooks.MediaDate = append(c.hooks.MediaDate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mediadate.Intercept(f(g(h())))`.
+func (c *MediaDateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MediaDate = append(c.inters.MediaDate, interceptors...)
+}
+
+// Create returns a builder for creating a MediaDate entity.
+func (c *MediaDateClient) Create() *MediaDateCreate {
+	mutation := newMediaDateMutation(c.config, OpCreate)
+	return &MediaDateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MediaDate entities.
+func (c *MediaDateClient) CreateBulk(builders ...*MediaDateCreate) *MediaDateCreateBulk {
+	return &MediaDateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaDateClient) MapCreateBulk(slice any, setFunc func(*MediaDateCreate, int)) *MediaDateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaDateCreateBulk{err: fmt.Errorf("calling to MediaDateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaDateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaDateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MediaDate.
+func (c *MediaDateClient) Update() *MediaDateUpdate {
+	mutation := newMediaDateMutation(c.config, OpUpdate)
+	return &MediaDateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaDateClient) UpdateOne(md *MediaDate) *MediaDateUpdateOne {
+	mutation := newMediaDateMutation(c.config, OpUpdateOne, withMediaDate(md))
+	return &MediaDateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaDateClient) UpdateOneID(id int) *MediaDateUpdateOne {
+	mutation := newMediaDateMutation(c.config, OpUpdateOne, withMediaDateID(id))
+	return &MediaDateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MediaDate.
+func (c *MediaDateClient) Delete() *MediaDateDelete {
+	mutation := newMediaDateMutation(c.config, OpDelete)
+	return &MediaDateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaDateClient) DeleteOne(md *MediaDate) *MediaDateDeleteOne {
+	return c.DeleteOneID(md.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaDateClient) DeleteOneID(id int) *MediaDateDeleteOne {
+	builder := c.Delete().Where(mediadate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaDateDeleteOne{builder}
+}
+
+// Query returns a query builder for MediaDate.
+func (c *MediaDateClient) Query() *MediaDateQuery {
+	return &MediaDateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMediaDate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MediaDate entity by its id.
+func (c *MediaDateClient) Get(ctx context.Context, id int) (*MediaDate, error) {
+	return c.Query().Where(mediadate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaDateClient) GetX(ctx context.Context, id int) *MediaDate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a MediaDate.
+func (c *MediaDateClient) QueryMedia(md *MediaDate) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := md.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediadate.Table, mediadate.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediadate.MediaTable, mediadate.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(md.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDate queries the date edge of a MediaDate.
+func (c *MediaDateClient) QueryDate(md *MediaDate) *DateQuery {
+	query := (&DateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := md.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediadate.Table, mediadate.FieldID, id),
+			sqlgraph.To(date.Table, date.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediadate.DateTable, mediadate.DateColumn),
+		)
+		fromV = sqlgraph.Neighbors(md.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaDateClient) Hooks() []Hook {
+	return c.hooks.MediaDate
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaDateClient) Interceptors() []Interceptor {
+	return c.inters.MediaDate
+}
+
+func (c *MediaDateClient) mutate(ctx context.Context, m *MediaDateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaDateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaDateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaDateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaDateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MediaDate mutation op: %q", m.Op())
+	}
+}
+
+// MediaFieldClient is a client for the MediaField schema.
+type MediaFieldClient struct {
+	config
+}
+
+// NewMediaFieldClient returns a client for the MediaField from the given config.
+func NewMediaFieldClient(c config) *MediaFieldClient {
+	return &MediaFieldClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mediafield.Hooks(f(g(h())))`.
+func (c *MediaFieldClient) Use(hooks ...Hook) {
+	c.hooks.MediaField = append(c.hooks.MediaField, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mediafield.Intercept(f(g(h())))`.
+func (c *MediaFieldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MediaField = append(c.inters.MediaField, interceptors...)
+}
+
+// Create returns a builder for creating a MediaField entity.
+func (c *MediaFieldClient) Create() *MediaFieldCreate {
+	mutation := newMediaFieldMutation(c.config, OpCreate)
+	return &MediaFieldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MediaField entities.
+func (c *MediaFieldClient) CreateBulk(builders ...*MediaFieldCreate) *MediaFieldCreateBulk {
+	return &MediaFieldCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaFieldClient) MapCreateBulk(slice any, setFunc func(*MediaFieldCreate, int)) *MediaFieldCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaFieldCreateBulk{err: fmt.Errorf("calling to MediaFieldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaFieldCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaFieldCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MediaField.
+func (c *MediaFieldClient) Update() *MediaFieldUpdate {
+	mutation := newMediaFieldMutation(c.config, OpUpdate)
+	return &MediaFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaFieldClient) UpdateOne(mf *MediaField) *MediaFieldUpdateOne {
+	mutation := newMediaFieldMutation(c.config, OpUpdateOne, withMediaField(mf))
+	return &MediaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaFieldClient) UpdateOneID(id int) *MediaFieldUpdateOne {
+	mutation := newMediaFieldMutation(c.config, OpUpdateOne, withMediaFieldID(id))
+	return &MediaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MediaField.
+func (c *MediaFieldClient) Delete() *MediaFieldDelete {
+	mutation := newMediaFieldMutation(c.config, OpDelete)
+	return &MediaFieldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaFieldClient) DeleteOne(mf *MediaField) *MediaFieldDeleteOne {
+	return c.DeleteOneID(mf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaFieldClient) DeleteOneID(id int) *MediaFieldDeleteOne {
+	builder := c.Delete().Where(mediafield.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaFieldDeleteOne{builder}
+}
+
+// Query returns a query builder for MediaField.
+func (c *MediaFieldClient) Query() *MediaFieldQuery {
+	return &MediaFieldQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMediaField},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MediaField entity by its id.
+func (c *MediaFieldClient) Get(ctx context.Context, id int) (*MediaField, error) {
+	return c.Query().Where(mediafield.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaFieldClient) GetX(ctx context.Context, id int) *MediaField {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a MediaField.
+func (c *MediaFieldClient) QueryMedia(mf *MediaField) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediafield.Table, mediafield.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediafield.MediaTable, mediafield.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(mf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMetaField queries the meta_field edge of a MediaField.
+func (c *MediaFieldClient) QueryMetaField(mf *MediaField) *MetaFieldQuery {
+	query := (&MetaFieldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediafield.Table, mediafield.FieldID, id),
+			sqlgraph.To(metafield.Table, metafield.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediafield.MetaFieldTable, mediafield.MetaFieldColumn),
+		)
+		fromV = sqlgraph.Neighbors(mf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaFieldClient) Hooks() []Hook {
+	return c.hooks.MediaField
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaFieldClient) Interceptors() []Interceptor {
+	return c.inters.MediaField
+}
+
+func (c *MediaFieldClient) mutate(ctx context.Context, m *MediaFieldMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaFieldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaFieldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MediaField mutation op: %q", m.Op())
+	}
+}
+
+// MediaRevisionClient is a client for the MediaRevision schema.
+type MediaRevisionClient struct {
+	config
+}
+
+// NewMediaRevisionClient returns a client for the MediaRevision from the given config.
+func NewMediaRevisionClient(c config) *MediaRevisionClient {
+	return &MediaRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mediarevision.Hooks(f(g(h())))`.
+func (c *MediaRevisionClient) Use(hooks ...Hook) {
+	c.hooks.MediaRevision = append(c.hooks.MediaRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mediarevision.Intercept(f(g(h())))`.
+func (c *MediaRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MediaRevision = append(c.inters.MediaRevision, interceptors...)
+}
+
+// Create returns a builder for creating a MediaRevision entity.
+func (c *MediaRevisionClient) Create() *MediaRevisionCreate {
+	mutation := newMediaRevisionMutation(c.config, OpCreate)
+	return &MediaRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MediaRevision entities.
+func (c *MediaRevisionClient) CreateBulk(builders ...*MediaRevisionCreate) *MediaRevisionCreateBulk {
+	return &MediaRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaRevisionClient) MapCreateBulk(slice any, setFunc func(*MediaRevisionCreate, int)) *MediaRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaRevisionCreateBulk{err: fmt.Errorf("calling to MediaRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MediaRevision.
+func (c *MediaRevisionClient) Update() *MediaRevisionUpdate {
+	mutation := newMediaRevisionMutation(c.config, OpUpdate)
+	return &MediaRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaRevisionClient) UpdateOne(mr *MediaRevision) *MediaRevisionUpdateOne {
+	mutation := newMediaRevisionMutation(c.config, OpUpdateOne, withMediaRevision(mr))
+	return &MediaRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaRevisionClient) UpdateOneID(id int) *MediaRevisionUpdateOne {
+	mutation := newMediaRevisionMutation(c.config, OpUpdateOne, withMediaRevisionID(id))
+	return &MediaRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MediaRevision.
+func (c *MediaRevisionClient) Delete() *MediaRevisionDelete {
+	mutation := newMediaRevisionMutation(c.config, OpDelete)
+	return &MediaRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaRevisionClient) DeleteOne(mr *MediaRevision) *MediaRevisionDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaRevisionClient) DeleteOneID(id int) *MediaRevisionDeleteOne {
+	builder := c.Delete().Where(mediarevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for MediaRevision.
+func (c *MediaRevisionClient) Query() *MediaRevisionQuery {
+	return &MediaRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMediaRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MediaRevision entity by its id.
+func (c *MediaRevisionClient) Get(ctx context.Context, id int) (*MediaRevision, error) {
+	return c.Query().Where(mediarevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaRevisionClient) GetX(ctx context.Context, id int) *MediaRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a MediaRevision.
+func (c *MediaRevisionClient) QueryMedia(mr *MediaRevision) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediarevision.Table, mediarevision.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediarevision.MediaTable, mediarevision.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaRevisionClient) Hooks() []Hook {
+	return c.hooks.MediaRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaRevisionClient) Interceptors() []Interceptor {
+	return c.inters.MediaRevision
+}
+
+func (c *MediaRevisionClient) mutate(ctx context.Context, m *MediaRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MediaRevision mutation op: %q", m.Op())
+	}
+}
+
+// MediaVectorClient is a client for the MediaVector schema.
+type MediaVectorClient struct {
+	config
+}
+
+// NewMediaVectorClient returns a client for the MediaVector from the given config.
+func NewMediaVectorClient(c config) *MediaVectorClient {
+	return &MediaVectorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mediavector.Hooks(f(g(h())))`.
+func (c *MediaVectorClient) Use(hooks ...Hook) {
+	c.hooks.MediaVector = append(c.hooks.MediaVector, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mediavector.Intercept(f(g(h())))`.
+func (c *MediaVectorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MediaVector = append(c.inters.MediaVector, interceptors...)
+}
+
+// Create returns a builder for creating a MediaVector entity.
+func (c *MediaVectorClient) Create() *MediaVectorCreate {
+	mutation := newMediaVectorMutation(c.config, OpCreate)
+	return &MediaVectorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MediaVector entities.
+func (c *MediaVectorClient) CreateBulk(builders ...*MediaVectorCreate) *MediaVectorCreateBulk {
+	return &MediaVectorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaVectorClient) MapCreateBulk(slice any, setFunc func(*MediaVectorCreate, int)) *MediaVectorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaVectorCreateBulk{err: fmt.Errorf("calling to MediaVectorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaVectorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaVectorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MediaVector.
+func (c *MediaVectorClient) Update() *MediaVectorUpdate {
+	mutation := newMediaVectorMutation(c.config, OpUpdate)
+	return &MediaVectorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaVectorClient) UpdateOne(mv *MediaVector) *MediaVectorUpdateOne {
+	mutation := newMediaVectorMutation(c.config, OpUpdateOne, withMediaVector(mv))
+	return &MediaVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaVectorClient) UpdateOneID(id int) *MediaVectorUpdateOne {
+	mutation := newMediaVectorMutation(c.config, OpUpdateOne, withMediaVectorID(id))
+	return &MediaVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MediaVector.
+func (c *MediaVectorClient) Delete() *MediaVectorDelete {
+	mutation := newMediaVectorMutation(c.config, OpDelete)
+	return &MediaVectorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaVectorClient) DeleteOne(mv *MediaVector) *MediaVectorDeleteOne {
+	return c.DeleteOneID(mv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaVectorClient) DeleteOneID(id int) *MediaVectorDeleteOne {
+	builder := c.Delete().Where(mediavector.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaVectorDeleteOne{builder}
+}
+
+// Query returns a query builder for MediaVector.
+func (c *MediaVectorClient) Query() *MediaVectorQuery {
+	return &MediaVectorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMediaVector},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MediaVector entity by its id.
+func (c *MediaVectorClient) Get(ctx context.Context, id int) (*MediaVector, error) {
+	return c.Query().Where(mediavector.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaVectorClient) GetX(ctx context.Context, id int) *MediaVector {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a MediaVector.
+func (c *MediaVectorClient) QueryMedia(mv *MediaVector) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediavector.Table, mediavector.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediavector.MediaTable, mediavector.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(mv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVector queries the vector edge of a MediaVector.
+func (c *MediaVectorClient) QueryVector(mv *MediaVector) *VectorQuery {
+	query := (&VectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediavector.Table, mediavector.FieldID, id),
+			sqlgraph.To(vector.Table, vector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediavector.VectorTable, mediavector.VectorColumn),
+		)
+		fromV = sqlgraph.Neighbors(mv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaVectorClient) Hooks() []Hook {
+	return c.hooks.MediaVector
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaVectorClient) Interceptors() []Interceptor {
+	return c.inters.MediaVector
+}
+
+func (c *MediaVectorClient) mutate(ctx context.Context, m *MediaVectorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaVectorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaVectorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaVectorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MediaVector mutation op: %q", m.Op())
+	}
+}
+
+// MediaVoteClient is a client for the MediaVote schema.
+type MediaVoteClient struct {
+	config
+}
+
+// NewMediaVoteClient returns a client for the MediaVote from the given config.
+func NewMediaVoteClient(c config) *MediaVoteClient {
+	return &MediaVoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mediavote.Hooks(f(g(h())))`.
+func (c *MediaVoteClient) Use(hooks ...Hook) {
+	c.hooks.MediaVote = append(c.hooks.MediaVote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mediavote.Intercept(f(g(h())))`.
+func (c *MediaVoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MediaVote = append(c.inters.MediaVote, interceptors...)
+}
+
+// Create returns a builder for creating a MediaVote entity.
+func (c *MediaVoteClient) Create() *MediaVoteCreate {
+	mutation := newMediaVoteMutation(c.config, OpCreate)
+	return &MediaVoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MediaVote entities.
+func (c *MediaVoteClient) CreateBulk(builders ...*MediaVoteCreate) *MediaVoteCreateBulk {
+	return &MediaVoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaVoteClient) MapCreateBulk(slice any, setFunc func(*MediaVoteCreate, int)) *MediaVoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaVoteCreateBulk{err: fmt.Errorf("calling to MediaVoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaVoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaVoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MediaVote.
+func (c *MediaVoteClient) Update() *MediaVoteUpdate {
+	mutation := newMediaVoteMutation(c.config, OpUpdate)
+	return &MediaVoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaVoteClient) UpdateOne(mv *MediaVote) *MediaVoteUpdateOne {
+	mutation := newMediaVoteMutation(c.config, OpUpdateOne, withMediaVote(mv))
+	return &MediaVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaVoteClient) UpdateOneID(id int) *MediaVoteUpdateOne {
+	mutation := newMediaVoteMutation(c.config, OpUpdateOne, withMediaVoteID(id))
+	return &MediaVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MediaVote.
+func (c *MediaVoteClient) Delete() *MediaVoteDelete {
+	mutation := newMediaVoteMutation(c.config, OpDelete)
+	return &MediaVoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaVoteClient) DeleteOne(mv *MediaVote) *MediaVoteDeleteOne {
+	return c.DeleteOneID(mv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaVoteClient) DeleteOneID(id int) *MediaVoteDeleteOne {
+	builder := c.Delete().Where(mediavote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaVoteDeleteOne{builder}
+}
+
+// Query returns a query builder for MediaVote.
+func (c *MediaVoteClient) Query() *MediaVoteQuery {
+	return &MediaVoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMediaVote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MediaVote entity by its id.
+func (c *MediaVoteClient) Get(ctx context.Context, id int) (*MediaVote, error) {
+	return c.Query().Where(mediavote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaVoteClient) GetX(ctx context.Context, id int) *MediaVote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a MediaVote.
+func (c *MediaVoteClient) QueryMedia(mv *MediaVote) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediavote.Table, mediavote.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediavote.MediaTable, mediavote.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(mv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaVoteClient) Hooks() []Hook {
+	return c.hooks.MediaVote
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaVoteClient) Interceptors() []Interceptor {
+	return c.inters.MediaVote
+}
+
+func (c *MediaVoteClient) mutate(ctx context.Context, m *MediaVoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaVoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaVoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaVoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MediaVote mutation op: %q", m.Op())
+	}
+}
+
+// MetaFieldClient is a client for the MetaField schema.
+type MetaFieldClient struct {
+	config
+}
+
+// NewMetaFieldClient returns a client for the MetaField from the given config.
+func NewMetaFieldClient(c config) *MetaFieldClient {
+	return &MetaFieldClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `metafield.Hooks(f(g(h())))`.
+func (c *MetaFieldClient) Use(hooks ...Hook) {
+	c.hooks.MetaField = append(c.hooks.MetaField, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `metafield.Intercept(f(g(h())))`.
+func (c *MetaFieldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MetaField = append(c.inters.MetaField, interceptors...)
+}
+
+// Create returns a builder for creating a MetaField entity.
+func (c *MetaFieldClient) Create() *MetaFieldCreate {
+	mutation := newMetaFieldMutation(c.config, OpCreate)
+	return &MetaFieldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MetaField entities.
+func (c *MetaFieldClient) CreateBulk(builders ...*MetaFieldCreate) *MetaFieldCreateBulk {
+	return &MetaFieldCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MetaFieldClient) MapCreateBulk(slice any, setFunc func(*MetaFieldCreate, int)) *MetaFieldCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MetaFieldCreateBulk{err: fmt.Errorf("calling to MetaFieldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MetaFieldCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MetaFieldCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MetaField.
+func (c *MetaFieldClient) Update() *MetaFieldUpdate {
+	mutation := newMetaFieldMutation(c.config, OpUpdate)
+	return &MetaFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MetaFieldClient) UpdateOne(mf *MetaField) *MetaFieldUpdateOne {
+	mutation := newMetaFieldMutation(c.config, OpUpdateOne, withMetaField(mf))
+	return &MetaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MetaFieldClient) UpdateOneID(id int) *MetaFieldUpdateOne {
+	mutation := newMetaFieldMutation(c.config, OpUpdateOne, withMetaFieldID(id))
+	return &MetaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MetaField.
+func (c *MetaFieldClient) Delete() *MetaFieldDelete {
+	mutation := newMetaFieldMutation(c.config, OpDelete)
+	return &MetaFieldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MetaFieldClient) DeleteOne(mf *MetaField) *MetaFieldDeleteOne {
+	return c.DeleteOneID(mf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MetaFieldClient) DeleteOneID(id int) *MetaFieldDeleteOne {
+	builder := c.Delete().Where(metafield.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MetaFieldDeleteOne{builder}
+}
+
+// Query returns a query builder for MetaField.
+func (c *MetaFieldClient) Query() *MetaFieldQuery {
+	return &MetaFieldQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMetaField},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MetaField entity by its id.
+func (c *MetaFieldClient) Get(ctx context.Context, id int) (*MetaField, error) {
+	return c.Query().Where(metafield.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MetaFieldClient) GetX(ctx context.Context, id int) *MetaField {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a MetaField.
+func (c *MetaFieldClient) QueryMedia(mf *MetaField) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(metafield.Table, metafield.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, metafield.MediaTable, metafield.MediaPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(mf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMediaFields queries the media_fields edge of a MetaField.
+func (c *MetaFieldClient) QueryMediaFields(mf *MetaField) *MediaFieldQuery {
+	query := (&MediaFieldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(metafield.Table, metafield.FieldID, id),
+			sqlgraph.To(mediafield.Table, mediafield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, metafield.MediaFieldsTable, metafield.MediaFieldsColumn),
+		)
+		fromV = sqlgraph.Neighbors(mf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MetaFieldClient) Hooks() []Hook {
+	return c.hooks.MetaField
+}
+
+// Interceptors returns the client interceptors.
+func (c *MetaFieldClient) Interceptors() []Interceptor {
+	return c.inters.MetaField
+}
+
+func (c *MetaFieldClient) mutate(ctx context.Context, m *MetaFieldMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MetaFieldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MetaFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MetaFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MetaFieldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MetaField mutation op: %q", m.Op())
+	}
+}
+
+// NoteClient is a client for the Note schema.
+type NoteClient struct {
+	config
+}
+
+// NewNoteClient returns a client for the Note from the given config.
+func NewNoteClient(c config) *NoteClient {
+	return &NoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `note.Hooks(f(g(h())))`.
+func (c *NoteClient) Use(hooks ...Hook) {
+	c.hooks.Note = append(c.hooks.Note, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `note.Intercept(f(g(h())))`.
+func (c *NoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Note = append(c.inters.Note, interceptors...)
+}
+
+// Create returns a builder for creating a Note entity.
+func (c *NoteClient) Create() *NoteCreate {
+	mutation := newNoteMutation(c.config, OpCreate)
+	return &NoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Note entities.
+func (c *NoteClient) CreateBulk(builders ...*NoteCreate) *NoteCreateBulk {
+	return &NoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteClient) MapCreateBulk(slice any, setFunc func(*NoteCreate, int)) *NoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteCreateBulk{err: fmt.Errorf("calling to NoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Note.
+func (c *NoteClient) Update() *NoteUpdate {
+	mutation := newNoteMutation(c.config, OpUpdate)
+	return &NoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NoteClient) UpdateOne(n *Note) *NoteUpdateOne {
+	mutation := newNoteMutation(c.config, OpUpdateOne, withNote(n))
+	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NoteClient) UpdateOneID(id int) *NoteUpdateOne {
+	mutation := newNoteMutation(c.config, OpUpdateOne, withNoteID(id))
+	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Note.
+func (c *NoteClient) Delete() *NoteDelete {
+	mutation := newNoteMutation(c.config, OpDelete)
+	return &NoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NoteClient) DeleteOne(n *Note) *NoteDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NoteClient) DeleteOneID(id int) *NoteDeleteOne {
+	builder := c.Delete().Where(note.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NoteDeleteOne{builder}
+}
+
+// Query returns a query builder for Note.
+func (c *NoteClient) Query() *NoteQuery {
+	return &NoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Note entity by its id.
+func (c *NoteClient) Get(ctx context.Context, id int) (*Note, error) {
+	return c.Query().Where(note.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NoteClient) GetX(ctx context.Context, id int) *Note {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a Note.
+func (c *NoteClient) QueryMedia(n *Note) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, note.MediaTable, note.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTag queries the tag edge of a Note.
+func (c *NoteClient) QueryTag(n *Note) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, note.TagTable, note.TagColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NoteClient) Hooks() []Hook {
+	return c.hooks.Note
+}
+
+// Interceptors returns the client interceptors.
+func (c *NoteClient) Interceptors() []Interceptor {
+	return c.inters.Note
+}
+
+func (c *NoteClient) mutate(ctx context.Context, m *NoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Note mutation op: %q", m.Op())
+	}
+}
+
+// PoolClient is a client for the Pool schema.
+type PoolClient struct {
+	config
+}
+
+// NewPoolClient returns a client for the Pool from the given config.
+func NewPoolClient(c config) *PoolClient {
+	return &PoolClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pool.Hooks(f(g(h())))`.
+func (c *PoolClient) Use(hooks ...Hook) {
+	c.hooks.Pool = append(c.hooks.Pool, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pool.Intercept(f(g(h())))`.
+func (c *PoolClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Pool = append(c.inters.Pool, interceptors...)
+}
+
+// Create returns a builder for creating a Pool entity.
+func (c *PoolClient) Create() *PoolCreate {
+	mutation := newPoolMutation(c.config, OpCreate)
+	return &PoolCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Pool entities.
+func (c *PoolClient) CreateBulk(builders ...*PoolCreate) *PoolCreateBulk {
+	return &PoolCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PoolClient) MapCreateBulk(slice any, setFunc func(*PoolCreate, int)) *PoolCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PoolCreateBulk{err: fmt.Errorf("calling to PoolClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PoolCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PoolCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Pool.
+func (c *PoolClient) Update() *PoolUpdate {
+	mutation := newPoolMutation(c.config, OpUpdate)
+	return &PoolUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PoolClient) UpdateOne(po *Pool) *PoolUpdateOne {
+	mutation := newPoolMutation(c.config, OpUpdateOne, withPool(po))
+	return &PoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PoolClient) UpdateOneID(id int) *PoolUpdateOne {
+	mutation := newPoolMutation(c.config, OpUpdateOne, withPoolID(id))
+	return &PoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Pool.
+func (c *PoolClient) Delete() *PoolDelete {
+	mutation := newPoolMutation(c.config, OpDelete)
+	return &PoolDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PoolClient) DeleteOne(po *Pool) *PoolDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PoolClient) DeleteOneID(id int) *PoolDeleteOne {
+	builder := c.Delete().Where(pool.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PoolDeleteOne{builder}
+}
+
+// Query returns a query builder for Pool.
+func (c *PoolClient) Query() *PoolQuery {
+	return &PoolQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePool},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Pool entity by its id.
+func (c *PoolClient) Get(ctx context.Context, id int) (*Pool, error) {
+	return c.Query().Where(pool.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PoolClient) GetX(ctx context.Context, id int) *Pool {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a Pool.
+func (c *PoolClient) QueryMedia(po *Pool) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pool.Table, pool.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, pool.MediaTable, pool.MediaPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCover queries the cover edge of a Pool.
+func (c *PoolClient) QueryCover(po *Pool) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pool.Table, pool.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pool.CoverTable, pool.CoverColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPoolMedia queries the pool_media edge of a Pool.
+func (c *PoolClient) QueryPoolMedia(po *Pool) *PoolMediaQuery {
+	query := (&PoolMediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pool.Table, pool.FieldID, id),
+			sqlgraph.To(poolmedia.Table, poolmedia.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, pool.PoolMediaTable, pool.PoolMediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PoolClient) Hooks() []Hook {
+	return c.hooks.Pool
+}
+
+// Interceptors returns the client interceptors.
+func (c *PoolClient) Interceptors() []Interceptor {
+	return c.inters.Pool
+}
+
+func (c *PoolClient) mutate(ctx context.Context, m *PoolMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PoolCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PoolUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PoolDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Pool mutation op: %q", m.Op())
+	}
+}
+
+// PoolMediaClient is a client for the PoolMedia schema.
+type PoolMediaClient struct {
+	config
+}
+
+// NewPoolMediaClient returns a client for the PoolMedia from the given config.
+func NewPoolMediaClient(c config) *PoolMediaClient {
+	return &PoolMediaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `poolmedia.Hooks(f(g(h())))`.
+func (c *PoolMediaClient) Use(hooks ...Hook) {
+	c.hooks.PoolMedia = append(c.hooks.PoolMedia, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `poolmedia.Intercept(f(g(h())))`.
+func (c *PoolMediaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PoolMedia = append(c.inters.PoolMedia, interceptors...)
+}
+
+// Create returns a builder for creating a PoolMedia entity.
+func (c *PoolMediaClient) Create() *PoolMediaCreate {
+	mutation := newPoolMediaMutation(c.config, OpCreate)
+	return &PoolMediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PoolMedia entities.
+func (c *PoolMediaClient) CreateBulk(builders ...*PoolMediaCreate) *PoolMediaCreateBulk {
+	return &PoolMediaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PoolMediaClient) MapCreateBulk(slice any, setFunc func(*PoolMediaCreate, int)) *PoolMediaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PoolMediaCreateBulk{err: fmt.Errorf("calling to PoolMediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PoolMediaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PoolMediaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PoolMedia.
+func (c *PoolMediaClient) Update() *PoolMediaUpdate {
+	mutation := newPoolMediaMutation(c.config, OpUpdate)
+	return &PoolMediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PoolMediaClient) UpdateOne(pm *PoolMedia) *PoolMediaUpdateOne {
+	mutation := newPoolMediaMutation(c.config, OpUpdateOne, withPoolMedia(pm))
+	return &PoolMediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PoolMediaClient) UpdateOneID(id int) *PoolMediaUpdateOne {
+	mutation := newPoolMediaMutation(c.config, OpUpdateOne, withPoolMediaID(id))
+	return &PoolMediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PoolMedia.
+func (c *PoolMediaClient) Delete() *PoolMediaDelete {
+	mutation := newPoolMediaMutation(c.config, OpDelete)
+	return &PoolMediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PoolMediaClient) DeleteOne(pm *PoolMedia) *PoolMediaDeleteOne {
+	return c.DeleteOneID(pm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PoolMediaClient) DeleteOneID(id int) *PoolMediaDeleteOne {
+	builder := c.Delete().Where(poolmedia.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PoolMediaDeleteOne{builder}
+}
+
+// Query returns a query builder for PoolMedia.
+func (c *PoolMediaClient) Query() *PoolMediaQuery {
+	return &PoolMediaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePoolMedia},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PoolMedia entity by its id.
+func (c *PoolMediaClient) Get(ctx context.Context, id int) (*PoolMedia, error) {
+	return c.Query().Where(poolmedia.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PoolMediaClient) GetX(ctx context.Context, id int) *PoolMedia {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPool queries the pool edge of a PoolMedia.
+func (c *PoolMediaClient) QueryPool(pm *PoolMedia) *PoolQuery {
+	query := (&PoolClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(mediadate.Table, mediadate.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, media.MediaDatesTable, media.MediaDatesColumn),
+			sqlgraph.From(poolmedia.Table, poolmedia.FieldID, id),
+			sqlgraph.To(pool.Table, pool.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, poolmedia.PoolTable, poolmedia.PoolColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryMediaVectors queries the media_vectors edge of a Media.
-func (c *MediaClient) QueryMediaVectors(m *Media) *MediaVectorQuery {
-	query := (&MediaVectorClient{config: c.config}).Query()
+// QueryMedia queries the media edge of a PoolMedia.
+func (c *PoolMediaClient) QueryMedia(pm *PoolMedia) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := pm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(mediavector.Table, mediavector.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, media.MediaVectorsTable, media.MediaVectorsColumn),
+			sqlgraph.From(poolmedia.Table, poolmedia.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, poolmedia.MediaTable, poolmedia.MediaColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MediaClient) Hooks() []Hook {
-	return c.hooks.Media
+func (c *PoolMediaClient) Hooks() []Hook {
+	return c.hooks.PoolMedia
 }
 
 // Interceptors returns the client interceptors.
-func (c *MediaClient) Interceptors() []Interceptor {
-	return c.inters.Media
+func (c *PoolMediaClient) Interceptors() []Interceptor {
+	return c.inters.PoolMedia
 }
 
-func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
+func (c *PoolMediaClient) mutate(ctx context.Context, m *PoolMediaMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PoolMediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PoolMediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PoolMediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PoolMediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PoolMedia mutation op: %q", m.Op())
 	}
 }
 
-// MediaDateClient is a client for the MediaDate schema.
-type MediaDateClient struct {
+// RenditionClient is a client for the Rendition schema.
+type RenditionClient struct {
 	config
 }
 
-// NewMediaDateClient returns a client for the MediaDate from the given config.
-func NewMediaDateClient(c config) *MediaDateClient {
-	return &MediaDateClient{config: c}
+// NewRenditionClient returns a client for the Rendition from the given config.
+func NewRenditionClient(c config) *RenditionClient {
+	return &RenditionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `mediadate.Hooks(f(g(h())))`.
-func (c *MediaDateClient) Use(hooks ...Hook) {
-	c.hooks.MediaDate = append(c.hooks.MediaDate, hooks...)
+// A call to `Use(f, g, h)` equals to `rendition.Hooks(f(g(h())))`.
+func (c *RenditionClient) Use(hooks ...Hook) {
+	c.hooks.Rendition = append(c.hooks.Rendition, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `mediadate.Intercept(f(g(h())))`.
-func (c *MediaDateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MediaDate = append(c.inters.MediaDate, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `rendition.Intercept(f(g(h())))`.
+func (c *RenditionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Rendition = append(c.inters.Rendition, interceptors...)
 }
 
-// Create returns a builder for creating a MediaDate entity.
-func (c *MediaDateClient) Create() *MediaDateCreate {
-	mutation := newMediaDateMutation(c.config, OpCreate)
-	return &MediaDateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Rendition entity.
+func (c *RenditionClient) Create() *RenditionCreate {
+	mutation := newRenditionMutation(c.config, OpCreate)
+	return &RenditionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MediaDate entities.
-func (c *MediaDateClient) CreateBulk(builders ...*MediaDateCreate) *MediaDateCreateBulk {
-	return &MediaDateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Rendition entities.
+func (c *RenditionClient) CreateBulk(builders ...*RenditionCreate) *RenditionCreateBulk {
+	return &RenditionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MediaDateClient) MapCreateBulk(slice any, setFunc func(*MediaDateCreate, int)) *MediaDateCreateBulk {
+func (c *RenditionClient) MapCreateBulk(slice any, setFunc func(*RenditionCreate, int)) *RenditionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MediaDateCreateBulk{err: fmt.Errorf("calling to MediaDateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RenditionCreateBulk{err: fmt.Errorf("calling to RenditionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MediaDateCreate, rv.Len())
+	builders := make([]*RenditionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MediaDateCreateBulk{config: c.config, builders: builders}
+	return &RenditionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MediaDate.
-func (c *MediaDateClient) Update() *MediaDateUpdate {
-	mutation := newMediaDateMutation(c.config, OpUpdate)
-	return &MediaDateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Rendition.
+func (c *RenditionClient) Update() *RenditionUpdate {
+	mutation := newRenditionMutation(c.config, OpUpdate)
+	return &RenditionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MediaDateClient) UpdateOne(md *MediaDate) *MediaDateUpdateOne {
-	mutation := newMediaDateMutation(c.config, OpUpdateOne, withMediaDate(md))
-	return &MediaDateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RenditionClient) UpdateOne(r *Rendition) *RenditionUpdateOne {
+	mutation := newRenditionMutation(c.config, OpUpdateOne, withRendition(r))
+	return &RenditionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MediaDateClient) UpdateOneID(id int) *MediaDateUpdateOne {
-	mutation := newMediaDateMutation(c.config, OpUpdateOne, withMediaDateID(id))
-	return &MediaDateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RenditionClient) UpdateOneID(id int) *RenditionUpdateOne {
+	mutation := newRenditionMutation(c.config, OpUpdateOne, withRenditionID(id))
+	return &RenditionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MediaDate.
-func (c *MediaDateClient) Delete() *MediaDateDelete {
-	mutation := newMediaDateMutation(c.config, OpDelete)
-	return &MediaDateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Rendition.
+func (c *RenditionClient) Delete() *RenditionDelete {
+	mutation := newRenditionMutation(c.config, OpDelete)
+	return &RenditionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MediaDateClient) DeleteOne(md *MediaDate) *MediaDateDeleteOne {
-	return c.DeleteOneID(md.ID)
+func (c *RenditionClient) DeleteOne(r *Rendition) *RenditionDeleteOne {
+	return c.DeleteOneID(r.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MediaDateClient) DeleteOneID(id int) *MediaDateDeleteOne {
-	builder := c.Delete().Where(mediadate.ID(id))
+func (c *RenditionClient) DeleteOneID(id int) *RenditionDeleteOne {
+	builder := c.Delete().Where(rendition.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MediaDateDeleteOne{builder}
+	return &RenditionDeleteOne{builder}
 }
 
-// Query returns a query builder for MediaDate.
-func (c *MediaDateClient) Query() *MediaDateQuery {
-	return &MediaDateQuery{
+// Query returns a query builder for Rendition.
+func (c *RenditionClient) Query() *RenditionQuery {
+	return &RenditionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMediaDate},
+		ctx:    &QueryContext{Type: TypeRendition},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MediaDate entity by its id.
-func (c *MediaDateClient) Get(ctx context.Context, id int) (*MediaDate, error) {
-	return c.Query().Where(mediadate.ID(id)).Only(ctx)
+// Get returns a Rendition entity by its id.
+func (c *RenditionClient) Get(ctx context.Context, id int) (*Rendition, error) {
+	return c.Query().Where(rendition.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MediaDateClient) GetX(ctx context.Context, id int) *MediaDate {
+func (c *RenditionClient) GetX(ctx context.Context, id int) *Rendition {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -883,164 +3184,148 @@ func (c *MediaDateClient) GetX(ctx context.Context, id int) *MediaDate {
 	return obj
 }
 
-// QueryMedia queries the media edge of a MediaDate.
-func (c *MediaDateClient) QueryMedia(md *MediaDate) *MediaQuery {
+// QueryMedia queries the media edge of a Rendition.
+func (c *RenditionClient) QueryMedia(r *Rendition) *MediaQuery {
 	query := (&MediaClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := md.ID
+		id := r.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(mediadate.Table, mediadate.FieldID, id),
+			sqlgraph.From(rendition.Table, rendition.FieldID, id),
 			sqlgraph.To(media.Table, media.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, mediadate.MediaTable, mediadate.MediaColumn),
-		)
-		fromV = sqlgraph.Neighbors(md.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDate queries the date edge of a MediaDate.
-func (c *MediaDateClient) QueryDate(md *MediaDate) *DateQuery {
-	query := (&DateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := md.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(mediadate.Table, mediadate.FieldID, id),
-			sqlgraph.To(date.Table, date.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, mediadate.DateTable, mediadate.DateColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, rendition.MediaTable, rendition.MediaColumn),
 		)
-		fromV = sqlgraph.Neighbors(md.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MediaDateClient) Hooks() []Hook {
-	return c.hooks.MediaDate
+func (c *RenditionClient) Hooks() []Hook {
+	return c.hooks.Rendition
 }
 
 // Interceptors returns the client interceptors.
-func (c *MediaDateClient) Interceptors() []Interceptor {
-	return c.inters.MediaDate
+func (c *RenditionClient) Interceptors() []Interceptor {
+	return c.inters.Rendition
 }
 
-func (c *MediaDateClient) mutate(ctx context.Context, m *MediaDateMutation) (Value, error) {
+func (c *RenditionClient) mutate(ctx context.Context, m *RenditionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MediaDateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RenditionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MediaDateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RenditionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MediaDateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RenditionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MediaDateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RenditionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MediaDate mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Rendition mutation op: %q", m.Op())
 	}
 }
 
-// MediaVectorClient is a client for the MediaVector schema.
-type MediaVectorClient struct {
+// SettingClient is a client for the Setting schema.
+type SettingClient struct {
 	config
 }
 
-// NewMediaVectorClient returns a client for the MediaVector from the given config.
-func NewMediaVectorClient(c config) *MediaVectorClient {
-	return &MediaVectorClient{config: c}
+// NewSettingClient returns a client for the Setting from the given config.
+func NewSettingClient(c config) *SettingClient {
+	return &SettingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `mediavector.Hooks(f(g(h())))`.
-func (c *MediaVectorClient) Use(hooks ...Hook) {
-	c.hooks.MediaVector = append(c.hooks.MediaVector, hooks...)
+// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
+func (c *SettingClient) Use(hooks ...Hook) {
+	c.hooks.Setting = append(c.hooks.Setting, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `mediavector.Intercept(f(g(h())))`.
-func (c *MediaVectorClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MediaVector = append(c.inters.MediaVector, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `setting.Intercept(f(g(h())))`.
+func (c *SettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Setting = append(c.inters.Setting, interceptors...)
 }
 
-// Create returns a builder for creating a MediaVector entity.
-func (c *MediaVectorClient) Create() *MediaVectorCreate {
-	mutation := newMediaVectorMutation(c.config, OpCreate)
-	return &MediaVectorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Setting entity.
+func (c *SettingClient) Create() *SettingCreate {
+	mutation := newSettingMutation(c.config, OpCreate)
+	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MediaVector entities.
-func (c *MediaVectorClient) CreateBulk(builders ...*MediaVectorCreate) *MediaVectorCreateBulk {
-	return &MediaVectorCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Setting entities.
+func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
+	return &SettingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MediaVectorClient) MapCreateBulk(slice any, setFunc func(*MediaVectorCreate, int)) *MediaVectorCreateBulk {
+func (c *SettingClient) MapCreateBulk(slice any, setFunc func(*SettingCreate, int)) *SettingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MediaVectorCreateBulk{err: fmt.Errorf("calling to MediaVectorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SettingCreateBulk{err: fmt.Errorf("calling to SettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MediaVectorCreate, rv.Len())
+	builders := make([]*SettingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MediaVectorCreateBulk{config: c.config, builders: builders}
+	return &SettingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MediaVector.
-func (c *MediaVectorClient) Update() *MediaVectorUpdate {
-	mutation := newMediaVectorMutation(c.config, OpUpdate)
-	return &MediaVectorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Setting.
+func (c *SettingClient) Update() *SettingUpdate {
+	mutation := newSettingMutation(c.config, OpUpdate)
+	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MediaVectorClient) UpdateOne(mv *MediaVector) *MediaVectorUpdateOne {
-	mutation := newMediaVectorMutation(c.config, OpUpdateOne, withMediaVector(mv))
-	return &MediaVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SettingClient) UpdateOne(s *Setting) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(s))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MediaVectorClient) UpdateOneID(id int) *MediaVectorUpdateOne {
-	mutation := newMediaVectorMutation(c.config, OpUpdateOne, withMediaVectorID(id))
-	return &MediaVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SettingClient) UpdateOneID(id int) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MediaVector.
-func (c *MediaVectorClient) Delete() *MediaVectorDelete {
-	mutation := newMediaVectorMutation(c.config, OpDelete)
-	return &MediaVectorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Setting.
+func (c *SettingClient) Delete() *SettingDelete {
+	mutation := newSettingMutation(c.config, OpDelete)
+	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MediaVectorClient) DeleteOne(mv *MediaVector) *MediaVectorDeleteOne {
-	return c.DeleteOneID(mv.ID)
+func (c *SettingClient) DeleteOne(s *Setting) *SettingDeleteOne {
+	return c.DeleteOneID(s.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MediaVectorClient) DeleteOneID(id int) *MediaVectorDeleteOne {
-	builder := c.Delete().Where(mediavector.ID(id))
+func (c *SettingClient) DeleteOneID(id int) *SettingDeleteOne {
+	builder := c.Delete().Where(setting.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MediaVectorDeleteOne{builder}
+	return &SettingDeleteOne{builder}
 }
 
-// Query returns a query builder for MediaVector.
-func (c *MediaVectorClient) Query() *MediaVectorQuery {
-	return &MediaVectorQuery{
+// Query returns a query builder for Setting.
+func (c *SettingClient) Query() *SettingQuery {
+	return &SettingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMediaVector},
+		ctx:    &QueryContext{Type: TypeSetting},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MediaVector entity by its id.
-func (c *MediaVectorClient) Get(ctx context.Context, id int) (*MediaVector, error) {
-	return c.Query().Where(mediavector.ID(id)).Only(ctx)
+// Get returns a Setting entity by its id.
+func (c *SettingClient) Get(ctx context.Context, id int) (*Setting, error) {
+	return c.Query().Where(setting.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MediaVectorClient) GetX(ctx context.Context, id int) *MediaVector {
+func (c *SettingClient) GetX(ctx context.Context, id int) *Setting {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1048,164 +3333,132 @@ func (c *MediaVectorClient) GetX(ctx context.Context, id int) *MediaVector {
 	return obj
 }
 
-// QueryMedia queries the media edge of a MediaVector.
-func (c *MediaVectorClient) QueryMedia(mv *MediaVector) *MediaQuery {
-	query := (&MediaClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := mv.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(mediavector.Table, mediavector.FieldID, id),
-			sqlgraph.To(media.Table, media.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, mediavector.MediaTable, mediavector.MediaColumn),
-		)
-		fromV = sqlgraph.Neighbors(mv.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryVector queries the vector edge of a MediaVector.
-func (c *MediaVectorClient) QueryVector(mv *MediaVector) *VectorQuery {
-	query := (&VectorClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := mv.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(mediavector.Table, mediavector.FieldID, id),
-			sqlgraph.To(vector.Table, vector.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, mediavector.VectorTable, mediavector.VectorColumn),
-		)
-		fromV = sqlgraph.Neighbors(mv.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *MediaVectorClient) Hooks() []Hook {
-	return c.hooks.MediaVector
+func (c *SettingClient) Hooks() []Hook {
+	return c.hooks.Setting
 }
 
 // Interceptors returns the client interceptors.
-func (c *MediaVectorClient) Interceptors() []Interceptor {
-	return c.inters.MediaVector
+func (c *SettingClient) Interceptors() []Interceptor {
+	return c.inters.Setting
 }
 
-func (c *MediaVectorClient) mutate(ctx context.Context, m *MediaVectorMutation) (Value, error) {
+func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MediaVectorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MediaVectorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MediaVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MediaVectorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MediaVector mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Setting mutation op: %q", m.Op())
 	}
 }
 
-// SettingClient is a client for the Setting schema.
-type SettingClient struct {
+// SourceClient is a client for the Source schema.
+type SourceClient struct {
 	config
 }
 
-// NewSettingClient returns a client for the Setting from the given config.
-func NewSettingClient(c config) *SettingClient {
-	return &SettingClient{config: c}
+// NewSourceClient returns a client for the Source from the given config.
+func NewSourceClient(c config) *SourceClient {
+	return &SourceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
-func (c *SettingClient) Use(hooks ...Hook) {
-	c.hooks.Setting = append(c.hooks.Setting, hooks...)
+// A call to `Use(f, g, h)` equals to `source.Hooks(f(g(h())))`.
+func (c *SourceClient) Use(hooks ...Hook) {
+	c.hooks.Source = append(c.hooks.Source, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `setting.Intercept(f(g(h())))`.
-func (c *SettingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Setting = append(c.inters.Setting, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `source.Intercept(f(g(h())))`.
+func (c *SourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Source = append(c.inters.Source, interceptors...)
 }
 
-// Create returns a builder for creating a Setting entity.
-func (c *SettingClient) Create() *SettingCreate {
-	mutation := newSettingMutation(c.config, OpCreate)
-	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Source entity.
+func (c *SourceClient) Create() *SourceCreate {
+	mutation := newSourceMutation(c.config, OpCreate)
+	return &SourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Setting entities.
-func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
-	return &SettingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Source entities.
+func (c *SourceClient) CreateBulk(builders ...*SourceCreate) *SourceCreateBulk {
+	return &SourceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SettingClient) MapCreateBulk(slice any, setFunc func(*SettingCreate, int)) *SettingCreateBulk {
+func (c *SourceClient) MapCreateBulk(slice any, setFunc func(*SourceCreate, int)) *SourceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SettingCreateBulk{err: fmt.Errorf("calling to SettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SourceCreateBulk{err: fmt.Errorf("calling to SourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SettingCreate, rv.Len())
+	builders := make([]*SourceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SettingCreateBulk{config: c.config, builders: builders}
+	return &SourceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Setting.
-func (c *SettingClient) Update() *SettingUpdate {
-	mutation := newSettingMutation(c.config, OpUpdate)
-	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Source.
+func (c *SourceClient) Update() *SourceUpdate {
+	mutation := newSourceMutation(c.config, OpUpdate)
+	return &SourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SettingClient) UpdateOne(s *Setting) *SettingUpdateOne {
-	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(s))
-	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SourceClient) UpdateOne(s *Source) *SourceUpdateOne {
+	mutation := newSourceMutation(c.config, OpUpdateOne, withSource(s))
+	return &SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SettingClient) UpdateOneID(id int) *SettingUpdateOne {
-	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
-	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SourceClient) UpdateOneID(id int) *SourceUpdateOne {
+	mutation := newSourceMutation(c.config, OpUpdateOne, withSourceID(id))
+	return &SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Setting.
-func (c *SettingClient) Delete() *SettingDelete {
-	mutation := newSettingMutation(c.config, OpDelete)
-	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Source.
+func (c *SourceClient) Delete() *SourceDelete {
+	mutation := newSourceMutation(c.config, OpDelete)
+	return &SourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SettingClient) DeleteOne(s *Setting) *SettingDeleteOne {
+func (c *SourceClient) DeleteOne(s *Source) *SourceDeleteOne {
 	return c.DeleteOneID(s.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SettingClient) DeleteOneID(id int) *SettingDeleteOne {
-	builder := c.Delete().Where(setting.ID(id))
+func (c *SourceClient) DeleteOneID(id int) *SourceDeleteOne {
+	builder := c.Delete().Where(source.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SettingDeleteOne{builder}
+	return &SourceDeleteOne{builder}
 }
 
-// Query returns a query builder for Setting.
-func (c *SettingClient) Query() *SettingQuery {
-	return &SettingQuery{
+// Query returns a query builder for Source.
+func (c *SourceClient) Query() *SourceQuery {
+	return &SourceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSetting},
+		ctx:    &QueryContext{Type: TypeSource},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Setting entity by its id.
-func (c *SettingClient) Get(ctx context.Context, id int) (*Setting, error) {
-	return c.Query().Where(setting.ID(id)).Only(ctx)
+// Get returns a Source entity by its id.
+func (c *SourceClient) Get(ctx context.Context, id int) (*Source, error) {
+	return c.Query().Where(source.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SettingClient) GetX(ctx context.Context, id int) *Setting {
+func (c *SourceClient) GetX(ctx context.Context, id int) *Source {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1213,28 +3466,44 @@ func (c *SettingClient) GetX(ctx context.Context, id int) *Setting {
 	return obj
 }
 
+// QueryMedia queries the media edge of a Source.
+func (c *SourceClient) QueryMedia(s *Source) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(source.Table, source.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, source.MediaTable, source.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *SettingClient) Hooks() []Hook {
-	return c.hooks.Setting
+func (c *SourceClient) Hooks() []Hook {
+	return c.hooks.Source
 }
 
 // Interceptors returns the client interceptors.
-func (c *SettingClient) Interceptors() []Interceptor {
-	return c.inters.Setting
+func (c *SourceClient) Interceptors() []Interceptor {
+	return c.inters.Source
 }
 
-func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value, error) {
+func (c *SourceClient) mutate(ctx context.Context, m *SourceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Setting mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Source mutation op: %q", m.Op())
 	}
 }
 
@@ -1387,6 +3656,336 @@ func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	}
 }
 
+// TagSuggestionClient is a client for the TagSuggestion schema.
+type TagSuggestionClient struct {
+	config
+}
+
+// NewTagSuggestionClient returns a client for the TagSuggestion from the given config.
+func NewTagSuggestionClient(c config) *TagSuggestionClient {
+	return &TagSuggestionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tagsuggestion.Hooks(f(g(h())))`.
+func (c *TagSuggestionClient) Use(hooks ...Hook) {
+	c.hooks.TagSuggestion = append(c.hooks.TagSuggestion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tagsuggestion.Intercept(f(g(h())))`.
+func (c *TagSuggestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagSuggestion = append(c.inters.TagSuggestion, interceptors...)
+}
+
+// Create returns a builder for creating a TagSuggestion entity.
+func (c *TagSuggestionClient) Create() *TagSuggestionCreate {
+	mutation := newTagSuggestionMutation(c.config, OpCreate)
+	return &TagSuggestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TagSuggestion entities.
+func (c *TagSuggestionClient) CreateBulk(builders ...*TagSuggestionCreate) *TagSuggestionCreateBulk {
+	return &TagSuggestionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagSuggestionClient) MapCreateBulk(slice any, setFunc func(*TagSuggestionCreate, int)) *TagSuggestionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagSuggestionCreateBulk{err: fmt.Errorf("calling to TagSuggestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagSuggestionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagSuggestionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TagSuggestion.
+func (c *TagSuggestionClient) Update() *TagSuggestionUpdate {
+	mutation := newTagSuggestionMutation(c.config, OpUpdate)
+	return &TagSuggestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagSuggestionClient) UpdateOne(ts *TagSuggestion) *TagSuggestionUpdateOne {
+	mutation := newTagSuggestionMutation(c.config, OpUpdateOne, withTagSuggestion(ts))
+	return &TagSuggestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagSuggestionClient) UpdateOneID(id int) *TagSuggestionUpdateOne {
+	mutation := newTagSuggestionMutation(c.config, OpUpdateOne, withTagSuggestionID(id))
+	return &TagSuggestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TagSuggestion.
+func (c *TagSuggestionClient) Delete() *TagSuggestionDelete {
+	mutation := newTagSuggestionMutation(c.config, OpDelete)
+	return &TagSuggestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagSuggestionClient) DeleteOne(ts *TagSuggestion) *TagSuggestionDeleteOne {
+	return c.DeleteOneID(ts.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagSuggestionClient) DeleteOneID(id int) *TagSuggestionDeleteOne {
+	builder := c.Delete().Where(tagsuggestion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagSuggestionDeleteOne{builder}
+}
+
+// Query returns a query builder for TagSuggestion.
+func (c *TagSuggestionClient) Query() *TagSuggestionQuery {
+	return &TagSuggestionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTagSuggestion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TagSuggestion entity by its id.
+func (c *TagSuggestionClient) Get(ctx context.Context, id int) (*TagSuggestion, error) {
+	return c.Query().Where(tagsuggestion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagSuggestionClient) GetX(ctx context.Context, id int) *TagSuggestion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a TagSuggestion.
+func (c *TagSuggestionClient) QueryMedia(ts *TagSuggestion) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagsuggestion.Table, tagsuggestion.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tagsuggestion.MediaTable, tagsuggestion.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTag queries the tag edge of a TagSuggestion.
+func (c *TagSuggestionClient) QueryTag(ts *TagSuggestion) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagsuggestion.Table, tagsuggestion.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tagsuggestion.TagTable, tagsuggestion.TagColumn),
+		)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagSuggestionClient) Hooks() []Hook {
+	return c.hooks.TagSuggestion
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagSuggestionClient) Interceptors() []Interceptor {
+	return c.inters.TagSuggestion
+}
+
+func (c *TagSuggestionClient) mutate(ctx context.Context, m *TagSuggestionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagSuggestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagSuggestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagSuggestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagSuggestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TagSuggestion mutation op: %q", m.Op())
+	}
+}
+
+// TagVectorClient is a client for the TagVector schema.
+type TagVectorClient struct {
+	config
+}
+
+// NewTagVectorClient returns a client for the TagVector from the given config.
+func NewTagVectorClient(c config) *TagVectorClient {
+	return &TagVectorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tagvector.Hooks(f(g(h())))`.
+func (c *TagVectorClient) Use(hooks ...Hook) {
+	c.hooks.TagVector = append(c.hooks.TagVector, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tagvector.Intercept(f(g(h())))`.
+func (c *TagVectorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagVector = append(c.inters.TagVector, interceptors...)
+}
+
+// Create returns a builder for creating a TagVector entity.
+func (c *TagVectorClient) Create() *TagVectorCreate {
+	mutation := newTagVectorMutation(c.config, OpCreate)
+	return &TagVectorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TagVector entities.
+func (c *TagVectorClient) CreateBulk(builders ...*TagVectorCreate) *TagVectorCreateBulk {
+	return &TagVectorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagVectorClient) MapCreateBulk(slice any, setFunc func(*TagVectorCreate, int)) *TagVectorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagVectorCreateBulk{err: fmt.Errorf("calling to TagVectorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagVectorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagVectorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TagVector.
+func (c *TagVectorClient) Update() *TagVectorUpdate {
+	mutation := newTagVectorMutation(c.config, OpUpdate)
+	return &TagVectorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagVectorClient) UpdateOne(tv *TagVector) *TagVectorUpdateOne {
+	mutation := newTagVectorMutation(c.config, OpUpdateOne, withTagVector(tv))
+	return &TagVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagVectorClient) UpdateOneID(id int) *TagVectorUpdateOne {
+	mutation := newTagVectorMutation(c.config, OpUpdateOne, withTagVectorID(id))
+	return &TagVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TagVector.
+func (c *TagVectorClient) Delete() *TagVectorDelete {
+	mutation := newTagVectorMutation(c.config, OpDelete)
+	return &TagVectorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagVectorClient) DeleteOne(tv *TagVector) *TagVectorDeleteOne {
+	return c.DeleteOneID(tv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagVectorClient) DeleteOneID(id int) *TagVectorDeleteOne {
+	builder := c.Delete().Where(tagvector.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagVectorDeleteOne{builder}
+}
+
+// Query returns a query builder for TagVector.
+func (c *TagVectorClient) Query() *TagVectorQuery {
+	return &TagVectorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTagVector},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TagVector entity by its id.
+func (c *TagVectorClient) Get(ctx context.Context, id int) (*TagVector, error) {
+	return c.Query().Where(tagvector.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagVectorClient) GetX(ctx context.Context, id int) *TagVector {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTag queries the tag edge of a TagVector.
+func (c *TagVectorClient) QueryTag(tv *TagVector) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagvector.Table, tagvector.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tagvector.TagTable, tagvector.TagColumn),
+		)
+		fromV = sqlgraph.Neighbors(tv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVector queries the vector edge of a TagVector.
+func (c *TagVectorClient) QueryVector(tv *TagVector) *VectorQuery {
+	query := (&VectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagvector.Table, tagvector.FieldID, id),
+			sqlgraph.To(vector.Table, vector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tagvector.VectorTable, tagvector.VectorColumn),
+		)
+		fromV = sqlgraph.Neighbors(tv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagVectorClient) Hooks() []Hook {
+	return c.hooks.TagVector
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagVectorClient) Interceptors() []Interceptor {
+	return c.inters.TagVector
+}
+
+func (c *TagVectorClient) mutate(ctx context.Context, m *TagVectorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagVectorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagVectorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagVectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagVectorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TagVector mutation op: %q", m.Op())
+	}
+}
+
 // VectorClient is a client for the Vector schema.
 type VectorClient struct {
 	config
@@ -1555,11 +4154,15 @@ func (c *VectorClient) mutate(ctx context.Context, m *VectorMutation) (Value, er
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Date, HiddenTagFilter, Media, MediaDate, MediaVector, Setting, Tag,
+		AuditLog, AutoTag, Comment, Date, Favorite, HiddenTagFilter, Media, MediaDate,
+		MediaField, MediaRevision, MediaVector, MediaVote, MetaField, Note, Pool,
+		PoolMedia, Rendition, Setting, Source, Tag, TagSuggestion, TagVector,
 		Vector []ent.Hook
 	}
 	inters struct {
-		Date, HiddenTagFilter, Media, MediaDate, MediaVector, Setting, Tag,
+		AuditLog, AutoTag, Comment, Date, Favorite, HiddenTagFilter, Media, MediaDate,
+		MediaField, MediaRevision, MediaVector, MediaVote, MetaField, Note, Pool,
+		PoolMedia, Rendition, Setting, Source, Tag, TagSuggestion, TagVector,
 		Vector []ent.Interceptor
 	}
 )
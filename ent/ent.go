@@ -4,13 +4,28 @@ package ent
 
 import (
 	"context"
+	"era/booru/ent/auditlog"
+	"era/booru/ent/autotag"
+	"era/booru/ent/comment"
 	"era/booru/ent/date"
+	"era/booru/ent/favorite"
 	"era/booru/ent/hiddentagfilter"
 	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/mediarevision"
 	"era/booru/ent/mediavector"
+	"era/booru/ent/mediavote"
+	"era/booru/ent/metafield"
+	"era/booru/ent/note"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"era/booru/ent/rendition"
 	"era/booru/ent/setting"
+	"era/booru/ent/source"
 	"era/booru/ent/tag"
+	"era/booru/ent/tagsuggestion"
+	"era/booru/ent/tagvector"
 	"era/booru/ent/vector"
 	"errors"
 	"fmt"
@@ -80,13 +95,28 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			auditlog.Table:        auditlog.ValidColumn,
+			autotag.Table:         autotag.ValidColumn,
+			comment.Table:         comment.ValidColumn,
 			date.Table:            date.ValidColumn,
+			favorite.Table:        favorite.ValidColumn,
 			hiddentagfilter.Table: hiddentagfilter.ValidColumn,
 			media.Table:           media.ValidColumn,
 			mediadate.Table:       mediadate.ValidColumn,
+			mediafield.Table:      mediafield.ValidColumn,
+			mediarevision.Table:   mediarevision.ValidColumn,
 			mediavector.Table:     mediavector.ValidColumn,
+			mediavote.Table:       mediavote.ValidColumn,
+			metafield.Table:       metafield.ValidColumn,
+			note.Table:            note.ValidColumn,
+			pool.Table:            pool.ValidColumn,
+			poolmedia.Table:       poolmedia.ValidColumn,
+			rendition.Table:       rendition.ValidColumn,
 			setting.Table:         setting.ValidColumn,
+			source.Table:          source.ValidColumn,
 			tag.Table:             tag.ValidColumn,
+			tagsuggestion.Table:   tagsuggestion.ValidColumn,
+			tagvector.Table:       tagvector.ValidColumn,
 			vector.Table:          vector.ValidColumn,
 		})
 	})
@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/favorite"
+	"era/booru/ent/media"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Favorite is the model entity for the Favorite schema.
+type Favorite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User who favorited the media, as reported by the request
+	Actor string `json:"actor,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID string `json:"media_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FavoriteQuery when eager-loading is set.
+	Edges        FavoriteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FavoriteEdges holds the relations/edges for other nodes in the graph.
+type FavoriteEdges struct {
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FavoriteEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Favorite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case favorite.FieldID:
+			values[i] = new(sql.NullInt64)
+		case favorite.FieldActor, favorite.FieldMediaID:
+			values[i] = new(sql.NullString)
+		case favorite.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Favorite fields.
+func (f *Favorite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case favorite.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			f.ID = int(value.Int64)
+		case favorite.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				f.Actor = value.String
+			}
+		case favorite.FieldMediaID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value.Valid {
+				f.MediaID = value.String
+			}
+		case favorite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				f.CreatedAt = value.Time
+			}
+		default:
+			f.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Favorite.
+// This includes values selected through modifiers, order, etc.
+func (f *Favorite) Value(name string) (ent.Value, error) {
+	return f.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the Favorite entity.
+func (f *Favorite) QueryMedia() *MediaQuery {
+	return NewFavoriteClient(f.config).QueryMedia(f)
+}
+
+// Update returns a builder for updating this Favorite.
+// Note that you need to call Favorite.Unwrap() before calling this method if this Favorite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *Favorite) Update() *FavoriteUpdateOne {
+	return NewFavoriteClient(f.config).UpdateOne(f)
+}
+
+// Unwrap unwraps the Favorite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (f *Favorite) Unwrap() *Favorite {
+	_tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Favorite is not a transactional entity")
+	}
+	f.config.driver = _tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *Favorite) String() string {
+	var builder strings.Builder
+	builder.WriteString("Favorite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", f.ID))
+	builder.WriteString("actor=")
+	builder.WriteString(f.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("media_id=")
+	builder.WriteString(f.MediaID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Favorites is a parsable slice of Favorite.
+type Favorites []*Favorite
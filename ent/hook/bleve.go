@@ -43,6 +43,20 @@ func SyncBleve(q *river.Client[pgx.Tx]) ent.Hook {
 				}
 
 				if id != "" && q != nil {
+					// Inside a transaction the index job must not read the row
+					// before the edit is committed.
+					if tx, err := mv.Tx(); err == nil {
+						tx.OnCommit(func(next ent.Committer) ent.Committer {
+							return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+								if err := next.Commit(ctx, tx); err != nil {
+									return err
+								}
+								log.Printf("SyncBleve enqueueing index job for ID: %s", id)
+								return queue.Enqueue(ctx, q, queue.IndexArgs{ID: id})
+							})
+						})
+						return v, nil
+					}
 					log.Printf("SyncBleve enqueueing index job for ID: %s", id)
 					if err := queue.Enqueue(ctx, q, queue.IndexArgs{ID: id}); err != nil {
 						return nil, err
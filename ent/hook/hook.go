@@ -8,6 +8,42 @@ import (
 	"fmt"
 )
 
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+}
+
+// The AutoTagFunc type is an adapter to allow the use of ordinary
+// function as AutoTag mutator.
+type AutoTagFunc func(context.Context, *ent.AutoTagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AutoTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AutoTagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AutoTagMutation", m)
+}
+
+// The CommentFunc type is an adapter to allow the use of ordinary
+// function as Comment mutator.
+type CommentFunc func(context.Context, *ent.CommentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentMutation", m)
+}
+
 // The DateFunc type is an adapter to allow the use of ordinary
 // function as Date mutator.
 type DateFunc func(context.Context, *ent.DateMutation) (ent.Value, error)
@@ -20,6 +56,18 @@ func (f DateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DateMutation", m)
 }
 
+// The FavoriteFunc type is an adapter to allow the use of ordinary
+// function as Favorite mutator.
+type FavoriteFunc func(context.Context, *ent.FavoriteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FavoriteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FavoriteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FavoriteMutation", m)
+}
+
 // The HiddenTagFilterFunc type is an adapter to allow the use of ordinary
 // function as HiddenTagFilter mutator.
 type HiddenTagFilterFunc func(context.Context, *ent.HiddenTagFilterMutation) (ent.Value, error)
@@ -56,6 +104,30 @@ func (f MediaDateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MediaDateMutation", m)
 }
 
+// The MediaFieldFunc type is an adapter to allow the use of ordinary
+// function as MediaField mutator.
+type MediaFieldFunc func(context.Context, *ent.MediaFieldMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MediaFieldFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MediaFieldMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MediaFieldMutation", m)
+}
+
+// The MediaRevisionFunc type is an adapter to allow the use of ordinary
+// function as MediaRevision mutator.
+type MediaRevisionFunc func(context.Context, *ent.MediaRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MediaRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MediaRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MediaRevisionMutation", m)
+}
+
 // The MediaVectorFunc type is an adapter to allow the use of ordinary
 // function as MediaVector mutator.
 type MediaVectorFunc func(context.Context, *ent.MediaVectorMutation) (ent.Value, error)
@@ -68,6 +140,78 @@ func (f MediaVectorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MediaVectorMutation", m)
 }
 
+// The MediaVoteFunc type is an adapter to allow the use of ordinary
+// function as MediaVote mutator.
+type MediaVoteFunc func(context.Context, *ent.MediaVoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MediaVoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MediaVoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MediaVoteMutation", m)
+}
+
+// The MetaFieldFunc type is an adapter to allow the use of ordinary
+// function as MetaField mutator.
+type MetaFieldFunc func(context.Context, *ent.MetaFieldMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MetaFieldFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MetaFieldMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MetaFieldMutation", m)
+}
+
+// The NoteFunc type is an adapter to allow the use of ordinary
+// function as Note mutator.
+type NoteFunc func(context.Context, *ent.NoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NoteMutation", m)
+}
+
+// The PoolFunc type is an adapter to allow the use of ordinary
+// function as Pool mutator.
+type PoolFunc func(context.Context, *ent.PoolMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PoolFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PoolMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PoolMutation", m)
+}
+
+// The PoolMediaFunc type is an adapter to allow the use of ordinary
+// function as PoolMedia mutator.
+type PoolMediaFunc func(context.Context, *ent.PoolMediaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PoolMediaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PoolMediaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PoolMediaMutation", m)
+}
+
+// The RenditionFunc type is an adapter to allow the use of ordinary
+// function as Rendition mutator.
+type RenditionFunc func(context.Context, *ent.RenditionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RenditionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RenditionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RenditionMutation", m)
+}
+
 // The SettingFunc type is an adapter to allow the use of ordinary
 // function as Setting mutator.
 type SettingFunc func(context.Context, *ent.SettingMutation) (ent.Value, error)
@@ -80,6 +224,18 @@ func (f SettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettingMutation", m)
 }
 
+// The SourceFunc type is an adapter to allow the use of ordinary
+// function as Source mutator.
+type SourceFunc func(context.Context, *ent.SourceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SourceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SourceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SourceMutation", m)
+}
+
 // The TagFunc type is an adapter to allow the use of ordinary
 // function as Tag mutator.
 type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
@@ -92,6 +248,30 @@ func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
 }
 
+// The TagSuggestionFunc type is an adapter to allow the use of ordinary
+// function as TagSuggestion mutator.
+type TagSuggestionFunc func(context.Context, *ent.TagSuggestionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagSuggestionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagSuggestionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagSuggestionMutation", m)
+}
+
+// The TagVectorFunc type is an adapter to allow the use of ordinary
+// function as TagVector mutator.
+type TagVectorFunc func(context.Context, *ent.TagVectorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagVectorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagVectorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagVectorMutation", m)
+}
+
 // The VectorFunc type is an adapter to allow the use of ordinary
 // function as Vector mutator.
 type VectorFunc func(context.Context, *ent.VectorMutation) (ent.Value, error)
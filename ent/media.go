@@ -6,6 +6,7 @@ import (
 	"era/booru/ent/media"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -23,8 +24,36 @@ type Media struct {
 	Width int16 `json:"width,omitempty"`
 	// Image height in pixels
 	Height int16 `json:"height,omitempty"`
-	// Duration in seconds for video or audio
-	Duration *int16 `json:"duration,omitempty"`
+	// Duration in seconds for video, audio or animated images
+	Duration *int32 `json:"duration,omitempty"`
+	// Number of frames for animated images
+	Frames *int32 `json:"frames,omitempty"`
+	// Bitrate in bits per second for video or audio
+	Bitrate *int `json:"bitrate,omitempty"`
+	// Codec of the primary video stream, e.g. h264
+	VideoCodec *string `json:"video_codec,omitempty"`
+	// Codec of the primary audio stream, e.g. aac
+	AudioCodec *string `json:"audio_codec,omitempty"`
+	// Average frame rate of the primary video stream
+	Fps *float64 `json:"fps,omitempty"`
+	// Whether a video or audio file contains an audio track
+	HasAudio *bool `json:"has_audio,omitempty"`
+	// Display rotation of the video in degrees clockwise
+	Rotation *int16 `json:"rotation,omitempty"`
+	// Incremented on every tag or date edit; used for optimistic concurrency
+	Version int `json:"version,omitempty"`
+	// Content rating; nil until someone rates the media
+	Rating *media.Rating `json:"rating,omitempty"`
+	// Sum of the up and down votes in MediaVote
+	Score int `json:"score,omitempty"`
+	// Number of users who favorited the media
+	FavCount int `json:"fav_count,omitempty"`
+	// Optional human-readable title
+	Title string `json:"title,omitempty"`
+	// Optional free-form description, searched as prose
+	Description string `json:"description,omitempty"`
+	// When the media was moved to the trash; nil while it is live
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MediaQuery when eager-loading is set.
 	Edges        MediaEdges `json:"edges"`
@@ -39,13 +68,29 @@ type MediaEdges struct {
 	Dates []*Date `json:"dates,omitempty"`
 	// Vector entries associated with the media item
 	Vectors []*Vector `json:"vectors,omitempty"`
+	// Custom metadata fields set on the media item
+	MetaFields []*MetaField `json:"meta_fields,omitempty"`
+	// Pools the media item belongs to
+	Pools []*Pool `json:"pools,omitempty"`
+	// Users who favorited the media item
+	Favorites []*Favorite `json:"favorites,omitempty"`
+	// Discussion thread of the media item
+	Comments []*Comment `json:"comments,omitempty"`
+	// Region annotations on the media item
+	Notes []*Note `json:"notes,omitempty"`
+	// URLs the media item was obtained from
+	Sources []*Source `json:"sources,omitempty"`
 	// MediaDates holds the value of the media_dates edge.
 	MediaDates []*MediaDate `json:"media_dates,omitempty"`
 	// MediaVectors holds the value of the media_vectors edge.
 	MediaVectors []*MediaVector `json:"media_vectors,omitempty"`
+	// MediaFields holds the value of the media_fields edge.
+	MediaFields []*MediaField `json:"media_fields,omitempty"`
+	// PoolMedia holds the value of the pool_media edge.
+	PoolMedia []*PoolMedia `json:"pool_media,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [13]bool
 }
 
 // TagsOrErr returns the Tags value or an error if the edge
@@ -75,10 +120,64 @@ func (e MediaEdges) VectorsOrErr() ([]*Vector, error) {
 	return nil, &NotLoadedError{edge: "vectors"}
 }
 
+// MetaFieldsOrErr returns the MetaFields value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) MetaFieldsOrErr() ([]*MetaField, error) {
+	if e.loadedTypes[3] {
+		return e.MetaFields, nil
+	}
+	return nil, &NotLoadedError{edge: "meta_fields"}
+}
+
+// PoolsOrErr returns the Pools value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) PoolsOrErr() ([]*Pool, error) {
+	if e.loadedTypes[4] {
+		return e.Pools, nil
+	}
+	return nil, &NotLoadedError{edge: "pools"}
+}
+
+// FavoritesOrErr returns the Favorites value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) FavoritesOrErr() ([]*Favorite, error) {
+	if e.loadedTypes[5] {
+		return e.Favorites, nil
+	}
+	return nil, &NotLoadedError{edge: "favorites"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[6] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// NotesOrErr returns the Notes value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) NotesOrErr() ([]*Note, error) {
+	if e.loadedTypes[7] {
+		return e.Notes, nil
+	}
+	return nil, &NotLoadedError{edge: "notes"}
+}
+
+// SourcesOrErr returns the Sources value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) SourcesOrErr() ([]*Source, error) {
+	if e.loadedTypes[8] {
+		return e.Sources, nil
+	}
+	return nil, &NotLoadedError{edge: "sources"}
+}
+
 // MediaDatesOrErr returns the MediaDates value or an error if the edge
 // was not loaded in eager-loading.
 func (e MediaEdges) MediaDatesOrErr() ([]*MediaDate, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[9] {
 		return e.MediaDates, nil
 	}
 	return nil, &NotLoadedError{edge: "media_dates"}
@@ -87,21 +186,45 @@ func (e MediaEdges) MediaDatesOrErr() ([]*MediaDate, error) {
 // MediaVectorsOrErr returns the MediaVectors value or an error if the edge
 // was not loaded in eager-loading.
 func (e MediaEdges) MediaVectorsOrErr() ([]*MediaVector, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[10] {
 		return e.MediaVectors, nil
 	}
 	return nil, &NotLoadedError{edge: "media_vectors"}
 }
 
+// MediaFieldsOrErr returns the MediaFields value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) MediaFieldsOrErr() ([]*MediaField, error) {
+	if e.loadedTypes[11] {
+		return e.MediaFields, nil
+	}
+	return nil, &NotLoadedError{edge: "media_fields"}
+}
+
+// PoolMediaOrErr returns the PoolMedia value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) PoolMediaOrErr() ([]*PoolMedia, error) {
+	if e.loadedTypes[12] {
+		return e.PoolMedia, nil
+	}
+	return nil, &NotLoadedError{edge: "pool_media"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Media) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case media.FieldWidth, media.FieldHeight, media.FieldDuration:
+		case media.FieldHasAudio:
+			values[i] = new(sql.NullBool)
+		case media.FieldFps:
+			values[i] = new(sql.NullFloat64)
+		case media.FieldWidth, media.FieldHeight, media.FieldDuration, media.FieldFrames, media.FieldBitrate, media.FieldRotation, media.FieldVersion, media.FieldScore, media.FieldFavCount:
 			values[i] = new(sql.NullInt64)
-		case media.FieldID, media.FieldFormat:
+		case media.FieldID, media.FieldFormat, media.FieldVideoCodec, media.FieldAudioCodec, media.FieldRating, media.FieldTitle, media.FieldDescription:
 			values[i] = new(sql.NullString)
+		case media.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -145,8 +268,101 @@ func (m *Media) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field duration", values[i])
 			} else if value.Valid {
-				m.Duration = new(int16)
-				*m.Duration = int16(value.Int64)
+				m.Duration = new(int32)
+				*m.Duration = int32(value.Int64)
+			}
+		case media.FieldFrames:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field frames", values[i])
+			} else if value.Valid {
+				m.Frames = new(int32)
+				*m.Frames = int32(value.Int64)
+			}
+		case media.FieldBitrate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bitrate", values[i])
+			} else if value.Valid {
+				m.Bitrate = new(int)
+				*m.Bitrate = int(value.Int64)
+			}
+		case media.FieldVideoCodec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field video_codec", values[i])
+			} else if value.Valid {
+				m.VideoCodec = new(string)
+				*m.VideoCodec = value.String
+			}
+		case media.FieldAudioCodec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_codec", values[i])
+			} else if value.Valid {
+				m.AudioCodec = new(string)
+				*m.AudioCodec = value.String
+			}
+		case media.FieldFps:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fps", values[i])
+			} else if value.Valid {
+				m.Fps = new(float64)
+				*m.Fps = value.Float64
+			}
+		case media.FieldHasAudio:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_audio", values[i])
+			} else if value.Valid {
+				m.HasAudio = new(bool)
+				*m.HasAudio = value.Bool
+			}
+		case media.FieldRotation:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation", values[i])
+			} else if value.Valid {
+				m.Rotation = new(int16)
+				*m.Rotation = int16(value.Int64)
+			}
+		case media.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				m.Version = int(value.Int64)
+			}
+		case media.FieldRating:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				m.Rating = new(media.Rating)
+				*m.Rating = media.Rating(value.String)
+			}
+		case media.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				m.Score = int(value.Int64)
+			}
+		case media.FieldFavCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fav_count", values[i])
+			} else if value.Valid {
+				m.FavCount = int(value.Int64)
+			}
+		case media.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				m.Title = value.String
+			}
+		case media.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				m.Description = value.String
+			}
+		case media.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				m.DeletedAt = new(time.Time)
+				*m.DeletedAt = value.Time
 			}
 		default:
 			m.selectValues.Set(columns[i], values[i])
@@ -176,6 +392,36 @@ func (m *Media) QueryVectors() *VectorQuery {
 	return NewMediaClient(m.config).QueryVectors(m)
 }
 
+// QueryMetaFields queries the "meta_fields" edge of the Media entity.
+func (m *Media) QueryMetaFields() *MetaFieldQuery {
+	return NewMediaClient(m.config).QueryMetaFields(m)
+}
+
+// QueryPools queries the "pools" edge of the Media entity.
+func (m *Media) QueryPools() *PoolQuery {
+	return NewMediaClient(m.config).QueryPools(m)
+}
+
+// QueryFavorites queries the "favorites" edge of the Media entity.
+func (m *Media) QueryFavorites() *FavoriteQuery {
+	return NewMediaClient(m.config).QueryFavorites(m)
+}
+
+// QueryComments queries the "comments" edge of the Media entity.
+func (m *Media) QueryComments() *CommentQuery {
+	return NewMediaClient(m.config).QueryComments(m)
+}
+
+// QueryNotes queries the "notes" edge of the Media entity.
+func (m *Media) QueryNotes() *NoteQuery {
+	return NewMediaClient(m.config).QueryNotes(m)
+}
+
+// QuerySources queries the "sources" edge of the Media entity.
+func (m *Media) QuerySources() *SourceQuery {
+	return NewMediaClient(m.config).QuerySources(m)
+}
+
 // QueryMediaDates queries the "media_dates" edge of the Media entity.
 func (m *Media) QueryMediaDates() *MediaDateQuery {
 	return NewMediaClient(m.config).QueryMediaDates(m)
@@ -186,6 +432,16 @@ func (m *Media) QueryMediaVectors() *MediaVectorQuery {
 	return NewMediaClient(m.config).QueryMediaVectors(m)
 }
 
+// QueryMediaFields queries the "media_fields" edge of the Media entity.
+func (m *Media) QueryMediaFields() *MediaFieldQuery {
+	return NewMediaClient(m.config).QueryMediaFields(m)
+}
+
+// QueryPoolMedia queries the "pool_media" edge of the Media entity.
+func (m *Media) QueryPoolMedia() *PoolMediaQuery {
+	return NewMediaClient(m.config).QueryPoolMedia(m)
+}
+
 // Update returns a builder for updating this Media.
 // Note that you need to call Media.Unwrap() before calling this method if this Media
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -222,6 +478,66 @@ func (m *Media) String() string {
 		builder.WriteString("duration=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := m.Frames; v != nil {
+		builder.WriteString("frames=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.Bitrate; v != nil {
+		builder.WriteString("bitrate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.VideoCodec; v != nil {
+		builder.WriteString("video_codec=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := m.AudioCodec; v != nil {
+		builder.WriteString("audio_codec=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := m.Fps; v != nil {
+		builder.WriteString("fps=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.HasAudio; v != nil {
+		builder.WriteString("has_audio=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.Rotation; v != nil {
+		builder.WriteString("rotation=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", m.Version))
+	builder.WriteString(", ")
+	if v := m.Rating; v != nil {
+		builder.WriteString("rating=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("fav_count=")
+	builder.WriteString(fmt.Sprintf("%v", m.FavCount))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(m.Description)
+	builder.WriteString(", ")
+	if v := m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
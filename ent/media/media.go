@@ -3,6 +3,8 @@
 package media
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,16 +22,60 @@ const (
 	FieldHeight = "height"
 	// FieldDuration holds the string denoting the duration field in the database.
 	FieldDuration = "duration"
+	// FieldFrames holds the string denoting the frames field in the database.
+	FieldFrames = "frames"
+	// FieldBitrate holds the string denoting the bitrate field in the database.
+	FieldBitrate = "bitrate"
+	// FieldVideoCodec holds the string denoting the video_codec field in the database.
+	FieldVideoCodec = "video_codec"
+	// FieldAudioCodec holds the string denoting the audio_codec field in the database.
+	FieldAudioCodec = "audio_codec"
+	// FieldFps holds the string denoting the fps field in the database.
+	FieldFps = "fps"
+	// FieldHasAudio holds the string denoting the has_audio field in the database.
+	FieldHasAudio = "has_audio"
+	// FieldRotation holds the string denoting the rotation field in the database.
+	FieldRotation = "rotation"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldFavCount holds the string denoting the fav_count field in the database.
+	FieldFavCount = "fav_count"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
 	// EdgeDates holds the string denoting the dates edge name in mutations.
 	EdgeDates = "dates"
 	// EdgeVectors holds the string denoting the vectors edge name in mutations.
 	EdgeVectors = "vectors"
+	// EdgeMetaFields holds the string denoting the meta_fields edge name in mutations.
+	EdgeMetaFields = "meta_fields"
+	// EdgePools holds the string denoting the pools edge name in mutations.
+	EdgePools = "pools"
+	// EdgeFavorites holds the string denoting the favorites edge name in mutations.
+	EdgeFavorites = "favorites"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeNotes holds the string denoting the notes edge name in mutations.
+	EdgeNotes = "notes"
+	// EdgeSources holds the string denoting the sources edge name in mutations.
+	EdgeSources = "sources"
 	// EdgeMediaDates holds the string denoting the media_dates edge name in mutations.
 	EdgeMediaDates = "media_dates"
 	// EdgeMediaVectors holds the string denoting the media_vectors edge name in mutations.
 	EdgeMediaVectors = "media_vectors"
+	// EdgeMediaFields holds the string denoting the media_fields edge name in mutations.
+	EdgeMediaFields = "media_fields"
+	// EdgePoolMedia holds the string denoting the pool_media edge name in mutations.
+	EdgePoolMedia = "pool_media"
 	// Table holds the table name of the media in the database.
 	Table = "media"
 	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
@@ -47,6 +93,44 @@ const (
 	// VectorsInverseTable is the table name for the Vector entity.
 	// It exists in this package in order to avoid circular dependency with the "vector" package.
 	VectorsInverseTable = "vectors"
+	// MetaFieldsTable is the table that holds the meta_fields relation/edge. The primary key declared below.
+	MetaFieldsTable = "media_fields"
+	// MetaFieldsInverseTable is the table name for the MetaField entity.
+	// It exists in this package in order to avoid circular dependency with the "metafield" package.
+	MetaFieldsInverseTable = "meta_fields"
+	// PoolsTable is the table that holds the pools relation/edge. The primary key declared below.
+	PoolsTable = "pool_media"
+	// PoolsInverseTable is the table name for the Pool entity.
+	// It exists in this package in order to avoid circular dependency with the "pool" package.
+	PoolsInverseTable = "pools"
+	// FavoritesTable is the table that holds the favorites relation/edge.
+	FavoritesTable = "favorites"
+	// FavoritesInverseTable is the table name for the Favorite entity.
+	// It exists in this package in order to avoid circular dependency with the "favorite" package.
+	FavoritesInverseTable = "favorites"
+	// FavoritesColumn is the table column denoting the favorites relation/edge.
+	FavoritesColumn = "media_id"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "media_id"
+	// NotesTable is the table that holds the notes relation/edge.
+	NotesTable = "notes"
+	// NotesInverseTable is the table name for the Note entity.
+	// It exists in this package in order to avoid circular dependency with the "note" package.
+	NotesInverseTable = "notes"
+	// NotesColumn is the table column denoting the notes relation/edge.
+	NotesColumn = "media_id"
+	// SourcesTable is the table that holds the sources relation/edge.
+	SourcesTable = "sources"
+	// SourcesInverseTable is the table name for the Source entity.
+	// It exists in this package in order to avoid circular dependency with the "source" package.
+	SourcesInverseTable = "sources"
+	// SourcesColumn is the table column denoting the sources relation/edge.
+	SourcesColumn = "media_id"
 	// MediaDatesTable is the table that holds the media_dates relation/edge.
 	MediaDatesTable = "media_dates"
 	// MediaDatesInverseTable is the table name for the MediaDate entity.
@@ -61,6 +145,20 @@ const (
 	MediaVectorsInverseTable = "media_vectors"
 	// MediaVectorsColumn is the table column denoting the media_vectors relation/edge.
 	MediaVectorsColumn = "media_id"
+	// MediaFieldsTable is the table that holds the media_fields relation/edge.
+	MediaFieldsTable = "media_fields"
+	// MediaFieldsInverseTable is the table name for the MediaField entity.
+	// It exists in this package in order to avoid circular dependency with the "mediafield" package.
+	MediaFieldsInverseTable = "media_fields"
+	// MediaFieldsColumn is the table column denoting the media_fields relation/edge.
+	MediaFieldsColumn = "media_id"
+	// PoolMediaTable is the table that holds the pool_media relation/edge.
+	PoolMediaTable = "pool_media"
+	// PoolMediaInverseTable is the table name for the PoolMedia entity.
+	// It exists in this package in order to avoid circular dependency with the "poolmedia" package.
+	PoolMediaInverseTable = "pool_media"
+	// PoolMediaColumn is the table column denoting the pool_media relation/edge.
+	PoolMediaColumn = "media_id"
 )
 
 // Columns holds all SQL columns for media fields.
@@ -70,6 +168,20 @@ var Columns = []string{
 	FieldWidth,
 	FieldHeight,
 	FieldDuration,
+	FieldFrames,
+	FieldBitrate,
+	FieldVideoCodec,
+	FieldAudioCodec,
+	FieldFps,
+	FieldHasAudio,
+	FieldRotation,
+	FieldVersion,
+	FieldRating,
+	FieldScore,
+	FieldFavCount,
+	FieldTitle,
+	FieldDescription,
+	FieldDeletedAt,
 }
 
 var (
@@ -82,6 +194,12 @@ var (
 	// VectorsPrimaryKey and VectorsColumn2 are the table columns denoting the
 	// primary key for the vectors relation (M2M).
 	VectorsPrimaryKey = []string{"media_id", "vector_id"}
+	// MetaFieldsPrimaryKey and MetaFieldsColumn2 are the table columns denoting the
+	// primary key for the meta_fields relation (M2M).
+	MetaFieldsPrimaryKey = []string{"media_id", "meta_field_id"}
+	// PoolsPrimaryKey and PoolsColumn2 are the table columns denoting the
+	// primary key for the pools relation (M2M).
+	PoolsPrimaryKey = []string{"pool_id", "media_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -95,10 +213,44 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore int
+	// DefaultFavCount holds the default value on creation for the "fav_count" field.
+	DefaultFavCount int
+	// DefaultTitle holds the default value on creation for the "title" field.
+	DefaultTitle string
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
 
+// Rating defines the type for the "rating" enum field.
+type Rating string
+
+// Rating values.
+const (
+	RatingSafe         Rating = "safe"
+	RatingQuestionable Rating = "questionable"
+	RatingExplicit     Rating = "explicit"
+)
+
+func (r Rating) String() string {
+	return string(r)
+}
+
+// RatingValidator is a validator for the "rating" field enum values. It is called by the builders before save.
+func RatingValidator(r Rating) error {
+	switch r {
+	case RatingSafe, RatingQuestionable, RatingExplicit:
+		return nil
+	default:
+		return fmt.Errorf("media: invalid enum value for rating field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the Media queries.
 type OrderOption func(*sql.Selector)
 
@@ -127,6 +279,76 @@ func ByDuration(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDuration, opts...).ToFunc()
 }
 
+// ByFrames orders the results by the frames field.
+func ByFrames(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrames, opts...).ToFunc()
+}
+
+// ByBitrate orders the results by the bitrate field.
+func ByBitrate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBitrate, opts...).ToFunc()
+}
+
+// ByVideoCodec orders the results by the video_codec field.
+func ByVideoCodec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoCodec, opts...).ToFunc()
+}
+
+// ByAudioCodec orders the results by the audio_codec field.
+func ByAudioCodec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioCodec, opts...).ToFunc()
+}
+
+// ByFps orders the results by the fps field.
+func ByFps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFps, opts...).ToFunc()
+}
+
+// ByHasAudio orders the results by the has_audio field.
+func ByHasAudio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHasAudio, opts...).ToFunc()
+}
+
+// ByRotation orders the results by the rotation field.
+func ByRotation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotation, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByRating orders the results by the rating field.
+func ByRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRating, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByFavCount orders the results by the fav_count field.
+func ByFavCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFavCount, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByTagsCount orders the results by tags count.
 func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -169,6 +391,90 @@ func ByVectors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByMetaFieldsCount orders the results by meta_fields count.
+func ByMetaFieldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMetaFieldsStep(), opts...)
+	}
+}
+
+// ByMetaFields orders the results by meta_fields terms.
+func ByMetaFields(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMetaFieldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPoolsCount orders the results by pools count.
+func ByPoolsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPoolsStep(), opts...)
+	}
+}
+
+// ByPools orders the results by pools terms.
+func ByPools(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPoolsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFavoritesCount orders the results by favorites count.
+func ByFavoritesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFavoritesStep(), opts...)
+	}
+}
+
+// ByFavorites orders the results by favorites terms.
+func ByFavorites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFavoritesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotesCount orders the results by notes count.
+func ByNotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotesStep(), opts...)
+	}
+}
+
+// ByNotes orders the results by notes terms.
+func ByNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySourcesCount orders the results by sources count.
+func BySourcesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSourcesStep(), opts...)
+	}
+}
+
+// BySources orders the results by sources terms.
+func BySources(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSourcesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByMediaDatesCount orders the results by media_dates count.
 func ByMediaDatesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -196,6 +502,34 @@ func ByMediaVectors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMediaVectorsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMediaFieldsCount orders the results by media_fields count.
+func ByMediaFieldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMediaFieldsStep(), opts...)
+	}
+}
+
+// ByMediaFields orders the results by media_fields terms.
+func ByMediaFields(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMediaFieldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPoolMediaCount orders the results by pool_media count.
+func ByPoolMediaCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPoolMediaStep(), opts...)
+	}
+}
+
+// ByPoolMedia orders the results by pool_media terms.
+func ByPoolMedia(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPoolMediaStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTagsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -217,6 +551,48 @@ func newVectorsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, VectorsTable, VectorsPrimaryKey...),
 	)
 }
+func newMetaFieldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MetaFieldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, MetaFieldsTable, MetaFieldsPrimaryKey...),
+	)
+}
+func newPoolsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PoolsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, PoolsTable, PoolsPrimaryKey...),
+	)
+}
+func newFavoritesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FavoritesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, FavoritesTable, FavoritesColumn),
+	)
+}
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, CommentsTable, CommentsColumn),
+	)
+}
+func newNotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, NotesTable, NotesColumn),
+	)
+}
+func newSourcesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SourcesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SourcesTable, SourcesColumn),
+	)
+}
 func newMediaDatesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -231,3 +607,17 @@ func newMediaVectorsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, MediaVectorsTable, MediaVectorsColumn),
 	)
 }
+func newMediaFieldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MediaFieldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MediaFieldsTable, MediaFieldsColumn),
+	)
+}
+func newPoolMediaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PoolMediaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, PoolMediaTable, PoolMediaColumn),
+	)
+}
@@ -4,6 +4,7 @@ package media
 
 import (
 	"era/booru/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -80,10 +81,75 @@ func Height(v int16) predicate.Media {
 }
 
 // Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
-func Duration(v int16) predicate.Media {
+func Duration(v int32) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldDuration, v))
 }
 
+// Frames applies equality check predicate on the "frames" field. It's identical to FramesEQ.
+func Frames(v int32) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFrames, v))
+}
+
+// Bitrate applies equality check predicate on the "bitrate" field. It's identical to BitrateEQ.
+func Bitrate(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldBitrate, v))
+}
+
+// VideoCodec applies equality check predicate on the "video_codec" field. It's identical to VideoCodecEQ.
+func VideoCodec(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldVideoCodec, v))
+}
+
+// AudioCodec applies equality check predicate on the "audio_codec" field. It's identical to AudioCodecEQ.
+func AudioCodec(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldAudioCodec, v))
+}
+
+// Fps applies equality check predicate on the "fps" field. It's identical to FpsEQ.
+func Fps(v float64) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFps, v))
+}
+
+// HasAudio applies equality check predicate on the "has_audio" field. It's identical to HasAudioEQ.
+func HasAudio(v bool) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldHasAudio, v))
+}
+
+// Rotation applies equality check predicate on the "rotation" field. It's identical to RotationEQ.
+func Rotation(v int16) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldRotation, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldVersion, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldScore, v))
+}
+
+// FavCount applies equality check predicate on the "fav_count" field. It's identical to FavCountEQ.
+func FavCount(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFavCount, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldDescription, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // FormatEQ applies the EQ predicate on the "format" field.
 func FormatEQ(v string) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldFormat, v))
@@ -230,42 +296,42 @@ func HeightLTE(v int16) predicate.Media {
 }
 
 // DurationEQ applies the EQ predicate on the "duration" field.
-func DurationEQ(v int16) predicate.Media {
+func DurationEQ(v int32) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldDuration, v))
 }
 
 // DurationNEQ applies the NEQ predicate on the "duration" field.
-func DurationNEQ(v int16) predicate.Media {
+func DurationNEQ(v int32) predicate.Media {
 	return predicate.Media(sql.FieldNEQ(FieldDuration, v))
 }
 
 // DurationIn applies the In predicate on the "duration" field.
-func DurationIn(vs ...int16) predicate.Media {
+func DurationIn(vs ...int32) predicate.Media {
 	return predicate.Media(sql.FieldIn(FieldDuration, vs...))
 }
 
 // DurationNotIn applies the NotIn predicate on the "duration" field.
-func DurationNotIn(vs ...int16) predicate.Media {
+func DurationNotIn(vs ...int32) predicate.Media {
 	return predicate.Media(sql.FieldNotIn(FieldDuration, vs...))
 }
 
 // DurationGT applies the GT predicate on the "duration" field.
-func DurationGT(v int16) predicate.Media {
+func DurationGT(v int32) predicate.Media {
 	return predicate.Media(sql.FieldGT(FieldDuration, v))
 }
 
 // DurationGTE applies the GTE predicate on the "duration" field.
-func DurationGTE(v int16) predicate.Media {
+func DurationGTE(v int32) predicate.Media {
 	return predicate.Media(sql.FieldGTE(FieldDuration, v))
 }
 
 // DurationLT applies the LT predicate on the "duration" field.
-func DurationLT(v int16) predicate.Media {
+func DurationLT(v int32) predicate.Media {
 	return predicate.Media(sql.FieldLT(FieldDuration, v))
 }
 
 // DurationLTE applies the LTE predicate on the "duration" field.
-func DurationLTE(v int16) predicate.Media {
+func DurationLTE(v int32) predicate.Media {
 	return predicate.Media(sql.FieldLTE(FieldDuration, v))
 }
 
@@ -279,6 +345,726 @@ func DurationNotNil() predicate.Media {
 	return predicate.Media(sql.FieldNotNull(FieldDuration))
 }
 
+// FramesEQ applies the EQ predicate on the "frames" field.
+func FramesEQ(v int32) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFrames, v))
+}
+
+// FramesNEQ applies the NEQ predicate on the "frames" field.
+func FramesNEQ(v int32) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldFrames, v))
+}
+
+// FramesIn applies the In predicate on the "frames" field.
+func FramesIn(vs ...int32) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldFrames, vs...))
+}
+
+// FramesNotIn applies the NotIn predicate on the "frames" field.
+func FramesNotIn(vs ...int32) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldFrames, vs...))
+}
+
+// FramesGT applies the GT predicate on the "frames" field.
+func FramesGT(v int32) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldFrames, v))
+}
+
+// FramesGTE applies the GTE predicate on the "frames" field.
+func FramesGTE(v int32) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldFrames, v))
+}
+
+// FramesLT applies the LT predicate on the "frames" field.
+func FramesLT(v int32) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldFrames, v))
+}
+
+// FramesLTE applies the LTE predicate on the "frames" field.
+func FramesLTE(v int32) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldFrames, v))
+}
+
+// FramesIsNil applies the IsNil predicate on the "frames" field.
+func FramesIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldFrames))
+}
+
+// FramesNotNil applies the NotNil predicate on the "frames" field.
+func FramesNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldFrames))
+}
+
+// BitrateEQ applies the EQ predicate on the "bitrate" field.
+func BitrateEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldBitrate, v))
+}
+
+// BitrateNEQ applies the NEQ predicate on the "bitrate" field.
+func BitrateNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldBitrate, v))
+}
+
+// BitrateIn applies the In predicate on the "bitrate" field.
+func BitrateIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldBitrate, vs...))
+}
+
+// BitrateNotIn applies the NotIn predicate on the "bitrate" field.
+func BitrateNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldBitrate, vs...))
+}
+
+// BitrateGT applies the GT predicate on the "bitrate" field.
+func BitrateGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldBitrate, v))
+}
+
+// BitrateGTE applies the GTE predicate on the "bitrate" field.
+func BitrateGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldBitrate, v))
+}
+
+// BitrateLT applies the LT predicate on the "bitrate" field.
+func BitrateLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldBitrate, v))
+}
+
+// BitrateLTE applies the LTE predicate on the "bitrate" field.
+func BitrateLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldBitrate, v))
+}
+
+// BitrateIsNil applies the IsNil predicate on the "bitrate" field.
+func BitrateIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldBitrate))
+}
+
+// BitrateNotNil applies the NotNil predicate on the "bitrate" field.
+func BitrateNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldBitrate))
+}
+
+// VideoCodecEQ applies the EQ predicate on the "video_codec" field.
+func VideoCodecEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldVideoCodec, v))
+}
+
+// VideoCodecNEQ applies the NEQ predicate on the "video_codec" field.
+func VideoCodecNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldVideoCodec, v))
+}
+
+// VideoCodecIn applies the In predicate on the "video_codec" field.
+func VideoCodecIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldVideoCodec, vs...))
+}
+
+// VideoCodecNotIn applies the NotIn predicate on the "video_codec" field.
+func VideoCodecNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldVideoCodec, vs...))
+}
+
+// VideoCodecGT applies the GT predicate on the "video_codec" field.
+func VideoCodecGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldVideoCodec, v))
+}
+
+// VideoCodecGTE applies the GTE predicate on the "video_codec" field.
+func VideoCodecGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldVideoCodec, v))
+}
+
+// VideoCodecLT applies the LT predicate on the "video_codec" field.
+func VideoCodecLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldVideoCodec, v))
+}
+
+// VideoCodecLTE applies the LTE predicate on the "video_codec" field.
+func VideoCodecLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldVideoCodec, v))
+}
+
+// VideoCodecContains applies the Contains predicate on the "video_codec" field.
+func VideoCodecContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldVideoCodec, v))
+}
+
+// VideoCodecHasPrefix applies the HasPrefix predicate on the "video_codec" field.
+func VideoCodecHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldVideoCodec, v))
+}
+
+// VideoCodecHasSuffix applies the HasSuffix predicate on the "video_codec" field.
+func VideoCodecHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldVideoCodec, v))
+}
+
+// VideoCodecIsNil applies the IsNil predicate on the "video_codec" field.
+func VideoCodecIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldVideoCodec))
+}
+
+// VideoCodecNotNil applies the NotNil predicate on the "video_codec" field.
+func VideoCodecNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldVideoCodec))
+}
+
+// VideoCodecEqualFold applies the EqualFold predicate on the "video_codec" field.
+func VideoCodecEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldVideoCodec, v))
+}
+
+// VideoCodecContainsFold applies the ContainsFold predicate on the "video_codec" field.
+func VideoCodecContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldVideoCodec, v))
+}
+
+// AudioCodecEQ applies the EQ predicate on the "audio_codec" field.
+func AudioCodecEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldAudioCodec, v))
+}
+
+// AudioCodecNEQ applies the NEQ predicate on the "audio_codec" field.
+func AudioCodecNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldAudioCodec, v))
+}
+
+// AudioCodecIn applies the In predicate on the "audio_codec" field.
+func AudioCodecIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldAudioCodec, vs...))
+}
+
+// AudioCodecNotIn applies the NotIn predicate on the "audio_codec" field.
+func AudioCodecNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldAudioCodec, vs...))
+}
+
+// AudioCodecGT applies the GT predicate on the "audio_codec" field.
+func AudioCodecGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldAudioCodec, v))
+}
+
+// AudioCodecGTE applies the GTE predicate on the "audio_codec" field.
+func AudioCodecGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldAudioCodec, v))
+}
+
+// AudioCodecLT applies the LT predicate on the "audio_codec" field.
+func AudioCodecLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldAudioCodec, v))
+}
+
+// AudioCodecLTE applies the LTE predicate on the "audio_codec" field.
+func AudioCodecLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldAudioCodec, v))
+}
+
+// AudioCodecContains applies the Contains predicate on the "audio_codec" field.
+func AudioCodecContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldAudioCodec, v))
+}
+
+// AudioCodecHasPrefix applies the HasPrefix predicate on the "audio_codec" field.
+func AudioCodecHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldAudioCodec, v))
+}
+
+// AudioCodecHasSuffix applies the HasSuffix predicate on the "audio_codec" field.
+func AudioCodecHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldAudioCodec, v))
+}
+
+// AudioCodecIsNil applies the IsNil predicate on the "audio_codec" field.
+func AudioCodecIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldAudioCodec))
+}
+
+// AudioCodecNotNil applies the NotNil predicate on the "audio_codec" field.
+func AudioCodecNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldAudioCodec))
+}
+
+// AudioCodecEqualFold applies the EqualFold predicate on the "audio_codec" field.
+func AudioCodecEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldAudioCodec, v))
+}
+
+// AudioCodecContainsFold applies the ContainsFold predicate on the "audio_codec" field.
+func AudioCodecContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldAudioCodec, v))
+}
+
+// FpsEQ applies the EQ predicate on the "fps" field.
+func FpsEQ(v float64) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFps, v))
+}
+
+// FpsNEQ applies the NEQ predicate on the "fps" field.
+func FpsNEQ(v float64) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldFps, v))
+}
+
+// FpsIn applies the In predicate on the "fps" field.
+func FpsIn(vs ...float64) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldFps, vs...))
+}
+
+// FpsNotIn applies the NotIn predicate on the "fps" field.
+func FpsNotIn(vs ...float64) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldFps, vs...))
+}
+
+// FpsGT applies the GT predicate on the "fps" field.
+func FpsGT(v float64) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldFps, v))
+}
+
+// FpsGTE applies the GTE predicate on the "fps" field.
+func FpsGTE(v float64) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldFps, v))
+}
+
+// FpsLT applies the LT predicate on the "fps" field.
+func FpsLT(v float64) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldFps, v))
+}
+
+// FpsLTE applies the LTE predicate on the "fps" field.
+func FpsLTE(v float64) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldFps, v))
+}
+
+// FpsIsNil applies the IsNil predicate on the "fps" field.
+func FpsIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldFps))
+}
+
+// FpsNotNil applies the NotNil predicate on the "fps" field.
+func FpsNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldFps))
+}
+
+// HasAudioEQ applies the EQ predicate on the "has_audio" field.
+func HasAudioEQ(v bool) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldHasAudio, v))
+}
+
+// HasAudioNEQ applies the NEQ predicate on the "has_audio" field.
+func HasAudioNEQ(v bool) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldHasAudio, v))
+}
+
+// HasAudioIsNil applies the IsNil predicate on the "has_audio" field.
+func HasAudioIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldHasAudio))
+}
+
+// HasAudioNotNil applies the NotNil predicate on the "has_audio" field.
+func HasAudioNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldHasAudio))
+}
+
+// RotationEQ applies the EQ predicate on the "rotation" field.
+func RotationEQ(v int16) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldRotation, v))
+}
+
+// RotationNEQ applies the NEQ predicate on the "rotation" field.
+func RotationNEQ(v int16) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldRotation, v))
+}
+
+// RotationIn applies the In predicate on the "rotation" field.
+func RotationIn(vs ...int16) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldRotation, vs...))
+}
+
+// RotationNotIn applies the NotIn predicate on the "rotation" field.
+func RotationNotIn(vs ...int16) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldRotation, vs...))
+}
+
+// RotationGT applies the GT predicate on the "rotation" field.
+func RotationGT(v int16) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldRotation, v))
+}
+
+// RotationGTE applies the GTE predicate on the "rotation" field.
+func RotationGTE(v int16) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldRotation, v))
+}
+
+// RotationLT applies the LT predicate on the "rotation" field.
+func RotationLT(v int16) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldRotation, v))
+}
+
+// RotationLTE applies the LTE predicate on the "rotation" field.
+func RotationLTE(v int16) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldRotation, v))
+}
+
+// RotationIsNil applies the IsNil predicate on the "rotation" field.
+func RotationIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldRotation))
+}
+
+// RotationNotNil applies the NotNil predicate on the "rotation" field.
+func RotationNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldRotation))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldVersion, v))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v Rating) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v Rating) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...Rating) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...Rating) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingIsNil applies the IsNil predicate on the "rating" field.
+func RatingIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldRating))
+}
+
+// RatingNotNil applies the NotNil predicate on the "rating" field.
+func RatingNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldRating))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldScore, v))
+}
+
+// FavCountEQ applies the EQ predicate on the "fav_count" field.
+func FavCountEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldFavCount, v))
+}
+
+// FavCountNEQ applies the NEQ predicate on the "fav_count" field.
+func FavCountNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldFavCount, v))
+}
+
+// FavCountIn applies the In predicate on the "fav_count" field.
+func FavCountIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldFavCount, vs...))
+}
+
+// FavCountNotIn applies the NotIn predicate on the "fav_count" field.
+func FavCountNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldFavCount, vs...))
+}
+
+// FavCountGT applies the GT predicate on the "fav_count" field.
+func FavCountGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldFavCount, v))
+}
+
+// FavCountGTE applies the GTE predicate on the "fav_count" field.
+func FavCountGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldFavCount, v))
+}
+
+// FavCountLT applies the LT predicate on the "fav_count" field.
+func FavCountLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldFavCount, v))
+}
+
+// FavCountLTE applies the LTE predicate on the "fav_count" field.
+func FavCountLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldFavCount, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasTags applies the HasEdge predicate on the "tags" edge.
 func HasTags() predicate.Media {
 	return predicate.Media(func(s *sql.Selector) {
@@ -348,6 +1134,144 @@ func HasVectorsWith(preds ...predicate.Vector) predicate.Media {
 	})
 }
 
+// HasMetaFields applies the HasEdge predicate on the "meta_fields" edge.
+func HasMetaFields() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, MetaFieldsTable, MetaFieldsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMetaFieldsWith applies the HasEdge predicate on the "meta_fields" edge with a given conditions (other predicates).
+func HasMetaFieldsWith(preds ...predicate.MetaField) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newMetaFieldsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPools applies the HasEdge predicate on the "pools" edge.
+func HasPools() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, PoolsTable, PoolsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPoolsWith applies the HasEdge predicate on the "pools" edge with a given conditions (other predicates).
+func HasPoolsWith(preds ...predicate.Pool) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newPoolsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFavorites applies the HasEdge predicate on the "favorites" edge.
+func HasFavorites() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, FavoritesTable, FavoritesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFavoritesWith applies the HasEdge predicate on the "favorites" edge with a given conditions (other predicates).
+func HasFavoritesWith(preds ...predicate.Favorite) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newFavoritesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotes applies the HasEdge predicate on the "notes" edge.
+func HasNotes() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, NotesTable, NotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotesWith applies the HasEdge predicate on the "notes" edge with a given conditions (other predicates).
+func HasNotesWith(preds ...predicate.Note) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newNotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSources applies the HasEdge predicate on the "sources" edge.
+func HasSources() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SourcesTable, SourcesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSourcesWith applies the HasEdge predicate on the "sources" edge with a given conditions (other predicates).
+func HasSourcesWith(preds ...predicate.Source) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newSourcesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasMediaDates applies the HasEdge predicate on the "media_dates" edge.
 func HasMediaDates() predicate.Media {
 	return predicate.Media(func(s *sql.Selector) {
@@ -394,6 +1318,52 @@ func HasMediaVectorsWith(preds ...predicate.MediaVector) predicate.Media {
 	})
 }
 
+// HasMediaFields applies the HasEdge predicate on the "media_fields" edge.
+func HasMediaFields() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MediaFieldsTable, MediaFieldsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMediaFieldsWith applies the HasEdge predicate on the "media_fields" edge with a given conditions (other predicates).
+func HasMediaFieldsWith(preds ...predicate.MediaField) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newMediaFieldsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPoolMedia applies the HasEdge predicate on the "pool_media" edge.
+func HasPoolMedia() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, PoolMediaTable, PoolMediaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPoolMediaWith applies the HasEdge predicate on the "pool_media" edge with a given conditions (other predicates).
+func HasPoolMediaWith(preds ...predicate.PoolMedia) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newPoolMediaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Media) predicate.Media {
 	return predicate.Media(sql.AndPredicates(predicates...))
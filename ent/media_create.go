@@ -4,14 +4,23 @@ package ent
 
 import (
 	"context"
+	"era/booru/ent/comment"
 	"era/booru/ent/date"
+	"era/booru/ent/favorite"
 	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
+	"era/booru/ent/mediafield"
 	"era/booru/ent/mediavector"
+	"era/booru/ent/metafield"
+	"era/booru/ent/note"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"era/booru/ent/source"
 	"era/booru/ent/tag"
 	"era/booru/ent/vector"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -43,19 +52,215 @@ func (mc *MediaCreate) SetHeight(i int16) *MediaCreate {
 }
 
 // SetDuration sets the "duration" field.
-func (mc *MediaCreate) SetDuration(i int16) *MediaCreate {
+func (mc *MediaCreate) SetDuration(i int32) *MediaCreate {
 	mc.mutation.SetDuration(i)
 	return mc
 }
 
 // SetNillableDuration sets the "duration" field if the given value is not nil.
-func (mc *MediaCreate) SetNillableDuration(i *int16) *MediaCreate {
+func (mc *MediaCreate) SetNillableDuration(i *int32) *MediaCreate {
 	if i != nil {
 		mc.SetDuration(*i)
 	}
 	return mc
 }
 
+// SetFrames sets the "frames" field.
+func (mc *MediaCreate) SetFrames(i int32) *MediaCreate {
+	mc.mutation.SetFrames(i)
+	return mc
+}
+
+// SetNillableFrames sets the "frames" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableFrames(i *int32) *MediaCreate {
+	if i != nil {
+		mc.SetFrames(*i)
+	}
+	return mc
+}
+
+// SetBitrate sets the "bitrate" field.
+func (mc *MediaCreate) SetBitrate(i int) *MediaCreate {
+	mc.mutation.SetBitrate(i)
+	return mc
+}
+
+// SetNillableBitrate sets the "bitrate" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableBitrate(i *int) *MediaCreate {
+	if i != nil {
+		mc.SetBitrate(*i)
+	}
+	return mc
+}
+
+// SetVideoCodec sets the "video_codec" field.
+func (mc *MediaCreate) SetVideoCodec(s string) *MediaCreate {
+	mc.mutation.SetVideoCodec(s)
+	return mc
+}
+
+// SetNillableVideoCodec sets the "video_codec" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableVideoCodec(s *string) *MediaCreate {
+	if s != nil {
+		mc.SetVideoCodec(*s)
+	}
+	return mc
+}
+
+// SetAudioCodec sets the "audio_codec" field.
+func (mc *MediaCreate) SetAudioCodec(s string) *MediaCreate {
+	mc.mutation.SetAudioCodec(s)
+	return mc
+}
+
+// SetNillableAudioCodec sets the "audio_codec" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableAudioCodec(s *string) *MediaCreate {
+	if s != nil {
+		mc.SetAudioCodec(*s)
+	}
+	return mc
+}
+
+// SetFps sets the "fps" field.
+func (mc *MediaCreate) SetFps(f float64) *MediaCreate {
+	mc.mutation.SetFps(f)
+	return mc
+}
+
+// SetNillableFps sets the "fps" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableFps(f *float64) *MediaCreate {
+	if f != nil {
+		mc.SetFps(*f)
+	}
+	return mc
+}
+
+// SetHasAudio sets the "has_audio" field.
+func (mc *MediaCreate) SetHasAudio(b bool) *MediaCreate {
+	mc.mutation.SetHasAudio(b)
+	return mc
+}
+
+// SetNillableHasAudio sets the "has_audio" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableHasAudio(b *bool) *MediaCreate {
+	if b != nil {
+		mc.SetHasAudio(*b)
+	}
+	return mc
+}
+
+// SetRotation sets the "rotation" field.
+func (mc *MediaCreate) SetRotation(i int16) *MediaCreate {
+	mc.mutation.SetRotation(i)
+	return mc
+}
+
+// SetNillableRotation sets the "rotation" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableRotation(i *int16) *MediaCreate {
+	if i != nil {
+		mc.SetRotation(*i)
+	}
+	return mc
+}
+
+// SetVersion sets the "version" field.
+func (mc *MediaCreate) SetVersion(i int) *MediaCreate {
+	mc.mutation.SetVersion(i)
+	return mc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableVersion(i *int) *MediaCreate {
+	if i != nil {
+		mc.SetVersion(*i)
+	}
+	return mc
+}
+
+// SetRating sets the "rating" field.
+func (mc *MediaCreate) SetRating(m media.Rating) *MediaCreate {
+	mc.mutation.SetRating(m)
+	return mc
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableRating(m *media.Rating) *MediaCreate {
+	if m != nil {
+		mc.SetRating(*m)
+	}
+	return mc
+}
+
+// SetScore sets the "score" field.
+func (mc *MediaCreate) SetScore(i int) *MediaCreate {
+	mc.mutation.SetScore(i)
+	return mc
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableScore(i *int) *MediaCreate {
+	if i != nil {
+		mc.SetScore(*i)
+	}
+	return mc
+}
+
+// SetFavCount sets the "fav_count" field.
+func (mc *MediaCreate) SetFavCount(i int) *MediaCreate {
+	mc.mutation.SetFavCount(i)
+	return mc
+}
+
+// SetNillableFavCount sets the "fav_count" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableFavCount(i *int) *MediaCreate {
+	if i != nil {
+		mc.SetFavCount(*i)
+	}
+	return mc
+}
+
+// SetTitle sets the "title" field.
+func (mc *MediaCreate) SetTitle(s string) *MediaCreate {
+	mc.mutation.SetTitle(s)
+	return mc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableTitle(s *string) *MediaCreate {
+	if s != nil {
+		mc.SetTitle(*s)
+	}
+	return mc
+}
+
+// SetDescription sets the "description" field.
+func (mc *MediaCreate) SetDescription(s string) *MediaCreate {
+	mc.mutation.SetDescription(s)
+	return mc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableDescription(s *string) *MediaCreate {
+	if s != nil {
+		mc.SetDescription(*s)
+	}
+	return mc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mc *MediaCreate) SetDeletedAt(t time.Time) *MediaCreate {
+	mc.mutation.SetDeletedAt(t)
+	return mc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mc *MediaCreate) SetNillableDeletedAt(t *time.Time) *MediaCreate {
+	if t != nil {
+		mc.SetDeletedAt(*t)
+	}
+	return mc
+}
+
 // SetID sets the "id" field.
 func (mc *MediaCreate) SetID(s string) *MediaCreate {
 	mc.mutation.SetID(s)
@@ -107,6 +312,96 @@ func (mc *MediaCreate) AddVectors(v ...*Vector) *MediaCreate {
 	return mc.AddVectorIDs(ids...)
 }
 
+// AddMetaFieldIDs adds the "meta_fields" edge to the MetaField entity by IDs.
+func (mc *MediaCreate) AddMetaFieldIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddMetaFieldIDs(ids...)
+	return mc
+}
+
+// AddMetaFields adds the "meta_fields" edges to the MetaField entity.
+func (mc *MediaCreate) AddMetaFields(m ...*MetaField) *MediaCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddMetaFieldIDs(ids...)
+}
+
+// AddPoolIDs adds the "pools" edge to the Pool entity by IDs.
+func (mc *MediaCreate) AddPoolIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddPoolIDs(ids...)
+	return mc
+}
+
+// AddPools adds the "pools" edges to the Pool entity.
+func (mc *MediaCreate) AddPools(p ...*Pool) *MediaCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mc.AddPoolIDs(ids...)
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (mc *MediaCreate) AddFavoriteIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddFavoriteIDs(ids...)
+	return mc
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (mc *MediaCreate) AddFavorites(f ...*Favorite) *MediaCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return mc.AddFavoriteIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (mc *MediaCreate) AddCommentIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddCommentIDs(ids...)
+	return mc
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (mc *MediaCreate) AddComments(c ...*Comment) *MediaCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return mc.AddCommentIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (mc *MediaCreate) AddNoteIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddNoteIDs(ids...)
+	return mc
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (mc *MediaCreate) AddNotes(n ...*Note) *MediaCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mc.AddNoteIDs(ids...)
+}
+
+// AddSourceIDs adds the "sources" edge to the Source entity by IDs.
+func (mc *MediaCreate) AddSourceIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddSourceIDs(ids...)
+	return mc
+}
+
+// AddSources adds the "sources" edges to the Source entity.
+func (mc *MediaCreate) AddSources(s ...*Source) *MediaCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mc.AddSourceIDs(ids...)
+}
+
 // AddMediaDateIDs adds the "media_dates" edge to the MediaDate entity by IDs.
 func (mc *MediaCreate) AddMediaDateIDs(ids ...int) *MediaCreate {
 	mc.mutation.AddMediaDateIDs(ids...)
@@ -137,6 +432,36 @@ func (mc *MediaCreate) AddMediaVectors(m ...*MediaVector) *MediaCreate {
 	return mc.AddMediaVectorIDs(ids...)
 }
 
+// AddMediaFieldIDs adds the "media_fields" edge to the MediaField entity by IDs.
+func (mc *MediaCreate) AddMediaFieldIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddMediaFieldIDs(ids...)
+	return mc
+}
+
+// AddMediaFields adds the "media_fields" edges to the MediaField entity.
+func (mc *MediaCreate) AddMediaFields(m ...*MediaField) *MediaCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddMediaFieldIDs(ids...)
+}
+
+// AddPoolMediumIDs adds the "pool_media" edge to the PoolMedia entity by IDs.
+func (mc *MediaCreate) AddPoolMediumIDs(ids ...int) *MediaCreate {
+	mc.mutation.AddPoolMediumIDs(ids...)
+	return mc
+}
+
+// AddPoolMedia adds the "pool_media" edges to the PoolMedia entity.
+func (mc *MediaCreate) AddPoolMedia(p ...*PoolMedia) *MediaCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mc.AddPoolMediumIDs(ids...)
+}
+
 // Mutation returns the MediaMutation object of the builder.
 func (mc *MediaCreate) Mutation() *MediaMutation {
 	return mc.mutation
@@ -144,6 +469,7 @@ func (mc *MediaCreate) Mutation() *MediaMutation {
 
 // Save creates the Media in the database.
 func (mc *MediaCreate) Save(ctx context.Context) (*Media, error) {
+	mc.defaults()
 	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
 }
 
@@ -169,6 +495,30 @@ func (mc *MediaCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (mc *MediaCreate) defaults() {
+	if _, ok := mc.mutation.Version(); !ok {
+		v := media.DefaultVersion
+		mc.mutation.SetVersion(v)
+	}
+	if _, ok := mc.mutation.Score(); !ok {
+		v := media.DefaultScore
+		mc.mutation.SetScore(v)
+	}
+	if _, ok := mc.mutation.FavCount(); !ok {
+		v := media.DefaultFavCount
+		mc.mutation.SetFavCount(v)
+	}
+	if _, ok := mc.mutation.Title(); !ok {
+		v := media.DefaultTitle
+		mc.mutation.SetTitle(v)
+	}
+	if _, ok := mc.mutation.Description(); !ok {
+		v := media.DefaultDescription
+		mc.mutation.SetDescription(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (mc *MediaCreate) check() error {
 	if _, ok := mc.mutation.Format(); !ok {
@@ -180,6 +530,20 @@ func (mc *MediaCreate) check() error {
 	if _, ok := mc.mutation.Height(); !ok {
 		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Media.height"`)}
 	}
+	if _, ok := mc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Media.version"`)}
+	}
+	if v, ok := mc.mutation.Rating(); ok {
+		if err := media.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "Media.rating": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "Media.score"`)}
+	}
+	if _, ok := mc.mutation.FavCount(); !ok {
+		return &ValidationError{Name: "fav_count", err: errors.New(`ent: missing required field "Media.fav_count"`)}
+	}
 	if v, ok := mc.mutation.ID(); ok {
 		if err := media.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Media.id": %w`, err)}
@@ -233,9 +597,65 @@ func (mc *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
 		_node.Height = value
 	}
 	if value, ok := mc.mutation.Duration(); ok {
-		_spec.SetField(media.FieldDuration, field.TypeInt16, value)
+		_spec.SetField(media.FieldDuration, field.TypeInt32, value)
 		_node.Duration = &value
 	}
+	if value, ok := mc.mutation.Frames(); ok {
+		_spec.SetField(media.FieldFrames, field.TypeInt32, value)
+		_node.Frames = &value
+	}
+	if value, ok := mc.mutation.Bitrate(); ok {
+		_spec.SetField(media.FieldBitrate, field.TypeInt, value)
+		_node.Bitrate = &value
+	}
+	if value, ok := mc.mutation.VideoCodec(); ok {
+		_spec.SetField(media.FieldVideoCodec, field.TypeString, value)
+		_node.VideoCodec = &value
+	}
+	if value, ok := mc.mutation.AudioCodec(); ok {
+		_spec.SetField(media.FieldAudioCodec, field.TypeString, value)
+		_node.AudioCodec = &value
+	}
+	if value, ok := mc.mutation.Fps(); ok {
+		_spec.SetField(media.FieldFps, field.TypeFloat64, value)
+		_node.Fps = &value
+	}
+	if value, ok := mc.mutation.HasAudio(); ok {
+		_spec.SetField(media.FieldHasAudio, field.TypeBool, value)
+		_node.HasAudio = &value
+	}
+	if value, ok := mc.mutation.Rotation(); ok {
+		_spec.SetField(media.FieldRotation, field.TypeInt16, value)
+		_node.Rotation = &value
+	}
+	if value, ok := mc.mutation.Version(); ok {
+		_spec.SetField(media.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := mc.mutation.Rating(); ok {
+		_spec.SetField(media.FieldRating, field.TypeEnum, value)
+		_node.Rating = &value
+	}
+	if value, ok := mc.mutation.Score(); ok {
+		_spec.SetField(media.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := mc.mutation.FavCount(); ok {
+		_spec.SetField(media.FieldFavCount, field.TypeInt, value)
+		_node.FavCount = value
+	}
+	if value, ok := mc.mutation.Title(); ok {
+		_spec.SetField(media.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := mc.mutation.Description(); ok {
+		_spec.SetField(media.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := mc.mutation.DeletedAt(); ok {
+		_spec.SetField(media.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := mc.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -284,6 +704,102 @@ func (mc *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.MetaFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.MetaFieldsTable,
+			Columns: media.MetaFieldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.PoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.PoolsTable,
+			Columns: media.PoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.FavoritesTable,
+			Columns: []string{media.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.CommentsTable,
+			Columns: []string{media.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.NotesTable,
+			Columns: []string{media.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.SourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.SourcesTable,
+			Columns: []string{media.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := mc.mutation.MediaDatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -316,6 +832,38 @@ func (mc *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.MediaFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.MediaFieldsTable,
+			Columns: []string{media.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.PoolMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.PoolMediaTable,
+			Columns: []string{media.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -337,6 +885,7 @@ func (mcb *MediaCreateBulk) Save(ctx context.Context) ([]*Media, error) {
 	for i := range mcb.builders {
 		func(i int, root context.Context) {
 			builder := mcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*MediaMutation)
 				if !ok {
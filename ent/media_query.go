@@ -5,11 +5,19 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"era/booru/ent/comment"
 	"era/booru/ent/date"
+	"era/booru/ent/favorite"
 	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
+	"era/booru/ent/mediafield"
 	"era/booru/ent/mediavector"
+	"era/booru/ent/metafield"
+	"era/booru/ent/note"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
 	"era/booru/ent/predicate"
+	"era/booru/ent/source"
 	"era/booru/ent/tag"
 	"era/booru/ent/vector"
 	"fmt"
@@ -31,8 +39,16 @@ type MediaQuery struct {
 	withTags         *TagQuery
 	withDates        *DateQuery
 	withVectors      *VectorQuery
+	withMetaFields   *MetaFieldQuery
+	withPools        *PoolQuery
+	withFavorites    *FavoriteQuery
+	withComments     *CommentQuery
+	withNotes        *NoteQuery
+	withSources      *SourceQuery
 	withMediaDates   *MediaDateQuery
 	withMediaVectors *MediaVectorQuery
+	withMediaFields  *MediaFieldQuery
+	withPoolMedia    *PoolMediaQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -135,6 +151,138 @@ func (mq *MediaQuery) QueryVectors() *VectorQuery {
 	return query
 }
 
+// QueryMetaFields chains the current query on the "meta_fields" edge.
+func (mq *MediaQuery) QueryMetaFields() *MetaFieldQuery {
+	query := (&MetaFieldClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(metafield.Table, metafield.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, media.MetaFieldsTable, media.MetaFieldsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPools chains the current query on the "pools" edge.
+func (mq *MediaQuery) QueryPools() *PoolQuery {
+	query := (&PoolClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(pool.Table, pool.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, media.PoolsTable, media.PoolsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFavorites chains the current query on the "favorites" edge.
+func (mq *MediaQuery) QueryFavorites() *FavoriteQuery {
+	query := (&FavoriteClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(favorite.Table, favorite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.FavoritesTable, media.FavoritesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the "comments" edge.
+func (mq *MediaQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.CommentsTable, media.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotes chains the current query on the "notes" edge.
+func (mq *MediaQuery) QueryNotes() *NoteQuery {
+	query := (&NoteClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.NotesTable, media.NotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySources chains the current query on the "sources" edge.
+func (mq *MediaQuery) QuerySources() *SourceQuery {
+	query := (&SourceClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(source.Table, source.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.SourcesTable, media.SourcesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryMediaDates chains the current query on the "media_dates" edge.
 func (mq *MediaQuery) QueryMediaDates() *MediaDateQuery {
 	query := (&MediaDateClient{config: mq.config}).Query()
@@ -179,6 +327,50 @@ func (mq *MediaQuery) QueryMediaVectors() *MediaVectorQuery {
 	return query
 }
 
+// QueryMediaFields chains the current query on the "media_fields" edge.
+func (mq *MediaQuery) QueryMediaFields() *MediaFieldQuery {
+	query := (&MediaFieldClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(mediafield.Table, mediafield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.MediaFieldsTable, media.MediaFieldsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPoolMedia chains the current query on the "pool_media" edge.
+func (mq *MediaQuery) QueryPoolMedia() *PoolMediaQuery {
+	query := (&PoolMediaClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(poolmedia.Table, poolmedia.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, media.PoolMediaTable, media.PoolMediaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Media entity from the query.
 // Returns a *NotFoundError when no Media was found.
 func (mq *MediaQuery) First(ctx context.Context) (*Media, error) {
@@ -374,8 +566,16 @@ func (mq *MediaQuery) Clone() *MediaQuery {
 		withTags:         mq.withTags.Clone(),
 		withDates:        mq.withDates.Clone(),
 		withVectors:      mq.withVectors.Clone(),
+		withMetaFields:   mq.withMetaFields.Clone(),
+		withPools:        mq.withPools.Clone(),
+		withFavorites:    mq.withFavorites.Clone(),
+		withComments:     mq.withComments.Clone(),
+		withNotes:        mq.withNotes.Clone(),
+		withSources:      mq.withSources.Clone(),
 		withMediaDates:   mq.withMediaDates.Clone(),
 		withMediaVectors: mq.withMediaVectors.Clone(),
+		withMediaFields:  mq.withMediaFields.Clone(),
+		withPoolMedia:    mq.withPoolMedia.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
@@ -415,6 +615,72 @@ func (mq *MediaQuery) WithVectors(opts ...func(*VectorQuery)) *MediaQuery {
 	return mq
 }
 
+// WithMetaFields tells the query-builder to eager-load the nodes that are connected to
+// the "meta_fields" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithMetaFields(opts ...func(*MetaFieldQuery)) *MediaQuery {
+	query := (&MetaFieldClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withMetaFields = query
+	return mq
+}
+
+// WithPools tells the query-builder to eager-load the nodes that are connected to
+// the "pools" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithPools(opts ...func(*PoolQuery)) *MediaQuery {
+	query := (&PoolClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withPools = query
+	return mq
+}
+
+// WithFavorites tells the query-builder to eager-load the nodes that are connected to
+// the "favorites" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithFavorites(opts ...func(*FavoriteQuery)) *MediaQuery {
+	query := (&FavoriteClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withFavorites = query
+	return mq
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithComments(opts ...func(*CommentQuery)) *MediaQuery {
+	query := (&CommentClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withComments = query
+	return mq
+}
+
+// WithNotes tells the query-builder to eager-load the nodes that are connected to
+// the "notes" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithNotes(opts ...func(*NoteQuery)) *MediaQuery {
+	query := (&NoteClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withNotes = query
+	return mq
+}
+
+// WithSources tells the query-builder to eager-load the nodes that are connected to
+// the "sources" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithSources(opts ...func(*SourceQuery)) *MediaQuery {
+	query := (&SourceClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withSources = query
+	return mq
+}
+
 // WithMediaDates tells the query-builder to eager-load the nodes that are connected to
 // the "media_dates" edge. The optional arguments are used to configure the query builder of the edge.
 func (mq *MediaQuery) WithMediaDates(opts ...func(*MediaDateQuery)) *MediaQuery {
@@ -437,6 +703,28 @@ func (mq *MediaQuery) WithMediaVectors(opts ...func(*MediaVectorQuery)) *MediaQu
 	return mq
 }
 
+// WithMediaFields tells the query-builder to eager-load the nodes that are connected to
+// the "media_fields" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithMediaFields(opts ...func(*MediaFieldQuery)) *MediaQuery {
+	query := (&MediaFieldClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withMediaFields = query
+	return mq
+}
+
+// WithPoolMedia tells the query-builder to eager-load the nodes that are connected to
+// the "pool_media" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MediaQuery) WithPoolMedia(opts ...func(*PoolMediaQuery)) *MediaQuery {
+	query := (&PoolMediaClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withPoolMedia = query
+	return mq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -515,12 +803,20 @@ func (mq *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 	var (
 		nodes       = []*Media{}
 		_spec       = mq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [13]bool{
 			mq.withTags != nil,
 			mq.withDates != nil,
 			mq.withVectors != nil,
+			mq.withMetaFields != nil,
+			mq.withPools != nil,
+			mq.withFavorites != nil,
+			mq.withComments != nil,
+			mq.withNotes != nil,
+			mq.withSources != nil,
 			mq.withMediaDates != nil,
 			mq.withMediaVectors != nil,
+			mq.withMediaFields != nil,
+			mq.withPoolMedia != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -562,6 +858,48 @@ func (mq *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 			return nil, err
 		}
 	}
+	if query := mq.withMetaFields; query != nil {
+		if err := mq.loadMetaFields(ctx, query, nodes,
+			func(n *Media) { n.Edges.MetaFields = []*MetaField{} },
+			func(n *Media, e *MetaField) { n.Edges.MetaFields = append(n.Edges.MetaFields, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withPools; query != nil {
+		if err := mq.loadPools(ctx, query, nodes,
+			func(n *Media) { n.Edges.Pools = []*Pool{} },
+			func(n *Media, e *Pool) { n.Edges.Pools = append(n.Edges.Pools, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withFavorites; query != nil {
+		if err := mq.loadFavorites(ctx, query, nodes,
+			func(n *Media) { n.Edges.Favorites = []*Favorite{} },
+			func(n *Media, e *Favorite) { n.Edges.Favorites = append(n.Edges.Favorites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withComments; query != nil {
+		if err := mq.loadComments(ctx, query, nodes,
+			func(n *Media) { n.Edges.Comments = []*Comment{} },
+			func(n *Media, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withNotes; query != nil {
+		if err := mq.loadNotes(ctx, query, nodes,
+			func(n *Media) { n.Edges.Notes = []*Note{} },
+			func(n *Media, e *Note) { n.Edges.Notes = append(n.Edges.Notes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withSources; query != nil {
+		if err := mq.loadSources(ctx, query, nodes,
+			func(n *Media) { n.Edges.Sources = []*Source{} },
+			func(n *Media, e *Source) { n.Edges.Sources = append(n.Edges.Sources, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := mq.withMediaDates; query != nil {
 		if err := mq.loadMediaDates(ctx, query, nodes,
 			func(n *Media) { n.Edges.MediaDates = []*MediaDate{} },
@@ -576,6 +914,20 @@ func (mq *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 			return nil, err
 		}
 	}
+	if query := mq.withMediaFields; query != nil {
+		if err := mq.loadMediaFields(ctx, query, nodes,
+			func(n *Media) { n.Edges.MediaFields = []*MediaField{} },
+			func(n *Media, e *MediaField) { n.Edges.MediaFields = append(n.Edges.MediaFields, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withPoolMedia; query != nil {
+		if err := mq.loadPoolMedia(ctx, query, nodes,
+			func(n *Media) { n.Edges.PoolMedia = []*PoolMedia{} },
+			func(n *Media, e *PoolMedia) { n.Edges.PoolMedia = append(n.Edges.PoolMedia, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -762,6 +1114,248 @@ func (mq *MediaQuery) loadVectors(ctx context.Context, query *VectorQuery, nodes
 	}
 	return nil
 }
+func (mq *MediaQuery) loadMetaFields(ctx context.Context, query *MetaFieldQuery, nodes []*Media, init func(*Media), assign func(*Media, *MetaField)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Media)
+	nids := make(map[int]map[*Media]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(media.MetaFieldsTable)
+		s.Join(joinT).On(s.C(metafield.FieldID), joinT.C(media.MetaFieldsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(media.MetaFieldsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(media.MetaFieldsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Media]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*MetaField](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "meta_fields" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (mq *MediaQuery) loadPools(ctx context.Context, query *PoolQuery, nodes []*Media, init func(*Media), assign func(*Media, *Pool)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Media)
+	nids := make(map[int]map[*Media]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(media.PoolsTable)
+		s.Join(joinT).On(s.C(pool.FieldID), joinT.C(media.PoolsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(media.PoolsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(media.PoolsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Media]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Pool](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "pools" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (mq *MediaQuery) loadFavorites(ctx context.Context, query *FavoriteQuery, nodes []*Media, init func(*Media), assign func(*Media, *Favorite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Media)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(favorite.FieldMediaID)
+	}
+	query.Where(predicate.Favorite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(media.FavoritesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MediaID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "media_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MediaQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Media, init func(*Media), assign func(*Media, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Media)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldMediaID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(media.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MediaID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "media_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MediaQuery) loadNotes(ctx context.Context, query *NoteQuery, nodes []*Media, init func(*Media), assign func(*Media, *Note)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Media)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(note.FieldMediaID)
+	}
+	query.Where(predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(media.NotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MediaID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "media_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MediaQuery) loadSources(ctx context.Context, query *SourceQuery, nodes []*Media, init func(*Media), assign func(*Media, *Source)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Media)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(source.FieldMediaID)
+	}
+	query.Where(predicate.Source(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(media.SourcesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MediaID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "media_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (mq *MediaQuery) loadMediaDates(ctx context.Context, query *MediaDateQuery, nodes []*Media, init func(*Media), assign func(*Media, *MediaDate)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Media)
@@ -822,6 +1416,66 @@ func (mq *MediaQuery) loadMediaVectors(ctx context.Context, query *MediaVectorQu
 	}
 	return nil
 }
+func (mq *MediaQuery) loadMediaFields(ctx context.Context, query *MediaFieldQuery, nodes []*Media, init func(*Media), assign func(*Media, *MediaField)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Media)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(mediafield.FieldMediaID)
+	}
+	query.Where(predicate.MediaField(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(media.MediaFieldsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MediaID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "media_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MediaQuery) loadPoolMedia(ctx context.Context, query *PoolMediaQuery, nodes []*Media, init func(*Media), assign func(*Media, *PoolMedia)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Media)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(poolmedia.FieldMediaID)
+	}
+	query.Where(predicate.PoolMedia(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(media.PoolMediaColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MediaID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "media_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (mq *MediaQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
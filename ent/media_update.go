@@ -4,15 +4,24 @@ package ent
 
 import (
 	"context"
+	"era/booru/ent/comment"
 	"era/booru/ent/date"
+	"era/booru/ent/favorite"
 	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
+	"era/booru/ent/mediafield"
 	"era/booru/ent/mediavector"
+	"era/booru/ent/metafield"
+	"era/booru/ent/note"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
 	"era/booru/ent/predicate"
+	"era/booru/ent/source"
 	"era/booru/ent/tag"
 	"era/booru/ent/vector"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -33,14 +42,14 @@ func (mu *MediaUpdate) Where(ps ...predicate.Media) *MediaUpdate {
 }
 
 // SetDuration sets the "duration" field.
-func (mu *MediaUpdate) SetDuration(i int16) *MediaUpdate {
+func (mu *MediaUpdate) SetDuration(i int32) *MediaUpdate {
 	mu.mutation.ResetDuration()
 	mu.mutation.SetDuration(i)
 	return mu
 }
 
 // SetNillableDuration sets the "duration" field if the given value is not nil.
-func (mu *MediaUpdate) SetNillableDuration(i *int16) *MediaUpdate {
+func (mu *MediaUpdate) SetNillableDuration(i *int32) *MediaUpdate {
 	if i != nil {
 		mu.SetDuration(*i)
 	}
@@ -48,7 +57,7 @@ func (mu *MediaUpdate) SetNillableDuration(i *int16) *MediaUpdate {
 }
 
 // AddDuration adds i to the "duration" field.
-func (mu *MediaUpdate) AddDuration(i int16) *MediaUpdate {
+func (mu *MediaUpdate) AddDuration(i int32) *MediaUpdate {
 	mu.mutation.AddDuration(i)
 	return mu
 }
@@ -59,6 +68,317 @@ func (mu *MediaUpdate) ClearDuration() *MediaUpdate {
 	return mu
 }
 
+// SetFrames sets the "frames" field.
+func (mu *MediaUpdate) SetFrames(i int32) *MediaUpdate {
+	mu.mutation.ResetFrames()
+	mu.mutation.SetFrames(i)
+	return mu
+}
+
+// SetNillableFrames sets the "frames" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableFrames(i *int32) *MediaUpdate {
+	if i != nil {
+		mu.SetFrames(*i)
+	}
+	return mu
+}
+
+// AddFrames adds i to the "frames" field.
+func (mu *MediaUpdate) AddFrames(i int32) *MediaUpdate {
+	mu.mutation.AddFrames(i)
+	return mu
+}
+
+// ClearFrames clears the value of the "frames" field.
+func (mu *MediaUpdate) ClearFrames() *MediaUpdate {
+	mu.mutation.ClearFrames()
+	return mu
+}
+
+// SetBitrate sets the "bitrate" field.
+func (mu *MediaUpdate) SetBitrate(i int) *MediaUpdate {
+	mu.mutation.ResetBitrate()
+	mu.mutation.SetBitrate(i)
+	return mu
+}
+
+// SetNillableBitrate sets the "bitrate" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableBitrate(i *int) *MediaUpdate {
+	if i != nil {
+		mu.SetBitrate(*i)
+	}
+	return mu
+}
+
+// AddBitrate adds i to the "bitrate" field.
+func (mu *MediaUpdate) AddBitrate(i int) *MediaUpdate {
+	mu.mutation.AddBitrate(i)
+	return mu
+}
+
+// ClearBitrate clears the value of the "bitrate" field.
+func (mu *MediaUpdate) ClearBitrate() *MediaUpdate {
+	mu.mutation.ClearBitrate()
+	return mu
+}
+
+// SetVideoCodec sets the "video_codec" field.
+func (mu *MediaUpdate) SetVideoCodec(s string) *MediaUpdate {
+	mu.mutation.SetVideoCodec(s)
+	return mu
+}
+
+// SetNillableVideoCodec sets the "video_codec" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableVideoCodec(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetVideoCodec(*s)
+	}
+	return mu
+}
+
+// ClearVideoCodec clears the value of the "video_codec" field.
+func (mu *MediaUpdate) ClearVideoCodec() *MediaUpdate {
+	mu.mutation.ClearVideoCodec()
+	return mu
+}
+
+// SetAudioCodec sets the "audio_codec" field.
+func (mu *MediaUpdate) SetAudioCodec(s string) *MediaUpdate {
+	mu.mutation.SetAudioCodec(s)
+	return mu
+}
+
+// SetNillableAudioCodec sets the "audio_codec" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableAudioCodec(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetAudioCodec(*s)
+	}
+	return mu
+}
+
+// ClearAudioCodec clears the value of the "audio_codec" field.
+func (mu *MediaUpdate) ClearAudioCodec() *MediaUpdate {
+	mu.mutation.ClearAudioCodec()
+	return mu
+}
+
+// SetFps sets the "fps" field.
+func (mu *MediaUpdate) SetFps(f float64) *MediaUpdate {
+	mu.mutation.ResetFps()
+	mu.mutation.SetFps(f)
+	return mu
+}
+
+// SetNillableFps sets the "fps" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableFps(f *float64) *MediaUpdate {
+	if f != nil {
+		mu.SetFps(*f)
+	}
+	return mu
+}
+
+// AddFps adds f to the "fps" field.
+func (mu *MediaUpdate) AddFps(f float64) *MediaUpdate {
+	mu.mutation.AddFps(f)
+	return mu
+}
+
+// ClearFps clears the value of the "fps" field.
+func (mu *MediaUpdate) ClearFps() *MediaUpdate {
+	mu.mutation.ClearFps()
+	return mu
+}
+
+// SetHasAudio sets the "has_audio" field.
+func (mu *MediaUpdate) SetHasAudio(b bool) *MediaUpdate {
+	mu.mutation.SetHasAudio(b)
+	return mu
+}
+
+// SetNillableHasAudio sets the "has_audio" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableHasAudio(b *bool) *MediaUpdate {
+	if b != nil {
+		mu.SetHasAudio(*b)
+	}
+	return mu
+}
+
+// ClearHasAudio clears the value of the "has_audio" field.
+func (mu *MediaUpdate) ClearHasAudio() *MediaUpdate {
+	mu.mutation.ClearHasAudio()
+	return mu
+}
+
+// SetRotation sets the "rotation" field.
+func (mu *MediaUpdate) SetRotation(i int16) *MediaUpdate {
+	mu.mutation.ResetRotation()
+	mu.mutation.SetRotation(i)
+	return mu
+}
+
+// SetNillableRotation sets the "rotation" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableRotation(i *int16) *MediaUpdate {
+	if i != nil {
+		mu.SetRotation(*i)
+	}
+	return mu
+}
+
+// AddRotation adds i to the "rotation" field.
+func (mu *MediaUpdate) AddRotation(i int16) *MediaUpdate {
+	mu.mutation.AddRotation(i)
+	return mu
+}
+
+// ClearRotation clears the value of the "rotation" field.
+func (mu *MediaUpdate) ClearRotation() *MediaUpdate {
+	mu.mutation.ClearRotation()
+	return mu
+}
+
+// SetVersion sets the "version" field.
+func (mu *MediaUpdate) SetVersion(i int) *MediaUpdate {
+	mu.mutation.ResetVersion()
+	mu.mutation.SetVersion(i)
+	return mu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableVersion(i *int) *MediaUpdate {
+	if i != nil {
+		mu.SetVersion(*i)
+	}
+	return mu
+}
+
+// AddVersion adds i to the "version" field.
+func (mu *MediaUpdate) AddVersion(i int) *MediaUpdate {
+	mu.mutation.AddVersion(i)
+	return mu
+}
+
+// SetRating sets the "rating" field.
+func (mu *MediaUpdate) SetRating(m media.Rating) *MediaUpdate {
+	mu.mutation.SetRating(m)
+	return mu
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableRating(m *media.Rating) *MediaUpdate {
+	if m != nil {
+		mu.SetRating(*m)
+	}
+	return mu
+}
+
+// ClearRating clears the value of the "rating" field.
+func (mu *MediaUpdate) ClearRating() *MediaUpdate {
+	mu.mutation.ClearRating()
+	return mu
+}
+
+// SetScore sets the "score" field.
+func (mu *MediaUpdate) SetScore(i int) *MediaUpdate {
+	mu.mutation.ResetScore()
+	mu.mutation.SetScore(i)
+	return mu
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableScore(i *int) *MediaUpdate {
+	if i != nil {
+		mu.SetScore(*i)
+	}
+	return mu
+}
+
+// AddScore adds i to the "score" field.
+func (mu *MediaUpdate) AddScore(i int) *MediaUpdate {
+	mu.mutation.AddScore(i)
+	return mu
+}
+
+// SetFavCount sets the "fav_count" field.
+func (mu *MediaUpdate) SetFavCount(i int) *MediaUpdate {
+	mu.mutation.ResetFavCount()
+	mu.mutation.SetFavCount(i)
+	return mu
+}
+
+// SetNillableFavCount sets the "fav_count" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableFavCount(i *int) *MediaUpdate {
+	if i != nil {
+		mu.SetFavCount(*i)
+	}
+	return mu
+}
+
+// AddFavCount adds i to the "fav_count" field.
+func (mu *MediaUpdate) AddFavCount(i int) *MediaUpdate {
+	mu.mutation.AddFavCount(i)
+	return mu
+}
+
+// SetTitle sets the "title" field.
+func (mu *MediaUpdate) SetTitle(s string) *MediaUpdate {
+	mu.mutation.SetTitle(s)
+	return mu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableTitle(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetTitle(*s)
+	}
+	return mu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (mu *MediaUpdate) ClearTitle() *MediaUpdate {
+	mu.mutation.ClearTitle()
+	return mu
+}
+
+// SetDescription sets the "description" field.
+func (mu *MediaUpdate) SetDescription(s string) *MediaUpdate {
+	mu.mutation.SetDescription(s)
+	return mu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableDescription(s *string) *MediaUpdate {
+	if s != nil {
+		mu.SetDescription(*s)
+	}
+	return mu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (mu *MediaUpdate) ClearDescription() *MediaUpdate {
+	mu.mutation.ClearDescription()
+	return mu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mu *MediaUpdate) SetDeletedAt(t time.Time) *MediaUpdate {
+	mu.mutation.SetDeletedAt(t)
+	return mu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mu *MediaUpdate) SetNillableDeletedAt(t *time.Time) *MediaUpdate {
+	if t != nil {
+		mu.SetDeletedAt(*t)
+	}
+	return mu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mu *MediaUpdate) ClearDeletedAt() *MediaUpdate {
+	mu.mutation.ClearDeletedAt()
+	return mu
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (mu *MediaUpdate) AddTagIDs(ids ...int) *MediaUpdate {
 	mu.mutation.AddTagIDs(ids...)
@@ -104,6 +424,96 @@ func (mu *MediaUpdate) AddVectors(v ...*Vector) *MediaUpdate {
 	return mu.AddVectorIDs(ids...)
 }
 
+// AddMetaFieldIDs adds the "meta_fields" edge to the MetaField entity by IDs.
+func (mu *MediaUpdate) AddMetaFieldIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddMetaFieldIDs(ids...)
+	return mu
+}
+
+// AddMetaFields adds the "meta_fields" edges to the MetaField entity.
+func (mu *MediaUpdate) AddMetaFields(m ...*MetaField) *MediaUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddMetaFieldIDs(ids...)
+}
+
+// AddPoolIDs adds the "pools" edge to the Pool entity by IDs.
+func (mu *MediaUpdate) AddPoolIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddPoolIDs(ids...)
+	return mu
+}
+
+// AddPools adds the "pools" edges to the Pool entity.
+func (mu *MediaUpdate) AddPools(p ...*Pool) *MediaUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mu.AddPoolIDs(ids...)
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (mu *MediaUpdate) AddFavoriteIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddFavoriteIDs(ids...)
+	return mu
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (mu *MediaUpdate) AddFavorites(f ...*Favorite) *MediaUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return mu.AddFavoriteIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (mu *MediaUpdate) AddCommentIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddCommentIDs(ids...)
+	return mu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (mu *MediaUpdate) AddComments(c ...*Comment) *MediaUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return mu.AddCommentIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (mu *MediaUpdate) AddNoteIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddNoteIDs(ids...)
+	return mu
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (mu *MediaUpdate) AddNotes(n ...*Note) *MediaUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.AddNoteIDs(ids...)
+}
+
+// AddSourceIDs adds the "sources" edge to the Source entity by IDs.
+func (mu *MediaUpdate) AddSourceIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddSourceIDs(ids...)
+	return mu
+}
+
+// AddSources adds the "sources" edges to the Source entity.
+func (mu *MediaUpdate) AddSources(s ...*Source) *MediaUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mu.AddSourceIDs(ids...)
+}
+
 // AddMediaDateIDs adds the "media_dates" edge to the MediaDate entity by IDs.
 func (mu *MediaUpdate) AddMediaDateIDs(ids ...int) *MediaUpdate {
 	mu.mutation.AddMediaDateIDs(ids...)
@@ -134,6 +544,36 @@ func (mu *MediaUpdate) AddMediaVectors(m ...*MediaVector) *MediaUpdate {
 	return mu.AddMediaVectorIDs(ids...)
 }
 
+// AddMediaFieldIDs adds the "media_fields" edge to the MediaField entity by IDs.
+func (mu *MediaUpdate) AddMediaFieldIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddMediaFieldIDs(ids...)
+	return mu
+}
+
+// AddMediaFields adds the "media_fields" edges to the MediaField entity.
+func (mu *MediaUpdate) AddMediaFields(m ...*MediaField) *MediaUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddMediaFieldIDs(ids...)
+}
+
+// AddPoolMediumIDs adds the "pool_media" edge to the PoolMedia entity by IDs.
+func (mu *MediaUpdate) AddPoolMediumIDs(ids ...int) *MediaUpdate {
+	mu.mutation.AddPoolMediumIDs(ids...)
+	return mu
+}
+
+// AddPoolMedia adds the "pool_media" edges to the PoolMedia entity.
+func (mu *MediaUpdate) AddPoolMedia(p ...*PoolMedia) *MediaUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mu.AddPoolMediumIDs(ids...)
+}
+
 // Mutation returns the MediaMutation object of the builder.
 func (mu *MediaUpdate) Mutation() *MediaMutation {
 	return mu.mutation
@@ -202,76 +642,257 @@ func (mu *MediaUpdate) RemoveVectors(v ...*Vector) *MediaUpdate {
 	return mu.RemoveVectorIDs(ids...)
 }
 
-// ClearMediaDates clears all "media_dates" edges to the MediaDate entity.
-func (mu *MediaUpdate) ClearMediaDates() *MediaUpdate {
-	mu.mutation.ClearMediaDates()
+// ClearMetaFields clears all "meta_fields" edges to the MetaField entity.
+func (mu *MediaUpdate) ClearMetaFields() *MediaUpdate {
+	mu.mutation.ClearMetaFields()
 	return mu
 }
 
-// RemoveMediaDateIDs removes the "media_dates" edge to MediaDate entities by IDs.
-func (mu *MediaUpdate) RemoveMediaDateIDs(ids ...int) *MediaUpdate {
-	mu.mutation.RemoveMediaDateIDs(ids...)
+// RemoveMetaFieldIDs removes the "meta_fields" edge to MetaField entities by IDs.
+func (mu *MediaUpdate) RemoveMetaFieldIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemoveMetaFieldIDs(ids...)
 	return mu
 }
 
-// RemoveMediaDates removes "media_dates" edges to MediaDate entities.
-func (mu *MediaUpdate) RemoveMediaDates(m ...*MediaDate) *MediaUpdate {
+// RemoveMetaFields removes "meta_fields" edges to MetaField entities.
+func (mu *MediaUpdate) RemoveMetaFields(m ...*MetaField) *MediaUpdate {
 	ids := make([]int, len(m))
 	for i := range m {
 		ids[i] = m[i].ID
 	}
-	return mu.RemoveMediaDateIDs(ids...)
+	return mu.RemoveMetaFieldIDs(ids...)
 }
 
-// ClearMediaVectors clears all "media_vectors" edges to the MediaVector entity.
-func (mu *MediaUpdate) ClearMediaVectors() *MediaUpdate {
-	mu.mutation.ClearMediaVectors()
+// ClearPools clears all "pools" edges to the Pool entity.
+func (mu *MediaUpdate) ClearPools() *MediaUpdate {
+	mu.mutation.ClearPools()
 	return mu
 }
 
-// RemoveMediaVectorIDs removes the "media_vectors" edge to MediaVector entities by IDs.
-func (mu *MediaUpdate) RemoveMediaVectorIDs(ids ...int) *MediaUpdate {
-	mu.mutation.RemoveMediaVectorIDs(ids...)
+// RemovePoolIDs removes the "pools" edge to Pool entities by IDs.
+func (mu *MediaUpdate) RemovePoolIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemovePoolIDs(ids...)
 	return mu
 }
 
-// RemoveMediaVectors removes "media_vectors" edges to MediaVector entities.
-func (mu *MediaUpdate) RemoveMediaVectors(m ...*MediaVector) *MediaUpdate {
-	ids := make([]int, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+// RemovePools removes "pools" edges to Pool entities.
+func (mu *MediaUpdate) RemovePools(p ...*Pool) *MediaUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return mu.RemoveMediaVectorIDs(ids...)
+	return mu.RemovePoolIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (mu *MediaUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+// ClearFavorites clears all "favorites" edges to the Favorite entity.
+func (mu *MediaUpdate) ClearFavorites() *MediaUpdate {
+	mu.mutation.ClearFavorites()
+	return mu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (mu *MediaUpdate) SaveX(ctx context.Context) int {
-	affected, err := mu.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// RemoveFavoriteIDs removes the "favorites" edge to Favorite entities by IDs.
+func (mu *MediaUpdate) RemoveFavoriteIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemoveFavoriteIDs(ids...)
+	return mu
 }
 
-// Exec executes the query.
-func (mu *MediaUpdate) Exec(ctx context.Context) error {
-	_, err := mu.Save(ctx)
-	return err
+// RemoveFavorites removes "favorites" edges to Favorite entities.
+func (mu *MediaUpdate) RemoveFavorites(f ...*Favorite) *MediaUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return mu.RemoveFavoriteIDs(ids...)
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (mu *MediaUpdate) ExecX(ctx context.Context) {
-	if err := mu.Exec(ctx); err != nil {
-		panic(err)
-	}
+// ClearComments clears all "comments" edges to the Comment entity.
+func (mu *MediaUpdate) ClearComments() *MediaUpdate {
+	mu.mutation.ClearComments()
+	return mu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (mu *MediaUpdate) RemoveCommentIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemoveCommentIDs(ids...)
+	return mu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (mu *MediaUpdate) RemoveComments(c ...*Comment) *MediaUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return mu.RemoveCommentIDs(ids...)
+}
+
+// ClearNotes clears all "notes" edges to the Note entity.
+func (mu *MediaUpdate) ClearNotes() *MediaUpdate {
+	mu.mutation.ClearNotes()
+	return mu
+}
+
+// RemoveNoteIDs removes the "notes" edge to Note entities by IDs.
+func (mu *MediaUpdate) RemoveNoteIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemoveNoteIDs(ids...)
+	return mu
+}
+
+// RemoveNotes removes "notes" edges to Note entities.
+func (mu *MediaUpdate) RemoveNotes(n ...*Note) *MediaUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.RemoveNoteIDs(ids...)
+}
+
+// ClearSources clears all "sources" edges to the Source entity.
+func (mu *MediaUpdate) ClearSources() *MediaUpdate {
+	mu.mutation.ClearSources()
+	return mu
+}
+
+// RemoveSourceIDs removes the "sources" edge to Source entities by IDs.
+func (mu *MediaUpdate) RemoveSourceIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemoveSourceIDs(ids...)
+	return mu
+}
+
+// RemoveSources removes "sources" edges to Source entities.
+func (mu *MediaUpdate) RemoveSources(s ...*Source) *MediaUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return mu.RemoveSourceIDs(ids...)
+}
+
+// ClearMediaDates clears all "media_dates" edges to the MediaDate entity.
+func (mu *MediaUpdate) ClearMediaDates() *MediaUpdate {
+	mu.mutation.ClearMediaDates()
+	return mu
+}
+
+// RemoveMediaDateIDs removes the "media_dates" edge to MediaDate entities by IDs.
+func (mu *MediaUpdate) RemoveMediaDateIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemoveMediaDateIDs(ids...)
+	return mu
+}
+
+// RemoveMediaDates removes "media_dates" edges to MediaDate entities.
+func (mu *MediaUpdate) RemoveMediaDates(m ...*MediaDate) *MediaUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveMediaDateIDs(ids...)
+}
+
+// ClearMediaVectors clears all "media_vectors" edges to the MediaVector entity.
+func (mu *MediaUpdate) ClearMediaVectors() *MediaUpdate {
+	mu.mutation.ClearMediaVectors()
+	return mu
+}
+
+// RemoveMediaVectorIDs removes the "media_vectors" edge to MediaVector entities by IDs.
+func (mu *MediaUpdate) RemoveMediaVectorIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemoveMediaVectorIDs(ids...)
+	return mu
+}
+
+// RemoveMediaVectors removes "media_vectors" edges to MediaVector entities.
+func (mu *MediaUpdate) RemoveMediaVectors(m ...*MediaVector) *MediaUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveMediaVectorIDs(ids...)
+}
+
+// ClearMediaFields clears all "media_fields" edges to the MediaField entity.
+func (mu *MediaUpdate) ClearMediaFields() *MediaUpdate {
+	mu.mutation.ClearMediaFields()
+	return mu
+}
+
+// RemoveMediaFieldIDs removes the "media_fields" edge to MediaField entities by IDs.
+func (mu *MediaUpdate) RemoveMediaFieldIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemoveMediaFieldIDs(ids...)
+	return mu
+}
+
+// RemoveMediaFields removes "media_fields" edges to MediaField entities.
+func (mu *MediaUpdate) RemoveMediaFields(m ...*MediaField) *MediaUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveMediaFieldIDs(ids...)
+}
+
+// ClearPoolMedia clears all "pool_media" edges to the PoolMedia entity.
+func (mu *MediaUpdate) ClearPoolMedia() *MediaUpdate {
+	mu.mutation.ClearPoolMedia()
+	return mu
+}
+
+// RemovePoolMediumIDs removes the "pool_media" edge to PoolMedia entities by IDs.
+func (mu *MediaUpdate) RemovePoolMediumIDs(ids ...int) *MediaUpdate {
+	mu.mutation.RemovePoolMediumIDs(ids...)
+	return mu
+}
+
+// RemovePoolMedia removes "pool_media" edges to PoolMedia entities.
+func (mu *MediaUpdate) RemovePoolMedia(p ...*PoolMedia) *MediaUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mu.RemovePoolMediumIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MediaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MediaUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MediaUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MediaUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MediaUpdate) check() error {
+	if v, ok := mu.mutation.Rating(); ok {
+		if err := media.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "Media.rating": %w`, err)}
+		}
+	}
+	return nil
 }
 
 func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(media.Table, media.Columns, sqlgraph.NewFieldSpec(media.FieldID, field.TypeString))
 	if ps := mu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -281,13 +902,109 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 	}
 	if value, ok := mu.mutation.Duration(); ok {
-		_spec.SetField(media.FieldDuration, field.TypeInt16, value)
+		_spec.SetField(media.FieldDuration, field.TypeInt32, value)
 	}
 	if value, ok := mu.mutation.AddedDuration(); ok {
-		_spec.AddField(media.FieldDuration, field.TypeInt16, value)
+		_spec.AddField(media.FieldDuration, field.TypeInt32, value)
 	}
 	if mu.mutation.DurationCleared() {
-		_spec.ClearField(media.FieldDuration, field.TypeInt16)
+		_spec.ClearField(media.FieldDuration, field.TypeInt32)
+	}
+	if value, ok := mu.mutation.Frames(); ok {
+		_spec.SetField(media.FieldFrames, field.TypeInt32, value)
+	}
+	if value, ok := mu.mutation.AddedFrames(); ok {
+		_spec.AddField(media.FieldFrames, field.TypeInt32, value)
+	}
+	if mu.mutation.FramesCleared() {
+		_spec.ClearField(media.FieldFrames, field.TypeInt32)
+	}
+	if value, ok := mu.mutation.Bitrate(); ok {
+		_spec.SetField(media.FieldBitrate, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedBitrate(); ok {
+		_spec.AddField(media.FieldBitrate, field.TypeInt, value)
+	}
+	if mu.mutation.BitrateCleared() {
+		_spec.ClearField(media.FieldBitrate, field.TypeInt)
+	}
+	if value, ok := mu.mutation.VideoCodec(); ok {
+		_spec.SetField(media.FieldVideoCodec, field.TypeString, value)
+	}
+	if mu.mutation.VideoCodecCleared() {
+		_spec.ClearField(media.FieldVideoCodec, field.TypeString)
+	}
+	if value, ok := mu.mutation.AudioCodec(); ok {
+		_spec.SetField(media.FieldAudioCodec, field.TypeString, value)
+	}
+	if mu.mutation.AudioCodecCleared() {
+		_spec.ClearField(media.FieldAudioCodec, field.TypeString)
+	}
+	if value, ok := mu.mutation.Fps(); ok {
+		_spec.SetField(media.FieldFps, field.TypeFloat64, value)
+	}
+	if value, ok := mu.mutation.AddedFps(); ok {
+		_spec.AddField(media.FieldFps, field.TypeFloat64, value)
+	}
+	if mu.mutation.FpsCleared() {
+		_spec.ClearField(media.FieldFps, field.TypeFloat64)
+	}
+	if value, ok := mu.mutation.HasAudio(); ok {
+		_spec.SetField(media.FieldHasAudio, field.TypeBool, value)
+	}
+	if mu.mutation.HasAudioCleared() {
+		_spec.ClearField(media.FieldHasAudio, field.TypeBool)
+	}
+	if value, ok := mu.mutation.Rotation(); ok {
+		_spec.SetField(media.FieldRotation, field.TypeInt16, value)
+	}
+	if value, ok := mu.mutation.AddedRotation(); ok {
+		_spec.AddField(media.FieldRotation, field.TypeInt16, value)
+	}
+	if mu.mutation.RotationCleared() {
+		_spec.ClearField(media.FieldRotation, field.TypeInt16)
+	}
+	if value, ok := mu.mutation.Version(); ok {
+		_spec.SetField(media.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedVersion(); ok {
+		_spec.AddField(media.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.Rating(); ok {
+		_spec.SetField(media.FieldRating, field.TypeEnum, value)
+	}
+	if mu.mutation.RatingCleared() {
+		_spec.ClearField(media.FieldRating, field.TypeEnum)
+	}
+	if value, ok := mu.mutation.Score(); ok {
+		_spec.SetField(media.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedScore(); ok {
+		_spec.AddField(media.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.FavCount(); ok {
+		_spec.SetField(media.FieldFavCount, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedFavCount(); ok {
+		_spec.AddField(media.FieldFavCount, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.Title(); ok {
+		_spec.SetField(media.FieldTitle, field.TypeString, value)
+	}
+	if mu.mutation.TitleCleared() {
+		_spec.ClearField(media.FieldTitle, field.TypeString)
+	}
+	if value, ok := mu.mutation.Description(); ok {
+		_spec.SetField(media.FieldDescription, field.TypeString, value)
+	}
+	if mu.mutation.DescriptionCleared() {
+		_spec.ClearField(media.FieldDescription, field.TypeString)
+	}
+	if value, ok := mu.mutation.DeletedAt(); ok {
+		_spec.SetField(media.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mu.mutation.DeletedAtCleared() {
+		_spec.ClearField(media.FieldDeletedAt, field.TypeTime)
 	}
 	if mu.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -355,7 +1072,277 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Columns: media.DatesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.DatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.DatesTable,
+			Columns: media.DatesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.VectorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.VectorsTable,
+			Columns: media.VectorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedVectorsIDs(); len(nodes) > 0 && !mu.mutation.VectorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.VectorsTable,
+			Columns: media.VectorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.VectorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.VectorsTable,
+			Columns: media.VectorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.MetaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.MetaFieldsTable,
+			Columns: media.MetaFieldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedMetaFieldsIDs(); len(nodes) > 0 && !mu.mutation.MetaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.MetaFieldsTable,
+			Columns: media.MetaFieldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.MetaFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.MetaFieldsTable,
+			Columns: media.MetaFieldsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.PoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.PoolsTable,
+			Columns: media.PoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedPoolsIDs(); len(nodes) > 0 && !mu.mutation.PoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.PoolsTable,
+			Columns: media.PoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.PoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.PoolsTable,
+			Columns: media.PoolsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.FavoritesTable,
+			Columns: []string{media.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedFavoritesIDs(); len(nodes) > 0 && !mu.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.FavoritesTable,
+			Columns: []string{media.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.FavoritesTable,
+			Columns: []string{media.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.CommentsTable,
+			Columns: []string{media.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !mu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.CommentsTable,
+			Columns: []string{media.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.CommentsTable,
+			Columns: []string{media.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.NotesTable,
+			Columns: []string{media.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedNotesIDs(); len(nodes) > 0 && !mu.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.NotesTable,
+			Columns: []string{media.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -363,15 +1350,15 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := mu.mutation.DatesIDs(); len(nodes) > 0 {
+	if nodes := mu.mutation.NotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: false,
-			Table:   media.DatesTable,
-			Columns: media.DatesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.NotesTable,
+			Columns: []string{media.NotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -379,28 +1366,28 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if mu.mutation.VectorsCleared() {
+	if mu.mutation.SourcesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: false,
-			Table:   media.VectorsTable,
-			Columns: media.VectorsPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.SourcesTable,
+			Columns: []string{media.SourcesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := mu.mutation.RemovedVectorsIDs(); len(nodes) > 0 && !mu.mutation.VectorsCleared() {
+	if nodes := mu.mutation.RemovedSourcesIDs(); len(nodes) > 0 && !mu.mutation.SourcesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: false,
-			Table:   media.VectorsTable,
-			Columns: media.VectorsPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.SourcesTable,
+			Columns: []string{media.SourcesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -408,15 +1395,15 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := mu.mutation.VectorsIDs(); len(nodes) > 0 {
+	if nodes := mu.mutation.SourcesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: false,
-			Table:   media.VectorsTable,
-			Columns: media.VectorsPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.SourcesTable,
+			Columns: []string{media.SourcesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -514,6 +1501,96 @@ func (mu *MediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if mu.mutation.MediaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.MediaFieldsTable,
+			Columns: []string{media.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedMediaFieldsIDs(); len(nodes) > 0 && !mu.mutation.MediaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.MediaFieldsTable,
+			Columns: []string{media.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.MediaFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.MediaFieldsTable,
+			Columns: []string{media.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.PoolMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.PoolMediaTable,
+			Columns: []string{media.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedPoolMediaIDs(); len(nodes) > 0 && !mu.mutation.PoolMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.PoolMediaTable,
+			Columns: []string{media.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.PoolMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.PoolMediaTable,
+			Columns: []string{media.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{media.Label}
@@ -535,14 +1612,14 @@ type MediaUpdateOne struct {
 }
 
 // SetDuration sets the "duration" field.
-func (muo *MediaUpdateOne) SetDuration(i int16) *MediaUpdateOne {
+func (muo *MediaUpdateOne) SetDuration(i int32) *MediaUpdateOne {
 	muo.mutation.ResetDuration()
 	muo.mutation.SetDuration(i)
 	return muo
 }
 
 // SetNillableDuration sets the "duration" field if the given value is not nil.
-func (muo *MediaUpdateOne) SetNillableDuration(i *int16) *MediaUpdateOne {
+func (muo *MediaUpdateOne) SetNillableDuration(i *int32) *MediaUpdateOne {
 	if i != nil {
 		muo.SetDuration(*i)
 	}
@@ -550,7 +1627,7 @@ func (muo *MediaUpdateOne) SetNillableDuration(i *int16) *MediaUpdateOne {
 }
 
 // AddDuration adds i to the "duration" field.
-func (muo *MediaUpdateOne) AddDuration(i int16) *MediaUpdateOne {
+func (muo *MediaUpdateOne) AddDuration(i int32) *MediaUpdateOne {
 	muo.mutation.AddDuration(i)
 	return muo
 }
@@ -561,6 +1638,317 @@ func (muo *MediaUpdateOne) ClearDuration() *MediaUpdateOne {
 	return muo
 }
 
+// SetFrames sets the "frames" field.
+func (muo *MediaUpdateOne) SetFrames(i int32) *MediaUpdateOne {
+	muo.mutation.ResetFrames()
+	muo.mutation.SetFrames(i)
+	return muo
+}
+
+// SetNillableFrames sets the "frames" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableFrames(i *int32) *MediaUpdateOne {
+	if i != nil {
+		muo.SetFrames(*i)
+	}
+	return muo
+}
+
+// AddFrames adds i to the "frames" field.
+func (muo *MediaUpdateOne) AddFrames(i int32) *MediaUpdateOne {
+	muo.mutation.AddFrames(i)
+	return muo
+}
+
+// ClearFrames clears the value of the "frames" field.
+func (muo *MediaUpdateOne) ClearFrames() *MediaUpdateOne {
+	muo.mutation.ClearFrames()
+	return muo
+}
+
+// SetBitrate sets the "bitrate" field.
+func (muo *MediaUpdateOne) SetBitrate(i int) *MediaUpdateOne {
+	muo.mutation.ResetBitrate()
+	muo.mutation.SetBitrate(i)
+	return muo
+}
+
+// SetNillableBitrate sets the "bitrate" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableBitrate(i *int) *MediaUpdateOne {
+	if i != nil {
+		muo.SetBitrate(*i)
+	}
+	return muo
+}
+
+// AddBitrate adds i to the "bitrate" field.
+func (muo *MediaUpdateOne) AddBitrate(i int) *MediaUpdateOne {
+	muo.mutation.AddBitrate(i)
+	return muo
+}
+
+// ClearBitrate clears the value of the "bitrate" field.
+func (muo *MediaUpdateOne) ClearBitrate() *MediaUpdateOne {
+	muo.mutation.ClearBitrate()
+	return muo
+}
+
+// SetVideoCodec sets the "video_codec" field.
+func (muo *MediaUpdateOne) SetVideoCodec(s string) *MediaUpdateOne {
+	muo.mutation.SetVideoCodec(s)
+	return muo
+}
+
+// SetNillableVideoCodec sets the "video_codec" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableVideoCodec(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetVideoCodec(*s)
+	}
+	return muo
+}
+
+// ClearVideoCodec clears the value of the "video_codec" field.
+func (muo *MediaUpdateOne) ClearVideoCodec() *MediaUpdateOne {
+	muo.mutation.ClearVideoCodec()
+	return muo
+}
+
+// SetAudioCodec sets the "audio_codec" field.
+func (muo *MediaUpdateOne) SetAudioCodec(s string) *MediaUpdateOne {
+	muo.mutation.SetAudioCodec(s)
+	return muo
+}
+
+// SetNillableAudioCodec sets the "audio_codec" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableAudioCodec(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetAudioCodec(*s)
+	}
+	return muo
+}
+
+// ClearAudioCodec clears the value of the "audio_codec" field.
+func (muo *MediaUpdateOne) ClearAudioCodec() *MediaUpdateOne {
+	muo.mutation.ClearAudioCodec()
+	return muo
+}
+
+// SetFps sets the "fps" field.
+func (muo *MediaUpdateOne) SetFps(f float64) *MediaUpdateOne {
+	muo.mutation.ResetFps()
+	muo.mutation.SetFps(f)
+	return muo
+}
+
+// SetNillableFps sets the "fps" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableFps(f *float64) *MediaUpdateOne {
+	if f != nil {
+		muo.SetFps(*f)
+	}
+	return muo
+}
+
+// AddFps adds f to the "fps" field.
+func (muo *MediaUpdateOne) AddFps(f float64) *MediaUpdateOne {
+	muo.mutation.AddFps(f)
+	return muo
+}
+
+// ClearFps clears the value of the "fps" field.
+func (muo *MediaUpdateOne) ClearFps() *MediaUpdateOne {
+	muo.mutation.ClearFps()
+	return muo
+}
+
+// SetHasAudio sets the "has_audio" field.
+func (muo *MediaUpdateOne) SetHasAudio(b bool) *MediaUpdateOne {
+	muo.mutation.SetHasAudio(b)
+	return muo
+}
+
+// SetNillableHasAudio sets the "has_audio" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableHasAudio(b *bool) *MediaUpdateOne {
+	if b != nil {
+		muo.SetHasAudio(*b)
+	}
+	return muo
+}
+
+// ClearHasAudio clears the value of the "has_audio" field.
+func (muo *MediaUpdateOne) ClearHasAudio() *MediaUpdateOne {
+	muo.mutation.ClearHasAudio()
+	return muo
+}
+
+// SetRotation sets the "rotation" field.
+func (muo *MediaUpdateOne) SetRotation(i int16) *MediaUpdateOne {
+	muo.mutation.ResetRotation()
+	muo.mutation.SetRotation(i)
+	return muo
+}
+
+// SetNillableRotation sets the "rotation" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableRotation(i *int16) *MediaUpdateOne {
+	if i != nil {
+		muo.SetRotation(*i)
+	}
+	return muo
+}
+
+// AddRotation adds i to the "rotation" field.
+func (muo *MediaUpdateOne) AddRotation(i int16) *MediaUpdateOne {
+	muo.mutation.AddRotation(i)
+	return muo
+}
+
+// ClearRotation clears the value of the "rotation" field.
+func (muo *MediaUpdateOne) ClearRotation() *MediaUpdateOne {
+	muo.mutation.ClearRotation()
+	return muo
+}
+
+// SetVersion sets the "version" field.
+func (muo *MediaUpdateOne) SetVersion(i int) *MediaUpdateOne {
+	muo.mutation.ResetVersion()
+	muo.mutation.SetVersion(i)
+	return muo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableVersion(i *int) *MediaUpdateOne {
+	if i != nil {
+		muo.SetVersion(*i)
+	}
+	return muo
+}
+
+// AddVersion adds i to the "version" field.
+func (muo *MediaUpdateOne) AddVersion(i int) *MediaUpdateOne {
+	muo.mutation.AddVersion(i)
+	return muo
+}
+
+// SetRating sets the "rating" field.
+func (muo *MediaUpdateOne) SetRating(m media.Rating) *MediaUpdateOne {
+	muo.mutation.SetRating(m)
+	return muo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableRating(m *media.Rating) *MediaUpdateOne {
+	if m != nil {
+		muo.SetRating(*m)
+	}
+	return muo
+}
+
+// ClearRating clears the value of the "rating" field.
+func (muo *MediaUpdateOne) ClearRating() *MediaUpdateOne {
+	muo.mutation.ClearRating()
+	return muo
+}
+
+// SetScore sets the "score" field.
+func (muo *MediaUpdateOne) SetScore(i int) *MediaUpdateOne {
+	muo.mutation.ResetScore()
+	muo.mutation.SetScore(i)
+	return muo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableScore(i *int) *MediaUpdateOne {
+	if i != nil {
+		muo.SetScore(*i)
+	}
+	return muo
+}
+
+// AddScore adds i to the "score" field.
+func (muo *MediaUpdateOne) AddScore(i int) *MediaUpdateOne {
+	muo.mutation.AddScore(i)
+	return muo
+}
+
+// SetFavCount sets the "fav_count" field.
+func (muo *MediaUpdateOne) SetFavCount(i int) *MediaUpdateOne {
+	muo.mutation.ResetFavCount()
+	muo.mutation.SetFavCount(i)
+	return muo
+}
+
+// SetNillableFavCount sets the "fav_count" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableFavCount(i *int) *MediaUpdateOne {
+	if i != nil {
+		muo.SetFavCount(*i)
+	}
+	return muo
+}
+
+// AddFavCount adds i to the "fav_count" field.
+func (muo *MediaUpdateOne) AddFavCount(i int) *MediaUpdateOne {
+	muo.mutation.AddFavCount(i)
+	return muo
+}
+
+// SetTitle sets the "title" field.
+func (muo *MediaUpdateOne) SetTitle(s string) *MediaUpdateOne {
+	muo.mutation.SetTitle(s)
+	return muo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableTitle(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetTitle(*s)
+	}
+	return muo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (muo *MediaUpdateOne) ClearTitle() *MediaUpdateOne {
+	muo.mutation.ClearTitle()
+	return muo
+}
+
+// SetDescription sets the "description" field.
+func (muo *MediaUpdateOne) SetDescription(s string) *MediaUpdateOne {
+	muo.mutation.SetDescription(s)
+	return muo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableDescription(s *string) *MediaUpdateOne {
+	if s != nil {
+		muo.SetDescription(*s)
+	}
+	return muo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (muo *MediaUpdateOne) ClearDescription() *MediaUpdateOne {
+	muo.mutation.ClearDescription()
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MediaUpdateOne) SetDeletedAt(t time.Time) *MediaUpdateOne {
+	muo.mutation.SetDeletedAt(t)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MediaUpdateOne) SetNillableDeletedAt(t *time.Time) *MediaUpdateOne {
+	if t != nil {
+		muo.SetDeletedAt(*t)
+	}
+	return muo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (muo *MediaUpdateOne) ClearDeletedAt() *MediaUpdateOne {
+	muo.mutation.ClearDeletedAt()
+	return muo
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (muo *MediaUpdateOne) AddTagIDs(ids ...int) *MediaUpdateOne {
 	muo.mutation.AddTagIDs(ids...)
@@ -606,6 +1994,96 @@ func (muo *MediaUpdateOne) AddVectors(v ...*Vector) *MediaUpdateOne {
 	return muo.AddVectorIDs(ids...)
 }
 
+// AddMetaFieldIDs adds the "meta_fields" edge to the MetaField entity by IDs.
+func (muo *MediaUpdateOne) AddMetaFieldIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddMetaFieldIDs(ids...)
+	return muo
+}
+
+// AddMetaFields adds the "meta_fields" edges to the MetaField entity.
+func (muo *MediaUpdateOne) AddMetaFields(m ...*MetaField) *MediaUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddMetaFieldIDs(ids...)
+}
+
+// AddPoolIDs adds the "pools" edge to the Pool entity by IDs.
+func (muo *MediaUpdateOne) AddPoolIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddPoolIDs(ids...)
+	return muo
+}
+
+// AddPools adds the "pools" edges to the Pool entity.
+func (muo *MediaUpdateOne) AddPools(p ...*Pool) *MediaUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return muo.AddPoolIDs(ids...)
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by IDs.
+func (muo *MediaUpdateOne) AddFavoriteIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddFavoriteIDs(ids...)
+	return muo
+}
+
+// AddFavorites adds the "favorites" edges to the Favorite entity.
+func (muo *MediaUpdateOne) AddFavorites(f ...*Favorite) *MediaUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return muo.AddFavoriteIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (muo *MediaUpdateOne) AddCommentIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddCommentIDs(ids...)
+	return muo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (muo *MediaUpdateOne) AddComments(c ...*Comment) *MediaUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return muo.AddCommentIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (muo *MediaUpdateOne) AddNoteIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddNoteIDs(ids...)
+	return muo
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (muo *MediaUpdateOne) AddNotes(n ...*Note) *MediaUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return muo.AddNoteIDs(ids...)
+}
+
+// AddSourceIDs adds the "sources" edge to the Source entity by IDs.
+func (muo *MediaUpdateOne) AddSourceIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddSourceIDs(ids...)
+	return muo
+}
+
+// AddSources adds the "sources" edges to the Source entity.
+func (muo *MediaUpdateOne) AddSources(s ...*Source) *MediaUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.AddSourceIDs(ids...)
+}
+
 // AddMediaDateIDs adds the "media_dates" edge to the MediaDate entity by IDs.
 func (muo *MediaUpdateOne) AddMediaDateIDs(ids ...int) *MediaUpdateOne {
 	muo.mutation.AddMediaDateIDs(ids...)
@@ -636,6 +2114,36 @@ func (muo *MediaUpdateOne) AddMediaVectors(m ...*MediaVector) *MediaUpdateOne {
 	return muo.AddMediaVectorIDs(ids...)
 }
 
+// AddMediaFieldIDs adds the "media_fields" edge to the MediaField entity by IDs.
+func (muo *MediaUpdateOne) AddMediaFieldIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddMediaFieldIDs(ids...)
+	return muo
+}
+
+// AddMediaFields adds the "media_fields" edges to the MediaField entity.
+func (muo *MediaUpdateOne) AddMediaFields(m ...*MediaField) *MediaUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddMediaFieldIDs(ids...)
+}
+
+// AddPoolMediumIDs adds the "pool_media" edge to the PoolMedia entity by IDs.
+func (muo *MediaUpdateOne) AddPoolMediumIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.AddPoolMediumIDs(ids...)
+	return muo
+}
+
+// AddPoolMedia adds the "pool_media" edges to the PoolMedia entity.
+func (muo *MediaUpdateOne) AddPoolMedia(p ...*PoolMedia) *MediaUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return muo.AddPoolMediumIDs(ids...)
+}
+
 // Mutation returns the MediaMutation object of the builder.
 func (muo *MediaUpdateOne) Mutation() *MediaMutation {
 	return muo.mutation
@@ -704,6 +2212,132 @@ func (muo *MediaUpdateOne) RemoveVectors(v ...*Vector) *MediaUpdateOne {
 	return muo.RemoveVectorIDs(ids...)
 }
 
+// ClearMetaFields clears all "meta_fields" edges to the MetaField entity.
+func (muo *MediaUpdateOne) ClearMetaFields() *MediaUpdateOne {
+	muo.mutation.ClearMetaFields()
+	return muo
+}
+
+// RemoveMetaFieldIDs removes the "meta_fields" edge to MetaField entities by IDs.
+func (muo *MediaUpdateOne) RemoveMetaFieldIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemoveMetaFieldIDs(ids...)
+	return muo
+}
+
+// RemoveMetaFields removes "meta_fields" edges to MetaField entities.
+func (muo *MediaUpdateOne) RemoveMetaFields(m ...*MetaField) *MediaUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveMetaFieldIDs(ids...)
+}
+
+// ClearPools clears all "pools" edges to the Pool entity.
+func (muo *MediaUpdateOne) ClearPools() *MediaUpdateOne {
+	muo.mutation.ClearPools()
+	return muo
+}
+
+// RemovePoolIDs removes the "pools" edge to Pool entities by IDs.
+func (muo *MediaUpdateOne) RemovePoolIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemovePoolIDs(ids...)
+	return muo
+}
+
+// RemovePools removes "pools" edges to Pool entities.
+func (muo *MediaUpdateOne) RemovePools(p ...*Pool) *MediaUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return muo.RemovePoolIDs(ids...)
+}
+
+// ClearFavorites clears all "favorites" edges to the Favorite entity.
+func (muo *MediaUpdateOne) ClearFavorites() *MediaUpdateOne {
+	muo.mutation.ClearFavorites()
+	return muo
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to Favorite entities by IDs.
+func (muo *MediaUpdateOne) RemoveFavoriteIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemoveFavoriteIDs(ids...)
+	return muo
+}
+
+// RemoveFavorites removes "favorites" edges to Favorite entities.
+func (muo *MediaUpdateOne) RemoveFavorites(f ...*Favorite) *MediaUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return muo.RemoveFavoriteIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (muo *MediaUpdateOne) ClearComments() *MediaUpdateOne {
+	muo.mutation.ClearComments()
+	return muo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (muo *MediaUpdateOne) RemoveCommentIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemoveCommentIDs(ids...)
+	return muo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (muo *MediaUpdateOne) RemoveComments(c ...*Comment) *MediaUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return muo.RemoveCommentIDs(ids...)
+}
+
+// ClearNotes clears all "notes" edges to the Note entity.
+func (muo *MediaUpdateOne) ClearNotes() *MediaUpdateOne {
+	muo.mutation.ClearNotes()
+	return muo
+}
+
+// RemoveNoteIDs removes the "notes" edge to Note entities by IDs.
+func (muo *MediaUpdateOne) RemoveNoteIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemoveNoteIDs(ids...)
+	return muo
+}
+
+// RemoveNotes removes "notes" edges to Note entities.
+func (muo *MediaUpdateOne) RemoveNotes(n ...*Note) *MediaUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return muo.RemoveNoteIDs(ids...)
+}
+
+// ClearSources clears all "sources" edges to the Source entity.
+func (muo *MediaUpdateOne) ClearSources() *MediaUpdateOne {
+	muo.mutation.ClearSources()
+	return muo
+}
+
+// RemoveSourceIDs removes the "sources" edge to Source entities by IDs.
+func (muo *MediaUpdateOne) RemoveSourceIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemoveSourceIDs(ids...)
+	return muo
+}
+
+// RemoveSources removes "sources" edges to Source entities.
+func (muo *MediaUpdateOne) RemoveSources(s ...*Source) *MediaUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return muo.RemoveSourceIDs(ids...)
+}
+
 // ClearMediaDates clears all "media_dates" edges to the MediaDate entity.
 func (muo *MediaUpdateOne) ClearMediaDates() *MediaUpdateOne {
 	muo.mutation.ClearMediaDates()
@@ -746,6 +2380,48 @@ func (muo *MediaUpdateOne) RemoveMediaVectors(m ...*MediaVector) *MediaUpdateOne
 	return muo.RemoveMediaVectorIDs(ids...)
 }
 
+// ClearMediaFields clears all "media_fields" edges to the MediaField entity.
+func (muo *MediaUpdateOne) ClearMediaFields() *MediaUpdateOne {
+	muo.mutation.ClearMediaFields()
+	return muo
+}
+
+// RemoveMediaFieldIDs removes the "media_fields" edge to MediaField entities by IDs.
+func (muo *MediaUpdateOne) RemoveMediaFieldIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemoveMediaFieldIDs(ids...)
+	return muo
+}
+
+// RemoveMediaFields removes "media_fields" edges to MediaField entities.
+func (muo *MediaUpdateOne) RemoveMediaFields(m ...*MediaField) *MediaUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveMediaFieldIDs(ids...)
+}
+
+// ClearPoolMedia clears all "pool_media" edges to the PoolMedia entity.
+func (muo *MediaUpdateOne) ClearPoolMedia() *MediaUpdateOne {
+	muo.mutation.ClearPoolMedia()
+	return muo
+}
+
+// RemovePoolMediumIDs removes the "pool_media" edge to PoolMedia entities by IDs.
+func (muo *MediaUpdateOne) RemovePoolMediumIDs(ids ...int) *MediaUpdateOne {
+	muo.mutation.RemovePoolMediumIDs(ids...)
+	return muo
+}
+
+// RemovePoolMedia removes "pool_media" edges to PoolMedia entities.
+func (muo *MediaUpdateOne) RemovePoolMedia(p ...*PoolMedia) *MediaUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return muo.RemovePoolMediumIDs(ids...)
+}
+
 // Where appends a list predicates to the MediaUpdate builder.
 func (muo *MediaUpdateOne) Where(ps ...predicate.Media) *MediaUpdateOne {
 	muo.mutation.Where(ps...)
@@ -786,7 +2462,20 @@ func (muo *MediaUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (muo *MediaUpdateOne) check() error {
+	if v, ok := muo.mutation.Rating(); ok {
+		if err := media.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "Media.rating": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(media.Table, media.Columns, sqlgraph.NewFieldSpec(media.FieldID, field.TypeString))
 	id, ok := muo.mutation.ID()
 	if !ok {
@@ -813,36 +2502,222 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 		}
 	}
 	if value, ok := muo.mutation.Duration(); ok {
-		_spec.SetField(media.FieldDuration, field.TypeInt16, value)
+		_spec.SetField(media.FieldDuration, field.TypeInt32, value)
 	}
 	if value, ok := muo.mutation.AddedDuration(); ok {
-		_spec.AddField(media.FieldDuration, field.TypeInt16, value)
+		_spec.AddField(media.FieldDuration, field.TypeInt32, value)
 	}
 	if muo.mutation.DurationCleared() {
-		_spec.ClearField(media.FieldDuration, field.TypeInt16)
+		_spec.ClearField(media.FieldDuration, field.TypeInt32)
+	}
+	if value, ok := muo.mutation.Frames(); ok {
+		_spec.SetField(media.FieldFrames, field.TypeInt32, value)
+	}
+	if value, ok := muo.mutation.AddedFrames(); ok {
+		_spec.AddField(media.FieldFrames, field.TypeInt32, value)
+	}
+	if muo.mutation.FramesCleared() {
+		_spec.ClearField(media.FieldFrames, field.TypeInt32)
+	}
+	if value, ok := muo.mutation.Bitrate(); ok {
+		_spec.SetField(media.FieldBitrate, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedBitrate(); ok {
+		_spec.AddField(media.FieldBitrate, field.TypeInt, value)
+	}
+	if muo.mutation.BitrateCleared() {
+		_spec.ClearField(media.FieldBitrate, field.TypeInt)
+	}
+	if value, ok := muo.mutation.VideoCodec(); ok {
+		_spec.SetField(media.FieldVideoCodec, field.TypeString, value)
+	}
+	if muo.mutation.VideoCodecCleared() {
+		_spec.ClearField(media.FieldVideoCodec, field.TypeString)
+	}
+	if value, ok := muo.mutation.AudioCodec(); ok {
+		_spec.SetField(media.FieldAudioCodec, field.TypeString, value)
+	}
+	if muo.mutation.AudioCodecCleared() {
+		_spec.ClearField(media.FieldAudioCodec, field.TypeString)
+	}
+	if value, ok := muo.mutation.Fps(); ok {
+		_spec.SetField(media.FieldFps, field.TypeFloat64, value)
+	}
+	if value, ok := muo.mutation.AddedFps(); ok {
+		_spec.AddField(media.FieldFps, field.TypeFloat64, value)
+	}
+	if muo.mutation.FpsCleared() {
+		_spec.ClearField(media.FieldFps, field.TypeFloat64)
+	}
+	if value, ok := muo.mutation.HasAudio(); ok {
+		_spec.SetField(media.FieldHasAudio, field.TypeBool, value)
+	}
+	if muo.mutation.HasAudioCleared() {
+		_spec.ClearField(media.FieldHasAudio, field.TypeBool)
+	}
+	if value, ok := muo.mutation.Rotation(); ok {
+		_spec.SetField(media.FieldRotation, field.TypeInt16, value)
+	}
+	if value, ok := muo.mutation.AddedRotation(); ok {
+		_spec.AddField(media.FieldRotation, field.TypeInt16, value)
+	}
+	if muo.mutation.RotationCleared() {
+		_spec.ClearField(media.FieldRotation, field.TypeInt16)
+	}
+	if value, ok := muo.mutation.Version(); ok {
+		_spec.SetField(media.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedVersion(); ok {
+		_spec.AddField(media.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.Rating(); ok {
+		_spec.SetField(media.FieldRating, field.TypeEnum, value)
+	}
+	if muo.mutation.RatingCleared() {
+		_spec.ClearField(media.FieldRating, field.TypeEnum)
+	}
+	if value, ok := muo.mutation.Score(); ok {
+		_spec.SetField(media.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedScore(); ok {
+		_spec.AddField(media.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.FavCount(); ok {
+		_spec.SetField(media.FieldFavCount, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedFavCount(); ok {
+		_spec.AddField(media.FieldFavCount, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.Title(); ok {
+		_spec.SetField(media.FieldTitle, field.TypeString, value)
+	}
+	if muo.mutation.TitleCleared() {
+		_spec.ClearField(media.FieldTitle, field.TypeString)
+	}
+	if value, ok := muo.mutation.Description(); ok {
+		_spec.SetField(media.FieldDescription, field.TypeString, value)
+	}
+	if muo.mutation.DescriptionCleared() {
+		_spec.ClearField(media.FieldDescription, field.TypeString)
+	}
+	if value, ok := muo.mutation.DeletedAt(); ok {
+		_spec.SetField(media.FieldDeletedAt, field.TypeTime, value)
+	}
+	if muo.mutation.DeletedAtCleared() {
+		_spec.ClearField(media.FieldDeletedAt, field.TypeTime)
+	}
+	if muo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.TagsTable,
+			Columns: media.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !muo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.TagsTable,
+			Columns: media.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.TagsTable,
+			Columns: media.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.DatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.DatesTable,
+			Columns: media.DatesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedDatesIDs(); len(nodes) > 0 && !muo.mutation.DatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.DatesTable,
+			Columns: media.DatesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.DatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   media.DatesTable,
+			Columns: media.DatesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if muo.mutation.TagsCleared() {
+	if muo.mutation.VectorsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   media.TagsTable,
-			Columns: media.TagsPrimaryKey,
+			Table:   media.VectorsTable,
+			Columns: media.VectorsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !muo.mutation.TagsCleared() {
+	if nodes := muo.mutation.RemovedVectorsIDs(); len(nodes) > 0 && !muo.mutation.VectorsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   media.TagsTable,
-			Columns: media.TagsPrimaryKey,
+			Table:   media.VectorsTable,
+			Columns: media.VectorsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -850,15 +2725,15 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.TagsIDs(); len(nodes) > 0 {
+	if nodes := muo.mutation.VectorsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   media.TagsTable,
-			Columns: media.TagsPrimaryKey,
+			Table:   media.VectorsTable,
+			Columns: media.VectorsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -866,28 +2741,28 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if muo.mutation.DatesCleared() {
+	if muo.mutation.MetaFieldsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   media.DatesTable,
-			Columns: media.DatesPrimaryKey,
+			Table:   media.MetaFieldsTable,
+			Columns: media.MetaFieldsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.RemovedDatesIDs(); len(nodes) > 0 && !muo.mutation.DatesCleared() {
+	if nodes := muo.mutation.RemovedMetaFieldsIDs(); len(nodes) > 0 && !muo.mutation.MetaFieldsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   media.DatesTable,
-			Columns: media.DatesPrimaryKey,
+			Table:   media.MetaFieldsTable,
+			Columns: media.MetaFieldsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -895,15 +2770,15 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.DatesIDs(); len(nodes) > 0 {
+	if nodes := muo.mutation.MetaFieldsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   media.DatesTable,
-			Columns: media.DatesPrimaryKey,
+			Table:   media.MetaFieldsTable,
+			Columns: media.MetaFieldsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(date.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -911,28 +2786,28 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if muo.mutation.VectorsCleared() {
+	if muo.mutation.PoolsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
-			Inverse: false,
-			Table:   media.VectorsTable,
-			Columns: media.VectorsPrimaryKey,
+			Inverse: true,
+			Table:   media.PoolsTable,
+			Columns: media.PoolsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.RemovedVectorsIDs(); len(nodes) > 0 && !muo.mutation.VectorsCleared() {
+	if nodes := muo.mutation.RemovedPoolsIDs(); len(nodes) > 0 && !muo.mutation.PoolsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
-			Inverse: false,
-			Table:   media.VectorsTable,
-			Columns: media.VectorsPrimaryKey,
+			Inverse: true,
+			Table:   media.PoolsTable,
+			Columns: media.PoolsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -940,15 +2815,195 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.VectorsIDs(); len(nodes) > 0 {
+	if nodes := muo.mutation.PoolsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
-			Inverse: false,
-			Table:   media.VectorsTable,
-			Columns: media.VectorsPrimaryKey,
+			Inverse: true,
+			Table:   media.PoolsTable,
+			Columns: media.PoolsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.FavoritesTable,
+			Columns: []string{media.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedFavoritesIDs(); len(nodes) > 0 && !muo.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.FavoritesTable,
+			Columns: []string{media.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.FavoritesTable,
+			Columns: []string{media.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favorite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.CommentsTable,
+			Columns: []string{media.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !muo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.CommentsTable,
+			Columns: []string{media.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.CommentsTable,
+			Columns: []string{media.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.NotesTable,
+			Columns: []string{media.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedNotesIDs(); len(nodes) > 0 && !muo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.NotesTable,
+			Columns: []string{media.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.NotesTable,
+			Columns: []string{media.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.SourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.SourcesTable,
+			Columns: []string{media.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedSourcesIDs(); len(nodes) > 0 && !muo.mutation.SourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.SourcesTable,
+			Columns: []string{media.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.SourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.SourcesTable,
+			Columns: []string{media.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1046,6 +3101,96 @@ func (muo *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if muo.mutation.MediaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.MediaFieldsTable,
+			Columns: []string{media.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedMediaFieldsIDs(); len(nodes) > 0 && !muo.mutation.MediaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.MediaFieldsTable,
+			Columns: []string{media.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.MediaFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.MediaFieldsTable,
+			Columns: []string{media.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.PoolMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.PoolMediaTable,
+			Columns: []string{media.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedPoolMediaIDs(); len(nodes) > 0 && !muo.mutation.PoolMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.PoolMediaTable,
+			Columns: []string{media.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.PoolMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   media.PoolMediaTable,
+			Columns: []string{media.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Media{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	"era/booru/ent/media"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MediaField is the model entity for the MediaField schema.
+type MediaField struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID string `json:"media_id,omitempty"`
+	// MetaFieldID holds the value of the "meta_field_id" field.
+	MetaFieldID int `json:"meta_field_id,omitempty"`
+	// JSON value of the field's type
+	Value jsontext.Value `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MediaFieldQuery when eager-loading is set.
+	Edges        MediaFieldEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MediaFieldEdges holds the relations/edges for other nodes in the graph.
+type MediaFieldEdges struct {
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// MetaField holds the value of the meta_field edge.
+	MetaField *MetaField `json:"meta_field,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MediaFieldEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// MetaFieldOrErr returns the MetaField value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MediaFieldEdges) MetaFieldOrErr() (*MetaField, error) {
+	if e.MetaField != nil {
+		return e.MetaField, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: metafield.Label}
+	}
+	return nil, &NotLoadedError{edge: "meta_field"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MediaField) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mediafield.FieldValue:
+			values[i] = new([]byte)
+		case mediafield.FieldID, mediafield.FieldMetaFieldID:
+			values[i] = new(sql.NullInt64)
+		case mediafield.FieldMediaID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MediaField fields.
+func (mf *MediaField) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mediafield.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mf.ID = int(value.Int64)
+		case mediafield.FieldMediaID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value.Valid {
+				mf.MediaID = value.String
+			}
+		case mediafield.FieldMetaFieldID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field meta_field_id", values[i])
+			} else if value.Valid {
+				mf.MetaFieldID = int(value.Int64)
+			}
+		case mediafield.FieldValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mf.Value); err != nil {
+					return fmt.Errorf("unmarshal field value: %w", err)
+				}
+			}
+		default:
+			mf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the MediaField.
+// This includes values selected through modifiers, order, etc.
+func (mf *MediaField) GetValue(name string) (ent.Value, error) {
+	return mf.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the MediaField entity.
+func (mf *MediaField) QueryMedia() *MediaQuery {
+	return NewMediaFieldClient(mf.config).QueryMedia(mf)
+}
+
+// QueryMetaField queries the "meta_field" edge of the MediaField entity.
+func (mf *MediaField) QueryMetaField() *MetaFieldQuery {
+	return NewMediaFieldClient(mf.config).QueryMetaField(mf)
+}
+
+// Update returns a builder for updating this MediaField.
+// Note that you need to call MediaField.Unwrap() before calling this method if this MediaField
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mf *MediaField) Update() *MediaFieldUpdateOne {
+	return NewMediaFieldClient(mf.config).UpdateOne(mf)
+}
+
+// Unwrap unwraps the MediaField entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mf *MediaField) Unwrap() *MediaField {
+	_tx, ok := mf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MediaField is not a transactional entity")
+	}
+	mf.config.driver = _tx.drv
+	return mf
+}
+
+// String implements the fmt.Stringer.
+func (mf *MediaField) String() string {
+	var builder strings.Builder
+	builder.WriteString("MediaField(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mf.ID))
+	builder.WriteString("media_id=")
+	builder.WriteString(mf.MediaID)
+	builder.WriteString(", ")
+	builder.WriteString("meta_field_id=")
+	builder.WriteString(fmt.Sprintf("%v", mf.MetaFieldID))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", mf.Value))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MediaFields is a parsable slice of MediaField.
+type MediaFields []*MediaField
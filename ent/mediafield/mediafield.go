@@ -0,0 +1,105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mediafield
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the mediafield type in the database.
+	Label = "media_field"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMediaID holds the string denoting the media_id field in the database.
+	FieldMediaID = "media_id"
+	// FieldMetaFieldID holds the string denoting the meta_field_id field in the database.
+	FieldMetaFieldID = "meta_field_id"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// EdgeMedia holds the string denoting the media edge name in mutations.
+	EdgeMedia = "media"
+	// EdgeMetaField holds the string denoting the meta_field edge name in mutations.
+	EdgeMetaField = "meta_field"
+	// Table holds the table name of the mediafield in the database.
+	Table = "media_fields"
+	// MediaTable is the table that holds the media relation/edge.
+	MediaTable = "media_fields"
+	// MediaInverseTable is the table name for the Media entity.
+	// It exists in this package in order to avoid circular dependency with the "media" package.
+	MediaInverseTable = "media"
+	// MediaColumn is the table column denoting the media relation/edge.
+	MediaColumn = "media_id"
+	// MetaFieldTable is the table that holds the meta_field relation/edge.
+	MetaFieldTable = "media_fields"
+	// MetaFieldInverseTable is the table name for the MetaField entity.
+	// It exists in this package in order to avoid circular dependency with the "metafield" package.
+	MetaFieldInverseTable = "meta_fields"
+	// MetaFieldColumn is the table column denoting the meta_field relation/edge.
+	MetaFieldColumn = "meta_field_id"
+)
+
+// Columns holds all SQL columns for mediafield fields.
+var Columns = []string{
+	FieldID,
+	FieldMediaID,
+	FieldMetaFieldID,
+	FieldValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the MediaField queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMediaID orders the results by the media_id field.
+func ByMediaID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMediaID, opts...).ToFunc()
+}
+
+// ByMetaFieldID orders the results by the meta_field_id field.
+func ByMetaFieldID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetaFieldID, opts...).ToFunc()
+}
+
+// ByMediaField orders the results by media field.
+func ByMediaField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMediaStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMetaFieldField orders the results by meta_field field.
+func ByMetaFieldField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMetaFieldStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMediaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MediaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MediaTable, MediaColumn),
+	)
+}
+func newMetaFieldStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MetaFieldInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MetaFieldTable, MetaFieldColumn),
+	)
+}
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mediafield
+
+import (
+	"era/booru/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldLTE(FieldID, id))
+}
+
+// MediaID applies equality check predicate on the "media_id" field. It's identical to MediaIDEQ.
+func MediaID(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldEQ(FieldMediaID, v))
+}
+
+// MetaFieldID applies equality check predicate on the "meta_field_id" field. It's identical to MetaFieldIDEQ.
+func MetaFieldID(v int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldEQ(FieldMetaFieldID, v))
+}
+
+// MediaIDEQ applies the EQ predicate on the "media_id" field.
+func MediaIDEQ(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldEQ(FieldMediaID, v))
+}
+
+// MediaIDNEQ applies the NEQ predicate on the "media_id" field.
+func MediaIDNEQ(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldNEQ(FieldMediaID, v))
+}
+
+// MediaIDIn applies the In predicate on the "media_id" field.
+func MediaIDIn(vs ...string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldIn(FieldMediaID, vs...))
+}
+
+// MediaIDNotIn applies the NotIn predicate on the "media_id" field.
+func MediaIDNotIn(vs ...string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldNotIn(FieldMediaID, vs...))
+}
+
+// MediaIDGT applies the GT predicate on the "media_id" field.
+func MediaIDGT(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldGT(FieldMediaID, v))
+}
+
+// MediaIDGTE applies the GTE predicate on the "media_id" field.
+func MediaIDGTE(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldGTE(FieldMediaID, v))
+}
+
+// MediaIDLT applies the LT predicate on the "media_id" field.
+func MediaIDLT(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldLT(FieldMediaID, v))
+}
+
+// MediaIDLTE applies the LTE predicate on the "media_id" field.
+func MediaIDLTE(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldLTE(FieldMediaID, v))
+}
+
+// MediaIDContains applies the Contains predicate on the "media_id" field.
+func MediaIDContains(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldContains(FieldMediaID, v))
+}
+
+// MediaIDHasPrefix applies the HasPrefix predicate on the "media_id" field.
+func MediaIDHasPrefix(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldHasPrefix(FieldMediaID, v))
+}
+
+// MediaIDHasSuffix applies the HasSuffix predicate on the "media_id" field.
+func MediaIDHasSuffix(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldHasSuffix(FieldMediaID, v))
+}
+
+// MediaIDEqualFold applies the EqualFold predicate on the "media_id" field.
+func MediaIDEqualFold(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldEqualFold(FieldMediaID, v))
+}
+
+// MediaIDContainsFold applies the ContainsFold predicate on the "media_id" field.
+func MediaIDContainsFold(v string) predicate.MediaField {
+	return predicate.MediaField(sql.FieldContainsFold(FieldMediaID, v))
+}
+
+// MetaFieldIDEQ applies the EQ predicate on the "meta_field_id" field.
+func MetaFieldIDEQ(v int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldEQ(FieldMetaFieldID, v))
+}
+
+// MetaFieldIDNEQ applies the NEQ predicate on the "meta_field_id" field.
+func MetaFieldIDNEQ(v int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldNEQ(FieldMetaFieldID, v))
+}
+
+// MetaFieldIDIn applies the In predicate on the "meta_field_id" field.
+func MetaFieldIDIn(vs ...int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldIn(FieldMetaFieldID, vs...))
+}
+
+// MetaFieldIDNotIn applies the NotIn predicate on the "meta_field_id" field.
+func MetaFieldIDNotIn(vs ...int) predicate.MediaField {
+	return predicate.MediaField(sql.FieldNotIn(FieldMetaFieldID, vs...))
+}
+
+// HasMedia applies the HasEdge predicate on the "media" edge.
+func HasMedia() predicate.MediaField {
+	return predicate.MediaField(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MediaTable, MediaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMediaWith applies the HasEdge predicate on the "media" edge with a given conditions (other predicates).
+func HasMediaWith(preds ...predicate.Media) predicate.MediaField {
+	return predicate.MediaField(func(s *sql.Selector) {
+		step := newMediaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMetaField applies the HasEdge predicate on the "meta_field" edge.
+func HasMetaField() predicate.MediaField {
+	return predicate.MediaField(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MetaFieldTable, MetaFieldColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMetaFieldWith applies the HasEdge predicate on the "meta_field" edge with a given conditions (other predicates).
+func HasMetaFieldWith(preds ...predicate.MetaField) predicate.MediaField {
+	return predicate.MediaField(func(s *sql.Selector) {
+		step := newMetaFieldStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MediaField) predicate.MediaField {
+	return predicate.MediaField(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MediaField) predicate.MediaField {
+	return predicate.MediaField(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MediaField) predicate.MediaField {
+	return predicate.MediaField(sql.NotPredicates(p))
+}
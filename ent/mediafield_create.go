@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"era/booru/ent/media"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaFieldCreate is the builder for creating a MediaField entity.
+type MediaFieldCreate struct {
+	config
+	mutation *MediaFieldMutation
+	hooks    []Hook
+}
+
+// SetMediaID sets the "media_id" field.
+func (mfc *MediaFieldCreate) SetMediaID(s string) *MediaFieldCreate {
+	mfc.mutation.SetMediaID(s)
+	return mfc
+}
+
+// SetMetaFieldID sets the "meta_field_id" field.
+func (mfc *MediaFieldCreate) SetMetaFieldID(i int) *MediaFieldCreate {
+	mfc.mutation.SetMetaFieldID(i)
+	return mfc
+}
+
+// SetValue sets the "value" field.
+func (mfc *MediaFieldCreate) SetValue(j jsontext.Value) *MediaFieldCreate {
+	mfc.mutation.SetValue(j)
+	return mfc
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (mfc *MediaFieldCreate) SetMedia(m *Media) *MediaFieldCreate {
+	return mfc.SetMediaID(m.ID)
+}
+
+// SetMetaField sets the "meta_field" edge to the MetaField entity.
+func (mfc *MediaFieldCreate) SetMetaField(m *MetaField) *MediaFieldCreate {
+	return mfc.SetMetaFieldID(m.ID)
+}
+
+// Mutation returns the MediaFieldMutation object of the builder.
+func (mfc *MediaFieldCreate) Mutation() *MediaFieldMutation {
+	return mfc.mutation
+}
+
+// Save creates the MediaField in the database.
+func (mfc *MediaFieldCreate) Save(ctx context.Context) (*MediaField, error) {
+	return withHooks(ctx, mfc.sqlSave, mfc.mutation, mfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mfc *MediaFieldCreate) SaveX(ctx context.Context) *MediaField {
+	v, err := mfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfc *MediaFieldCreate) Exec(ctx context.Context) error {
+	_, err := mfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfc *MediaFieldCreate) ExecX(ctx context.Context) {
+	if err := mfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfc *MediaFieldCreate) check() error {
+	if _, ok := mfc.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "MediaField.media_id"`)}
+	}
+	if _, ok := mfc.mutation.MetaFieldID(); !ok {
+		return &ValidationError{Name: "meta_field_id", err: errors.New(`ent: missing required field "MediaField.meta_field_id"`)}
+	}
+	if _, ok := mfc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "MediaField.value"`)}
+	}
+	if len(mfc.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "MediaField.media"`)}
+	}
+	if len(mfc.mutation.MetaFieldIDs()) == 0 {
+		return &ValidationError{Name: "meta_field", err: errors.New(`ent: missing required edge "MediaField.meta_field"`)}
+	}
+	return nil
+}
+
+func (mfc *MediaFieldCreate) sqlSave(ctx context.Context) (*MediaField, error) {
+	if err := mfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mfc.mutation.id = &_node.ID
+	mfc.mutation.done = true
+	return _node, nil
+}
+
+func (mfc *MediaFieldCreate) createSpec() (*MediaField, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MediaField{config: mfc.config}
+		_spec = sqlgraph.NewCreateSpec(mediafield.Table, sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt))
+	)
+	if value, ok := mfc.mutation.Value(); ok {
+		_spec.SetField(mediafield.FieldValue, field.TypeJSON, value)
+		_node.Value = value
+	}
+	if nodes := mfc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MediaTable,
+			Columns: []string{mediafield.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mfc.mutation.MetaFieldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MetaFieldTable,
+			Columns: []string{mediafield.MetaFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MetaFieldID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MediaFieldCreateBulk is the builder for creating many MediaField entities in bulk.
+type MediaFieldCreateBulk struct {
+	config
+	err      error
+	builders []*MediaFieldCreate
+}
+
+// Save creates the MediaField entities in the database.
+func (mfcb *MediaFieldCreateBulk) Save(ctx context.Context) ([]*MediaField, error) {
+	if mfcb.err != nil {
+		return nil, mfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mfcb.builders))
+	nodes := make([]*MediaField, len(mfcb.builders))
+	mutators := make([]Mutator, len(mfcb.builders))
+	for i := range mfcb.builders {
+		func(i int, root context.Context) {
+			builder := mfcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MediaFieldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfcb *MediaFieldCreateBulk) SaveX(ctx context.Context) []*MediaField {
+	v, err := mfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfcb *MediaFieldCreateBulk) Exec(ctx context.Context) error {
+	_, err := mfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfcb *MediaFieldCreateBulk) ExecX(ctx context.Context) {
+	if err := mfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
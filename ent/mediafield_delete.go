@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaFieldDelete is the builder for deleting a MediaField entity.
+type MediaFieldDelete struct {
+	config
+	hooks    []Hook
+	mutation *MediaFieldMutation
+}
+
+// Where appends a list predicates to the MediaFieldDelete builder.
+func (mfd *MediaFieldDelete) Where(ps ...predicate.MediaField) *MediaFieldDelete {
+	mfd.mutation.Where(ps...)
+	return mfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mfd *MediaFieldDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfd.sqlExec, mfd.mutation, mfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfd *MediaFieldDelete) ExecX(ctx context.Context) int {
+	n, err := mfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mfd *MediaFieldDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mediafield.Table, sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt))
+	if ps := mfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mfd.mutation.done = true
+	return affected, err
+}
+
+// MediaFieldDeleteOne is the builder for deleting a single MediaField entity.
+type MediaFieldDeleteOne struct {
+	mfd *MediaFieldDelete
+}
+
+// Where appends a list predicates to the MediaFieldDelete builder.
+func (mfdo *MediaFieldDeleteOne) Where(ps ...predicate.MediaField) *MediaFieldDeleteOne {
+	mfdo.mfd.mutation.Where(ps...)
+	return mfdo
+}
+
+// Exec executes the deletion query.
+func (mfdo *MediaFieldDeleteOne) Exec(ctx context.Context) error {
+	n, err := mfdo.mfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mediafield.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfdo *MediaFieldDeleteOne) ExecX(ctx context.Context) {
+	if err := mfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+	"era/booru/ent/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaFieldQuery is the builder for querying MediaField entities.
+type MediaFieldQuery struct {
+	config
+	ctx           *QueryContext
+	order         []mediafield.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.MediaField
+	withMedia     *MediaQuery
+	withMetaField *MetaFieldQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MediaFieldQuery builder.
+func (mfq *MediaFieldQuery) Where(ps ...predicate.MediaField) *MediaFieldQuery {
+	mfq.predicates = append(mfq.predicates, ps...)
+	return mfq
+}
+
+// Limit the number of records to be returned by this query.
+func (mfq *MediaFieldQuery) Limit(limit int) *MediaFieldQuery {
+	mfq.ctx.Limit = &limit
+	return mfq
+}
+
+// Offset to start from.
+func (mfq *MediaFieldQuery) Offset(offset int) *MediaFieldQuery {
+	mfq.ctx.Offset = &offset
+	return mfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mfq *MediaFieldQuery) Unique(unique bool) *MediaFieldQuery {
+	mfq.ctx.Unique = &unique
+	return mfq
+}
+
+// Order specifies how the records should be ordered.
+func (mfq *MediaFieldQuery) Order(o ...mediafield.OrderOption) *MediaFieldQuery {
+	mfq.order = append(mfq.order, o...)
+	return mfq
+}
+
+// QueryMedia chains the current query on the "media" edge.
+func (mfq *MediaFieldQuery) QueryMedia() *MediaQuery {
+	query := (&MediaClient{config: mfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediafield.Table, mediafield.FieldID, selector),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediafield.MediaTable, mediafield.MediaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMetaField chains the current query on the "meta_field" edge.
+func (mfq *MediaFieldQuery) QueryMetaField() *MetaFieldQuery {
+	query := (&MetaFieldClient{config: mfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediafield.Table, mediafield.FieldID, selector),
+			sqlgraph.To(metafield.Table, metafield.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediafield.MetaFieldTable, mediafield.MetaFieldColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MediaField entity from the query.
+// Returns a *NotFoundError when no MediaField was found.
+func (mfq *MediaFieldQuery) First(ctx context.Context) (*MediaField, error) {
+	nodes, err := mfq.Limit(1).All(setContextOp(ctx, mfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mediafield.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mfq *MediaFieldQuery) FirstX(ctx context.Context) *MediaField {
+	node, err := mfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MediaField ID from the query.
+// Returns a *NotFoundError when no MediaField ID was found.
+func (mfq *MediaFieldQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfq.Limit(1).IDs(setContextOp(ctx, mfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mediafield.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mfq *MediaFieldQuery) FirstIDX(ctx context.Context) int {
+	id, err := mfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MediaField entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MediaField entity is found.
+// Returns a *NotFoundError when no MediaField entities are found.
+func (mfq *MediaFieldQuery) Only(ctx context.Context) (*MediaField, error) {
+	nodes, err := mfq.Limit(2).All(setContextOp(ctx, mfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mediafield.Label}
+	default:
+		return nil, &NotSingularError{mediafield.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mfq *MediaFieldQuery) OnlyX(ctx context.Context) *MediaField {
+	node, err := mfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MediaField ID in the query.
+// Returns a *NotSingularError when more than one MediaField ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mfq *MediaFieldQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfq.Limit(2).IDs(setContextOp(ctx, mfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mediafield.Label}
+	default:
+		err = &NotSingularError{mediafield.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mfq *MediaFieldQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MediaFields.
+func (mfq *MediaFieldQuery) All(ctx context.Context) ([]*MediaField, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryAll)
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MediaField, *MediaFieldQuery]()
+	return withInterceptors[[]*MediaField](ctx, mfq, qr, mfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mfq *MediaFieldQuery) AllX(ctx context.Context) []*MediaField {
+	nodes, err := mfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MediaField IDs.
+func (mfq *MediaFieldQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mfq.ctx.Unique == nil && mfq.path != nil {
+		mfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryIDs)
+	if err = mfq.Select(mediafield.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mfq *MediaFieldQuery) IDsX(ctx context.Context) []int {
+	ids, err := mfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mfq *MediaFieldQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryCount)
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mfq, querierCount[*MediaFieldQuery](), mfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mfq *MediaFieldQuery) CountX(ctx context.Context) int {
+	count, err := mfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mfq *MediaFieldQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryExist)
+	switch _, err := mfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mfq *MediaFieldQuery) ExistX(ctx context.Context) bool {
+	exist, err := mfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MediaFieldQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mfq *MediaFieldQuery) Clone() *MediaFieldQuery {
+	if mfq == nil {
+		return nil
+	}
+	return &MediaFieldQuery{
+		config:        mfq.config,
+		ctx:           mfq.ctx.Clone(),
+		order:         append([]mediafield.OrderOption{}, mfq.order...),
+		inters:        append([]Interceptor{}, mfq.inters...),
+		predicates:    append([]predicate.MediaField{}, mfq.predicates...),
+		withMedia:     mfq.withMedia.Clone(),
+		withMetaField: mfq.withMetaField.Clone(),
+		// clone intermediate query.
+		sql:  mfq.sql.Clone(),
+		path: mfq.path,
+	}
+}
+
+// WithMedia tells the query-builder to eager-load the nodes that are connected to
+// the "media" edge. The optional arguments are used to configure the query builder of the edge.
+func (mfq *MediaFieldQuery) WithMedia(opts ...func(*MediaQuery)) *MediaFieldQuery {
+	query := (&MediaClient{config: mfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mfq.withMedia = query
+	return mfq
+}
+
+// WithMetaField tells the query-builder to eager-load the nodes that are connected to
+// the "meta_field" edge. The optional arguments are used to configure the query builder of the edge.
+func (mfq *MediaFieldQuery) WithMetaField(opts ...func(*MetaFieldQuery)) *MediaFieldQuery {
+	query := (&MetaFieldClient{config: mfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mfq.withMetaField = query
+	return mfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MediaID string `json:"media_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MediaField.Query().
+//		GroupBy(mediafield.FieldMediaID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mfq *MediaFieldQuery) GroupBy(field string, fields ...string) *MediaFieldGroupBy {
+	mfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MediaFieldGroupBy{build: mfq}
+	grbuild.flds = &mfq.ctx.Fields
+	grbuild.label = mediafield.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MediaID string `json:"media_id,omitempty"`
+//	}
+//
+//	client.MediaField.Query().
+//		Select(mediafield.FieldMediaID).
+//		Scan(ctx, &v)
+func (mfq *MediaFieldQuery) Select(fields ...string) *MediaFieldSelect {
+	mfq.ctx.Fields = append(mfq.ctx.Fields, fields...)
+	sbuild := &MediaFieldSelect{MediaFieldQuery: mfq}
+	sbuild.label = mediafield.Label
+	sbuild.flds, sbuild.scan = &mfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MediaFieldSelect configured with the given aggregations.
+func (mfq *MediaFieldQuery) Aggregate(fns ...AggregateFunc) *MediaFieldSelect {
+	return mfq.Select().Aggregate(fns...)
+}
+
+func (mfq *MediaFieldQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mfq.ctx.Fields {
+		if !mediafield.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mfq.path != nil {
+		prev, err := mfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mfq.sql = prev
+	}
+	return nil
+}
+
+func (mfq *MediaFieldQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MediaField, error) {
+	var (
+		nodes       = []*MediaField{}
+		_spec       = mfq.querySpec()
+		loadedTypes = [2]bool{
+			mfq.withMedia != nil,
+			mfq.withMetaField != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MediaField).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MediaField{config: mfq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mfq.withMedia; query != nil {
+		if err := mfq.loadMedia(ctx, query, nodes, nil,
+			func(n *MediaField, e *Media) { n.Edges.Media = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mfq.withMetaField; query != nil {
+		if err := mfq.loadMetaField(ctx, query, nodes, nil,
+			func(n *MediaField, e *MetaField) { n.Edges.MetaField = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mfq *MediaFieldQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes []*MediaField, init func(*MediaField), assign func(*MediaField, *Media)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*MediaField)
+	for i := range nodes {
+		fk := nodes[i].MediaID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(media.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "media_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mfq *MediaFieldQuery) loadMetaField(ctx context.Context, query *MetaFieldQuery, nodes []*MediaField, init func(*MediaField), assign func(*MediaField, *MetaField)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MediaField)
+	for i := range nodes {
+		fk := nodes[i].MetaFieldID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(metafield.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "meta_field_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mfq *MediaFieldQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mfq.querySpec()
+	_spec.Node.Columns = mfq.ctx.Fields
+	if len(mfq.ctx.Fields) > 0 {
+		_spec.Unique = mfq.ctx.Unique != nil && *mfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mfq.driver, _spec)
+}
+
+func (mfq *MediaFieldQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mediafield.Table, mediafield.Columns, sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt))
+	_spec.From = mfq.sql
+	if unique := mfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mediafield.FieldID)
+		for i := range fields {
+			if fields[i] != mediafield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mfq.withMedia != nil {
+			_spec.Node.AddColumnOnce(mediafield.FieldMediaID)
+		}
+		if mfq.withMetaField != nil {
+			_spec.Node.AddColumnOnce(mediafield.FieldMetaFieldID)
+		}
+	}
+	if ps := mfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mfq *MediaFieldQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mfq.driver.Dialect())
+	t1 := builder.Table(mediafield.Table)
+	columns := mfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mediafield.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mfq.sql != nil {
+		selector = mfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mfq.ctx.Unique != nil && *mfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mfq.predicates {
+		p(selector)
+	}
+	for _, p := range mfq.order {
+		p(selector)
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MediaFieldGroupBy is the group-by builder for MediaField entities.
+type MediaFieldGroupBy struct {
+	selector
+	build *MediaFieldQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mfgb *MediaFieldGroupBy) Aggregate(fns ...AggregateFunc) *MediaFieldGroupBy {
+	mfgb.fns = append(mfgb.fns, fns...)
+	return mfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfgb *MediaFieldGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MediaFieldQuery, *MediaFieldGroupBy](ctx, mfgb.build, mfgb, mfgb.build.inters, v)
+}
+
+func (mfgb *MediaFieldGroupBy) sqlScan(ctx context.Context, root *MediaFieldQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mfgb.fns))
+	for _, fn := range mfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mfgb.flds)+len(mfgb.fns))
+		for _, f := range *mfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MediaFieldSelect is the builder for selecting fields of MediaField entities.
+type MediaFieldSelect struct {
+	*MediaFieldQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mfs *MediaFieldSelect) Aggregate(fns ...AggregateFunc) *MediaFieldSelect {
+	mfs.fns = append(mfs.fns, fns...)
+	return mfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfs *MediaFieldSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfs.ctx, ent.OpQuerySelect)
+	if err := mfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MediaFieldQuery, *MediaFieldSelect](ctx, mfs.MediaFieldQuery, mfs, mfs.inters, v)
+}
+
+func (mfs *MediaFieldSelect) sqlScan(ctx context.Context, root *MediaFieldQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mfs.fns))
+	for _, fn := range mfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,463 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"era/booru/ent/media"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+	"era/booru/ent/predicate"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaFieldUpdate is the builder for updating MediaField entities.
+type MediaFieldUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MediaFieldMutation
+}
+
+// Where appends a list predicates to the MediaFieldUpdate builder.
+func (mfu *MediaFieldUpdate) Where(ps ...predicate.MediaField) *MediaFieldUpdate {
+	mfu.mutation.Where(ps...)
+	return mfu
+}
+
+// SetMediaID sets the "media_id" field.
+func (mfu *MediaFieldUpdate) SetMediaID(s string) *MediaFieldUpdate {
+	mfu.mutation.SetMediaID(s)
+	return mfu
+}
+
+// SetNillableMediaID sets the "media_id" field if the given value is not nil.
+func (mfu *MediaFieldUpdate) SetNillableMediaID(s *string) *MediaFieldUpdate {
+	if s != nil {
+		mfu.SetMediaID(*s)
+	}
+	return mfu
+}
+
+// SetMetaFieldID sets the "meta_field_id" field.
+func (mfu *MediaFieldUpdate) SetMetaFieldID(i int) *MediaFieldUpdate {
+	mfu.mutation.SetMetaFieldID(i)
+	return mfu
+}
+
+// SetNillableMetaFieldID sets the "meta_field_id" field if the given value is not nil.
+func (mfu *MediaFieldUpdate) SetNillableMetaFieldID(i *int) *MediaFieldUpdate {
+	if i != nil {
+		mfu.SetMetaFieldID(*i)
+	}
+	return mfu
+}
+
+// SetValue sets the "value" field.
+func (mfu *MediaFieldUpdate) SetValue(j jsontext.Value) *MediaFieldUpdate {
+	mfu.mutation.SetValue(j)
+	return mfu
+}
+
+// AppendValue appends j to the "value" field.
+func (mfu *MediaFieldUpdate) AppendValue(j jsontext.Value) *MediaFieldUpdate {
+	mfu.mutation.AppendValue(j)
+	return mfu
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (mfu *MediaFieldUpdate) SetMedia(m *Media) *MediaFieldUpdate {
+	return mfu.SetMediaID(m.ID)
+}
+
+// SetMetaField sets the "meta_field" edge to the MetaField entity.
+func (mfu *MediaFieldUpdate) SetMetaField(m *MetaField) *MediaFieldUpdate {
+	return mfu.SetMetaFieldID(m.ID)
+}
+
+// Mutation returns the MediaFieldMutation object of the builder.
+func (mfu *MediaFieldUpdate) Mutation() *MediaFieldMutation {
+	return mfu.mutation
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (mfu *MediaFieldUpdate) ClearMedia() *MediaFieldUpdate {
+	mfu.mutation.ClearMedia()
+	return mfu
+}
+
+// ClearMetaField clears the "meta_field" edge to the MetaField entity.
+func (mfu *MediaFieldUpdate) ClearMetaField() *MediaFieldUpdate {
+	mfu.mutation.ClearMetaField()
+	return mfu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mfu *MediaFieldUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfu.sqlSave, mfu.mutation, mfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfu *MediaFieldUpdate) SaveX(ctx context.Context) int {
+	affected, err := mfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mfu *MediaFieldUpdate) Exec(ctx context.Context) error {
+	_, err := mfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfu *MediaFieldUpdate) ExecX(ctx context.Context) {
+	if err := mfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfu *MediaFieldUpdate) check() error {
+	if mfu.mutation.MediaCleared() && len(mfu.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaField.media"`)
+	}
+	if mfu.mutation.MetaFieldCleared() && len(mfu.mutation.MetaFieldIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaField.meta_field"`)
+	}
+	return nil
+}
+
+func (mfu *MediaFieldUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mediafield.Table, mediafield.Columns, sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt))
+	if ps := mfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfu.mutation.Value(); ok {
+		_spec.SetField(mediafield.FieldValue, field.TypeJSON, value)
+	}
+	if value, ok := mfu.mutation.AppendedValue(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, mediafield.FieldValue, value)
+		})
+	}
+	if mfu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MediaTable,
+			Columns: []string{mediafield.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfu.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MediaTable,
+			Columns: []string{mediafield.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mfu.mutation.MetaFieldCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MetaFieldTable,
+			Columns: []string{mediafield.MetaFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfu.mutation.MetaFieldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MetaFieldTable,
+			Columns: []string{mediafield.MetaFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mediafield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mfu.mutation.done = true
+	return n, nil
+}
+
+// MediaFieldUpdateOne is the builder for updating a single MediaField entity.
+type MediaFieldUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MediaFieldMutation
+}
+
+// SetMediaID sets the "media_id" field.
+func (mfuo *MediaFieldUpdateOne) SetMediaID(s string) *MediaFieldUpdateOne {
+	mfuo.mutation.SetMediaID(s)
+	return mfuo
+}
+
+// SetNillableMediaID sets the "media_id" field if the given value is not nil.
+func (mfuo *MediaFieldUpdateOne) SetNillableMediaID(s *string) *MediaFieldUpdateOne {
+	if s != nil {
+		mfuo.SetMediaID(*s)
+	}
+	return mfuo
+}
+
+// SetMetaFieldID sets the "meta_field_id" field.
+func (mfuo *MediaFieldUpdateOne) SetMetaFieldID(i int) *MediaFieldUpdateOne {
+	mfuo.mutation.SetMetaFieldID(i)
+	return mfuo
+}
+
+// SetNillableMetaFieldID sets the "meta_field_id" field if the given value is not nil.
+func (mfuo *MediaFieldUpdateOne) SetNillableMetaFieldID(i *int) *MediaFieldUpdateOne {
+	if i != nil {
+		mfuo.SetMetaFieldID(*i)
+	}
+	return mfuo
+}
+
+// SetValue sets the "value" field.
+func (mfuo *MediaFieldUpdateOne) SetValue(j jsontext.Value) *MediaFieldUpdateOne {
+	mfuo.mutation.SetValue(j)
+	return mfuo
+}
+
+// AppendValue appends j to the "value" field.
+func (mfuo *MediaFieldUpdateOne) AppendValue(j jsontext.Value) *MediaFieldUpdateOne {
+	mfuo.mutation.AppendValue(j)
+	return mfuo
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (mfuo *MediaFieldUpdateOne) SetMedia(m *Media) *MediaFieldUpdateOne {
+	return mfuo.SetMediaID(m.ID)
+}
+
+// SetMetaField sets the "meta_field" edge to the MetaField entity.
+func (mfuo *MediaFieldUpdateOne) SetMetaField(m *MetaField) *MediaFieldUpdateOne {
+	return mfuo.SetMetaFieldID(m.ID)
+}
+
+// Mutation returns the MediaFieldMutation object of the builder.
+func (mfuo *MediaFieldUpdateOne) Mutation() *MediaFieldMutation {
+	return mfuo.mutation
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (mfuo *MediaFieldUpdateOne) ClearMedia() *MediaFieldUpdateOne {
+	mfuo.mutation.ClearMedia()
+	return mfuo
+}
+
+// ClearMetaField clears the "meta_field" edge to the MetaField entity.
+func (mfuo *MediaFieldUpdateOne) ClearMetaField() *MediaFieldUpdateOne {
+	mfuo.mutation.ClearMetaField()
+	return mfuo
+}
+
+// Where appends a list predicates to the MediaFieldUpdate builder.
+func (mfuo *MediaFieldUpdateOne) Where(ps ...predicate.MediaField) *MediaFieldUpdateOne {
+	mfuo.mutation.Where(ps...)
+	return mfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mfuo *MediaFieldUpdateOne) Select(field string, fields ...string) *MediaFieldUpdateOne {
+	mfuo.fields = append([]string{field}, fields...)
+	return mfuo
+}
+
+// Save executes the query and returns the updated MediaField entity.
+func (mfuo *MediaFieldUpdateOne) Save(ctx context.Context) (*MediaField, error) {
+	return withHooks(ctx, mfuo.sqlSave, mfuo.mutation, mfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfuo *MediaFieldUpdateOne) SaveX(ctx context.Context) *MediaField {
+	node, err := mfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mfuo *MediaFieldUpdateOne) Exec(ctx context.Context) error {
+	_, err := mfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfuo *MediaFieldUpdateOne) ExecX(ctx context.Context) {
+	if err := mfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfuo *MediaFieldUpdateOne) check() error {
+	if mfuo.mutation.MediaCleared() && len(mfuo.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaField.media"`)
+	}
+	if mfuo.mutation.MetaFieldCleared() && len(mfuo.mutation.MetaFieldIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaField.meta_field"`)
+	}
+	return nil
+}
+
+func (mfuo *MediaFieldUpdateOne) sqlSave(ctx context.Context) (_node *MediaField, err error) {
+	if err := mfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mediafield.Table, mediafield.Columns, sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt))
+	id, ok := mfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MediaField.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mediafield.FieldID)
+		for _, f := range fields {
+			if !mediafield.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mediafield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfuo.mutation.Value(); ok {
+		_spec.SetField(mediafield.FieldValue, field.TypeJSON, value)
+	}
+	if value, ok := mfuo.mutation.AppendedValue(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, mediafield.FieldValue, value)
+		})
+	}
+	if mfuo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MediaTable,
+			Columns: []string{mediafield.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfuo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MediaTable,
+			Columns: []string{mediafield.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mfuo.mutation.MetaFieldCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MetaFieldTable,
+			Columns: []string{mediafield.MetaFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfuo.mutation.MetaFieldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediafield.MetaFieldTable,
+			Columns: []string{mediafield.MetaFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MediaField{config: mfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mediafield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mfuo.mutation.done = true
+	return _node, nil
+}
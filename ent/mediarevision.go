@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"era/booru/ent/media"
+	"era/booru/ent/mediarevision"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MediaRevision is the model entity for the MediaRevision schema.
+type MediaRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID string `json:"media_id,omitempty"`
+	// Kind of edit, e.g. set_tags, edit_tags, set_dates, import, bulk_edit or revert
+	Action string `json:"action,omitempty"`
+	// Who made the edit, as reported by the request or job
+	Actor string `json:"actor,omitempty"`
+	// Media version after the edit
+	Version int `json:"version,omitempty"`
+	// TagsBefore holds the value of the "tags_before" field.
+	TagsBefore []string `json:"tags_before,omitempty"`
+	// TagsAfter holds the value of the "tags_after" field.
+	TagsAfter []string `json:"tags_after,omitempty"`
+	// Date name to YYYY-MM-DD value
+	DatesBefore map[string]string `json:"dates_before,omitempty"`
+	// DatesAfter holds the value of the "dates_after" field.
+	DatesAfter map[string]string `json:"dates_after,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MediaRevisionQuery when eager-loading is set.
+	Edges        MediaRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MediaRevisionEdges holds the relations/edges for other nodes in the graph.
+type MediaRevisionEdges struct {
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MediaRevisionEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MediaRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mediarevision.FieldTagsBefore, mediarevision.FieldTagsAfter, mediarevision.FieldDatesBefore, mediarevision.FieldDatesAfter:
+			values[i] = new([]byte)
+		case mediarevision.FieldID, mediarevision.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case mediarevision.FieldMediaID, mediarevision.FieldAction, mediarevision.FieldActor:
+			values[i] = new(sql.NullString)
+		case mediarevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MediaRevision fields.
+func (mr *MediaRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mediarevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mr.ID = int(value.Int64)
+		case mediarevision.FieldMediaID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value.Valid {
+				mr.MediaID = value.String
+			}
+		case mediarevision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				mr.Action = value.String
+			}
+		case mediarevision.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				mr.Actor = value.String
+			}
+		case mediarevision.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				mr.Version = int(value.Int64)
+			}
+		case mediarevision.FieldTagsBefore:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_before", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mr.TagsBefore); err != nil {
+					return fmt.Errorf("unmarshal field tags_before: %w", err)
+				}
+			}
+		case mediarevision.FieldTagsAfter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_after", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mr.TagsAfter); err != nil {
+					return fmt.Errorf("unmarshal field tags_after: %w", err)
+				}
+			}
+		case mediarevision.FieldDatesBefore:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dates_before", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mr.DatesBefore); err != nil {
+					return fmt.Errorf("unmarshal field dates_before: %w", err)
+				}
+			}
+		case mediarevision.FieldDatesAfter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dates_after", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mr.DatesAfter); err != nil {
+					return fmt.Errorf("unmarshal field dates_after: %w", err)
+				}
+			}
+		case mediarevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mr.CreatedAt = value.Time
+			}
+		default:
+			mr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MediaRevision.
+// This includes values selected through modifiers, order, etc.
+func (mr *MediaRevision) Value(name string) (ent.Value, error) {
+	return mr.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the MediaRevision entity.
+func (mr *MediaRevision) QueryMedia() *MediaQuery {
+	return NewMediaRevisionClient(mr.config).QueryMedia(mr)
+}
+
+// Update returns a builder for updating this MediaRevision.
+// Note that you need to call MediaRevision.Unwrap() before calling this method if this MediaRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mr *MediaRevision) Update() *MediaRevisionUpdateOne {
+	return NewMediaRevisionClient(mr.config).UpdateOne(mr)
+}
+
+// Unwrap unwraps the MediaRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mr *MediaRevision) Unwrap() *MediaRevision {
+	_tx, ok := mr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MediaRevision is not a transactional entity")
+	}
+	mr.config.driver = _tx.drv
+	return mr
+}
+
+// String implements the fmt.Stringer.
+func (mr *MediaRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("MediaRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mr.ID))
+	builder.WriteString("media_id=")
+	builder.WriteString(mr.MediaID)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(mr.Action)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(mr.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Version))
+	builder.WriteString(", ")
+	builder.WriteString("tags_before=")
+	builder.WriteString(fmt.Sprintf("%v", mr.TagsBefore))
+	builder.WriteString(", ")
+	builder.WriteString("tags_after=")
+	builder.WriteString(fmt.Sprintf("%v", mr.TagsAfter))
+	builder.WriteString(", ")
+	builder.WriteString("dates_before=")
+	builder.WriteString(fmt.Sprintf("%v", mr.DatesBefore))
+	builder.WriteString(", ")
+	builder.WriteString("dates_after=")
+	builder.WriteString(fmt.Sprintf("%v", mr.DatesAfter))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MediaRevisions is a parsable slice of MediaRevision.
+type MediaRevisions []*MediaRevision
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/mediarevision"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaRevisionCreate is the builder for creating a MediaRevision entity.
+type MediaRevisionCreate struct {
+	config
+	mutation *MediaRevisionMutation
+	hooks    []Hook
+}
+
+// SetMediaID sets the "media_id" field.
+func (mrc *MediaRevisionCreate) SetMediaID(s string) *MediaRevisionCreate {
+	mrc.mutation.SetMediaID(s)
+	return mrc
+}
+
+// SetAction sets the "action" field.
+func (mrc *MediaRevisionCreate) SetAction(s string) *MediaRevisionCreate {
+	mrc.mutation.SetAction(s)
+	return mrc
+}
+
+// SetActor sets the "actor" field.
+func (mrc *MediaRevisionCreate) SetActor(s string) *MediaRevisionCreate {
+	mrc.mutation.SetActor(s)
+	return mrc
+}
+
+// SetVersion sets the "version" field.
+func (mrc *MediaRevisionCreate) SetVersion(i int) *MediaRevisionCreate {
+	mrc.mutation.SetVersion(i)
+	return mrc
+}
+
+// SetTagsBefore sets the "tags_before" field.
+func (mrc *MediaRevisionCreate) SetTagsBefore(s []string) *MediaRevisionCreate {
+	mrc.mutation.SetTagsBefore(s)
+	return mrc
+}
+
+// SetTagsAfter sets the "tags_after" field.
+func (mrc *MediaRevisionCreate) SetTagsAfter(s []string) *MediaRevisionCreate {
+	mrc.mutation.SetTagsAfter(s)
+	return mrc
+}
+
+// SetDatesBefore sets the "dates_before" field.
+func (mrc *MediaRevisionCreate) SetDatesBefore(m map[string]string) *MediaRevisionCreate {
+	mrc.mutation.SetDatesBefore(m)
+	return mrc
+}
+
+// SetDatesAfter sets the "dates_after" field.
+func (mrc *MediaRevisionCreate) SetDatesAfter(m map[string]string) *MediaRevisionCreate {
+	mrc.mutation.SetDatesAfter(m)
+	return mrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mrc *MediaRevisionCreate) SetCreatedAt(t time.Time) *MediaRevisionCreate {
+	mrc.mutation.SetCreatedAt(t)
+	return mrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mrc *MediaRevisionCreate) SetNillableCreatedAt(t *time.Time) *MediaRevisionCreate {
+	if t != nil {
+		mrc.SetCreatedAt(*t)
+	}
+	return mrc
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (mrc *MediaRevisionCreate) SetMedia(m *Media) *MediaRevisionCreate {
+	return mrc.SetMediaID(m.ID)
+}
+
+// Mutation returns the MediaRevisionMutation object of the builder.
+func (mrc *MediaRevisionCreate) Mutation() *MediaRevisionMutation {
+	return mrc.mutation
+}
+
+// Save creates the MediaRevision in the database.
+func (mrc *MediaRevisionCreate) Save(ctx context.Context) (*MediaRevision, error) {
+	mrc.defaults()
+	return withHooks(ctx, mrc.sqlSave, mrc.mutation, mrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MediaRevisionCreate) SaveX(ctx context.Context) *MediaRevision {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MediaRevisionCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MediaRevisionCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MediaRevisionCreate) defaults() {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		v := mediarevision.DefaultCreatedAt()
+		mrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MediaRevisionCreate) check() error {
+	if _, ok := mrc.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "MediaRevision.media_id"`)}
+	}
+	if _, ok := mrc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "MediaRevision.action"`)}
+	}
+	if _, ok := mrc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "MediaRevision.actor"`)}
+	}
+	if _, ok := mrc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "MediaRevision.version"`)}
+	}
+	if _, ok := mrc.mutation.TagsBefore(); !ok {
+		return &ValidationError{Name: "tags_before", err: errors.New(`ent: missing required field "MediaRevision.tags_before"`)}
+	}
+	if _, ok := mrc.mutation.TagsAfter(); !ok {
+		return &ValidationError{Name: "tags_after", err: errors.New(`ent: missing required field "MediaRevision.tags_after"`)}
+	}
+	if _, ok := mrc.mutation.DatesBefore(); !ok {
+		return &ValidationError{Name: "dates_before", err: errors.New(`ent: missing required field "MediaRevision.dates_before"`)}
+	}
+	if _, ok := mrc.mutation.DatesAfter(); !ok {
+		return &ValidationError{Name: "dates_after", err: errors.New(`ent: missing required field "MediaRevision.dates_after"`)}
+	}
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MediaRevision.created_at"`)}
+	}
+	if len(mrc.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "MediaRevision.media"`)}
+	}
+	return nil
+}
+
+func (mrc *MediaRevisionCreate) sqlSave(ctx context.Context) (*MediaRevision, error) {
+	if err := mrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mrc.mutation.id = &_node.ID
+	mrc.mutation.done = true
+	return _node, nil
+}
+
+func (mrc *MediaRevisionCreate) createSpec() (*MediaRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MediaRevision{config: mrc.config}
+		_spec = sqlgraph.NewCreateSpec(mediarevision.Table, sqlgraph.NewFieldSpec(mediarevision.FieldID, field.TypeInt))
+	)
+	if value, ok := mrc.mutation.Action(); ok {
+		_spec.SetField(mediarevision.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := mrc.mutation.Actor(); ok {
+		_spec.SetField(mediarevision.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := mrc.mutation.Version(); ok {
+		_spec.SetField(mediarevision.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := mrc.mutation.TagsBefore(); ok {
+		_spec.SetField(mediarevision.FieldTagsBefore, field.TypeJSON, value)
+		_node.TagsBefore = value
+	}
+	if value, ok := mrc.mutation.TagsAfter(); ok {
+		_spec.SetField(mediarevision.FieldTagsAfter, field.TypeJSON, value)
+		_node.TagsAfter = value
+	}
+	if value, ok := mrc.mutation.DatesBefore(); ok {
+		_spec.SetField(mediarevision.FieldDatesBefore, field.TypeJSON, value)
+		_node.DatesBefore = value
+	}
+	if value, ok := mrc.mutation.DatesAfter(); ok {
+		_spec.SetField(mediarevision.FieldDatesAfter, field.TypeJSON, value)
+		_node.DatesAfter = value
+	}
+	if value, ok := mrc.mutation.CreatedAt(); ok {
+		_spec.SetField(mediarevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mrc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediarevision.MediaTable,
+			Columns: []string{mediarevision.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MediaRevisionCreateBulk is the builder for creating many MediaRevision entities in bulk.
+type MediaRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*MediaRevisionCreate
+}
+
+// Save creates the MediaRevision entities in the database.
+func (mrcb *MediaRevisionCreateBulk) Save(ctx context.Context) ([]*MediaRevision, error) {
+	if mrcb.err != nil {
+		return nil, mrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MediaRevision, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MediaRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MediaRevisionCreateBulk) SaveX(ctx context.Context) []*MediaRevision {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MediaRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MediaRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/mediarevision"
+	"era/booru/ent/predicate"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaRevisionUpdate is the builder for updating MediaRevision entities.
+type MediaRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MediaRevisionMutation
+}
+
+// Where appends a list predicates to the MediaRevisionUpdate builder.
+func (mru *MediaRevisionUpdate) Where(ps ...predicate.MediaRevision) *MediaRevisionUpdate {
+	mru.mutation.Where(ps...)
+	return mru
+}
+
+// Mutation returns the MediaRevisionMutation object of the builder.
+func (mru *MediaRevisionUpdate) Mutation() *MediaRevisionMutation {
+	return mru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mru *MediaRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mru.sqlSave, mru.mutation, mru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mru *MediaRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mru *MediaRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := mru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mru *MediaRevisionUpdate) ExecX(ctx context.Context) {
+	if err := mru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mru *MediaRevisionUpdate) check() error {
+	if mru.mutation.MediaCleared() && len(mru.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaRevision.media"`)
+	}
+	return nil
+}
+
+func (mru *MediaRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mediarevision.Table, mediarevision.Columns, sqlgraph.NewFieldSpec(mediarevision.FieldID, field.TypeInt))
+	if ps := mru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mediarevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mru.mutation.done = true
+	return n, nil
+}
+
+// MediaRevisionUpdateOne is the builder for updating a single MediaRevision entity.
+type MediaRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MediaRevisionMutation
+}
+
+// Mutation returns the MediaRevisionMutation object of the builder.
+func (mruo *MediaRevisionUpdateOne) Mutation() *MediaRevisionMutation {
+	return mruo.mutation
+}
+
+// Where appends a list predicates to the MediaRevisionUpdate builder.
+func (mruo *MediaRevisionUpdateOne) Where(ps ...predicate.MediaRevision) *MediaRevisionUpdateOne {
+	mruo.mutation.Where(ps...)
+	return mruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mruo *MediaRevisionUpdateOne) Select(field string, fields ...string) *MediaRevisionUpdateOne {
+	mruo.fields = append([]string{field}, fields...)
+	return mruo
+}
+
+// Save executes the query and returns the updated MediaRevision entity.
+func (mruo *MediaRevisionUpdateOne) Save(ctx context.Context) (*MediaRevision, error) {
+	return withHooks(ctx, mruo.sqlSave, mruo.mutation, mruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mruo *MediaRevisionUpdateOne) SaveX(ctx context.Context) *MediaRevision {
+	node, err := mruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mruo *MediaRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mruo *MediaRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := mruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mruo *MediaRevisionUpdateOne) check() error {
+	if mruo.mutation.MediaCleared() && len(mruo.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaRevision.media"`)
+	}
+	return nil
+}
+
+func (mruo *MediaRevisionUpdateOne) sqlSave(ctx context.Context) (_node *MediaRevision, err error) {
+	if err := mruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mediarevision.Table, mediarevision.Columns, sqlgraph.NewFieldSpec(mediarevision.FieldID, field.TypeInt))
+	id, ok := mruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MediaRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mediarevision.FieldID)
+		for _, f := range fields {
+			if !mediarevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mediarevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &MediaRevision{config: mruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mediarevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/media"
+	"era/booru/ent/mediavote"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MediaVote is the model entity for the MediaVote schema.
+type MediaVote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// User who voted, as reported by the request
+	Actor string `json:"actor,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID string `json:"media_id,omitempty"`
+	// +1 for an up vote, -1 for a down vote
+	Value int `json:"value,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MediaVoteQuery when eager-loading is set.
+	Edges        MediaVoteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MediaVoteEdges holds the relations/edges for other nodes in the graph.
+type MediaVoteEdges struct {
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MediaVoteEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MediaVote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mediavote.FieldID, mediavote.FieldValue:
+			values[i] = new(sql.NullInt64)
+		case mediavote.FieldActor, mediavote.FieldMediaID:
+			values[i] = new(sql.NullString)
+		case mediavote.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MediaVote fields.
+func (mv *MediaVote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mediavote.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mv.ID = int(value.Int64)
+		case mediavote.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				mv.Actor = value.String
+			}
+		case mediavote.FieldMediaID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value.Valid {
+				mv.MediaID = value.String
+			}
+		case mediavote.FieldValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				mv.Value = int(value.Int64)
+			}
+		case mediavote.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mv.CreatedAt = value.Time
+			}
+		default:
+			mv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the MediaVote.
+// This includes values selected through modifiers, order, etc.
+func (mv *MediaVote) GetValue(name string) (ent.Value, error) {
+	return mv.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the MediaVote entity.
+func (mv *MediaVote) QueryMedia() *MediaQuery {
+	return NewMediaVoteClient(mv.config).QueryMedia(mv)
+}
+
+// Update returns a builder for updating this MediaVote.
+// Note that you need to call MediaVote.Unwrap() before calling this method if this MediaVote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mv *MediaVote) Update() *MediaVoteUpdateOne {
+	return NewMediaVoteClient(mv.config).UpdateOne(mv)
+}
+
+// Unwrap unwraps the MediaVote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mv *MediaVote) Unwrap() *MediaVote {
+	_tx, ok := mv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MediaVote is not a transactional entity")
+	}
+	mv.config.driver = _tx.drv
+	return mv
+}
+
+// String implements the fmt.Stringer.
+func (mv *MediaVote) String() string {
+	var builder strings.Builder
+	builder.WriteString("MediaVote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mv.ID))
+	builder.WriteString("actor=")
+	builder.WriteString(mv.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("media_id=")
+	builder.WriteString(mv.MediaID)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", mv.Value))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mv.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MediaVotes is a parsable slice of MediaVote.
+type MediaVotes []*MediaVote
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/mediavote"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaVoteCreate is the builder for creating a MediaVote entity.
+type MediaVoteCreate struct {
+	config
+	mutation *MediaVoteMutation
+	hooks    []Hook
+}
+
+// SetActor sets the "actor" field.
+func (mvc *MediaVoteCreate) SetActor(s string) *MediaVoteCreate {
+	mvc.mutation.SetActor(s)
+	return mvc
+}
+
+// SetMediaID sets the "media_id" field.
+func (mvc *MediaVoteCreate) SetMediaID(s string) *MediaVoteCreate {
+	mvc.mutation.SetMediaID(s)
+	return mvc
+}
+
+// SetValue sets the "value" field.
+func (mvc *MediaVoteCreate) SetValue(i int) *MediaVoteCreate {
+	mvc.mutation.SetValue(i)
+	return mvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mvc *MediaVoteCreate) SetCreatedAt(t time.Time) *MediaVoteCreate {
+	mvc.mutation.SetCreatedAt(t)
+	return mvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mvc *MediaVoteCreate) SetNillableCreatedAt(t *time.Time) *MediaVoteCreate {
+	if t != nil {
+		mvc.SetCreatedAt(*t)
+	}
+	return mvc
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (mvc *MediaVoteCreate) SetMedia(m *Media) *MediaVoteCreate {
+	return mvc.SetMediaID(m.ID)
+}
+
+// Mutation returns the MediaVoteMutation object of the builder.
+func (mvc *MediaVoteCreate) Mutation() *MediaVoteMutation {
+	return mvc.mutation
+}
+
+// Save creates the MediaVote in the database.
+func (mvc *MediaVoteCreate) Save(ctx context.Context) (*MediaVote, error) {
+	mvc.defaults()
+	return withHooks(ctx, mvc.sqlSave, mvc.mutation, mvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mvc *MediaVoteCreate) SaveX(ctx context.Context) *MediaVote {
+	v, err := mvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mvc *MediaVoteCreate) Exec(ctx context.Context) error {
+	_, err := mvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvc *MediaVoteCreate) ExecX(ctx context.Context) {
+	if err := mvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mvc *MediaVoteCreate) defaults() {
+	if _, ok := mvc.mutation.CreatedAt(); !ok {
+		v := mediavote.DefaultCreatedAt()
+		mvc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvc *MediaVoteCreate) check() error {
+	if _, ok := mvc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "MediaVote.actor"`)}
+	}
+	if v, ok := mvc.mutation.Actor(); ok {
+		if err := mediavote.ActorValidator(v); err != nil {
+			return &ValidationError{Name: "actor", err: fmt.Errorf(`ent: validator failed for field "MediaVote.actor": %w`, err)}
+		}
+	}
+	if _, ok := mvc.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "MediaVote.media_id"`)}
+	}
+	if _, ok := mvc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "MediaVote.value"`)}
+	}
+	if v, ok := mvc.mutation.Value(); ok {
+		if err := mediavote.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "MediaVote.value": %w`, err)}
+		}
+	}
+	if _, ok := mvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MediaVote.created_at"`)}
+	}
+	if len(mvc.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "MediaVote.media"`)}
+	}
+	return nil
+}
+
+func (mvc *MediaVoteCreate) sqlSave(ctx context.Context) (*MediaVote, error) {
+	if err := mvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mvc.mutation.id = &_node.ID
+	mvc.mutation.done = true
+	return _node, nil
+}
+
+func (mvc *MediaVoteCreate) createSpec() (*MediaVote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MediaVote{config: mvc.config}
+		_spec = sqlgraph.NewCreateSpec(mediavote.Table, sqlgraph.NewFieldSpec(mediavote.FieldID, field.TypeInt))
+	)
+	if value, ok := mvc.mutation.Actor(); ok {
+		_spec.SetField(mediavote.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := mvc.mutation.Value(); ok {
+		_spec.SetField(mediavote.FieldValue, field.TypeInt, value)
+		_node.Value = value
+	}
+	if value, ok := mvc.mutation.CreatedAt(); ok {
+		_spec.SetField(mediavote.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mvc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mediavote.MediaTable,
+			Columns: []string{mediavote.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MediaVoteCreateBulk is the builder for creating many MediaVote entities in bulk.
+type MediaVoteCreateBulk struct {
+	config
+	err      error
+	builders []*MediaVoteCreate
+}
+
+// Save creates the MediaVote entities in the database.
+func (mvcb *MediaVoteCreateBulk) Save(ctx context.Context) ([]*MediaVote, error) {
+	if mvcb.err != nil {
+		return nil, mvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mvcb.builders))
+	nodes := make([]*MediaVote, len(mvcb.builders))
+	mutators := make([]Mutator, len(mvcb.builders))
+	for i := range mvcb.builders {
+		func(i int, root context.Context) {
+			builder := mvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MediaVoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvcb *MediaVoteCreateBulk) SaveX(ctx context.Context) []*MediaVote {
+	v, err := mvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mvcb *MediaVoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := mvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvcb *MediaVoteCreateBulk) ExecX(ctx context.Context) {
+	if err := mvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
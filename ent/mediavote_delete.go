@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/mediavote"
+	"era/booru/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaVoteDelete is the builder for deleting a MediaVote entity.
+type MediaVoteDelete struct {
+	config
+	hooks    []Hook
+	mutation *MediaVoteMutation
+}
+
+// Where appends a list predicates to the MediaVoteDelete builder.
+func (mvd *MediaVoteDelete) Where(ps ...predicate.MediaVote) *MediaVoteDelete {
+	mvd.mutation.Where(ps...)
+	return mvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mvd *MediaVoteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mvd.sqlExec, mvd.mutation, mvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvd *MediaVoteDelete) ExecX(ctx context.Context) int {
+	n, err := mvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mvd *MediaVoteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mediavote.Table, sqlgraph.NewFieldSpec(mediavote.FieldID, field.TypeInt))
+	if ps := mvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mvd.mutation.done = true
+	return affected, err
+}
+
+// MediaVoteDeleteOne is the builder for deleting a single MediaVote entity.
+type MediaVoteDeleteOne struct {
+	mvd *MediaVoteDelete
+}
+
+// Where appends a list predicates to the MediaVoteDelete builder.
+func (mvdo *MediaVoteDeleteOne) Where(ps ...predicate.MediaVote) *MediaVoteDeleteOne {
+	mvdo.mvd.mutation.Where(ps...)
+	return mvdo
+}
+
+// Exec executes the deletion query.
+func (mvdo *MediaVoteDeleteOne) Exec(ctx context.Context) error {
+	n, err := mvdo.mvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mediavote.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvdo *MediaVoteDeleteOne) ExecX(ctx context.Context) {
+	if err := mvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/mediavote"
+	"era/booru/ent/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaVoteQuery is the builder for querying MediaVote entities.
+type MediaVoteQuery struct {
+	config
+	ctx        *QueryContext
+	order      []mediavote.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MediaVote
+	withMedia  *MediaQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MediaVoteQuery builder.
+func (mvq *MediaVoteQuery) Where(ps ...predicate.MediaVote) *MediaVoteQuery {
+	mvq.predicates = append(mvq.predicates, ps...)
+	return mvq
+}
+
+// Limit the number of records to be returned by this query.
+func (mvq *MediaVoteQuery) Limit(limit int) *MediaVoteQuery {
+	mvq.ctx.Limit = &limit
+	return mvq
+}
+
+// Offset to start from.
+func (mvq *MediaVoteQuery) Offset(offset int) *MediaVoteQuery {
+	mvq.ctx.Offset = &offset
+	return mvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mvq *MediaVoteQuery) Unique(unique bool) *MediaVoteQuery {
+	mvq.ctx.Unique = &unique
+	return mvq
+}
+
+// Order specifies how the records should be ordered.
+func (mvq *MediaVoteQuery) Order(o ...mediavote.OrderOption) *MediaVoteQuery {
+	mvq.order = append(mvq.order, o...)
+	return mvq
+}
+
+// QueryMedia chains the current query on the "media" edge.
+func (mvq *MediaVoteQuery) QueryMedia() *MediaQuery {
+	query := (&MediaClient{config: mvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediavote.Table, mediavote.FieldID, selector),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mediavote.MediaTable, mediavote.MediaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MediaVote entity from the query.
+// Returns a *NotFoundError when no MediaVote was found.
+func (mvq *MediaVoteQuery) First(ctx context.Context) (*MediaVote, error) {
+	nodes, err := mvq.Limit(1).All(setContextOp(ctx, mvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mediavote.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mvq *MediaVoteQuery) FirstX(ctx context.Context) *MediaVote {
+	node, err := mvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MediaVote ID from the query.
+// Returns a *NotFoundError when no MediaVote ID was found.
+func (mvq *MediaVoteQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mvq.Limit(1).IDs(setContextOp(ctx, mvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mediavote.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mvq *MediaVoteQuery) FirstIDX(ctx context.Context) int {
+	id, err := mvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MediaVote entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MediaVote entity is found.
+// Returns a *NotFoundError when no MediaVote entities are found.
+func (mvq *MediaVoteQuery) Only(ctx context.Context) (*MediaVote, error) {
+	nodes, err := mvq.Limit(2).All(setContextOp(ctx, mvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mediavote.Label}
+	default:
+		return nil, &NotSingularError{mediavote.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mvq *MediaVoteQuery) OnlyX(ctx context.Context) *MediaVote {
+	node, err := mvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MediaVote ID in the query.
+// Returns a *NotSingularError when more than one MediaVote ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mvq *MediaVoteQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mvq.Limit(2).IDs(setContextOp(ctx, mvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mediavote.Label}
+	default:
+		err = &NotSingularError{mediavote.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mvq *MediaVoteQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MediaVotes.
+func (mvq *MediaVoteQuery) All(ctx context.Context) ([]*MediaVote, error) {
+	ctx = setContextOp(ctx, mvq.ctx, ent.OpQueryAll)
+	if err := mvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MediaVote, *MediaVoteQuery]()
+	return withInterceptors[[]*MediaVote](ctx, mvq, qr, mvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mvq *MediaVoteQuery) AllX(ctx context.Context) []*MediaVote {
+	nodes, err := mvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MediaVote IDs.
+func (mvq *MediaVoteQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mvq.ctx.Unique == nil && mvq.path != nil {
+		mvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mvq.ctx, ent.OpQueryIDs)
+	if err = mvq.Select(mediavote.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mvq *MediaVoteQuery) IDsX(ctx context.Context) []int {
+	ids, err := mvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mvq *MediaVoteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mvq.ctx, ent.OpQueryCount)
+	if err := mvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mvq, querierCount[*MediaVoteQuery](), mvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mvq *MediaVoteQuery) CountX(ctx context.Context) int {
+	count, err := mvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mvq *MediaVoteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mvq.ctx, ent.OpQueryExist)
+	switch _, err := mvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mvq *MediaVoteQuery) ExistX(ctx context.Context) bool {
+	exist, err := mvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MediaVoteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mvq *MediaVoteQuery) Clone() *MediaVoteQuery {
+	if mvq == nil {
+		return nil
+	}
+	return &MediaVoteQuery{
+		config:     mvq.config,
+		ctx:        mvq.ctx.Clone(),
+		order:      append([]mediavote.OrderOption{}, mvq.order...),
+		inters:     append([]Interceptor{}, mvq.inters...),
+		predicates: append([]predicate.MediaVote{}, mvq.predicates...),
+		withMedia:  mvq.withMedia.Clone(),
+		// clone intermediate query.
+		sql:  mvq.sql.Clone(),
+		path: mvq.path,
+	}
+}
+
+// WithMedia tells the query-builder to eager-load the nodes that are connected to
+// the "media" edge. The optional arguments are used to configure the query builder of the edge.
+func (mvq *MediaVoteQuery) WithMedia(opts ...func(*MediaQuery)) *MediaVoteQuery {
+	query := (&MediaClient{config: mvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mvq.withMedia = query
+	return mvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Actor string `json:"actor,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MediaVote.Query().
+//		GroupBy(mediavote.FieldActor).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mvq *MediaVoteQuery) GroupBy(field string, fields ...string) *MediaVoteGroupBy {
+	mvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MediaVoteGroupBy{build: mvq}
+	grbuild.flds = &mvq.ctx.Fields
+	grbuild.label = mediavote.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Actor string `json:"actor,omitempty"`
+//	}
+//
+//	client.MediaVote.Query().
+//		Select(mediavote.FieldActor).
+//		Scan(ctx, &v)
+func (mvq *MediaVoteQuery) Select(fields ...string) *MediaVoteSelect {
+	mvq.ctx.Fields = append(mvq.ctx.Fields, fields...)
+	sbuild := &MediaVoteSelect{MediaVoteQuery: mvq}
+	sbuild.label = mediavote.Label
+	sbuild.flds, sbuild.scan = &mvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MediaVoteSelect configured with the given aggregations.
+func (mvq *MediaVoteQuery) Aggregate(fns ...AggregateFunc) *MediaVoteSelect {
+	return mvq.Select().Aggregate(fns...)
+}
+
+func (mvq *MediaVoteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mvq.ctx.Fields {
+		if !mediavote.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mvq.path != nil {
+		prev, err := mvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mvq.sql = prev
+	}
+	return nil
+}
+
+func (mvq *MediaVoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MediaVote, error) {
+	var (
+		nodes       = []*MediaVote{}
+		_spec       = mvq.querySpec()
+		loadedTypes = [1]bool{
+			mvq.withMedia != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MediaVote).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MediaVote{config: mvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mvq.withMedia; query != nil {
+		if err := mvq.loadMedia(ctx, query, nodes, nil,
+			func(n *MediaVote, e *Media) { n.Edges.Media = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mvq *MediaVoteQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes []*MediaVote, init func(*MediaVote), assign func(*MediaVote, *Media)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*MediaVote)
+	for i := range nodes {
+		fk := nodes[i].MediaID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(media.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "media_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mvq *MediaVoteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mvq.querySpec()
+	_spec.Node.Columns = mvq.ctx.Fields
+	if len(mvq.ctx.Fields) > 0 {
+		_spec.Unique = mvq.ctx.Unique != nil && *mvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mvq.driver, _spec)
+}
+
+func (mvq *MediaVoteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mediavote.Table, mediavote.Columns, sqlgraph.NewFieldSpec(mediavote.FieldID, field.TypeInt))
+	_spec.From = mvq.sql
+	if unique := mvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mediavote.FieldID)
+		for i := range fields {
+			if fields[i] != mediavote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mvq.withMedia != nil {
+			_spec.Node.AddColumnOnce(mediavote.FieldMediaID)
+		}
+	}
+	if ps := mvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mvq *MediaVoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mvq.driver.Dialect())
+	t1 := builder.Table(mediavote.Table)
+	columns := mvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mediavote.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mvq.sql != nil {
+		selector = mvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mvq.ctx.Unique != nil && *mvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mvq.predicates {
+		p(selector)
+	}
+	for _, p := range mvq.order {
+		p(selector)
+	}
+	if offset := mvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MediaVoteGroupBy is the group-by builder for MediaVote entities.
+type MediaVoteGroupBy struct {
+	selector
+	build *MediaVoteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mvgb *MediaVoteGroupBy) Aggregate(fns ...AggregateFunc) *MediaVoteGroupBy {
+	mvgb.fns = append(mvgb.fns, fns...)
+	return mvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mvgb *MediaVoteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MediaVoteQuery, *MediaVoteGroupBy](ctx, mvgb.build, mvgb, mvgb.build.inters, v)
+}
+
+func (mvgb *MediaVoteGroupBy) sqlScan(ctx context.Context, root *MediaVoteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mvgb.fns))
+	for _, fn := range mvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mvgb.flds)+len(mvgb.fns))
+		for _, f := range *mvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MediaVoteSelect is the builder for selecting fields of MediaVote entities.
+type MediaVoteSelect struct {
+	*MediaVoteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mvs *MediaVoteSelect) Aggregate(fns ...AggregateFunc) *MediaVoteSelect {
+	mvs.fns = append(mvs.fns, fns...)
+	return mvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mvs *MediaVoteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mvs.ctx, ent.OpQuerySelect)
+	if err := mvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MediaVoteQuery, *MediaVoteSelect](ctx, mvs.MediaVoteQuery, mvs, mvs.inters, v)
+}
+
+func (mvs *MediaVoteSelect) sqlScan(ctx context.Context, root *MediaVoteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mvs.fns))
+	for _, fn := range mvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/mediavote"
+	"era/booru/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaVoteUpdate is the builder for updating MediaVote entities.
+type MediaVoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MediaVoteMutation
+}
+
+// Where appends a list predicates to the MediaVoteUpdate builder.
+func (mvu *MediaVoteUpdate) Where(ps ...predicate.MediaVote) *MediaVoteUpdate {
+	mvu.mutation.Where(ps...)
+	return mvu
+}
+
+// SetValue sets the "value" field.
+func (mvu *MediaVoteUpdate) SetValue(i int) *MediaVoteUpdate {
+	mvu.mutation.ResetValue()
+	mvu.mutation.SetValue(i)
+	return mvu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (mvu *MediaVoteUpdate) SetNillableValue(i *int) *MediaVoteUpdate {
+	if i != nil {
+		mvu.SetValue(*i)
+	}
+	return mvu
+}
+
+// AddValue adds i to the "value" field.
+func (mvu *MediaVoteUpdate) AddValue(i int) *MediaVoteUpdate {
+	mvu.mutation.AddValue(i)
+	return mvu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mvu *MediaVoteUpdate) SetCreatedAt(t time.Time) *MediaVoteUpdate {
+	mvu.mutation.SetCreatedAt(t)
+	return mvu
+}
+
+// Mutation returns the MediaVoteMutation object of the builder.
+func (mvu *MediaVoteUpdate) Mutation() *MediaVoteMutation {
+	return mvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mvu *MediaVoteUpdate) Save(ctx context.Context) (int, error) {
+	mvu.defaults()
+	return withHooks(ctx, mvu.sqlSave, mvu.mutation, mvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvu *MediaVoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := mvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mvu *MediaVoteUpdate) Exec(ctx context.Context) error {
+	_, err := mvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvu *MediaVoteUpdate) ExecX(ctx context.Context) {
+	if err := mvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mvu *MediaVoteUpdate) defaults() {
+	if _, ok := mvu.mutation.CreatedAt(); !ok {
+		v := mediavote.UpdateDefaultCreatedAt()
+		mvu.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvu *MediaVoteUpdate) check() error {
+	if v, ok := mvu.mutation.Value(); ok {
+		if err := mediavote.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "MediaVote.value": %w`, err)}
+		}
+	}
+	if mvu.mutation.MediaCleared() && len(mvu.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaVote.media"`)
+	}
+	return nil
+}
+
+func (mvu *MediaVoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mediavote.Table, mediavote.Columns, sqlgraph.NewFieldSpec(mediavote.FieldID, field.TypeInt))
+	if ps := mvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mvu.mutation.Value(); ok {
+		_spec.SetField(mediavote.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := mvu.mutation.AddedValue(); ok {
+		_spec.AddField(mediavote.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := mvu.mutation.CreatedAt(); ok {
+		_spec.SetField(mediavote.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mediavote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mvu.mutation.done = true
+	return n, nil
+}
+
+// MediaVoteUpdateOne is the builder for updating a single MediaVote entity.
+type MediaVoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MediaVoteMutation
+}
+
+// SetValue sets the "value" field.
+func (mvuo *MediaVoteUpdateOne) SetValue(i int) *MediaVoteUpdateOne {
+	mvuo.mutation.ResetValue()
+	mvuo.mutation.SetValue(i)
+	return mvuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (mvuo *MediaVoteUpdateOne) SetNillableValue(i *int) *MediaVoteUpdateOne {
+	if i != nil {
+		mvuo.SetValue(*i)
+	}
+	return mvuo
+}
+
+// AddValue adds i to the "value" field.
+func (mvuo *MediaVoteUpdateOne) AddValue(i int) *MediaVoteUpdateOne {
+	mvuo.mutation.AddValue(i)
+	return mvuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mvuo *MediaVoteUpdateOne) SetCreatedAt(t time.Time) *MediaVoteUpdateOne {
+	mvuo.mutation.SetCreatedAt(t)
+	return mvuo
+}
+
+// Mutation returns the MediaVoteMutation object of the builder.
+func (mvuo *MediaVoteUpdateOne) Mutation() *MediaVoteMutation {
+	return mvuo.mutation
+}
+
+// Where appends a list predicates to the MediaVoteUpdate builder.
+func (mvuo *MediaVoteUpdateOne) Where(ps ...predicate.MediaVote) *MediaVoteUpdateOne {
+	mvuo.mutation.Where(ps...)
+	return mvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mvuo *MediaVoteUpdateOne) Select(field string, fields ...string) *MediaVoteUpdateOne {
+	mvuo.fields = append([]string{field}, fields...)
+	return mvuo
+}
+
+// Save executes the query and returns the updated MediaVote entity.
+func (mvuo *MediaVoteUpdateOne) Save(ctx context.Context) (*MediaVote, error) {
+	mvuo.defaults()
+	return withHooks(ctx, mvuo.sqlSave, mvuo.mutation, mvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mvuo *MediaVoteUpdateOne) SaveX(ctx context.Context) *MediaVote {
+	node, err := mvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mvuo *MediaVoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := mvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mvuo *MediaVoteUpdateOne) ExecX(ctx context.Context) {
+	if err := mvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mvuo *MediaVoteUpdateOne) defaults() {
+	if _, ok := mvuo.mutation.CreatedAt(); !ok {
+		v := mediavote.UpdateDefaultCreatedAt()
+		mvuo.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mvuo *MediaVoteUpdateOne) check() error {
+	if v, ok := mvuo.mutation.Value(); ok {
+		if err := mediavote.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "MediaVote.value": %w`, err)}
+		}
+	}
+	if mvuo.mutation.MediaCleared() && len(mvuo.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaVote.media"`)
+	}
+	return nil
+}
+
+func (mvuo *MediaVoteUpdateOne) sqlSave(ctx context.Context) (_node *MediaVote, err error) {
+	if err := mvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mediavote.Table, mediavote.Columns, sqlgraph.NewFieldSpec(mediavote.FieldID, field.TypeInt))
+	id, ok := mvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MediaVote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mediavote.FieldID)
+		for _, f := range fields {
+			if !mediavote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mediavote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mvuo.mutation.Value(); ok {
+		_spec.SetField(mediavote.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := mvuo.mutation.AddedValue(); ok {
+		_spec.AddField(mediavote.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := mvuo.mutation.CreatedAt(); ok {
+		_spec.SetField(mediavote.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &MediaVote{config: mvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mediavote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mvuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"era/booru/ent/metafield"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MetaField is the model entity for the MetaField schema.
+type MetaField struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Field name, also used as the search field
+	Name string `json:"name,omitempty"`
+	// Type that values must have
+	Type metafield.Type `json:"type,omitempty"`
+	// Allowed values of an enum field
+	Values []string `json:"values,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MetaFieldQuery when eager-loading is set.
+	Edges        MetaFieldEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MetaFieldEdges holds the relations/edges for other nodes in the graph.
+type MetaFieldEdges struct {
+	// Media items with a value for this field
+	Media []*Media `json:"media,omitempty"`
+	// MediaFields holds the value of the media_fields edge.
+	MediaFields []*MediaField `json:"media_fields,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading.
+func (e MetaFieldEdges) MediaOrErr() ([]*Media, error) {
+	if e.loadedTypes[0] {
+		return e.Media, nil
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// MediaFieldsOrErr returns the MediaFields value or an error if the edge
+// was not loaded in eager-loading.
+func (e MetaFieldEdges) MediaFieldsOrErr() ([]*MediaField, error) {
+	if e.loadedTypes[1] {
+		return e.MediaFields, nil
+	}
+	return nil, &NotLoadedError{edge: "media_fields"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MetaField) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case metafield.FieldValues:
+			values[i] = new([]byte)
+		case metafield.FieldID:
+			values[i] = new(sql.NullInt64)
+		case metafield.FieldName, metafield.FieldType, metafield.FieldDescription:
+			values[i] = new(sql.NullString)
+		case metafield.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MetaField fields.
+func (mf *MetaField) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case metafield.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mf.ID = int(value.Int64)
+		case metafield.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				mf.Name = value.String
+			}
+		case metafield.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				mf.Type = metafield.Type(value.String)
+			}
+		case metafield.FieldValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mf.Values); err != nil {
+					return fmt.Errorf("unmarshal field values: %w", err)
+				}
+			}
+		case metafield.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				mf.Description = value.String
+			}
+		case metafield.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mf.CreatedAt = value.Time
+			}
+		default:
+			mf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MetaField.
+// This includes values selected through modifiers, order, etc.
+func (mf *MetaField) Value(name string) (ent.Value, error) {
+	return mf.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the MetaField entity.
+func (mf *MetaField) QueryMedia() *MediaQuery {
+	return NewMetaFieldClient(mf.config).QueryMedia(mf)
+}
+
+// QueryMediaFields queries the "media_fields" edge of the MetaField entity.
+func (mf *MetaField) QueryMediaFields() *MediaFieldQuery {
+	return NewMetaFieldClient(mf.config).QueryMediaFields(mf)
+}
+
+// Update returns a builder for updating this MetaField.
+// Note that you need to call MetaField.Unwrap() before calling this method if this MetaField
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mf *MetaField) Update() *MetaFieldUpdateOne {
+	return NewMetaFieldClient(mf.config).UpdateOne(mf)
+}
+
+// Unwrap unwraps the MetaField entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mf *MetaField) Unwrap() *MetaField {
+	_tx, ok := mf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MetaField is not a transactional entity")
+	}
+	mf.config.driver = _tx.drv
+	return mf
+}
+
+// String implements the fmt.Stringer.
+func (mf *MetaField) String() string {
+	var builder strings.Builder
+	builder.WriteString("MetaField(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mf.ID))
+	builder.WriteString("name=")
+	builder.WriteString(mf.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", mf.Type))
+	builder.WriteString(", ")
+	builder.WriteString("values=")
+	builder.WriteString(fmt.Sprintf("%v", mf.Values))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(mf.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mf.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MetaFields is a parsable slice of MetaField.
+type MetaFields []*MetaField
@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package metafield
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the metafield type in the database.
+	Label = "meta_field"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldValues holds the string denoting the values field in the database.
+	FieldValues = "values"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMedia holds the string denoting the media edge name in mutations.
+	EdgeMedia = "media"
+	// EdgeMediaFields holds the string denoting the media_fields edge name in mutations.
+	EdgeMediaFields = "media_fields"
+	// Table holds the table name of the metafield in the database.
+	Table = "meta_fields"
+	// MediaTable is the table that holds the media relation/edge. The primary key declared below.
+	MediaTable = "media_fields"
+	// MediaInverseTable is the table name for the Media entity.
+	// It exists in this package in order to avoid circular dependency with the "media" package.
+	MediaInverseTable = "media"
+	// MediaFieldsTable is the table that holds the media_fields relation/edge.
+	MediaFieldsTable = "media_fields"
+	// MediaFieldsInverseTable is the table name for the MediaField entity.
+	// It exists in this package in order to avoid circular dependency with the "mediafield" package.
+	MediaFieldsInverseTable = "media_fields"
+	// MediaFieldsColumn is the table column denoting the media_fields relation/edge.
+	MediaFieldsColumn = "meta_field_id"
+)
+
+// Columns holds all SQL columns for metafield fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldType,
+	FieldValues,
+	FieldDescription,
+	FieldCreatedAt,
+}
+
+var (
+	// MediaPrimaryKey and MediaColumn2 are the table columns denoting the
+	// primary key for the media relation (M2M).
+	MediaPrimaryKey = []string{"media_id", "meta_field_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeInt    Type = "int"
+	TypeFloat  Type = "float"
+	TypeString Type = "string"
+	TypeEnum   Type = "enum"
+	TypeBool   Type = "bool"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeInt, TypeFloat, TypeString, TypeEnum, TypeBool:
+		return nil
+	default:
+		return fmt.Errorf("metafield: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the MetaField queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMediaCount orders the results by media count.
+func ByMediaCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMediaStep(), opts...)
+	}
+}
+
+// ByMedia orders the results by media terms.
+func ByMedia(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMediaStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMediaFieldsCount orders the results by media_fields count.
+func ByMediaFieldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMediaFieldsStep(), opts...)
+	}
+}
+
+// ByMediaFields orders the results by media_fields terms.
+func ByMediaFields(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMediaFieldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMediaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MediaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MediaTable, MediaPrimaryKey...),
+	)
+}
+func newMediaFieldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MediaFieldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MediaFieldsTable, MediaFieldsColumn),
+	)
+}
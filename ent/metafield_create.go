@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MetaFieldCreate is the builder for creating a MetaField entity.
+type MetaFieldCreate struct {
+	config
+	mutation *MetaFieldMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (mfc *MetaFieldCreate) SetName(s string) *MetaFieldCreate {
+	mfc.mutation.SetName(s)
+	return mfc
+}
+
+// SetType sets the "type" field.
+func (mfc *MetaFieldCreate) SetType(m metafield.Type) *MetaFieldCreate {
+	mfc.mutation.SetType(m)
+	return mfc
+}
+
+// SetValues sets the "values" field.
+func (mfc *MetaFieldCreate) SetValues(s []string) *MetaFieldCreate {
+	mfc.mutation.SetValues(s)
+	return mfc
+}
+
+// SetDescription sets the "description" field.
+func (mfc *MetaFieldCreate) SetDescription(s string) *MetaFieldCreate {
+	mfc.mutation.SetDescription(s)
+	return mfc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mfc *MetaFieldCreate) SetNillableDescription(s *string) *MetaFieldCreate {
+	if s != nil {
+		mfc.SetDescription(*s)
+	}
+	return mfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mfc *MetaFieldCreate) SetCreatedAt(t time.Time) *MetaFieldCreate {
+	mfc.mutation.SetCreatedAt(t)
+	return mfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mfc *MetaFieldCreate) SetNillableCreatedAt(t *time.Time) *MetaFieldCreate {
+	if t != nil {
+		mfc.SetCreatedAt(*t)
+	}
+	return mfc
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by IDs.
+func (mfc *MetaFieldCreate) AddMediumIDs(ids ...string) *MetaFieldCreate {
+	mfc.mutation.AddMediumIDs(ids...)
+	return mfc
+}
+
+// AddMedia adds the "media" edges to the Media entity.
+func (mfc *MetaFieldCreate) AddMedia(m ...*Media) *MetaFieldCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfc.AddMediumIDs(ids...)
+}
+
+// AddMediaFieldIDs adds the "media_fields" edge to the MediaField entity by IDs.
+func (mfc *MetaFieldCreate) AddMediaFieldIDs(ids ...int) *MetaFieldCreate {
+	mfc.mutation.AddMediaFieldIDs(ids...)
+	return mfc
+}
+
+// AddMediaFields adds the "media_fields" edges to the MediaField entity.
+func (mfc *MetaFieldCreate) AddMediaFields(m ...*MediaField) *MetaFieldCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfc.AddMediaFieldIDs(ids...)
+}
+
+// Mutation returns the MetaFieldMutation object of the builder.
+func (mfc *MetaFieldCreate) Mutation() *MetaFieldMutation {
+	return mfc.mutation
+}
+
+// Save creates the MetaField in the database.
+func (mfc *MetaFieldCreate) Save(ctx context.Context) (*MetaField, error) {
+	mfc.defaults()
+	return withHooks(ctx, mfc.sqlSave, mfc.mutation, mfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mfc *MetaFieldCreate) SaveX(ctx context.Context) *MetaField {
+	v, err := mfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfc *MetaFieldCreate) Exec(ctx context.Context) error {
+	_, err := mfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfc *MetaFieldCreate) ExecX(ctx context.Context) {
+	if err := mfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mfc *MetaFieldCreate) defaults() {
+	if _, ok := mfc.mutation.Description(); !ok {
+		v := metafield.DefaultDescription
+		mfc.mutation.SetDescription(v)
+	}
+	if _, ok := mfc.mutation.CreatedAt(); !ok {
+		v := metafield.DefaultCreatedAt()
+		mfc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfc *MetaFieldCreate) check() error {
+	if _, ok := mfc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MetaField.name"`)}
+	}
+	if v, ok := mfc.mutation.Name(); ok {
+		if err := metafield.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MetaField.name": %w`, err)}
+		}
+	}
+	if _, ok := mfc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "MetaField.type"`)}
+	}
+	if v, ok := mfc.mutation.GetType(); ok {
+		if err := metafield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MetaField.type": %w`, err)}
+		}
+	}
+	if _, ok := mfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MetaField.created_at"`)}
+	}
+	return nil
+}
+
+func (mfc *MetaFieldCreate) sqlSave(ctx context.Context) (*MetaField, error) {
+	if err := mfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mfc.mutation.id = &_node.ID
+	mfc.mutation.done = true
+	return _node, nil
+}
+
+func (mfc *MetaFieldCreate) createSpec() (*MetaField, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MetaField{config: mfc.config}
+		_spec = sqlgraph.NewCreateSpec(metafield.Table, sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt))
+	)
+	if value, ok := mfc.mutation.Name(); ok {
+		_spec.SetField(metafield.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mfc.mutation.GetType(); ok {
+		_spec.SetField(metafield.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := mfc.mutation.Values(); ok {
+		_spec.SetField(metafield.FieldValues, field.TypeJSON, value)
+		_node.Values = value
+	}
+	if value, ok := mfc.mutation.Description(); ok {
+		_spec.SetField(metafield.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := mfc.mutation.CreatedAt(); ok {
+		_spec.SetField(metafield.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mfc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   metafield.MediaTable,
+			Columns: metafield.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mfc.mutation.MediaFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   metafield.MediaFieldsTable,
+			Columns: []string{metafield.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MetaFieldCreateBulk is the builder for creating many MetaField entities in bulk.
+type MetaFieldCreateBulk struct {
+	config
+	err      error
+	builders []*MetaFieldCreate
+}
+
+// Save creates the MetaField entities in the database.
+func (mfcb *MetaFieldCreateBulk) Save(ctx context.Context) ([]*MetaField, error) {
+	if mfcb.err != nil {
+		return nil, mfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mfcb.builders))
+	nodes := make([]*MetaField, len(mfcb.builders))
+	mutators := make([]Mutator, len(mfcb.builders))
+	for i := range mfcb.builders {
+		func(i int, root context.Context) {
+			builder := mfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MetaFieldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfcb *MetaFieldCreateBulk) SaveX(ctx context.Context) []*MetaField {
+	v, err := mfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfcb *MetaFieldCreateBulk) Exec(ctx context.Context) error {
+	_, err := mfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfcb *MetaFieldCreateBulk) ExecX(ctx context.Context) {
+	if err := mfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
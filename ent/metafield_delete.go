@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/metafield"
+	"era/booru/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MetaFieldDelete is the builder for deleting a MetaField entity.
+type MetaFieldDelete struct {
+	config
+	hooks    []Hook
+	mutation *MetaFieldMutation
+}
+
+// Where appends a list predicates to the MetaFieldDelete builder.
+func (mfd *MetaFieldDelete) Where(ps ...predicate.MetaField) *MetaFieldDelete {
+	mfd.mutation.Where(ps...)
+	return mfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mfd *MetaFieldDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfd.sqlExec, mfd.mutation, mfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfd *MetaFieldDelete) ExecX(ctx context.Context) int {
+	n, err := mfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mfd *MetaFieldDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(metafield.Table, sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt))
+	if ps := mfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mfd.mutation.done = true
+	return affected, err
+}
+
+// MetaFieldDeleteOne is the builder for deleting a single MetaField entity.
+type MetaFieldDeleteOne struct {
+	mfd *MetaFieldDelete
+}
+
+// Where appends a list predicates to the MetaFieldDelete builder.
+func (mfdo *MetaFieldDeleteOne) Where(ps ...predicate.MetaField) *MetaFieldDeleteOne {
+	mfdo.mfd.mutation.Where(ps...)
+	return mfdo
+}
+
+// Exec executes the deletion query.
+func (mfdo *MetaFieldDeleteOne) Exec(ctx context.Context) error {
+	n, err := mfdo.mfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{metafield.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfdo *MetaFieldDeleteOne) ExecX(ctx context.Context) {
+	if err := mfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
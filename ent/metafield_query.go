@@ -0,0 +1,711 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"era/booru/ent/media"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+	"era/booru/ent/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MetaFieldQuery is the builder for querying MetaField entities.
+type MetaFieldQuery struct {
+	config
+	ctx             *QueryContext
+	order           []metafield.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.MetaField
+	withMedia       *MediaQuery
+	withMediaFields *MediaFieldQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MetaFieldQuery builder.
+func (mfq *MetaFieldQuery) Where(ps ...predicate.MetaField) *MetaFieldQuery {
+	mfq.predicates = append(mfq.predicates, ps...)
+	return mfq
+}
+
+// Limit the number of records to be returned by this query.
+func (mfq *MetaFieldQuery) Limit(limit int) *MetaFieldQuery {
+	mfq.ctx.Limit = &limit
+	return mfq
+}
+
+// Offset to start from.
+func (mfq *MetaFieldQuery) Offset(offset int) *MetaFieldQuery {
+	mfq.ctx.Offset = &offset
+	return mfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mfq *MetaFieldQuery) Unique(unique bool) *MetaFieldQuery {
+	mfq.ctx.Unique = &unique
+	return mfq
+}
+
+// Order specifies how the records should be ordered.
+func (mfq *MetaFieldQuery) Order(o ...metafield.OrderOption) *MetaFieldQuery {
+	mfq.order = append(mfq.order, o...)
+	return mfq
+}
+
+// QueryMedia chains the current query on the "media" edge.
+func (mfq *MetaFieldQuery) QueryMedia() *MediaQuery {
+	query := (&MediaClient{config: mfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(metafield.Table, metafield.FieldID, selector),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, metafield.MediaTable, metafield.MediaPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(mfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMediaFields chains the current query on the "media_fields" edge.
+func (mfq *MetaFieldQuery) QueryMediaFields() *MediaFieldQuery {
+	query := (&MediaFieldClient{config: mfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(metafield.Table, metafield.FieldID, selector),
+			sqlgraph.To(mediafield.Table, mediafield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, metafield.MediaFieldsTable, metafield.MediaFieldsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MetaField entity from the query.
+// Returns a *NotFoundError when no MetaField was found.
+func (mfq *MetaFieldQuery) First(ctx context.Context) (*MetaField, error) {
+	nodes, err := mfq.Limit(1).All(setContextOp(ctx, mfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{metafield.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mfq *MetaFieldQuery) FirstX(ctx context.Context) *MetaField {
+	node, err := mfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MetaField ID from the query.
+// Returns a *NotFoundError when no MetaField ID was found.
+func (mfq *MetaFieldQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfq.Limit(1).IDs(setContextOp(ctx, mfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{metafield.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mfq *MetaFieldQuery) FirstIDX(ctx context.Context) int {
+	id, err := mfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MetaField entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MetaField entity is found.
+// Returns a *NotFoundError when no MetaField entities are found.
+func (mfq *MetaFieldQuery) Only(ctx context.Context) (*MetaField, error) {
+	nodes, err := mfq.Limit(2).All(setContextOp(ctx, mfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{metafield.Label}
+	default:
+		return nil, &NotSingularError{metafield.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mfq *MetaFieldQuery) OnlyX(ctx context.Context) *MetaField {
+	node, err := mfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MetaField ID in the query.
+// Returns a *NotSingularError when more than one MetaField ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mfq *MetaFieldQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mfq.Limit(2).IDs(setContextOp(ctx, mfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{metafield.Label}
+	default:
+		err = &NotSingularError{metafield.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mfq *MetaFieldQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MetaFields.
+func (mfq *MetaFieldQuery) All(ctx context.Context) ([]*MetaField, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryAll)
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MetaField, *MetaFieldQuery]()
+	return withInterceptors[[]*MetaField](ctx, mfq, qr, mfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mfq *MetaFieldQuery) AllX(ctx context.Context) []*MetaField {
+	nodes, err := mfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MetaField IDs.
+func (mfq *MetaFieldQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mfq.ctx.Unique == nil && mfq.path != nil {
+		mfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryIDs)
+	if err = mfq.Select(metafield.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mfq *MetaFieldQuery) IDsX(ctx context.Context) []int {
+	ids, err := mfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mfq *MetaFieldQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryCount)
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mfq, querierCount[*MetaFieldQuery](), mfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mfq *MetaFieldQuery) CountX(ctx context.Context) int {
+	count, err := mfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mfq *MetaFieldQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryExist)
+	switch _, err := mfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mfq *MetaFieldQuery) ExistX(ctx context.Context) bool {
+	exist, err := mfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MetaFieldQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mfq *MetaFieldQuery) Clone() *MetaFieldQuery {
+	if mfq == nil {
+		return nil
+	}
+	return &MetaFieldQuery{
+		config:          mfq.config,
+		ctx:             mfq.ctx.Clone(),
+		order:           append([]metafield.OrderOption{}, mfq.order...),
+		inters:          append([]Interceptor{}, mfq.inters...),
+		predicates:      append([]predicate.MetaField{}, mfq.predicates...),
+		withMedia:       mfq.withMedia.Clone(),
+		withMediaFields: mfq.withMediaFields.Clone(),
+		// clone intermediate query.
+		sql:  mfq.sql.Clone(),
+		path: mfq.path,
+	}
+}
+
+// WithMedia tells the query-builder to eager-load the nodes that are connected to
+// the "media" edge. The optional arguments are used to configure the query builder of the edge.
+func (mfq *MetaFieldQuery) WithMedia(opts ...func(*MediaQuery)) *MetaFieldQuery {
+	query := (&MediaClient{config: mfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mfq.withMedia = query
+	return mfq
+}
+
+// WithMediaFields tells the query-builder to eager-load the nodes that are connected to
+// the "media_fields" edge. The optional arguments are used to configure the query builder of the edge.
+func (mfq *MetaFieldQuery) WithMediaFields(opts ...func(*MediaFieldQuery)) *MetaFieldQuery {
+	query := (&MediaFieldClient{config: mfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mfq.withMediaFields = query
+	return mfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MetaField.Query().
+//		GroupBy(metafield.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mfq *MetaFieldQuery) GroupBy(field string, fields ...string) *MetaFieldGroupBy {
+	mfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MetaFieldGroupBy{build: mfq}
+	grbuild.flds = &mfq.ctx.Fields
+	grbuild.label = metafield.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.MetaField.Query().
+//		Select(metafield.FieldName).
+//		Scan(ctx, &v)
+func (mfq *MetaFieldQuery) Select(fields ...string) *MetaFieldSelect {
+	mfq.ctx.Fields = append(mfq.ctx.Fields, fields...)
+	sbuild := &MetaFieldSelect{MetaFieldQuery: mfq}
+	sbuild.label = metafield.Label
+	sbuild.flds, sbuild.scan = &mfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MetaFieldSelect configured with the given aggregations.
+func (mfq *MetaFieldQuery) Aggregate(fns ...AggregateFunc) *MetaFieldSelect {
+	return mfq.Select().Aggregate(fns...)
+}
+
+func (mfq *MetaFieldQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mfq.ctx.Fields {
+		if !metafield.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mfq.path != nil {
+		prev, err := mfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mfq.sql = prev
+	}
+	return nil
+}
+
+func (mfq *MetaFieldQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MetaField, error) {
+	var (
+		nodes       = []*MetaField{}
+		_spec       = mfq.querySpec()
+		loadedTypes = [2]bool{
+			mfq.withMedia != nil,
+			mfq.withMediaFields != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MetaField).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MetaField{config: mfq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mfq.withMedia; query != nil {
+		if err := mfq.loadMedia(ctx, query, nodes,
+			func(n *MetaField) { n.Edges.Media = []*Media{} },
+			func(n *MetaField, e *Media) { n.Edges.Media = append(n.Edges.Media, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mfq.withMediaFields; query != nil {
+		if err := mfq.loadMediaFields(ctx, query, nodes,
+			func(n *MetaField) { n.Edges.MediaFields = []*MediaField{} },
+			func(n *MetaField, e *MediaField) { n.Edges.MediaFields = append(n.Edges.MediaFields, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mfq *MetaFieldQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes []*MetaField, init func(*MetaField), assign func(*MetaField, *Media)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*MetaField)
+	nids := make(map[string]map[*MetaField]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(metafield.MediaTable)
+		s.Join(joinT).On(s.C(media.FieldID), joinT.C(metafield.MediaPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(metafield.MediaPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(metafield.MediaPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*MetaField]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Media](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "media" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (mfq *MetaFieldQuery) loadMediaFields(ctx context.Context, query *MediaFieldQuery, nodes []*MetaField, init func(*MetaField), assign func(*MetaField, *MediaField)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*MetaField)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(mediafield.FieldMetaFieldID)
+	}
+	query.Where(predicate.MediaField(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(metafield.MediaFieldsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MetaFieldID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "meta_field_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (mfq *MetaFieldQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mfq.querySpec()
+	_spec.Node.Columns = mfq.ctx.Fields
+	if len(mfq.ctx.Fields) > 0 {
+		_spec.Unique = mfq.ctx.Unique != nil && *mfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mfq.driver, _spec)
+}
+
+func (mfq *MetaFieldQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(metafield.Table, metafield.Columns, sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt))
+	_spec.From = mfq.sql
+	if unique := mfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metafield.FieldID)
+		for i := range fields {
+			if fields[i] != metafield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mfq *MetaFieldQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mfq.driver.Dialect())
+	t1 := builder.Table(metafield.Table)
+	columns := mfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = metafield.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mfq.sql != nil {
+		selector = mfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mfq.ctx.Unique != nil && *mfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mfq.predicates {
+		p(selector)
+	}
+	for _, p := range mfq.order {
+		p(selector)
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MetaFieldGroupBy is the group-by builder for MetaField entities.
+type MetaFieldGroupBy struct {
+	selector
+	build *MetaFieldQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mfgb *MetaFieldGroupBy) Aggregate(fns ...AggregateFunc) *MetaFieldGroupBy {
+	mfgb.fns = append(mfgb.fns, fns...)
+	return mfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfgb *MetaFieldGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MetaFieldQuery, *MetaFieldGroupBy](ctx, mfgb.build, mfgb, mfgb.build.inters, v)
+}
+
+func (mfgb *MetaFieldGroupBy) sqlScan(ctx context.Context, root *MetaFieldQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mfgb.fns))
+	for _, fn := range mfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mfgb.flds)+len(mfgb.fns))
+		for _, f := range *mfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MetaFieldSelect is the builder for selecting fields of MetaField entities.
+type MetaFieldSelect struct {
+	*MetaFieldQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mfs *MetaFieldSelect) Aggregate(fns ...AggregateFunc) *MetaFieldSelect {
+	mfs.fns = append(mfs.fns, fns...)
+	return mfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfs *MetaFieldSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfs.ctx, ent.OpQuerySelect)
+	if err := mfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MetaFieldQuery, *MetaFieldSelect](ctx, mfs.MetaFieldQuery, mfs, mfs.inters, v)
+}
+
+func (mfs *MetaFieldSelect) sqlScan(ctx context.Context, root *MetaFieldQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mfs.fns))
+	for _, fn := range mfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,612 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+	"era/booru/ent/predicate"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MetaFieldUpdate is the builder for updating MetaField entities.
+type MetaFieldUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MetaFieldMutation
+}
+
+// Where appends a list predicates to the MetaFieldUpdate builder.
+func (mfu *MetaFieldUpdate) Where(ps ...predicate.MetaField) *MetaFieldUpdate {
+	mfu.mutation.Where(ps...)
+	return mfu
+}
+
+// SetValues sets the "values" field.
+func (mfu *MetaFieldUpdate) SetValues(s []string) *MetaFieldUpdate {
+	mfu.mutation.SetValues(s)
+	return mfu
+}
+
+// AppendValues appends s to the "values" field.
+func (mfu *MetaFieldUpdate) AppendValues(s []string) *MetaFieldUpdate {
+	mfu.mutation.AppendValues(s)
+	return mfu
+}
+
+// ClearValues clears the value of the "values" field.
+func (mfu *MetaFieldUpdate) ClearValues() *MetaFieldUpdate {
+	mfu.mutation.ClearValues()
+	return mfu
+}
+
+// SetDescription sets the "description" field.
+func (mfu *MetaFieldUpdate) SetDescription(s string) *MetaFieldUpdate {
+	mfu.mutation.SetDescription(s)
+	return mfu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mfu *MetaFieldUpdate) SetNillableDescription(s *string) *MetaFieldUpdate {
+	if s != nil {
+		mfu.SetDescription(*s)
+	}
+	return mfu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (mfu *MetaFieldUpdate) ClearDescription() *MetaFieldUpdate {
+	mfu.mutation.ClearDescription()
+	return mfu
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by IDs.
+func (mfu *MetaFieldUpdate) AddMediumIDs(ids ...string) *MetaFieldUpdate {
+	mfu.mutation.AddMediumIDs(ids...)
+	return mfu
+}
+
+// AddMedia adds the "media" edges to the Media entity.
+func (mfu *MetaFieldUpdate) AddMedia(m ...*Media) *MetaFieldUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfu.AddMediumIDs(ids...)
+}
+
+// AddMediaFieldIDs adds the "media_fields" edge to the MediaField entity by IDs.
+func (mfu *MetaFieldUpdate) AddMediaFieldIDs(ids ...int) *MetaFieldUpdate {
+	mfu.mutation.AddMediaFieldIDs(ids...)
+	return mfu
+}
+
+// AddMediaFields adds the "media_fields" edges to the MediaField entity.
+func (mfu *MetaFieldUpdate) AddMediaFields(m ...*MediaField) *MetaFieldUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfu.AddMediaFieldIDs(ids...)
+}
+
+// Mutation returns the MetaFieldMutation object of the builder.
+func (mfu *MetaFieldUpdate) Mutation() *MetaFieldMutation {
+	return mfu.mutation
+}
+
+// ClearMedia clears all "media" edges to the Media entity.
+func (mfu *MetaFieldUpdate) ClearMedia() *MetaFieldUpdate {
+	mfu.mutation.ClearMedia()
+	return mfu
+}
+
+// RemoveMediumIDs removes the "media" edge to Media entities by IDs.
+func (mfu *MetaFieldUpdate) RemoveMediumIDs(ids ...string) *MetaFieldUpdate {
+	mfu.mutation.RemoveMediumIDs(ids...)
+	return mfu
+}
+
+// RemoveMedia removes "media" edges to Media entities.
+func (mfu *MetaFieldUpdate) RemoveMedia(m ...*Media) *MetaFieldUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfu.RemoveMediumIDs(ids...)
+}
+
+// ClearMediaFields clears all "media_fields" edges to the MediaField entity.
+func (mfu *MetaFieldUpdate) ClearMediaFields() *MetaFieldUpdate {
+	mfu.mutation.ClearMediaFields()
+	return mfu
+}
+
+// RemoveMediaFieldIDs removes the "media_fields" edge to MediaField entities by IDs.
+func (mfu *MetaFieldUpdate) RemoveMediaFieldIDs(ids ...int) *MetaFieldUpdate {
+	mfu.mutation.RemoveMediaFieldIDs(ids...)
+	return mfu
+}
+
+// RemoveMediaFields removes "media_fields" edges to MediaField entities.
+func (mfu *MetaFieldUpdate) RemoveMediaFields(m ...*MediaField) *MetaFieldUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfu.RemoveMediaFieldIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mfu *MetaFieldUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfu.sqlSave, mfu.mutation, mfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfu *MetaFieldUpdate) SaveX(ctx context.Context) int {
+	affected, err := mfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mfu *MetaFieldUpdate) Exec(ctx context.Context) error {
+	_, err := mfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfu *MetaFieldUpdate) ExecX(ctx context.Context) {
+	if err := mfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mfu *MetaFieldUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(metafield.Table, metafield.Columns, sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt))
+	if ps := mfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfu.mutation.Values(); ok {
+		_spec.SetField(metafield.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := mfu.mutation.AppendedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, metafield.FieldValues, value)
+		})
+	}
+	if mfu.mutation.ValuesCleared() {
+		_spec.ClearField(metafield.FieldValues, field.TypeJSON)
+	}
+	if value, ok := mfu.mutation.Description(); ok {
+		_spec.SetField(metafield.FieldDescription, field.TypeString, value)
+	}
+	if mfu.mutation.DescriptionCleared() {
+		_spec.ClearField(metafield.FieldDescription, field.TypeString)
+	}
+	if mfu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   metafield.MediaTable,
+			Columns: metafield.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfu.mutation.RemovedMediaIDs(); len(nodes) > 0 && !mfu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   metafield.MediaTable,
+			Columns: metafield.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfu.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   metafield.MediaTable,
+			Columns: metafield.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mfu.mutation.MediaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   metafield.MediaFieldsTable,
+			Columns: []string{metafield.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfu.mutation.RemovedMediaFieldsIDs(); len(nodes) > 0 && !mfu.mutation.MediaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   metafield.MediaFieldsTable,
+			Columns: []string{metafield.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfu.mutation.MediaFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   metafield.MediaFieldsTable,
+			Columns: []string{metafield.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metafield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mfu.mutation.done = true
+	return n, nil
+}
+
+// MetaFieldUpdateOne is the builder for updating a single MetaField entity.
+type MetaFieldUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MetaFieldMutation
+}
+
+// SetValues sets the "values" field.
+func (mfuo *MetaFieldUpdateOne) SetValues(s []string) *MetaFieldUpdateOne {
+	mfuo.mutation.SetValues(s)
+	return mfuo
+}
+
+// AppendValues appends s to the "values" field.
+func (mfuo *MetaFieldUpdateOne) AppendValues(s []string) *MetaFieldUpdateOne {
+	mfuo.mutation.AppendValues(s)
+	return mfuo
+}
+
+// ClearValues clears the value of the "values" field.
+func (mfuo *MetaFieldUpdateOne) ClearValues() *MetaFieldUpdateOne {
+	mfuo.mutation.ClearValues()
+	return mfuo
+}
+
+// SetDescription sets the "description" field.
+func (mfuo *MetaFieldUpdateOne) SetDescription(s string) *MetaFieldUpdateOne {
+	mfuo.mutation.SetDescription(s)
+	return mfuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (mfuo *MetaFieldUpdateOne) SetNillableDescription(s *string) *MetaFieldUpdateOne {
+	if s != nil {
+		mfuo.SetDescription(*s)
+	}
+	return mfuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (mfuo *MetaFieldUpdateOne) ClearDescription() *MetaFieldUpdateOne {
+	mfuo.mutation.ClearDescription()
+	return mfuo
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by IDs.
+func (mfuo *MetaFieldUpdateOne) AddMediumIDs(ids ...string) *MetaFieldUpdateOne {
+	mfuo.mutation.AddMediumIDs(ids...)
+	return mfuo
+}
+
+// AddMedia adds the "media" edges to the Media entity.
+func (mfuo *MetaFieldUpdateOne) AddMedia(m ...*Media) *MetaFieldUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfuo.AddMediumIDs(ids...)
+}
+
+// AddMediaFieldIDs adds the "media_fields" edge to the MediaField entity by IDs.
+func (mfuo *MetaFieldUpdateOne) AddMediaFieldIDs(ids ...int) *MetaFieldUpdateOne {
+	mfuo.mutation.AddMediaFieldIDs(ids...)
+	return mfuo
+}
+
+// AddMediaFields adds the "media_fields" edges to the MediaField entity.
+func (mfuo *MetaFieldUpdateOne) AddMediaFields(m ...*MediaField) *MetaFieldUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfuo.AddMediaFieldIDs(ids...)
+}
+
+// Mutation returns the MetaFieldMutation object of the builder.
+func (mfuo *MetaFieldUpdateOne) Mutation() *MetaFieldMutation {
+	return mfuo.mutation
+}
+
+// ClearMedia clears all "media" edges to the Media entity.
+func (mfuo *MetaFieldUpdateOne) ClearMedia() *MetaFieldUpdateOne {
+	mfuo.mutation.ClearMedia()
+	return mfuo
+}
+
+// RemoveMediumIDs removes the "media" edge to Media entities by IDs.
+func (mfuo *MetaFieldUpdateOne) RemoveMediumIDs(ids ...string) *MetaFieldUpdateOne {
+	mfuo.mutation.RemoveMediumIDs(ids...)
+	return mfuo
+}
+
+// RemoveMedia removes "media" edges to Media entities.
+func (mfuo *MetaFieldUpdateOne) RemoveMedia(m ...*Media) *MetaFieldUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfuo.RemoveMediumIDs(ids...)
+}
+
+// ClearMediaFields clears all "media_fields" edges to the MediaField entity.
+func (mfuo *MetaFieldUpdateOne) ClearMediaFields() *MetaFieldUpdateOne {
+	mfuo.mutation.ClearMediaFields()
+	return mfuo
+}
+
+// RemoveMediaFieldIDs removes the "media_fields" edge to MediaField entities by IDs.
+func (mfuo *MetaFieldUpdateOne) RemoveMediaFieldIDs(ids ...int) *MetaFieldUpdateOne {
+	mfuo.mutation.RemoveMediaFieldIDs(ids...)
+	return mfuo
+}
+
+// RemoveMediaFields removes "media_fields" edges to MediaField entities.
+func (mfuo *MetaFieldUpdateOne) RemoveMediaFields(m ...*MediaField) *MetaFieldUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mfuo.RemoveMediaFieldIDs(ids...)
+}
+
+// Where appends a list predicates to the MetaFieldUpdate builder.
+func (mfuo *MetaFieldUpdateOne) Where(ps ...predicate.MetaField) *MetaFieldUpdateOne {
+	mfuo.mutation.Where(ps...)
+	return mfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mfuo *MetaFieldUpdateOne) Select(field string, fields ...string) *MetaFieldUpdateOne {
+	mfuo.fields = append([]string{field}, fields...)
+	return mfuo
+}
+
+// Save executes the query and returns the updated MetaField entity.
+func (mfuo *MetaFieldUpdateOne) Save(ctx context.Context) (*MetaField, error) {
+	return withHooks(ctx, mfuo.sqlSave, mfuo.mutation, mfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfuo *MetaFieldUpdateOne) SaveX(ctx context.Context) *MetaField {
+	node, err := mfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mfuo *MetaFieldUpdateOne) Exec(ctx context.Context) error {
+	_, err := mfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfuo *MetaFieldUpdateOne) ExecX(ctx context.Context) {
+	if err := mfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mfuo *MetaFieldUpdateOne) sqlSave(ctx context.Context) (_node *MetaField, err error) {
+	_spec := sqlgraph.NewUpdateSpec(metafield.Table, metafield.Columns, sqlgraph.NewFieldSpec(metafield.FieldID, field.TypeInt))
+	id, ok := mfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MetaField.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metafield.FieldID)
+		for _, f := range fields {
+			if !metafield.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != metafield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfuo.mutation.Values(); ok {
+		_spec.SetField(metafield.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := mfuo.mutation.AppendedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, metafield.FieldValues, value)
+		})
+	}
+	if mfuo.mutation.ValuesCleared() {
+		_spec.ClearField(metafield.FieldValues, field.TypeJSON)
+	}
+	if value, ok := mfuo.mutation.Description(); ok {
+		_spec.SetField(metafield.FieldDescription, field.TypeString, value)
+	}
+	if mfuo.mutation.DescriptionCleared() {
+		_spec.ClearField(metafield.FieldDescription, field.TypeString)
+	}
+	if mfuo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   metafield.MediaTable,
+			Columns: metafield.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfuo.mutation.RemovedMediaIDs(); len(nodes) > 0 && !mfuo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   metafield.MediaTable,
+			Columns: metafield.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfuo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   metafield.MediaTable,
+			Columns: metafield.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mfuo.mutation.MediaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   metafield.MediaFieldsTable,
+			Columns: []string{metafield.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfuo.mutation.RemovedMediaFieldsIDs(); len(nodes) > 0 && !mfuo.mutation.MediaFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   metafield.MediaFieldsTable,
+			Columns: []string{metafield.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mfuo.mutation.MediaFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   metafield.MediaFieldsTable,
+			Columns: []string{metafield.MediaFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediafield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MetaField{config: mfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metafield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mfuo.mutation.done = true
+	return _node, nil
+}
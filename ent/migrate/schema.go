@@ -8,6 +8,98 @@ import (
 )
 
 var (
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "method", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString},
+		{Name: "params", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "result", Type: field.TypeJSON, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt64},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_action_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_actor_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[1]},
+			},
+		},
+	}
+	// AutoTagsColumns holds the columns for the "auto_tags" table.
+	AutoTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "prompt", Type: field.TypeString, Size: 2147483647},
+		{Name: "threshold", Type: field.TypeFloat64},
+		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector"}},
+		{Name: "model", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// AutoTagsTable holds the schema information for the "auto_tags" table.
+	AutoTagsTable = &schema.Table{
+		Name:       "auto_tags",
+		Columns:    AutoTagsColumns,
+		PrimaryKey: []*schema.Column{AutoTagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "auto_tags_tags_tag",
+				Columns:    []*schema.Column{AutoTagsColumns[7]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "author", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeString},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_media_media",
+				Columns:    []*schema.Column{CommentsColumns[5]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_media_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[5], CommentsColumns[3]},
+			},
+		},
+	}
 	// DatesColumns holds the columns for the "dates" table.
 	DatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -19,6 +111,39 @@ var (
 		Columns:    DatesColumns,
 		PrimaryKey: []*schema.Column{DatesColumns[0]},
 	}
+	// FavoritesColumns holds the columns for the "favorites" table.
+	FavoritesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeString},
+	}
+	// FavoritesTable holds the schema information for the "favorites" table.
+	FavoritesTable = &schema.Table{
+		Name:       "favorites",
+		Columns:    FavoritesColumns,
+		PrimaryKey: []*schema.Column{FavoritesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "favorites_media_media",
+				Columns:    []*schema.Column{FavoritesColumns[3]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "favorite_actor_media_id",
+				Unique:  true,
+				Columns: []*schema.Column{FavoritesColumns[1], FavoritesColumns[3]},
+			},
+			{
+				Name:    "favorite_media_id",
+				Unique:  false,
+				Columns: []*schema.Column{FavoritesColumns[3]},
+			},
+		},
+	}
 	// HiddenTagFiltersColumns holds the columns for the "hidden_tag_filters" table.
 	HiddenTagFiltersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -38,13 +163,44 @@ var (
 		{Name: "format", Type: field.TypeString},
 		{Name: "width", Type: field.TypeInt16},
 		{Name: "height", Type: field.TypeInt16},
-		{Name: "duration", Type: field.TypeInt16, Nullable: true},
+		{Name: "duration", Type: field.TypeInt32, Nullable: true},
+		{Name: "frames", Type: field.TypeInt32, Nullable: true},
+		{Name: "bitrate", Type: field.TypeInt, Nullable: true},
+		{Name: "video_codec", Type: field.TypeString, Nullable: true},
+		{Name: "audio_codec", Type: field.TypeString, Nullable: true},
+		{Name: "fps", Type: field.TypeFloat64, Nullable: true},
+		{Name: "has_audio", Type: field.TypeBool, Nullable: true},
+		{Name: "rotation", Type: field.TypeInt16, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 0},
+		{Name: "rating", Type: field.TypeEnum, Nullable: true, Enums: []string{"safe", "questionable", "explicit"}},
+		{Name: "score", Type: field.TypeInt, Default: 0},
+		{Name: "fav_count", Type: field.TypeInt, Default: 0},
+		{Name: "title", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(300)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// MediaTable holds the schema information for the "media" table.
 	MediaTable = &schema.Table{
 		Name:       "media",
 		Columns:    MediaColumns,
 		PrimaryKey: []*schema.Column{MediaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "media_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{MediaColumns[18]},
+			},
+			{
+				Name:    "media_score",
+				Unique:  false,
+				Columns: []*schema.Column{MediaColumns[14]},
+			},
+			{
+				Name:    "media_fav_count",
+				Unique:  false,
+				Columns: []*schema.Column{MediaColumns[15]},
+			},
+		},
 	}
 	// MediaDatesColumns holds the columns for the "media_dates" table.
 	MediaDatesColumns = []*schema.Column{
@@ -80,6 +236,74 @@ var (
 			},
 		},
 	}
+	// MediaFieldsColumns holds the columns for the "media_fields" table.
+	MediaFieldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "value", Type: field.TypeJSON},
+		{Name: "media_id", Type: field.TypeString},
+		{Name: "meta_field_id", Type: field.TypeInt},
+	}
+	// MediaFieldsTable holds the schema information for the "media_fields" table.
+	MediaFieldsTable = &schema.Table{
+		Name:       "media_fields",
+		Columns:    MediaFieldsColumns,
+		PrimaryKey: []*schema.Column{MediaFieldsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "media_fields_media_media",
+				Columns:    []*schema.Column{MediaFieldsColumns[2]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "media_fields_meta_fields_meta_field",
+				Columns:    []*schema.Column{MediaFieldsColumns[3]},
+				RefColumns: []*schema.Column{MetaFieldsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mediafield_media_id_meta_field_id",
+				Unique:  true,
+				Columns: []*schema.Column{MediaFieldsColumns[2], MediaFieldsColumns[3]},
+			},
+		},
+	}
+	// MediaRevisionsColumns holds the columns for the "media_revisions" table.
+	MediaRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "tags_before", Type: field.TypeJSON},
+		{Name: "tags_after", Type: field.TypeJSON},
+		{Name: "dates_before", Type: field.TypeJSON},
+		{Name: "dates_after", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeString},
+	}
+	// MediaRevisionsTable holds the schema information for the "media_revisions" table.
+	MediaRevisionsTable = &schema.Table{
+		Name:       "media_revisions",
+		Columns:    MediaRevisionsColumns,
+		PrimaryKey: []*schema.Column{MediaRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "media_revisions_media_media",
+				Columns:    []*schema.Column{MediaRevisionsColumns[9]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mediarevision_media_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MediaRevisionsColumns[9], MediaRevisionsColumns[8]},
+			},
+		},
+	}
 	// MediaVectorsColumns holds the columns for the "media_vectors" table.
 	MediaVectorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -114,6 +338,193 @@ var (
 			},
 		},
 	}
+	// MediaVotesColumns holds the columns for the "media_votes" table.
+	MediaVotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "value", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeString},
+	}
+	// MediaVotesTable holds the schema information for the "media_votes" table.
+	MediaVotesTable = &schema.Table{
+		Name:       "media_votes",
+		Columns:    MediaVotesColumns,
+		PrimaryKey: []*schema.Column{MediaVotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "media_votes_media_media",
+				Columns:    []*schema.Column{MediaVotesColumns[4]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mediavote_actor_media_id",
+				Unique:  true,
+				Columns: []*schema.Column{MediaVotesColumns[1], MediaVotesColumns[4]},
+			},
+			{
+				Name:    "mediavote_media_id",
+				Unique:  false,
+				Columns: []*schema.Column{MediaVotesColumns[4]},
+			},
+		},
+	}
+	// MetaFieldsColumns holds the columns for the "meta_fields" table.
+	MetaFieldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"int", "float", "string", "enum", "bool"}},
+		{Name: "values", Type: field.TypeJSON, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MetaFieldsTable holds the schema information for the "meta_fields" table.
+	MetaFieldsTable = &schema.Table{
+		Name:       "meta_fields",
+		Columns:    MetaFieldsColumns,
+		PrimaryKey: []*schema.Column{MetaFieldsColumns[0]},
+	}
+	// NotesColumns holds the columns for the "notes" table.
+	NotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"note", "box", "crop"}, Default: "note"},
+		{Name: "x", Type: field.TypeFloat64},
+		{Name: "y", Type: field.TypeFloat64},
+		{Name: "width", Type: field.TypeFloat64},
+		{Name: "height", Type: field.TypeFloat64},
+		{Name: "text", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "author", Type: field.TypeString},
+		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector"}},
+		{Name: "embedding_model", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeString},
+		{Name: "tag_id", Type: field.TypeInt, Nullable: true},
+	}
+	// NotesTable holds the schema information for the "notes" table.
+	NotesTable = &schema.Table{
+		Name:       "notes",
+		Columns:    NotesColumns,
+		PrimaryKey: []*schema.Column{NotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notes_media_media",
+				Columns:    []*schema.Column{NotesColumns[12]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notes_tags_tag",
+				Columns:    []*schema.Column{NotesColumns[13]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "note_media_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotesColumns[12]},
+			},
+		},
+	}
+	// PoolsColumns holds the columns for the "pools" table.
+	PoolsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "cover_id", Type: field.TypeString, Nullable: true},
+	}
+	// PoolsTable holds the schema information for the "pools" table.
+	PoolsTable = &schema.Table{
+		Name:       "pools",
+		Columns:    PoolsColumns,
+		PrimaryKey: []*schema.Column{PoolsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pools_media_cover",
+				Columns:    []*schema.Column{PoolsColumns[5]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PoolMediaColumns holds the columns for the "pool_media" table.
+	PoolMediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "pool_id", Type: field.TypeInt},
+		{Name: "media_id", Type: field.TypeString},
+	}
+	// PoolMediaTable holds the schema information for the "pool_media" table.
+	PoolMediaTable = &schema.Table{
+		Name:       "pool_media",
+		Columns:    PoolMediaColumns,
+		PrimaryKey: []*schema.Column{PoolMediaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pool_media_pools_pool",
+				Columns:    []*schema.Column{PoolMediaColumns[2]},
+				RefColumns: []*schema.Column{PoolsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "pool_media_media_media",
+				Columns:    []*schema.Column{PoolMediaColumns[3]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "poolmedia_pool_id_media_id",
+				Unique:  true,
+				Columns: []*schema.Column{PoolMediaColumns[2], PoolMediaColumns[3]},
+			},
+			{
+				Name:    "poolmedia_pool_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{PoolMediaColumns[2], PoolMediaColumns[1]},
+			},
+		},
+	}
+	// RenditionsColumns holds the columns for the "renditions" table.
+	RenditionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"mp4", "webm"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "ready", "failed"}, Default: "pending"},
+		{Name: "progress", Type: field.TypeFloat64, Default: 0},
+		{Name: "size", Type: field.TypeInt64, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeString},
+	}
+	// RenditionsTable holds the schema information for the "renditions" table.
+	RenditionsTable = &schema.Table{
+		Name:       "renditions",
+		Columns:    RenditionsColumns,
+		PrimaryKey: []*schema.Column{RenditionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "renditions_media_media",
+				Columns:    []*schema.Column{RenditionsColumns[7]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rendition_media_id_format",
+				Unique:  true,
+				Columns: []*schema.Column{RenditionsColumns[7], RenditionsColumns[1]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -127,6 +538,45 @@ var (
 		Columns:    SettingsColumns,
 		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
+	// SourcesColumns holds the columns for the "sources" table.
+	SourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "domain", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeString},
+	}
+	// SourcesTable holds the schema information for the "sources" table.
+	SourcesTable = &schema.Table{
+		Name:       "sources",
+		Columns:    SourcesColumns,
+		PrimaryKey: []*schema.Column{SourcesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sources_media_media",
+				Columns:    []*schema.Column{SourcesColumns[4]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "source_media_id_url",
+				Unique:  true,
+				Columns: []*schema.Column{SourcesColumns[4], SourcesColumns[1]},
+			},
+			{
+				Name:    "source_url",
+				Unique:  false,
+				Columns: []*schema.Column{SourcesColumns[1]},
+			},
+			{
+				Name:    "source_domain",
+				Unique:  false,
+				Columns: []*schema.Column{SourcesColumns[2]},
+			},
+		},
+	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -139,10 +589,92 @@ var (
 		Columns:    TagsColumns,
 		PrimaryKey: []*schema.Column{TagsColumns[0]},
 	}
+	// TagSuggestionsColumns holds the columns for the "tag_suggestions" table.
+	TagSuggestionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "score", Type: field.TypeFloat64},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"zero_shot"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "rejected"}, Default: "pending"},
+		{Name: "decided_by", Type: field.TypeString, Nullable: true},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeString},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// TagSuggestionsTable holds the schema information for the "tag_suggestions" table.
+	TagSuggestionsTable = &schema.Table{
+		Name:       "tag_suggestions",
+		Columns:    TagSuggestionsColumns,
+		PrimaryKey: []*schema.Column{TagSuggestionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_suggestions_media_media",
+				Columns:    []*schema.Column{TagSuggestionsColumns[7]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_suggestions_tags_tag",
+				Columns:    []*schema.Column{TagSuggestionsColumns[8]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tagsuggestion_media_id_tag_id",
+				Unique:  true,
+				Columns: []*schema.Column{TagSuggestionsColumns[7], TagSuggestionsColumns[8]},
+			},
+			{
+				Name:    "tagsuggestion_status_score",
+				Unique:  false,
+				Columns: []*schema.Column{TagSuggestionsColumns[3], TagSuggestionsColumns[1]},
+			},
+		},
+	}
+	// TagVectorsColumns holds the columns for the "tag_vectors" table.
+	TagVectorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "value", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "vector"}},
+		{Name: "media_count", Type: field.TypeInt},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "vector_id", Type: field.TypeInt},
+	}
+	// TagVectorsTable holds the schema information for the "tag_vectors" table.
+	TagVectorsTable = &schema.Table{
+		Name:       "tag_vectors",
+		Columns:    TagVectorsColumns,
+		PrimaryKey: []*schema.Column{TagVectorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_vectors_tags_tag",
+				Columns:    []*schema.Column{TagVectorsColumns[4]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_vectors_vectors_vector",
+				Columns:    []*schema.Column{TagVectorsColumns[5]},
+				RefColumns: []*schema.Column{VectorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tagvector_tag_id_vector_id",
+				Unique:  true,
+				Columns: []*schema.Column{TagVectorsColumns[4], TagVectorsColumns[5]},
+			},
+		},
+	}
 	// VectorsColumns holds the columns for the "vectors" table.
 	VectorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "model", Type: field.TypeString, Default: ""},
+		{Name: "dimension", Type: field.TypeInt, Default: 0},
 	}
 	// VectorsTable holds the schema information for the "vectors" table.
 	VectorsTable = &schema.Table{
@@ -177,23 +709,56 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditLogsTable,
+		AutoTagsTable,
+		CommentsTable,
 		DatesTable,
+		FavoritesTable,
 		HiddenTagFiltersTable,
 		MediaTable,
 		MediaDatesTable,
+		MediaFieldsTable,
+		MediaRevisionsTable,
 		MediaVectorsTable,
+		MediaVotesTable,
+		MetaFieldsTable,
+		NotesTable,
+		PoolsTable,
+		PoolMediaTable,
+		RenditionsTable,
 		SettingsTable,
+		SourcesTable,
 		TagsTable,
+		TagSuggestionsTable,
+		TagVectorsTable,
 		VectorsTable,
 		MediaTagsTable,
 	}
 )
 
 func init() {
+	AutoTagsTable.ForeignKeys[0].RefTable = TagsTable
+	CommentsTable.ForeignKeys[0].RefTable = MediaTable
+	FavoritesTable.ForeignKeys[0].RefTable = MediaTable
 	MediaDatesTable.ForeignKeys[0].RefTable = MediaTable
 	MediaDatesTable.ForeignKeys[1].RefTable = DatesTable
+	MediaFieldsTable.ForeignKeys[0].RefTable = MediaTable
+	MediaFieldsTable.ForeignKeys[1].RefTable = MetaFieldsTable
+	MediaRevisionsTable.ForeignKeys[0].RefTable = MediaTable
 	MediaVectorsTable.ForeignKeys[0].RefTable = MediaTable
 	MediaVectorsTable.ForeignKeys[1].RefTable = VectorsTable
+	MediaVotesTable.ForeignKeys[0].RefTable = MediaTable
+	NotesTable.ForeignKeys[0].RefTable = MediaTable
+	NotesTable.ForeignKeys[1].RefTable = TagsTable
+	PoolsTable.ForeignKeys[0].RefTable = MediaTable
+	PoolMediaTable.ForeignKeys[0].RefTable = PoolsTable
+	PoolMediaTable.ForeignKeys[1].RefTable = MediaTable
+	RenditionsTable.ForeignKeys[0].RefTable = MediaTable
+	SourcesTable.ForeignKeys[0].RefTable = MediaTable
+	TagSuggestionsTable.ForeignKeys[0].RefTable = MediaTable
+	TagSuggestionsTable.ForeignKeys[1].RefTable = TagsTable
+	TagVectorsTable.ForeignKeys[0].RefTable = TagsTable
+	TagVectorsTable.ForeignKeys[1].RefTable = VectorsTable
 	MediaTagsTable.ForeignKeys[0].RefTable = MediaTable
 	MediaTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
@@ -4,14 +4,30 @@ package ent
 
 import (
 	"context"
+	"encoding/json/jsontext"
+	"era/booru/ent/auditlog"
+	"era/booru/ent/autotag"
+	"era/booru/ent/comment"
 	"era/booru/ent/date"
+	"era/booru/ent/favorite"
 	"era/booru/ent/hiddentagfilter"
 	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/mediarevision"
 	"era/booru/ent/mediavector"
+	"era/booru/ent/mediavote"
+	"era/booru/ent/metafield"
+	"era/booru/ent/note"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
 	"era/booru/ent/predicate"
+	"era/booru/ent/rendition"
 	"era/booru/ent/setting"
+	"era/booru/ent/source"
 	"era/booru/ent/tag"
+	"era/booru/ent/tagsuggestion"
+	"era/booru/ent/tagvector"
 	"era/booru/ent/vector"
 	"errors"
 	"fmt"
@@ -32,46 +48,67 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAuditLog        = "AuditLog"
+	TypeAutoTag         = "AutoTag"
+	TypeComment         = "Comment"
 	TypeDate            = "Date"
+	TypeFavorite        = "Favorite"
 	TypeHiddenTagFilter = "HiddenTagFilter"
 	TypeMedia           = "Media"
 	TypeMediaDate       = "MediaDate"
+	TypeMediaField      = "MediaField"
+	TypeMediaRevision   = "MediaRevision"
 	TypeMediaVector     = "MediaVector"
+	TypeMediaVote       = "MediaVote"
+	TypeMetaField       = "MetaField"
+	TypeNote            = "Note"
+	TypePool            = "Pool"
+	TypePoolMedia       = "PoolMedia"
+	TypeRendition       = "Rendition"
 	TypeSetting         = "Setting"
+	TypeSource          = "Source"
 	TypeTag             = "Tag"
+	TypeTagSuggestion   = "TagSuggestion"
+	TypeTagVector       = "TagVector"
 	TypeVector          = "Vector"
 )
 
-// DateMutation represents an operation that mutates the Date nodes in the graph.
-type DateMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	clearedFields      map[string]struct{}
-	media              map[string]struct{}
-	removedmedia       map[string]struct{}
-	clearedmedia       bool
-	media_dates        map[int]struct{}
-	removedmedia_dates map[int]struct{}
-	clearedmedia_dates bool
-	done               bool
-	oldValue           func(context.Context) (*Date, error)
-	predicates         []predicate.Date
-}
-
-var _ ent.Mutation = (*DateMutation)(nil)
-
-// dateOption allows management of the mutation configuration using functional options.
-type dateOption func(*DateMutation)
-
-// newDateMutation creates new mutation for the Date entity.
-func newDateMutation(c config, op Op, opts ...dateOption) *DateMutation {
-	m := &DateMutation{
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	actor          *string
+	action         *string
+	method         *string
+	_path          *string
+	params         *map[string]interface{}
+	status         *int
+	addstatus      *int
+	success        *bool
+	result         *jsontext.Value
+	appendresult   jsontext.Value
+	duration_ms    *int64
+	addduration_ms *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*AuditLog, error)
+	predicates     []predicate.AuditLog
+}
+
+var _ ent.Mutation = (*AuditLogMutation)(nil)
+
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
+
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDate,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -80,20 +117,20 @@ func newDateMutation(c config, op Op, opts ...dateOption) *DateMutation {
 	return m
 }
 
-// withDateID sets the ID field of the mutation.
-func withDateID(id int) dateOption {
-	return func(m *DateMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Date
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*Date, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Date.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -102,10 +139,10 @@ func withDateID(id int) dateOption {
 	}
 }
 
-// withDate sets the old Date of the mutation.
-func withDate(node *Date) dateOption {
-	return func(m *DateMutation) {
-		m.oldValue = func(context.Context) (*Date, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -114,7 +151,7 @@ func withDate(node *Date) dateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DateMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -122,7 +159,7 @@ func (m DateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DateMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -131,15 +168,9 @@ func (m DateMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Date entities.
-func (m *DateMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DateMutation) ID() (id int, exists bool) {
+func (m *AuditLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -150,7 +181,7 @@ func (m *DateMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DateMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -159,627 +190,463 @@ func (m *DateMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Date.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *DateMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DateMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Date entity.
-// If the Date object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DateMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DateMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddMediumIDs adds the "media" edge to the Media entity by ids.
-func (m *DateMutation) AddMediumIDs(ids ...string) {
-	if m.media == nil {
-		m.media = make(map[string]struct{})
+// SetActor sets the "actor" field.
+func (m *AuditLogMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditLogMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.media[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
+	return oldValue.Actor, nil
 }
 
-// ClearMedia clears the "media" edge to the Media entity.
-func (m *DateMutation) ClearMedia() {
-	m.clearedmedia = true
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
 }
 
-// MediaCleared reports if the "media" edge to the Media entity was cleared.
-func (m *DateMutation) MediaCleared() bool {
-	return m.clearedmedia
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
-func (m *DateMutation) RemoveMediumIDs(ids ...string) {
-	if m.removedmedia == nil {
-		m.removedmedia = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.media, ids[i])
-		m.removedmedia[ids[i]] = struct{}{}
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
-func (m *DateMutation) RemovedMediaIDs() (ids []string) {
-	for id := range m.removedmedia {
-		ids = append(ids, id)
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
 }
 
-// MediaIDs returns the "media" edge IDs in the mutation.
-func (m *DateMutation) MediaIDs() (ids []string) {
-	for id := range m.media {
-		ids = append(ids, id)
-	}
-	return
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// ResetMedia resets all changes to the "media" edge.
-func (m *DateMutation) ResetMedia() {
-	m.media = nil
-	m.clearedmedia = false
-	m.removedmedia = nil
+// SetMethod sets the "method" field.
+func (m *AuditLogMutation) SetMethod(s string) {
+	m.method = &s
 }
 
-// AddMediaDateIDs adds the "media_dates" edge to the MediaDate entity by ids.
-func (m *DateMutation) AddMediaDateIDs(ids ...int) {
-	if m.media_dates == nil {
-		m.media_dates = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.media_dates[ids[i]] = struct{}{}
+// Method returns the value of the "method" field in the mutation.
+func (m *AuditLogMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearMediaDates clears the "media_dates" edge to the MediaDate entity.
-func (m *DateMutation) ClearMediaDates() {
-	m.clearedmedia_dates = true
+// OldMethod returns the old "method" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
 }
 
-// MediaDatesCleared reports if the "media_dates" edge to the MediaDate entity was cleared.
-func (m *DateMutation) MediaDatesCleared() bool {
-	return m.clearedmedia_dates
+// ResetMethod resets all changes to the "method" field.
+func (m *AuditLogMutation) ResetMethod() {
+	m.method = nil
 }
 
-// RemoveMediaDateIDs removes the "media_dates" edge to the MediaDate entity by IDs.
-func (m *DateMutation) RemoveMediaDateIDs(ids ...int) {
-	if m.removedmedia_dates == nil {
-		m.removedmedia_dates = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.media_dates, ids[i])
-		m.removedmedia_dates[ids[i]] = struct{}{}
-	}
+// SetPath sets the "path" field.
+func (m *AuditLogMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// RemovedMediaDates returns the removed IDs of the "media_dates" edge to the MediaDate entity.
-func (m *DateMutation) RemovedMediaDatesIDs() (ids []int) {
-	for id := range m.removedmedia_dates {
-		ids = append(ids, id)
+// Path returns the value of the "path" field in the mutation.
+func (m *AuditLogMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MediaDatesIDs returns the "media_dates" edge IDs in the mutation.
-func (m *DateMutation) MediaDatesIDs() (ids []int) {
-	for id := range m.media_dates {
-		ids = append(ids, id)
+// OldPath returns the old "path" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
 }
 
-// ResetMediaDates resets all changes to the "media_dates" edge.
-func (m *DateMutation) ResetMediaDates() {
-	m.media_dates = nil
-	m.clearedmedia_dates = false
-	m.removedmedia_dates = nil
+// ResetPath resets all changes to the "path" field.
+func (m *AuditLogMutation) ResetPath() {
+	m._path = nil
 }
 
-// Where appends a list predicates to the DateMutation builder.
-func (m *DateMutation) Where(ps ...predicate.Date) {
-	m.predicates = append(m.predicates, ps...)
+// SetParams sets the "params" field.
+func (m *AuditLogMutation) SetParams(value map[string]interface{}) {
+	m.params = &value
 }
 
-// WhereP appends storage-level predicates to the DateMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Date, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Params returns the value of the "params" field in the mutation.
+func (m *AuditLogMutation) Params() (r map[string]interface{}, exists bool) {
+	v := m.params
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *DateMutation) Op() Op {
-	return m.op
+// OldParams returns the old "params" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldParams(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	}
+	return oldValue.Params, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DateMutation) SetOp(op Op) {
-	m.op = op
+// ClearParams clears the value of the "params" field.
+func (m *AuditLogMutation) ClearParams() {
+	m.params = nil
+	m.clearedFields[auditlog.FieldParams] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Date).
-func (m *DateMutation) Type() string {
-	return m.typ
+// ParamsCleared returns if the "params" field was cleared in this mutation.
+func (m *AuditLogMutation) ParamsCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldParams]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *DateMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, date.FieldName)
-	}
-	return fields
+// ResetParams resets all changes to the "params" field.
+func (m *AuditLogMutation) ResetParams() {
+	m.params = nil
+	delete(m.clearedFields, auditlog.FieldParams)
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *DateMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case date.FieldName:
-		return m.Name()
-	}
-	return nil, false
+// SetStatus sets the "status" field.
+func (m *AuditLogMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *DateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case date.FieldName:
-		return m.OldName(ctx)
+// Status returns the value of the "status" field in the mutation.
+func (m *AuditLogMutation) Status() (r int, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Date field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DateMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case date.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
+// OldStatus returns the old "status" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Date field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DateMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DateMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DateMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AddStatus adds i to the "status" field.
+func (m *AuditLogMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
 	}
-	return fmt.Errorf("unknown Date numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *DateMutation) ClearedFields() []string {
-	return nil
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *AuditLogMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *DateMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetStatus resets all changes to the "status" field.
+func (m *AuditLogMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DateMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Date nullable field %s", name)
+// SetSuccess sets the "success" field.
+func (m *AuditLogMutation) SetSuccess(b bool) {
+	m.success = &b
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *DateMutation) ResetField(name string) error {
-	switch name {
-	case date.FieldName:
-		m.ResetName()
-		return nil
+// Success returns the value of the "success" field in the mutation.
+func (m *AuditLogMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Date field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.media != nil {
-		edges = append(edges, date.EdgeMedia)
+// OldSuccess returns the old "success" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
 	}
-	if m.media_dates != nil {
-		edges = append(edges, date.EdgeMediaDates)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *DateMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case date.EdgeMedia:
-		ids := make([]ent.Value, 0, len(m.media))
-		for id := range m.media {
-			ids = append(ids, id)
-		}
-		return ids
-	case date.EdgeMediaDates:
-		ids := make([]ent.Value, 0, len(m.media_dates))
-		for id := range m.media_dates {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetSuccess resets all changes to the "success" field.
+func (m *AuditLogMutation) ResetSuccess() {
+	m.success = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmedia != nil {
-		edges = append(edges, date.EdgeMedia)
-	}
-	if m.removedmedia_dates != nil {
-		edges = append(edges, date.EdgeMediaDates)
-	}
-	return edges
+// SetResult sets the "result" field.
+func (m *AuditLogMutation) SetResult(j jsontext.Value) {
+	m.result = &j
+	m.appendresult = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *DateMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case date.EdgeMedia:
-		ids := make([]ent.Value, 0, len(m.removedmedia))
-		for id := range m.removedmedia {
-			ids = append(ids, id)
-		}
-		return ids
-	case date.EdgeMediaDates:
-		ids := make([]ent.Value, 0, len(m.removedmedia_dates))
-		for id := range m.removedmedia_dates {
-			ids = append(ids, id)
-		}
-		return ids
+// Result returns the value of the "result" field in the mutation.
+func (m *AuditLogMutation) Result() (r jsontext.Value, exists bool) {
+	v := m.result
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmedia {
-		edges = append(edges, date.EdgeMedia)
+// OldResult returns the old "result" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldResult(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
 	}
-	if m.clearedmedia_dates {
-		edges = append(edges, date.EdgeMediaDates)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *DateMutation) EdgeCleared(name string) bool {
-	switch name {
-	case date.EdgeMedia:
-		return m.clearedmedia
-	case date.EdgeMediaDates:
-		return m.clearedmedia_dates
-	}
-	return false
+// AppendResult adds j to the "result" field.
+func (m *AuditLogMutation) AppendResult(j jsontext.Value) {
+	m.appendresult = append(m.appendresult, j...)
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *DateMutation) ClearEdge(name string) error {
-	switch name {
+// AppendedResult returns the list of values that were appended to the "result" field in this mutation.
+func (m *AuditLogMutation) AppendedResult() (jsontext.Value, bool) {
+	if len(m.appendresult) == 0 {
+		return nil, false
 	}
-	return fmt.Errorf("unknown Date unique edge %s", name)
+	return m.appendresult, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *DateMutation) ResetEdge(name string) error {
-	switch name {
-	case date.EdgeMedia:
-		m.ResetMedia()
-		return nil
-	case date.EdgeMediaDates:
-		m.ResetMediaDates()
-		return nil
-	}
-	return fmt.Errorf("unknown Date edge %s", name)
+// ClearResult clears the value of the "result" field.
+func (m *AuditLogMutation) ClearResult() {
+	m.result = nil
+	m.appendresult = nil
+	m.clearedFields[auditlog.FieldResult] = struct{}{}
 }
 
-// HiddenTagFilterMutation represents an operation that mutates the HiddenTagFilter nodes in the graph.
-type HiddenTagFilterMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	value         *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*HiddenTagFilter, error)
-	predicates    []predicate.HiddenTagFilter
+// ResultCleared returns if the "result" field was cleared in this mutation.
+func (m *AuditLogMutation) ResultCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldResult]
+	return ok
 }
 
-var _ ent.Mutation = (*HiddenTagFilterMutation)(nil)
+// ResetResult resets all changes to the "result" field.
+func (m *AuditLogMutation) ResetResult() {
+	m.result = nil
+	m.appendresult = nil
+	delete(m.clearedFields, auditlog.FieldResult)
+}
 
-// hiddentagfilterOption allows management of the mutation configuration using functional options.
-type hiddentagfilterOption func(*HiddenTagFilterMutation)
+// SetDurationMs sets the "duration_ms" field.
+func (m *AuditLogMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
 
-// newHiddenTagFilterMutation creates new mutation for the HiddenTagFilter entity.
-func newHiddenTagFilterMutation(c config, op Op, opts ...hiddentagfilterOption) *HiddenTagFilterMutation {
-	m := &HiddenTagFilterMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeHiddenTagFilter,
-		clearedFields: make(map[string]struct{}),
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *AuditLogMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
 }
 
-// withHiddenTagFilterID sets the ID field of the mutation.
-func withHiddenTagFilterID(id int) hiddentagfilterOption {
-	return func(m *HiddenTagFilterMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *HiddenTagFilter
-		)
-		m.oldValue = func(ctx context.Context) (*HiddenTagFilter, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().HiddenTagFilter.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *AuditLogMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
 	}
 }
 
-// withHiddenTagFilter sets the old HiddenTagFilter of the mutation.
-func withHiddenTagFilter(node *HiddenTagFilter) hiddentagfilterOption {
-	return func(m *HiddenTagFilterMutation) {
-		m.oldValue = func(context.Context) (*HiddenTagFilter, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *AuditLogMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HiddenTagFilterMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *AuditLogMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m HiddenTagFilterMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *HiddenTagFilterMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *HiddenTagFilterMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().HiddenTagFilter.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetValue sets the "value" field.
-func (m *HiddenTagFilterMutation) SetValue(s string) {
-	m.value = &s
-}
-
-// Value returns the value of the "value" field in the mutation.
-func (m *HiddenTagFilterMutation) Value() (r string, exists bool) {
-	v := m.value
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldValue returns the old "value" field's value of the HiddenTagFilter entity.
-// If the HiddenTagFilter object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HiddenTagFilterMutation) OldValue(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
-	}
-	return oldValue.Value, nil
-}
-
-// ResetValue resets all changes to the "value" field.
-func (m *HiddenTagFilterMutation) ResetValue() {
-	m.value = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *HiddenTagFilterMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HiddenTagFilterMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the HiddenTagFilter entity.
-// If the HiddenTagFilter object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HiddenTagFilterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HiddenTagFilterMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *HiddenTagFilterMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *HiddenTagFilterMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the HiddenTagFilter entity.
-// If the HiddenTagFilter object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HiddenTagFilterMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *HiddenTagFilterMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// Where appends a list predicates to the HiddenTagFilterMutation builder.
-func (m *HiddenTagFilterMutation) Where(ps ...predicate.HiddenTagFilter) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the HiddenTagFilterMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HiddenTagFilterMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.HiddenTagFilter, len(ps))
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -787,33 +654,54 @@ func (m *HiddenTagFilterMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HiddenTagFilterMutation) Op() Op {
+func (m *AuditLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HiddenTagFilterMutation) SetOp(op Op) {
+func (m *AuditLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (HiddenTagFilter).
-func (m *HiddenTagFilterMutation) Type() string {
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HiddenTagFilterMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.value != nil {
-		fields = append(fields, hiddentagfilter.FieldValue)
-	}
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
-		fields = append(fields, hiddentagfilter.FieldCreatedAt)
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, hiddentagfilter.FieldUpdatedAt)
+	if m.actor != nil {
+		fields = append(fields, auditlog.FieldActor)
+	}
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
+	}
+	if m.method != nil {
+		fields = append(fields, auditlog.FieldMethod)
+	}
+	if m._path != nil {
+		fields = append(fields, auditlog.FieldPath)
+	}
+	if m.params != nil {
+		fields = append(fields, auditlog.FieldParams)
+	}
+	if m.status != nil {
+		fields = append(fields, auditlog.FieldStatus)
+	}
+	if m.success != nil {
+		fields = append(fields, auditlog.FieldSuccess)
+	}
+	if m.result != nil {
+		fields = append(fields, auditlog.FieldResult)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, auditlog.FieldDurationMs)
 	}
 	return fields
 }
@@ -821,14 +709,28 @@ func (m *HiddenTagFilterMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HiddenTagFilterMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case hiddentagfilter.FieldValue:
-		return m.Value()
-	case hiddentagfilter.FieldCreatedAt:
+	case auditlog.FieldCreatedAt:
 		return m.CreatedAt()
-	case hiddentagfilter.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case auditlog.FieldActor:
+		return m.Actor()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldMethod:
+		return m.Method()
+	case auditlog.FieldPath:
+		return m.Path()
+	case auditlog.FieldParams:
+		return m.Params()
+	case auditlog.FieldStatus:
+		return m.Status()
+	case auditlog.FieldSuccess:
+		return m.Success()
+	case auditlog.FieldResult:
+		return m.Result()
+	case auditlog.FieldDurationMs:
+		return m.DurationMs()
 	}
 	return nil, false
 }
@@ -836,199 +738,312 @@ func (m *HiddenTagFilterMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HiddenTagFilterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case hiddentagfilter.FieldValue:
-		return m.OldValue(ctx)
-	case hiddentagfilter.FieldCreatedAt:
+	case auditlog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case hiddentagfilter.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown HiddenTagFilter field %s", name)
+	case auditlog.FieldActor:
+		return m.OldActor(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldMethod:
+		return m.OldMethod(ctx)
+	case auditlog.FieldPath:
+		return m.OldPath(ctx)
+	case auditlog.FieldParams:
+		return m.OldParams(ctx)
+	case auditlog.FieldStatus:
+		return m.OldStatus(ctx)
+	case auditlog.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case auditlog.FieldResult:
+		return m.OldResult(ctx)
+	case auditlog.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HiddenTagFilterMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case hiddentagfilter.FieldValue:
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditlog.FieldActor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetActor(v)
 		return nil
-	case hiddentagfilter.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case auditlog.FieldAction:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetAction(v)
 		return nil
-	case hiddentagfilter.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case auditlog.FieldMethod:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetMethod(v)
+		return nil
+	case auditlog.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case auditlog.FieldParams:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case auditlog.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case auditlog.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case auditlog.FieldResult:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case auditlog.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
 		return nil
 	}
-	return fmt.Errorf("unknown HiddenTagFilter field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HiddenTagFilterMutation) AddedFields() []string {
-	return nil
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, auditlog.FieldStatus)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, auditlog.FieldDurationMs)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HiddenTagFilterMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldStatus:
+		return m.AddedStatus()
+	case auditlog.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HiddenTagFilterMutation) AddField(name string, value ent.Value) error {
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case auditlog.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case auditlog.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
 	}
-	return fmt.Errorf("unknown HiddenTagFilter numeric field %s", name)
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HiddenTagFilterMutation) ClearedFields() []string {
-	return nil
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldParams) {
+		fields = append(fields, auditlog.FieldParams)
+	}
+	if m.FieldCleared(auditlog.FieldResult) {
+		fields = append(fields, auditlog.FieldResult)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HiddenTagFilterMutation) FieldCleared(name string) bool {
+func (m *AuditLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HiddenTagFilterMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown HiddenTagFilter nullable field %s", name)
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldParams:
+		m.ClearParams()
+		return nil
+	case auditlog.FieldResult:
+		m.ClearResult()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HiddenTagFilterMutation) ResetField(name string) error {
+func (m *AuditLogMutation) ResetField(name string) error {
 	switch name {
-	case hiddentagfilter.FieldValue:
-		m.ResetValue()
-		return nil
-	case hiddentagfilter.FieldCreatedAt:
+	case auditlog.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case hiddentagfilter.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case auditlog.FieldActor:
+		m.ResetActor()
+		return nil
+	case auditlog.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditlog.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case auditlog.FieldPath:
+		m.ResetPath()
+		return nil
+	case auditlog.FieldParams:
+		m.ResetParams()
+		return nil
+	case auditlog.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case auditlog.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case auditlog.FieldResult:
+		m.ResetResult()
+		return nil
+	case auditlog.FieldDurationMs:
+		m.ResetDurationMs()
 		return nil
 	}
-	return fmt.Errorf("unknown HiddenTagFilter field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HiddenTagFilterMutation) AddedEdges() []string {
+func (m *AuditLogMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HiddenTagFilterMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HiddenTagFilterMutation) RemovedEdges() []string {
+func (m *AuditLogMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HiddenTagFilterMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HiddenTagFilterMutation) ClearedEdges() []string {
+func (m *AuditLogMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HiddenTagFilterMutation) EdgeCleared(name string) bool {
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HiddenTagFilterMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown HiddenTagFilter unique edge %s", name)
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HiddenTagFilterMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown HiddenTagFilter edge %s", name)
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
 }
 
-// MediaMutation represents an operation that mutates the Media nodes in the graph.
-type MediaMutation struct {
+// AutoTagMutation represents an operation that mutates the AutoTag nodes in the graph.
+type AutoTagMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *string
-	format               *string
-	width                *int16
-	addwidth             *int16
-	height               *int16
-	addheight            *int16
-	duration             *int16
-	addduration          *int16
-	clearedFields        map[string]struct{}
-	tags                 map[int]struct{}
-	removedtags          map[int]struct{}
-	clearedtags          bool
-	dates                map[int]struct{}
-	removeddates         map[int]struct{}
-	cleareddates         bool
-	vectors              map[int]struct{}
-	removedvectors       map[int]struct{}
-	clearedvectors       bool
-	media_dates          map[int]struct{}
-	removedmedia_dates   map[int]struct{}
-	clearedmedia_dates   bool
-	media_vectors        map[int]struct{}
-	removedmedia_vectors map[int]struct{}
-	clearedmedia_vectors bool
-	done                 bool
-	oldValue             func(context.Context) (*Media, error)
-	predicates           []predicate.Media
+	op            Op
+	typ           string
+	id            *int
+	prompt        *string
+	threshold     *float64
+	addthreshold  *float64
+	embedding     *pgvector.Vector
+	model         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	tag           *int
+	clearedtag    bool
+	done          bool
+	oldValue      func(context.Context) (*AutoTag, error)
+	predicates    []predicate.AutoTag
 }
 
-var _ ent.Mutation = (*MediaMutation)(nil)
+var _ ent.Mutation = (*AutoTagMutation)(nil)
 
-// mediaOption allows management of the mutation configuration using functional options.
-type mediaOption func(*MediaMutation)
+// autotagOption allows management of the mutation configuration using functional options.
+type autotagOption func(*AutoTagMutation)
 
-// newMediaMutation creates new mutation for the Media entity.
-func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
-	m := &MediaMutation{
+// newAutoTagMutation creates new mutation for the AutoTag entity.
+func newAutoTagMutation(c config, op Op, opts ...autotagOption) *AutoTagMutation {
+	m := &AutoTagMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMedia,
+		typ:           TypeAutoTag,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1037,20 +1052,20 @@ func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
 	return m
 }
 
-// withMediaID sets the ID field of the mutation.
-func withMediaID(id string) mediaOption {
-	return func(m *MediaMutation) {
+// withAutoTagID sets the ID field of the mutation.
+func withAutoTagID(id int) autotagOption {
+	return func(m *AutoTagMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Media
+			value *AutoTag
 		)
-		m.oldValue = func(ctx context.Context) (*Media, error) {
+		m.oldValue = func(ctx context.Context) (*AutoTag, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Media.Get(ctx, id)
+					value, err = m.Client().AutoTag.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1059,10 +1074,10 @@ func withMediaID(id string) mediaOption {
 	}
 }
 
-// withMedia sets the old Media of the mutation.
-func withMedia(node *Media) mediaOption {
-	return func(m *MediaMutation) {
-		m.oldValue = func(context.Context) (*Media, error) {
+// withAutoTag sets the old AutoTag of the mutation.
+func withAutoTag(node *AutoTag) autotagOption {
+	return func(m *AutoTagMutation) {
+		m.oldValue = func(context.Context) (*AutoTag, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1071,7 +1086,7 @@ func withMedia(node *Media) mediaOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MediaMutation) Client() *Client {
+func (m AutoTagMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1079,7 +1094,7 @@ func (m MediaMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MediaMutation) Tx() (*Tx, error) {
+func (m AutoTagMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1088,15 +1103,9 @@ func (m MediaMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Media entities.
-func (m *MediaMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MediaMutation) ID() (id string, exists bool) {
+func (m *AutoTagMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1107,462 +1116,4429 @@ func (m *MediaMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MediaMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AutoTagMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Media.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AutoTag.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFormat sets the "format" field.
-func (m *MediaMutation) SetFormat(s string) {
-	m.format = &s
+// SetTagID sets the "tag_id" field.
+func (m *AutoTagMutation) SetTagID(i int) {
+	m.tag = &i
 }
 
-// Format returns the value of the "format" field in the mutation.
-func (m *MediaMutation) Format() (r string, exists bool) {
-	v := m.format
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *AutoTagMutation) TagID() (r int, exists bool) {
+	v := m.tag
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFormat returns the old "format" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldTagID returns the old "tag_id" field's value of the AutoTag entity.
+// If the AutoTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldFormat(ctx context.Context) (v string, err error) {
+func (m *AutoTagMutation) OldTagID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFormat requires an ID field in the mutation")
+		return v, errors.New("OldTagID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
 	}
-	return oldValue.Format, nil
+	return oldValue.TagID, nil
 }
 
-// ResetFormat resets all changes to the "format" field.
-func (m *MediaMutation) ResetFormat() {
-	m.format = nil
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *AutoTagMutation) ResetTagID() {
+	m.tag = nil
 }
 
-// SetWidth sets the "width" field.
-func (m *MediaMutation) SetWidth(i int16) {
-	m.width = &i
-	m.addwidth = nil
+// SetPrompt sets the "prompt" field.
+func (m *AutoTagMutation) SetPrompt(s string) {
+	m.prompt = &s
 }
 
-// Width returns the value of the "width" field in the mutation.
-func (m *MediaMutation) Width() (r int16, exists bool) {
-	v := m.width
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *AutoTagMutation) Prompt() (r string, exists bool) {
+	v := m.prompt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWidth returns the old "width" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldPrompt returns the old "prompt" field's value of the AutoTag entity.
+// If the AutoTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldWidth(ctx context.Context) (v int16, err error) {
+func (m *AutoTagMutation) OldPrompt(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWidth requires an ID field in the mutation")
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
-	}
-	return oldValue.Width, nil
-}
-
-// AddWidth adds i to the "width" field.
-func (m *MediaMutation) AddWidth(i int16) {
-	if m.addwidth != nil {
-		*m.addwidth += i
-	} else {
-		m.addwidth = &i
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
 	}
+	return oldValue.Prompt, nil
 }
 
-// AddedWidth returns the value that was added to the "width" field in this mutation.
-func (m *MediaMutation) AddedWidth() (r int16, exists bool) {
-	v := m.addwidth
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetWidth resets all changes to the "width" field.
-func (m *MediaMutation) ResetWidth() {
-	m.width = nil
-	m.addwidth = nil
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *AutoTagMutation) ResetPrompt() {
+	m.prompt = nil
 }
 
-// SetHeight sets the "height" field.
-func (m *MediaMutation) SetHeight(i int16) {
-	m.height = &i
-	m.addheight = nil
+// SetThreshold sets the "threshold" field.
+func (m *AutoTagMutation) SetThreshold(f float64) {
+	m.threshold = &f
+	m.addthreshold = nil
 }
 
-// Height returns the value of the "height" field in the mutation.
-func (m *MediaMutation) Height() (r int16, exists bool) {
-	v := m.height
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *AutoTagMutation) Threshold() (r float64, exists bool) {
+	v := m.threshold
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHeight returns the old "height" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldThreshold returns the old "threshold" field's value of the AutoTag entity.
+// If the AutoTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldHeight(ctx context.Context) (v int16, err error) {
+func (m *AutoTagMutation) OldThreshold(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeight requires an ID field in the mutation")
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
 	}
-	return oldValue.Height, nil
+	return oldValue.Threshold, nil
 }
 
-// AddHeight adds i to the "height" field.
-func (m *MediaMutation) AddHeight(i int16) {
-	if m.addheight != nil {
-		*m.addheight += i
+// AddThreshold adds f to the "threshold" field.
+func (m *AutoTagMutation) AddThreshold(f float64) {
+	if m.addthreshold != nil {
+		*m.addthreshold += f
 	} else {
-		m.addheight = &i
+		m.addthreshold = &f
 	}
 }
 
-// AddedHeight returns the value that was added to the "height" field in this mutation.
-func (m *MediaMutation) AddedHeight() (r int16, exists bool) {
-	v := m.addheight
+// AddedThreshold returns the value that was added to the "threshold" field in this mutation.
+func (m *AutoTagMutation) AddedThreshold() (r float64, exists bool) {
+	v := m.addthreshold
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetHeight resets all changes to the "height" field.
-func (m *MediaMutation) ResetHeight() {
-	m.height = nil
-	m.addheight = nil
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *AutoTagMutation) ResetThreshold() {
+	m.threshold = nil
+	m.addthreshold = nil
 }
 
-// SetDuration sets the "duration" field.
-func (m *MediaMutation) SetDuration(i int16) {
-	m.duration = &i
-	m.addduration = nil
+// SetEmbedding sets the "embedding" field.
+func (m *AutoTagMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
 }
 
-// Duration returns the value of the "duration" field in the mutation.
-func (m *MediaMutation) Duration() (r int16, exists bool) {
-	v := m.duration
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *AutoTagMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDuration returns the old "duration" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldEmbedding returns the old "embedding" field's value of the AutoTag entity.
+// If the AutoTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldDuration(ctx context.Context) (v *int16, err error) {
+func (m *AutoTagMutation) OldEmbedding(ctx context.Context) (v *pgvector.Vector, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDuration requires an ID field in the mutation")
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
 	}
-	return oldValue.Duration, nil
+	return oldValue.Embedding, nil
 }
 
-// AddDuration adds i to the "duration" field.
-func (m *MediaMutation) AddDuration(i int16) {
-	if m.addduration != nil {
-		*m.addduration += i
-	} else {
-		m.addduration = &i
-	}
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *AutoTagMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.clearedFields[autotag.FieldEmbedding] = struct{}{}
 }
 
-// AddedDuration returns the value that was added to the "duration" field in this mutation.
-func (m *MediaMutation) AddedDuration() (r int16, exists bool) {
-	v := m.addduration
-	if v == nil {
-		return
-	}
-	return *v, true
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *AutoTagMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[autotag.FieldEmbedding]
+	return ok
 }
 
-// ClearDuration clears the value of the "duration" field.
-func (m *MediaMutation) ClearDuration() {
-	m.duration = nil
-	m.addduration = nil
-	m.clearedFields[media.FieldDuration] = struct{}{}
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *AutoTagMutation) ResetEmbedding() {
+	m.embedding = nil
+	delete(m.clearedFields, autotag.FieldEmbedding)
 }
 
-// DurationCleared returns if the "duration" field was cleared in this mutation.
-func (m *MediaMutation) DurationCleared() bool {
-	_, ok := m.clearedFields[media.FieldDuration]
-	return ok
+// SetModel sets the "model" field.
+func (m *AutoTagMutation) SetModel(s string) {
+	m.model = &s
 }
 
-// ResetDuration resets all changes to the "duration" field.
-func (m *MediaMutation) ResetDuration() {
-	m.duration = nil
-	m.addduration = nil
-	delete(m.clearedFields, media.FieldDuration)
+// Model returns the value of the "model" field in the mutation.
+func (m *AutoTagMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddTagIDs adds the "tags" edge to the Tag entity by ids.
-func (m *MediaMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
+// OldModel returns the old "model" field's value of the AutoTag entity.
+// If the AutoTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AutoTagMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
 	}
+	return oldValue.Model, nil
 }
 
-// ClearTags clears the "tags" edge to the Tag entity.
-func (m *MediaMutation) ClearTags() {
-	m.clearedtags = true
+// ResetModel resets all changes to the "model" field.
+func (m *AutoTagMutation) ResetModel() {
+	m.model = nil
 }
 
-// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
-func (m *MediaMutation) TagsCleared() bool {
-	return m.clearedtags
+// SetCreatedAt sets the "created_at" field.
+func (m *AutoTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
-func (m *MediaMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AutoTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
-func (m *MediaMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the AutoTag entity.
+// If the AutoTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AutoTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *MediaMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetTags resets all changes to the "tags" edge.
-func (m *MediaMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// AddDateIDs adds the "dates" edge to the Date entity by ids.
-func (m *MediaMutation) AddDateIDs(ids ...int) {
-	if m.dates == nil {
-		m.dates = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.dates[ids[i]] = struct{}{}
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AutoTagMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearDates clears the "dates" edge to the Date entity.
-func (m *MediaMutation) ClearDates() {
-	m.cleareddates = true
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AutoTagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// DatesCleared reports if the "dates" edge to the Date entity was cleared.
-func (m *MediaMutation) DatesCleared() bool {
-	return m.cleareddates
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AutoTagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveDateIDs removes the "dates" edge to the Date entity by IDs.
-func (m *MediaMutation) RemoveDateIDs(ids ...int) {
-	if m.removeddates == nil {
-		m.removeddates = make(map[int]struct{})
+// OldUpdatedAt returns the old "updated_at" field's value of the AutoTag entity.
+// If the AutoTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AutoTagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.dates, ids[i])
-		m.removeddates[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// RemovedDates returns the removed IDs of the "dates" edge to the Date entity.
-func (m *MediaMutation) RemovedDatesIDs() (ids []int) {
-	for id := range m.removeddates {
-		ids = append(ids, id)
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AutoTagMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// DatesIDs returns the "dates" edge IDs in the mutation.
-func (m *MediaMutation) DatesIDs() (ids []int) {
-	for id := range m.dates {
-		ids = append(ids, id)
-	}
-	return
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *AutoTagMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[autotag.FieldTagID] = struct{}{}
 }
 
-// ResetDates resets all changes to the "dates" edge.
-func (m *MediaMutation) ResetDates() {
-	m.dates = nil
-	m.cleareddates = false
-	m.removeddates = nil
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *AutoTagMutation) TagCleared() bool {
+	return m.clearedtag
 }
 
-// AddVectorIDs adds the "vectors" edge to the Vector entity by ids.
-func (m *MediaMutation) AddVectorIDs(ids ...int) {
-	if m.vectors == nil {
-		m.vectors = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.vectors[ids[i]] = struct{}{}
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *AutoTagMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearVectors clears the "vectors" edge to the Vector entity.
-func (m *MediaMutation) ClearVectors() {
-	m.clearedvectors = true
+// ResetTag resets all changes to the "tag" edge.
+func (m *AutoTagMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
 }
 
-// VectorsCleared reports if the "vectors" edge to the Vector entity was cleared.
-func (m *MediaMutation) VectorsCleared() bool {
-	return m.clearedvectors
+// Where appends a list predicates to the AutoTagMutation builder.
+func (m *AutoTagMutation) Where(ps ...predicate.AutoTag) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// RemoveVectorIDs removes the "vectors" edge to the Vector entity by IDs.
-func (m *MediaMutation) RemoveVectorIDs(ids ...int) {
-	if m.removedvectors == nil {
-		m.removedvectors = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.vectors, ids[i])
-		m.removedvectors[ids[i]] = struct{}{}
+// WhereP appends storage-level predicates to the AutoTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AutoTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AutoTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// RemovedVectors returns the removed IDs of the "vectors" edge to the Vector entity.
-func (m *MediaMutation) RemovedVectorsIDs() (ids []int) {
-	for id := range m.removedvectors {
-		ids = append(ids, id)
-	}
-	return
+// Op returns the operation name.
+func (m *AutoTagMutation) Op() Op {
+	return m.op
 }
 
-// VectorsIDs returns the "vectors" edge IDs in the mutation.
-func (m *MediaMutation) VectorsIDs() (ids []int) {
-	for id := range m.vectors {
-		ids = append(ids, id)
-	}
-	return
+// SetOp allows setting the mutation operation.
+func (m *AutoTagMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetVectors resets all changes to the "vectors" edge.
-func (m *MediaMutation) ResetVectors() {
-	m.vectors = nil
-	m.clearedvectors = false
-	m.removedvectors = nil
+// Type returns the node type of this mutation (AutoTag).
+func (m *AutoTagMutation) Type() string {
+	return m.typ
 }
 
-// AddMediaDateIDs adds the "media_dates" edge to the MediaDate entity by ids.
-func (m *MediaMutation) AddMediaDateIDs(ids ...int) {
-	if m.media_dates == nil {
-		m.media_dates = make(map[int]struct{})
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AutoTagMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.tag != nil {
+		fields = append(fields, autotag.FieldTagID)
 	}
-	for i := range ids {
-		m.media_dates[ids[i]] = struct{}{}
+	if m.prompt != nil {
+		fields = append(fields, autotag.FieldPrompt)
+	}
+	if m.threshold != nil {
+		fields = append(fields, autotag.FieldThreshold)
 	}
+	if m.embedding != nil {
+		fields = append(fields, autotag.FieldEmbedding)
+	}
+	if m.model != nil {
+		fields = append(fields, autotag.FieldModel)
+	}
+	if m.created_at != nil {
+		fields = append(fields, autotag.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, autotag.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearMediaDates clears the "media_dates" edge to the MediaDate entity.
-func (m *MediaMutation) ClearMediaDates() {
-	m.clearedmedia_dates = true
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AutoTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case autotag.FieldTagID:
+		return m.TagID()
+	case autotag.FieldPrompt:
+		return m.Prompt()
+	case autotag.FieldThreshold:
+		return m.Threshold()
+	case autotag.FieldEmbedding:
+		return m.Embedding()
+	case autotag.FieldModel:
+		return m.Model()
+	case autotag.FieldCreatedAt:
+		return m.CreatedAt()
+	case autotag.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// MediaDatesCleared reports if the "media_dates" edge to the MediaDate entity was cleared.
-func (m *MediaMutation) MediaDatesCleared() bool {
-	return m.clearedmedia_dates
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AutoTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case autotag.FieldTagID:
+		return m.OldTagID(ctx)
+	case autotag.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case autotag.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case autotag.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case autotag.FieldModel:
+		return m.OldModel(ctx)
+	case autotag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case autotag.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AutoTag field %s", name)
 }
 
-// RemoveMediaDateIDs removes the "media_dates" edge to the MediaDate entity by IDs.
-func (m *MediaMutation) RemoveMediaDateIDs(ids ...int) {
-	if m.removedmedia_dates == nil {
-		m.removedmedia_dates = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.media_dates, ids[i])
-		m.removedmedia_dates[ids[i]] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AutoTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case autotag.FieldTagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case autotag.FieldPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrompt(v)
+		return nil
+	case autotag.FieldThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreshold(v)
+		return nil
+	case autotag.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case autotag.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case autotag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case autotag.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
+	return fmt.Errorf("unknown AutoTag field %s", name)
 }
 
-// RemovedMediaDates returns the removed IDs of the "media_dates" edge to the MediaDate entity.
-func (m *MediaMutation) RemovedMediaDatesIDs() (ids []int) {
-	for id := range m.removedmedia_dates {
-		ids = append(ids, id)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AutoTagMutation) AddedFields() []string {
+	var fields []string
+	if m.addthreshold != nil {
+		fields = append(fields, autotag.FieldThreshold)
 	}
-	return
+	return fields
 }
 
-// MediaDatesIDs returns the "media_dates" edge IDs in the mutation.
-func (m *MediaMutation) MediaDatesIDs() (ids []int) {
-	for id := range m.media_dates {
-		ids = append(ids, id)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AutoTagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case autotag.FieldThreshold:
+		return m.AddedThreshold()
 	}
-	return
+	return nil, false
 }
 
-// ResetMediaDates resets all changes to the "media_dates" edge.
-func (m *MediaMutation) ResetMediaDates() {
-	m.media_dates = nil
-	m.clearedmedia_dates = false
-	m.removedmedia_dates = nil
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AutoTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case autotag.FieldThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThreshold(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AutoTag numeric field %s", name)
 }
 
-// AddMediaVectorIDs adds the "media_vectors" edge to the MediaVector entity by ids.
-func (m *MediaMutation) AddMediaVectorIDs(ids ...int) {
-	if m.media_vectors == nil {
-		m.media_vectors = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.media_vectors[ids[i]] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AutoTagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(autotag.FieldEmbedding) {
+		fields = append(fields, autotag.FieldEmbedding)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AutoTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AutoTagMutation) ClearField(name string) error {
+	switch name {
+	case autotag.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	}
+	return fmt.Errorf("unknown AutoTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AutoTagMutation) ResetField(name string) error {
+	switch name {
+	case autotag.FieldTagID:
+		m.ResetTagID()
+		return nil
+	case autotag.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case autotag.FieldThreshold:
+		m.ResetThreshold()
+		return nil
+	case autotag.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case autotag.FieldModel:
+		m.ResetModel()
+		return nil
+	case autotag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case autotag.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AutoTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AutoTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tag != nil {
+		edges = append(edges, autotag.EdgeTag)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AutoTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case autotag.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AutoTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AutoTagMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AutoTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtag {
+		edges = append(edges, autotag.EdgeTag)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AutoTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case autotag.EdgeTag:
+		return m.clearedtag
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AutoTagMutation) ClearEdge(name string) error {
+	switch name {
+	case autotag.EdgeTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown AutoTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AutoTagMutation) ResetEdge(name string) error {
+	switch name {
+	case autotag.EdgeTag:
+		m.ResetTag()
+		return nil
+	}
+	return fmt.Errorf("unknown AutoTag edge %s", name)
+}
+
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	author        *string
+	body          *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	media         *string
+	clearedmedia  bool
+	done          bool
+	oldValue      func(context.Context) (*Comment, error)
+	predicates    []predicate.Comment
+}
+
+var _ ent.Mutation = (*CommentMutation)(nil)
+
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
+
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeComment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id int) commentOption {
+	return func(m *CommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Comment
+		)
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Comment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *CommentMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *CommentMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *CommentMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *CommentMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *CommentMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *CommentMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetBody sets the "body" field.
+func (m *CommentMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *CommentMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *CommentMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *CommentMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[comment.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *CommentMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *CommentMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.media != nil {
+		fields = append(fields, comment.FieldMediaID)
+	}
+	if m.author != nil {
+		fields = append(fields, comment.FieldAuthor)
+	}
+	if m.body != nil {
+		fields = append(fields, comment.FieldBody)
+	}
+	if m.created_at != nil {
+		fields = append(fields, comment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, comment.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldMediaID:
+		return m.MediaID()
+	case comment.FieldAuthor:
+		return m.Author()
+	case comment.FieldBody:
+		return m.Body()
+	case comment.FieldCreatedAt:
+		return m.CreatedAt()
+	case comment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case comment.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case comment.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case comment.FieldBody:
+		return m.OldBody(ctx)
+	case comment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case comment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Comment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case comment.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case comment.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case comment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case comment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Comment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Comment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentMutation) ResetField(name string) error {
+	switch name {
+	case comment.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case comment.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case comment.FieldBody:
+		m.ResetBody()
+		return nil
+	case comment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case comment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.media != nil {
+		edges = append(edges, comment.EdgeMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmedia {
+		edges = append(edges, comment.EdgeMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case comment.EdgeMedia:
+		return m.clearedmedia
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentMutation) ClearEdge(name string) error {
+	switch name {
+	case comment.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentMutation) ResetEdge(name string) error {
+	switch name {
+	case comment.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment edge %s", name)
+}
+
+// DateMutation represents an operation that mutates the Date nodes in the graph.
+type DateMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	clearedFields      map[string]struct{}
+	media              map[string]struct{}
+	removedmedia       map[string]struct{}
+	clearedmedia       bool
+	media_dates        map[int]struct{}
+	removedmedia_dates map[int]struct{}
+	clearedmedia_dates bool
+	done               bool
+	oldValue           func(context.Context) (*Date, error)
+	predicates         []predicate.Date
+}
+
+var _ ent.Mutation = (*DateMutation)(nil)
+
+// dateOption allows management of the mutation configuration using functional options.
+type dateOption func(*DateMutation)
+
+// newDateMutation creates new mutation for the Date entity.
+func newDateMutation(c config, op Op, opts ...dateOption) *DateMutation {
+	m := &DateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDateID sets the ID field of the mutation.
+func withDateID(id int) dateOption {
+	return func(m *DateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Date
+		)
+		m.oldValue = func(ctx context.Context) (*Date, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Date.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDate sets the old Date of the mutation.
+func withDate(node *Date) dateOption {
+	return func(m *DateMutation) {
+		m.oldValue = func(context.Context) (*Date, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Date entities.
+func (m *DateMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Date.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Date entity.
+// If the Date object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DateMutation) ResetName() {
+	m.name = nil
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by ids.
+func (m *DateMutation) AddMediumIDs(ids ...string) {
+	if m.media == nil {
+		m.media = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *DateMutation) ClearMedia() {
+	m.clearedmedia = true
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *DateMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
+func (m *DateMutation) RemoveMediumIDs(ids ...string) {
+	if m.removedmedia == nil {
+		m.removedmedia = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.media, ids[i])
+		m.removedmedia[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
+func (m *DateMutation) RemovedMediaIDs() (ids []string) {
+	for id := range m.removedmedia {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+func (m *DateMutation) MediaIDs() (ids []string) {
+	for id := range m.media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *DateMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+	m.removedmedia = nil
+}
+
+// AddMediaDateIDs adds the "media_dates" edge to the MediaDate entity by ids.
+func (m *DateMutation) AddMediaDateIDs(ids ...int) {
+	if m.media_dates == nil {
+		m.media_dates = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.media_dates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMediaDates clears the "media_dates" edge to the MediaDate entity.
+func (m *DateMutation) ClearMediaDates() {
+	m.clearedmedia_dates = true
+}
+
+// MediaDatesCleared reports if the "media_dates" edge to the MediaDate entity was cleared.
+func (m *DateMutation) MediaDatesCleared() bool {
+	return m.clearedmedia_dates
+}
+
+// RemoveMediaDateIDs removes the "media_dates" edge to the MediaDate entity by IDs.
+func (m *DateMutation) RemoveMediaDateIDs(ids ...int) {
+	if m.removedmedia_dates == nil {
+		m.removedmedia_dates = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.media_dates, ids[i])
+		m.removedmedia_dates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMediaDates returns the removed IDs of the "media_dates" edge to the MediaDate entity.
+func (m *DateMutation) RemovedMediaDatesIDs() (ids []int) {
+	for id := range m.removedmedia_dates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaDatesIDs returns the "media_dates" edge IDs in the mutation.
+func (m *DateMutation) MediaDatesIDs() (ids []int) {
+	for id := range m.media_dates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMediaDates resets all changes to the "media_dates" edge.
+func (m *DateMutation) ResetMediaDates() {
+	m.media_dates = nil
+	m.clearedmedia_dates = false
+	m.removedmedia_dates = nil
+}
+
+// Where appends a list predicates to the DateMutation builder.
+func (m *DateMutation) Where(ps ...predicate.Date) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Date, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Date).
+func (m *DateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DateMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, date.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case date.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case date.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Date field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case date.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Date field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Date numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Date nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DateMutation) ResetField(name string) error {
+	switch name {
+	case date.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Date field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.media != nil {
+		edges = append(edges, date.EdgeMedia)
+	}
+	if m.media_dates != nil {
+		edges = append(edges, date.EdgeMediaDates)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case date.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.media))
+		for id := range m.media {
+			ids = append(ids, id)
+		}
+		return ids
+	case date.EdgeMediaDates:
+		ids := make([]ent.Value, 0, len(m.media_dates))
+		for id := range m.media_dates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmedia != nil {
+		edges = append(edges, date.EdgeMedia)
+	}
+	if m.removedmedia_dates != nil {
+		edges = append(edges, date.EdgeMediaDates)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case date.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.removedmedia))
+		for id := range m.removedmedia {
+			ids = append(ids, id)
+		}
+		return ids
+	case date.EdgeMediaDates:
+		ids := make([]ent.Value, 0, len(m.removedmedia_dates))
+		for id := range m.removedmedia_dates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmedia {
+		edges = append(edges, date.EdgeMedia)
+	}
+	if m.clearedmedia_dates {
+		edges = append(edges, date.EdgeMediaDates)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case date.EdgeMedia:
+		return m.clearedmedia
+	case date.EdgeMediaDates:
+		return m.clearedmedia_dates
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DateMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Date unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DateMutation) ResetEdge(name string) error {
+	switch name {
+	case date.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case date.EdgeMediaDates:
+		m.ResetMediaDates()
+		return nil
+	}
+	return fmt.Errorf("unknown Date edge %s", name)
+}
+
+// FavoriteMutation represents an operation that mutates the Favorite nodes in the graph.
+type FavoriteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	actor         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	media         *string
+	clearedmedia  bool
+	done          bool
+	oldValue      func(context.Context) (*Favorite, error)
+	predicates    []predicate.Favorite
+}
+
+var _ ent.Mutation = (*FavoriteMutation)(nil)
+
+// favoriteOption allows management of the mutation configuration using functional options.
+type favoriteOption func(*FavoriteMutation)
+
+// newFavoriteMutation creates new mutation for the Favorite entity.
+func newFavoriteMutation(c config, op Op, opts ...favoriteOption) *FavoriteMutation {
+	m := &FavoriteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFavorite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFavoriteID sets the ID field of the mutation.
+func withFavoriteID(id int) favoriteOption {
+	return func(m *FavoriteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Favorite
+		)
+		m.oldValue = func(ctx context.Context) (*Favorite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Favorite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFavorite sets the old Favorite of the mutation.
+func withFavorite(node *Favorite) favoriteOption {
+	return func(m *FavoriteMutation) {
+		m.oldValue = func(context.Context) (*Favorite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FavoriteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FavoriteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FavoriteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FavoriteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Favorite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetActor sets the "actor" field.
+func (m *FavoriteMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *FavoriteMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the Favorite entity.
+// If the Favorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FavoriteMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *FavoriteMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *FavoriteMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *FavoriteMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the Favorite entity.
+// If the Favorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FavoriteMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *FavoriteMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FavoriteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FavoriteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Favorite entity.
+// If the Favorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FavoriteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FavoriteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *FavoriteMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[favorite.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *FavoriteMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *FavoriteMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *FavoriteMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// Where appends a list predicates to the FavoriteMutation builder.
+func (m *FavoriteMutation) Where(ps ...predicate.Favorite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FavoriteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FavoriteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Favorite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FavoriteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FavoriteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Favorite).
+func (m *FavoriteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FavoriteMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.actor != nil {
+		fields = append(fields, favorite.FieldActor)
+	}
+	if m.media != nil {
+		fields = append(fields, favorite.FieldMediaID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, favorite.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FavoriteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case favorite.FieldActor:
+		return m.Actor()
+	case favorite.FieldMediaID:
+		return m.MediaID()
+	case favorite.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FavoriteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case favorite.FieldActor:
+		return m.OldActor(ctx)
+	case favorite.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case favorite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Favorite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FavoriteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case favorite.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case favorite.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case favorite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Favorite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FavoriteMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FavoriteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FavoriteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Favorite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FavoriteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FavoriteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FavoriteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Favorite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FavoriteMutation) ResetField(name string) error {
+	switch name {
+	case favorite.FieldActor:
+		m.ResetActor()
+		return nil
+	case favorite.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case favorite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Favorite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FavoriteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.media != nil {
+		edges = append(edges, favorite.EdgeMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FavoriteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case favorite.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FavoriteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FavoriteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FavoriteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmedia {
+		edges = append(edges, favorite.EdgeMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FavoriteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case favorite.EdgeMedia:
+		return m.clearedmedia
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FavoriteMutation) ClearEdge(name string) error {
+	switch name {
+	case favorite.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Favorite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FavoriteMutation) ResetEdge(name string) error {
+	switch name {
+	case favorite.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Favorite edge %s", name)
+}
+
+// HiddenTagFilterMutation represents an operation that mutates the HiddenTagFilter nodes in the graph.
+type HiddenTagFilterMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	value         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*HiddenTagFilter, error)
+	predicates    []predicate.HiddenTagFilter
+}
+
+var _ ent.Mutation = (*HiddenTagFilterMutation)(nil)
+
+// hiddentagfilterOption allows management of the mutation configuration using functional options.
+type hiddentagfilterOption func(*HiddenTagFilterMutation)
+
+// newHiddenTagFilterMutation creates new mutation for the HiddenTagFilter entity.
+func newHiddenTagFilterMutation(c config, op Op, opts ...hiddentagfilterOption) *HiddenTagFilterMutation {
+	m := &HiddenTagFilterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHiddenTagFilter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHiddenTagFilterID sets the ID field of the mutation.
+func withHiddenTagFilterID(id int) hiddentagfilterOption {
+	return func(m *HiddenTagFilterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HiddenTagFilter
+		)
+		m.oldValue = func(ctx context.Context) (*HiddenTagFilter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HiddenTagFilter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHiddenTagFilter sets the old HiddenTagFilter of the mutation.
+func withHiddenTagFilter(node *HiddenTagFilter) hiddentagfilterOption {
+	return func(m *HiddenTagFilterMutation) {
+		m.oldValue = func(context.Context) (*HiddenTagFilter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HiddenTagFilterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HiddenTagFilterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HiddenTagFilterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HiddenTagFilterMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HiddenTagFilter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValue sets the "value" field.
+func (m *HiddenTagFilterMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *HiddenTagFilterMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the HiddenTagFilter entity.
+// If the HiddenTagFilter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HiddenTagFilterMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *HiddenTagFilterMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HiddenTagFilterMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HiddenTagFilterMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the HiddenTagFilter entity.
+// If the HiddenTagFilter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HiddenTagFilterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HiddenTagFilterMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HiddenTagFilterMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HiddenTagFilterMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the HiddenTagFilter entity.
+// If the HiddenTagFilter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HiddenTagFilterMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HiddenTagFilterMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the HiddenTagFilterMutation builder.
+func (m *HiddenTagFilterMutation) Where(ps ...predicate.HiddenTagFilter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HiddenTagFilterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HiddenTagFilterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HiddenTagFilter, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HiddenTagFilterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HiddenTagFilterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HiddenTagFilter).
+func (m *HiddenTagFilterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HiddenTagFilterMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.value != nil {
+		fields = append(fields, hiddentagfilter.FieldValue)
+	}
+	if m.created_at != nil {
+		fields = append(fields, hiddentagfilter.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, hiddentagfilter.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HiddenTagFilterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hiddentagfilter.FieldValue:
+		return m.Value()
+	case hiddentagfilter.FieldCreatedAt:
+		return m.CreatedAt()
+	case hiddentagfilter.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HiddenTagFilterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hiddentagfilter.FieldValue:
+		return m.OldValue(ctx)
+	case hiddentagfilter.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case hiddentagfilter.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HiddenTagFilter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HiddenTagFilterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hiddentagfilter.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case hiddentagfilter.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case hiddentagfilter.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HiddenTagFilter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HiddenTagFilterMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HiddenTagFilterMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HiddenTagFilterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HiddenTagFilter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HiddenTagFilterMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HiddenTagFilterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HiddenTagFilterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HiddenTagFilter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HiddenTagFilterMutation) ResetField(name string) error {
+	switch name {
+	case hiddentagfilter.FieldValue:
+		m.ResetValue()
+		return nil
+	case hiddentagfilter.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case hiddentagfilter.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HiddenTagFilter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HiddenTagFilterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HiddenTagFilterMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HiddenTagFilterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HiddenTagFilterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HiddenTagFilterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HiddenTagFilterMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HiddenTagFilterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown HiddenTagFilter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HiddenTagFilterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown HiddenTagFilter edge %s", name)
+}
+
+// MediaMutation represents an operation that mutates the Media nodes in the graph.
+type MediaMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	format               *string
+	width                *int16
+	addwidth             *int16
+	height               *int16
+	addheight            *int16
+	duration             *int32
+	addduration          *int32
+	frames               *int32
+	addframes            *int32
+	bitrate              *int
+	addbitrate           *int
+	video_codec          *string
+	audio_codec          *string
+	fps                  *float64
+	addfps               *float64
+	has_audio            *bool
+	rotation             *int16
+	addrotation          *int16
+	version              *int
+	addversion           *int
+	rating               *media.Rating
+	score                *int
+	addscore             *int
+	fav_count            *int
+	addfav_count         *int
+	title                *string
+	description          *string
+	deleted_at           *time.Time
+	clearedFields        map[string]struct{}
+	tags                 map[int]struct{}
+	removedtags          map[int]struct{}
+	clearedtags          bool
+	dates                map[int]struct{}
+	removeddates         map[int]struct{}
+	cleareddates         bool
+	vectors              map[int]struct{}
+	removedvectors       map[int]struct{}
+	clearedvectors       bool
+	meta_fields          map[int]struct{}
+	removedmeta_fields   map[int]struct{}
+	clearedmeta_fields   bool
+	pools                map[int]struct{}
+	removedpools         map[int]struct{}
+	clearedpools         bool
+	favorites            map[int]struct{}
+	removedfavorites     map[int]struct{}
+	clearedfavorites     bool
+	comments             map[int]struct{}
+	removedcomments      map[int]struct{}
+	clearedcomments      bool
+	notes                map[int]struct{}
+	removednotes         map[int]struct{}
+	clearednotes         bool
+	sources              map[int]struct{}
+	removedsources       map[int]struct{}
+	clearedsources       bool
+	media_dates          map[int]struct{}
+	removedmedia_dates   map[int]struct{}
+	clearedmedia_dates   bool
+	media_vectors        map[int]struct{}
+	removedmedia_vectors map[int]struct{}
+	clearedmedia_vectors bool
+	media_fields         map[int]struct{}
+	removedmedia_fields  map[int]struct{}
+	clearedmedia_fields  bool
+	pool_media           map[int]struct{}
+	removedpool_media    map[int]struct{}
+	clearedpool_media    bool
+	done                 bool
+	oldValue             func(context.Context) (*Media, error)
+	predicates           []predicate.Media
+}
+
+var _ ent.Mutation = (*MediaMutation)(nil)
+
+// mediaOption allows management of the mutation configuration using functional options.
+type mediaOption func(*MediaMutation)
+
+// newMediaMutation creates new mutation for the Media entity.
+func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
+	m := &MediaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMedia,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaID sets the ID field of the mutation.
+func withMediaID(id string) mediaOption {
+	return func(m *MediaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Media
+		)
+		m.oldValue = func(ctx context.Context) (*Media, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Media.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMedia sets the old Media of the mutation.
+func withMedia(node *Media) mediaOption {
+	return func(m *MediaMutation) {
+		m.oldValue = func(context.Context) (*Media, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Media entities.
+func (m *MediaMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Media.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFormat sets the "format" field.
+func (m *MediaMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *MediaMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *MediaMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *MediaMutation) SetWidth(i int16) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *MediaMutation) Width() (r int16, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldWidth(ctx context.Context) (v int16, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *MediaMutation) AddWidth(i int16) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *MediaMutation) AddedWidth() (r int16, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *MediaMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *MediaMutation) SetHeight(i int16) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *MediaMutation) Height() (r int16, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldHeight(ctx context.Context) (v int16, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *MediaMutation) AddHeight(i int16) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *MediaMutation) AddedHeight() (r int16, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *MediaMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetDuration sets the "duration" field.
+func (m *MediaMutation) SetDuration(i int32) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *MediaMutation) Duration() (r int32, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldDuration(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds i to the "duration" field.
+func (m *MediaMutation) AddDuration(i int32) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *MediaMutation) AddedDuration() (r int32, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (m *MediaMutation) ClearDuration() {
+	m.duration = nil
+	m.addduration = nil
+	m.clearedFields[media.FieldDuration] = struct{}{}
+}
+
+// DurationCleared returns if the "duration" field was cleared in this mutation.
+func (m *MediaMutation) DurationCleared() bool {
+	_, ok := m.clearedFields[media.FieldDuration]
+	return ok
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *MediaMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+	delete(m.clearedFields, media.FieldDuration)
+}
+
+// SetFrames sets the "frames" field.
+func (m *MediaMutation) SetFrames(i int32) {
+	m.frames = &i
+	m.addframes = nil
+}
+
+// Frames returns the value of the "frames" field in the mutation.
+func (m *MediaMutation) Frames() (r int32, exists bool) {
+	v := m.frames
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrames returns the old "frames" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldFrames(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrames: %w", err)
+	}
+	return oldValue.Frames, nil
+}
+
+// AddFrames adds i to the "frames" field.
+func (m *MediaMutation) AddFrames(i int32) {
+	if m.addframes != nil {
+		*m.addframes += i
+	} else {
+		m.addframes = &i
+	}
+}
+
+// AddedFrames returns the value that was added to the "frames" field in this mutation.
+func (m *MediaMutation) AddedFrames() (r int32, exists bool) {
+	v := m.addframes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFrames clears the value of the "frames" field.
+func (m *MediaMutation) ClearFrames() {
+	m.frames = nil
+	m.addframes = nil
+	m.clearedFields[media.FieldFrames] = struct{}{}
+}
+
+// FramesCleared returns if the "frames" field was cleared in this mutation.
+func (m *MediaMutation) FramesCleared() bool {
+	_, ok := m.clearedFields[media.FieldFrames]
+	return ok
+}
+
+// ResetFrames resets all changes to the "frames" field.
+func (m *MediaMutation) ResetFrames() {
+	m.frames = nil
+	m.addframes = nil
+	delete(m.clearedFields, media.FieldFrames)
+}
+
+// SetBitrate sets the "bitrate" field.
+func (m *MediaMutation) SetBitrate(i int) {
+	m.bitrate = &i
+	m.addbitrate = nil
+}
+
+// Bitrate returns the value of the "bitrate" field in the mutation.
+func (m *MediaMutation) Bitrate() (r int, exists bool) {
+	v := m.bitrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBitrate returns the old "bitrate" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldBitrate(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBitrate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBitrate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBitrate: %w", err)
+	}
+	return oldValue.Bitrate, nil
+}
+
+// AddBitrate adds i to the "bitrate" field.
+func (m *MediaMutation) AddBitrate(i int) {
+	if m.addbitrate != nil {
+		*m.addbitrate += i
+	} else {
+		m.addbitrate = &i
+	}
+}
+
+// AddedBitrate returns the value that was added to the "bitrate" field in this mutation.
+func (m *MediaMutation) AddedBitrate() (r int, exists bool) {
+	v := m.addbitrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBitrate clears the value of the "bitrate" field.
+func (m *MediaMutation) ClearBitrate() {
+	m.bitrate = nil
+	m.addbitrate = nil
+	m.clearedFields[media.FieldBitrate] = struct{}{}
+}
+
+// BitrateCleared returns if the "bitrate" field was cleared in this mutation.
+func (m *MediaMutation) BitrateCleared() bool {
+	_, ok := m.clearedFields[media.FieldBitrate]
+	return ok
+}
+
+// ResetBitrate resets all changes to the "bitrate" field.
+func (m *MediaMutation) ResetBitrate() {
+	m.bitrate = nil
+	m.addbitrate = nil
+	delete(m.clearedFields, media.FieldBitrate)
+}
+
+// SetVideoCodec sets the "video_codec" field.
+func (m *MediaMutation) SetVideoCodec(s string) {
+	m.video_codec = &s
+}
+
+// VideoCodec returns the value of the "video_codec" field in the mutation.
+func (m *MediaMutation) VideoCodec() (r string, exists bool) {
+	v := m.video_codec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVideoCodec returns the old "video_codec" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldVideoCodec(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVideoCodec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVideoCodec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVideoCodec: %w", err)
+	}
+	return oldValue.VideoCodec, nil
+}
+
+// ClearVideoCodec clears the value of the "video_codec" field.
+func (m *MediaMutation) ClearVideoCodec() {
+	m.video_codec = nil
+	m.clearedFields[media.FieldVideoCodec] = struct{}{}
+}
+
+// VideoCodecCleared returns if the "video_codec" field was cleared in this mutation.
+func (m *MediaMutation) VideoCodecCleared() bool {
+	_, ok := m.clearedFields[media.FieldVideoCodec]
+	return ok
+}
+
+// ResetVideoCodec resets all changes to the "video_codec" field.
+func (m *MediaMutation) ResetVideoCodec() {
+	m.video_codec = nil
+	delete(m.clearedFields, media.FieldVideoCodec)
+}
+
+// SetAudioCodec sets the "audio_codec" field.
+func (m *MediaMutation) SetAudioCodec(s string) {
+	m.audio_codec = &s
+}
+
+// AudioCodec returns the value of the "audio_codec" field in the mutation.
+func (m *MediaMutation) AudioCodec() (r string, exists bool) {
+	v := m.audio_codec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudioCodec returns the old "audio_codec" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldAudioCodec(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudioCodec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudioCodec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudioCodec: %w", err)
+	}
+	return oldValue.AudioCodec, nil
+}
+
+// ClearAudioCodec clears the value of the "audio_codec" field.
+func (m *MediaMutation) ClearAudioCodec() {
+	m.audio_codec = nil
+	m.clearedFields[media.FieldAudioCodec] = struct{}{}
+}
+
+// AudioCodecCleared returns if the "audio_codec" field was cleared in this mutation.
+func (m *MediaMutation) AudioCodecCleared() bool {
+	_, ok := m.clearedFields[media.FieldAudioCodec]
+	return ok
+}
+
+// ResetAudioCodec resets all changes to the "audio_codec" field.
+func (m *MediaMutation) ResetAudioCodec() {
+	m.audio_codec = nil
+	delete(m.clearedFields, media.FieldAudioCodec)
+}
+
+// SetFps sets the "fps" field.
+func (m *MediaMutation) SetFps(f float64) {
+	m.fps = &f
+	m.addfps = nil
+}
+
+// Fps returns the value of the "fps" field in the mutation.
+func (m *MediaMutation) Fps() (r float64, exists bool) {
+	v := m.fps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFps returns the old "fps" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldFps(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFps: %w", err)
+	}
+	return oldValue.Fps, nil
+}
+
+// AddFps adds f to the "fps" field.
+func (m *MediaMutation) AddFps(f float64) {
+	if m.addfps != nil {
+		*m.addfps += f
+	} else {
+		m.addfps = &f
+	}
+}
+
+// AddedFps returns the value that was added to the "fps" field in this mutation.
+func (m *MediaMutation) AddedFps() (r float64, exists bool) {
+	v := m.addfps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFps clears the value of the "fps" field.
+func (m *MediaMutation) ClearFps() {
+	m.fps = nil
+	m.addfps = nil
+	m.clearedFields[media.FieldFps] = struct{}{}
+}
+
+// FpsCleared returns if the "fps" field was cleared in this mutation.
+func (m *MediaMutation) FpsCleared() bool {
+	_, ok := m.clearedFields[media.FieldFps]
+	return ok
+}
+
+// ResetFps resets all changes to the "fps" field.
+func (m *MediaMutation) ResetFps() {
+	m.fps = nil
+	m.addfps = nil
+	delete(m.clearedFields, media.FieldFps)
+}
+
+// SetHasAudio sets the "has_audio" field.
+func (m *MediaMutation) SetHasAudio(b bool) {
+	m.has_audio = &b
+}
+
+// HasAudio returns the value of the "has_audio" field in the mutation.
+func (m *MediaMutation) HasAudio() (r bool, exists bool) {
+	v := m.has_audio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasAudio returns the old "has_audio" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldHasAudio(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasAudio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasAudio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasAudio: %w", err)
+	}
+	return oldValue.HasAudio, nil
+}
+
+// ClearHasAudio clears the value of the "has_audio" field.
+func (m *MediaMutation) ClearHasAudio() {
+	m.has_audio = nil
+	m.clearedFields[media.FieldHasAudio] = struct{}{}
+}
+
+// HasAudioCleared returns if the "has_audio" field was cleared in this mutation.
+func (m *MediaMutation) HasAudioCleared() bool {
+	_, ok := m.clearedFields[media.FieldHasAudio]
+	return ok
+}
+
+// ResetHasAudio resets all changes to the "has_audio" field.
+func (m *MediaMutation) ResetHasAudio() {
+	m.has_audio = nil
+	delete(m.clearedFields, media.FieldHasAudio)
+}
+
+// SetRotation sets the "rotation" field.
+func (m *MediaMutation) SetRotation(i int16) {
+	m.rotation = &i
+	m.addrotation = nil
+}
+
+// Rotation returns the value of the "rotation" field in the mutation.
+func (m *MediaMutation) Rotation() (r int16, exists bool) {
+	v := m.rotation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotation returns the old "rotation" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldRotation(ctx context.Context) (v *int16, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotation: %w", err)
+	}
+	return oldValue.Rotation, nil
+}
+
+// AddRotation adds i to the "rotation" field.
+func (m *MediaMutation) AddRotation(i int16) {
+	if m.addrotation != nil {
+		*m.addrotation += i
+	} else {
+		m.addrotation = &i
+	}
+}
+
+// AddedRotation returns the value that was added to the "rotation" field in this mutation.
+func (m *MediaMutation) AddedRotation() (r int16, exists bool) {
+	v := m.addrotation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRotation clears the value of the "rotation" field.
+func (m *MediaMutation) ClearRotation() {
+	m.rotation = nil
+	m.addrotation = nil
+	m.clearedFields[media.FieldRotation] = struct{}{}
+}
+
+// RotationCleared returns if the "rotation" field was cleared in this mutation.
+func (m *MediaMutation) RotationCleared() bool {
+	_, ok := m.clearedFields[media.FieldRotation]
+	return ok
+}
+
+// ResetRotation resets all changes to the "rotation" field.
+func (m *MediaMutation) ResetRotation() {
+	m.rotation = nil
+	m.addrotation = nil
+	delete(m.clearedFields, media.FieldRotation)
+}
+
+// SetVersion sets the "version" field.
+func (m *MediaMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *MediaMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *MediaMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *MediaMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *MediaMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *MediaMutation) SetRating(value media.Rating) {
+	m.rating = &value
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *MediaMutation) Rating() (r media.Rating, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldRating(ctx context.Context) (v *media.Rating, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// ClearRating clears the value of the "rating" field.
+func (m *MediaMutation) ClearRating() {
+	m.rating = nil
+	m.clearedFields[media.FieldRating] = struct{}{}
+}
+
+// RatingCleared returns if the "rating" field was cleared in this mutation.
+func (m *MediaMutation) RatingCleared() bool {
+	_, ok := m.clearedFields[media.FieldRating]
+	return ok
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *MediaMutation) ResetRating() {
+	m.rating = nil
+	delete(m.clearedFields, media.FieldRating)
+}
+
+// SetScore sets the "score" field.
+func (m *MediaMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *MediaMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *MediaMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *MediaMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *MediaMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetFavCount sets the "fav_count" field.
+func (m *MediaMutation) SetFavCount(i int) {
+	m.fav_count = &i
+	m.addfav_count = nil
+}
+
+// FavCount returns the value of the "fav_count" field in the mutation.
+func (m *MediaMutation) FavCount() (r int, exists bool) {
+	v := m.fav_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFavCount returns the old "fav_count" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldFavCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFavCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFavCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFavCount: %w", err)
+	}
+	return oldValue.FavCount, nil
+}
+
+// AddFavCount adds i to the "fav_count" field.
+func (m *MediaMutation) AddFavCount(i int) {
+	if m.addfav_count != nil {
+		*m.addfav_count += i
+	} else {
+		m.addfav_count = &i
+	}
+}
+
+// AddedFavCount returns the value that was added to the "fav_count" field in this mutation.
+func (m *MediaMutation) AddedFavCount() (r int, exists bool) {
+	v := m.addfav_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFavCount resets all changes to the "fav_count" field.
+func (m *MediaMutation) ResetFavCount() {
+	m.fav_count = nil
+	m.addfav_count = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *MediaMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *MediaMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *MediaMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[media.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *MediaMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[media.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *MediaMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, media.FieldTitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *MediaMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MediaMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MediaMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[media.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MediaMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[media.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MediaMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, media.FieldDescription)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MediaMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MediaMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MediaMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[media.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MediaMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[media.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MediaMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, media.FieldDeletedAt)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *MediaMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *MediaMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *MediaMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *MediaMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *MediaMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *MediaMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *MediaMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddDateIDs adds the "dates" edge to the Date entity by ids.
+func (m *MediaMutation) AddDateIDs(ids ...int) {
+	if m.dates == nil {
+		m.dates = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.dates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDates clears the "dates" edge to the Date entity.
+func (m *MediaMutation) ClearDates() {
+	m.cleareddates = true
+}
+
+// DatesCleared reports if the "dates" edge to the Date entity was cleared.
+func (m *MediaMutation) DatesCleared() bool {
+	return m.cleareddates
+}
+
+// RemoveDateIDs removes the "dates" edge to the Date entity by IDs.
+func (m *MediaMutation) RemoveDateIDs(ids ...int) {
+	if m.removeddates == nil {
+		m.removeddates = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.dates, ids[i])
+		m.removeddates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDates returns the removed IDs of the "dates" edge to the Date entity.
+func (m *MediaMutation) RemovedDatesIDs() (ids []int) {
+	for id := range m.removeddates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DatesIDs returns the "dates" edge IDs in the mutation.
+func (m *MediaMutation) DatesIDs() (ids []int) {
+	for id := range m.dates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDates resets all changes to the "dates" edge.
+func (m *MediaMutation) ResetDates() {
+	m.dates = nil
+	m.cleareddates = false
+	m.removeddates = nil
+}
+
+// AddVectorIDs adds the "vectors" edge to the Vector entity by ids.
+func (m *MediaMutation) AddVectorIDs(ids ...int) {
+	if m.vectors == nil {
+		m.vectors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.vectors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVectors clears the "vectors" edge to the Vector entity.
+func (m *MediaMutation) ClearVectors() {
+	m.clearedvectors = true
+}
+
+// VectorsCleared reports if the "vectors" edge to the Vector entity was cleared.
+func (m *MediaMutation) VectorsCleared() bool {
+	return m.clearedvectors
+}
+
+// RemoveVectorIDs removes the "vectors" edge to the Vector entity by IDs.
+func (m *MediaMutation) RemoveVectorIDs(ids ...int) {
+	if m.removedvectors == nil {
+		m.removedvectors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.vectors, ids[i])
+		m.removedvectors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVectors returns the removed IDs of the "vectors" edge to the Vector entity.
+func (m *MediaMutation) RemovedVectorsIDs() (ids []int) {
+	for id := range m.removedvectors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VectorsIDs returns the "vectors" edge IDs in the mutation.
+func (m *MediaMutation) VectorsIDs() (ids []int) {
+	for id := range m.vectors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVectors resets all changes to the "vectors" edge.
+func (m *MediaMutation) ResetVectors() {
+	m.vectors = nil
+	m.clearedvectors = false
+	m.removedvectors = nil
+}
+
+// AddMetaFieldIDs adds the "meta_fields" edge to the MetaField entity by ids.
+func (m *MediaMutation) AddMetaFieldIDs(ids ...int) {
+	if m.meta_fields == nil {
+		m.meta_fields = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.meta_fields[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMetaFields clears the "meta_fields" edge to the MetaField entity.
+func (m *MediaMutation) ClearMetaFields() {
+	m.clearedmeta_fields = true
+}
+
+// MetaFieldsCleared reports if the "meta_fields" edge to the MetaField entity was cleared.
+func (m *MediaMutation) MetaFieldsCleared() bool {
+	return m.clearedmeta_fields
+}
+
+// RemoveMetaFieldIDs removes the "meta_fields" edge to the MetaField entity by IDs.
+func (m *MediaMutation) RemoveMetaFieldIDs(ids ...int) {
+	if m.removedmeta_fields == nil {
+		m.removedmeta_fields = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.meta_fields, ids[i])
+		m.removedmeta_fields[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMetaFields returns the removed IDs of the "meta_fields" edge to the MetaField entity.
+func (m *MediaMutation) RemovedMetaFieldsIDs() (ids []int) {
+	for id := range m.removedmeta_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MetaFieldsIDs returns the "meta_fields" edge IDs in the mutation.
+func (m *MediaMutation) MetaFieldsIDs() (ids []int) {
+	for id := range m.meta_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMetaFields resets all changes to the "meta_fields" edge.
+func (m *MediaMutation) ResetMetaFields() {
+	m.meta_fields = nil
+	m.clearedmeta_fields = false
+	m.removedmeta_fields = nil
+}
+
+// AddPoolIDs adds the "pools" edge to the Pool entity by ids.
+func (m *MediaMutation) AddPoolIDs(ids ...int) {
+	if m.pools == nil {
+		m.pools = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pools[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPools clears the "pools" edge to the Pool entity.
+func (m *MediaMutation) ClearPools() {
+	m.clearedpools = true
+}
+
+// PoolsCleared reports if the "pools" edge to the Pool entity was cleared.
+func (m *MediaMutation) PoolsCleared() bool {
+	return m.clearedpools
+}
+
+// RemovePoolIDs removes the "pools" edge to the Pool entity by IDs.
+func (m *MediaMutation) RemovePoolIDs(ids ...int) {
+	if m.removedpools == nil {
+		m.removedpools = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pools, ids[i])
+		m.removedpools[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPools returns the removed IDs of the "pools" edge to the Pool entity.
+func (m *MediaMutation) RemovedPoolsIDs() (ids []int) {
+	for id := range m.removedpools {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PoolsIDs returns the "pools" edge IDs in the mutation.
+func (m *MediaMutation) PoolsIDs() (ids []int) {
+	for id := range m.pools {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPools resets all changes to the "pools" edge.
+func (m *MediaMutation) ResetPools() {
+	m.pools = nil
+	m.clearedpools = false
+	m.removedpools = nil
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the Favorite entity by ids.
+func (m *MediaMutation) AddFavoriteIDs(ids ...int) {
+	if m.favorites == nil {
+		m.favorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavorites clears the "favorites" edge to the Favorite entity.
+func (m *MediaMutation) ClearFavorites() {
+	m.clearedfavorites = true
+}
+
+// FavoritesCleared reports if the "favorites" edge to the Favorite entity was cleared.
+func (m *MediaMutation) FavoritesCleared() bool {
+	return m.clearedfavorites
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to the Favorite entity by IDs.
+func (m *MediaMutation) RemoveFavoriteIDs(ids ...int) {
+	if m.removedfavorites == nil {
+		m.removedfavorites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorites, ids[i])
+		m.removedfavorites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavorites returns the removed IDs of the "favorites" edge to the Favorite entity.
+func (m *MediaMutation) RemovedFavoritesIDs() (ids []int) {
+	for id := range m.removedfavorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoritesIDs returns the "favorites" edge IDs in the mutation.
+func (m *MediaMutation) FavoritesIDs() (ids []int) {
+	for id := range m.favorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavorites resets all changes to the "favorites" edge.
+func (m *MediaMutation) ResetFavorites() {
+	m.favorites = nil
+	m.clearedfavorites = false
+	m.removedfavorites = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *MediaMutation) AddCommentIDs(ids ...int) {
+	if m.comments == nil {
+		m.comments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *MediaMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *MediaMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *MediaMutation) RemoveCommentIDs(ids ...int) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *MediaMutation) RemovedCommentsIDs() (ids []int) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *MediaMutation) CommentsIDs() (ids []int) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *MediaMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by ids.
+func (m *MediaMutation) AddNoteIDs(ids ...int) {
+	if m.notes == nil {
+		m.notes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotes clears the "notes" edge to the Note entity.
+func (m *MediaMutation) ClearNotes() {
+	m.clearednotes = true
+}
+
+// NotesCleared reports if the "notes" edge to the Note entity was cleared.
+func (m *MediaMutation) NotesCleared() bool {
+	return m.clearednotes
+}
+
+// RemoveNoteIDs removes the "notes" edge to the Note entity by IDs.
+func (m *MediaMutation) RemoveNoteIDs(ids ...int) {
+	if m.removednotes == nil {
+		m.removednotes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notes, ids[i])
+		m.removednotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotes returns the removed IDs of the "notes" edge to the Note entity.
+func (m *MediaMutation) RemovedNotesIDs() (ids []int) {
+	for id := range m.removednotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotesIDs returns the "notes" edge IDs in the mutation.
+func (m *MediaMutation) NotesIDs() (ids []int) {
+	for id := range m.notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotes resets all changes to the "notes" edge.
+func (m *MediaMutation) ResetNotes() {
+	m.notes = nil
+	m.clearednotes = false
+	m.removednotes = nil
+}
+
+// AddSourceIDs adds the "sources" edge to the Source entity by ids.
+func (m *MediaMutation) AddSourceIDs(ids ...int) {
+	if m.sources == nil {
+		m.sources = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sources[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSources clears the "sources" edge to the Source entity.
+func (m *MediaMutation) ClearSources() {
+	m.clearedsources = true
+}
+
+// SourcesCleared reports if the "sources" edge to the Source entity was cleared.
+func (m *MediaMutation) SourcesCleared() bool {
+	return m.clearedsources
+}
+
+// RemoveSourceIDs removes the "sources" edge to the Source entity by IDs.
+func (m *MediaMutation) RemoveSourceIDs(ids ...int) {
+	if m.removedsources == nil {
+		m.removedsources = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sources, ids[i])
+		m.removedsources[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSources returns the removed IDs of the "sources" edge to the Source entity.
+func (m *MediaMutation) RemovedSourcesIDs() (ids []int) {
+	for id := range m.removedsources {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SourcesIDs returns the "sources" edge IDs in the mutation.
+func (m *MediaMutation) SourcesIDs() (ids []int) {
+	for id := range m.sources {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSources resets all changes to the "sources" edge.
+func (m *MediaMutation) ResetSources() {
+	m.sources = nil
+	m.clearedsources = false
+	m.removedsources = nil
+}
+
+// AddMediaDateIDs adds the "media_dates" edge to the MediaDate entity by ids.
+func (m *MediaMutation) AddMediaDateIDs(ids ...int) {
+	if m.media_dates == nil {
+		m.media_dates = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.media_dates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMediaDates clears the "media_dates" edge to the MediaDate entity.
+func (m *MediaMutation) ClearMediaDates() {
+	m.clearedmedia_dates = true
+}
+
+// MediaDatesCleared reports if the "media_dates" edge to the MediaDate entity was cleared.
+func (m *MediaMutation) MediaDatesCleared() bool {
+	return m.clearedmedia_dates
+}
+
+// RemoveMediaDateIDs removes the "media_dates" edge to the MediaDate entity by IDs.
+func (m *MediaMutation) RemoveMediaDateIDs(ids ...int) {
+	if m.removedmedia_dates == nil {
+		m.removedmedia_dates = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.media_dates, ids[i])
+		m.removedmedia_dates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMediaDates returns the removed IDs of the "media_dates" edge to the MediaDate entity.
+func (m *MediaMutation) RemovedMediaDatesIDs() (ids []int) {
+	for id := range m.removedmedia_dates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaDatesIDs returns the "media_dates" edge IDs in the mutation.
+func (m *MediaMutation) MediaDatesIDs() (ids []int) {
+	for id := range m.media_dates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMediaDates resets all changes to the "media_dates" edge.
+func (m *MediaMutation) ResetMediaDates() {
+	m.media_dates = nil
+	m.clearedmedia_dates = false
+	m.removedmedia_dates = nil
+}
+
+// AddMediaVectorIDs adds the "media_vectors" edge to the MediaVector entity by ids.
+func (m *MediaMutation) AddMediaVectorIDs(ids ...int) {
+	if m.media_vectors == nil {
+		m.media_vectors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.media_vectors[ids[i]] = struct{}{}
 	}
 }
 
@@ -1571,54 +5547,8698 @@ func (m *MediaMutation) ClearMediaVectors() {
 	m.clearedmedia_vectors = true
 }
 
-// MediaVectorsCleared reports if the "media_vectors" edge to the MediaVector entity was cleared.
-func (m *MediaMutation) MediaVectorsCleared() bool {
-	return m.clearedmedia_vectors
+// MediaVectorsCleared reports if the "media_vectors" edge to the MediaVector entity was cleared.
+func (m *MediaMutation) MediaVectorsCleared() bool {
+	return m.clearedmedia_vectors
+}
+
+// RemoveMediaVectorIDs removes the "media_vectors" edge to the MediaVector entity by IDs.
+func (m *MediaMutation) RemoveMediaVectorIDs(ids ...int) {
+	if m.removedmedia_vectors == nil {
+		m.removedmedia_vectors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.media_vectors, ids[i])
+		m.removedmedia_vectors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMediaVectors returns the removed IDs of the "media_vectors" edge to the MediaVector entity.
+func (m *MediaMutation) RemovedMediaVectorsIDs() (ids []int) {
+	for id := range m.removedmedia_vectors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaVectorsIDs returns the "media_vectors" edge IDs in the mutation.
+func (m *MediaMutation) MediaVectorsIDs() (ids []int) {
+	for id := range m.media_vectors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMediaVectors resets all changes to the "media_vectors" edge.
+func (m *MediaMutation) ResetMediaVectors() {
+	m.media_vectors = nil
+	m.clearedmedia_vectors = false
+	m.removedmedia_vectors = nil
+}
+
+// AddMediaFieldIDs adds the "media_fields" edge to the MediaField entity by ids.
+func (m *MediaMutation) AddMediaFieldIDs(ids ...int) {
+	if m.media_fields == nil {
+		m.media_fields = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.media_fields[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMediaFields clears the "media_fields" edge to the MediaField entity.
+func (m *MediaMutation) ClearMediaFields() {
+	m.clearedmedia_fields = true
+}
+
+// MediaFieldsCleared reports if the "media_fields" edge to the MediaField entity was cleared.
+func (m *MediaMutation) MediaFieldsCleared() bool {
+	return m.clearedmedia_fields
+}
+
+// RemoveMediaFieldIDs removes the "media_fields" edge to the MediaField entity by IDs.
+func (m *MediaMutation) RemoveMediaFieldIDs(ids ...int) {
+	if m.removedmedia_fields == nil {
+		m.removedmedia_fields = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.media_fields, ids[i])
+		m.removedmedia_fields[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMediaFields returns the removed IDs of the "media_fields" edge to the MediaField entity.
+func (m *MediaMutation) RemovedMediaFieldsIDs() (ids []int) {
+	for id := range m.removedmedia_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaFieldsIDs returns the "media_fields" edge IDs in the mutation.
+func (m *MediaMutation) MediaFieldsIDs() (ids []int) {
+	for id := range m.media_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMediaFields resets all changes to the "media_fields" edge.
+func (m *MediaMutation) ResetMediaFields() {
+	m.media_fields = nil
+	m.clearedmedia_fields = false
+	m.removedmedia_fields = nil
+}
+
+// AddPoolMediumIDs adds the "pool_media" edge to the PoolMedia entity by ids.
+func (m *MediaMutation) AddPoolMediumIDs(ids ...int) {
+	if m.pool_media == nil {
+		m.pool_media = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pool_media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPoolMedia clears the "pool_media" edge to the PoolMedia entity.
+func (m *MediaMutation) ClearPoolMedia() {
+	m.clearedpool_media = true
+}
+
+// PoolMediaCleared reports if the "pool_media" edge to the PoolMedia entity was cleared.
+func (m *MediaMutation) PoolMediaCleared() bool {
+	return m.clearedpool_media
+}
+
+// RemovePoolMediumIDs removes the "pool_media" edge to the PoolMedia entity by IDs.
+func (m *MediaMutation) RemovePoolMediumIDs(ids ...int) {
+	if m.removedpool_media == nil {
+		m.removedpool_media = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pool_media, ids[i])
+		m.removedpool_media[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPoolMedia returns the removed IDs of the "pool_media" edge to the PoolMedia entity.
+func (m *MediaMutation) RemovedPoolMediaIDs() (ids []int) {
+	for id := range m.removedpool_media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PoolMediaIDs returns the "pool_media" edge IDs in the mutation.
+func (m *MediaMutation) PoolMediaIDs() (ids []int) {
+	for id := range m.pool_media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPoolMedia resets all changes to the "pool_media" edge.
+func (m *MediaMutation) ResetPoolMedia() {
+	m.pool_media = nil
+	m.clearedpool_media = false
+	m.removedpool_media = nil
+}
+
+// Where appends a list predicates to the MediaMutation builder.
+func (m *MediaMutation) Where(ps ...predicate.Media) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Media, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Media).
+func (m *MediaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.format != nil {
+		fields = append(fields, media.FieldFormat)
+	}
+	if m.width != nil {
+		fields = append(fields, media.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, media.FieldHeight)
+	}
+	if m.duration != nil {
+		fields = append(fields, media.FieldDuration)
+	}
+	if m.frames != nil {
+		fields = append(fields, media.FieldFrames)
+	}
+	if m.bitrate != nil {
+		fields = append(fields, media.FieldBitrate)
+	}
+	if m.video_codec != nil {
+		fields = append(fields, media.FieldVideoCodec)
+	}
+	if m.audio_codec != nil {
+		fields = append(fields, media.FieldAudioCodec)
+	}
+	if m.fps != nil {
+		fields = append(fields, media.FieldFps)
+	}
+	if m.has_audio != nil {
+		fields = append(fields, media.FieldHasAudio)
+	}
+	if m.rotation != nil {
+		fields = append(fields, media.FieldRotation)
+	}
+	if m.version != nil {
+		fields = append(fields, media.FieldVersion)
+	}
+	if m.rating != nil {
+		fields = append(fields, media.FieldRating)
+	}
+	if m.score != nil {
+		fields = append(fields, media.FieldScore)
+	}
+	if m.fav_count != nil {
+		fields = append(fields, media.FieldFavCount)
+	}
+	if m.title != nil {
+		fields = append(fields, media.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, media.FieldDescription)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, media.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldFormat:
+		return m.Format()
+	case media.FieldWidth:
+		return m.Width()
+	case media.FieldHeight:
+		return m.Height()
+	case media.FieldDuration:
+		return m.Duration()
+	case media.FieldFrames:
+		return m.Frames()
+	case media.FieldBitrate:
+		return m.Bitrate()
+	case media.FieldVideoCodec:
+		return m.VideoCodec()
+	case media.FieldAudioCodec:
+		return m.AudioCodec()
+	case media.FieldFps:
+		return m.Fps()
+	case media.FieldHasAudio:
+		return m.HasAudio()
+	case media.FieldRotation:
+		return m.Rotation()
+	case media.FieldVersion:
+		return m.Version()
+	case media.FieldRating:
+		return m.Rating()
+	case media.FieldScore:
+		return m.Score()
+	case media.FieldFavCount:
+		return m.FavCount()
+	case media.FieldTitle:
+		return m.Title()
+	case media.FieldDescription:
+		return m.Description()
+	case media.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case media.FieldFormat:
+		return m.OldFormat(ctx)
+	case media.FieldWidth:
+		return m.OldWidth(ctx)
+	case media.FieldHeight:
+		return m.OldHeight(ctx)
+	case media.FieldDuration:
+		return m.OldDuration(ctx)
+	case media.FieldFrames:
+		return m.OldFrames(ctx)
+	case media.FieldBitrate:
+		return m.OldBitrate(ctx)
+	case media.FieldVideoCodec:
+		return m.OldVideoCodec(ctx)
+	case media.FieldAudioCodec:
+		return m.OldAudioCodec(ctx)
+	case media.FieldFps:
+		return m.OldFps(ctx)
+	case media.FieldHasAudio:
+		return m.OldHasAudio(ctx)
+	case media.FieldRotation:
+		return m.OldRotation(ctx)
+	case media.FieldVersion:
+		return m.OldVersion(ctx)
+	case media.FieldRating:
+		return m.OldRating(ctx)
+	case media.FieldScore:
+		return m.OldScore(ctx)
+	case media.FieldFavCount:
+		return m.OldFavCount(ctx)
+	case media.FieldTitle:
+		return m.OldTitle(ctx)
+	case media.FieldDescription:
+		return m.OldDescription(ctx)
+	case media.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Media field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case media.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case media.FieldWidth:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case media.FieldHeight:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case media.FieldDuration:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case media.FieldFrames:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrames(v)
+		return nil
+	case media.FieldBitrate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBitrate(v)
+		return nil
+	case media.FieldVideoCodec:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVideoCodec(v)
+		return nil
+	case media.FieldAudioCodec:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudioCodec(v)
+		return nil
+	case media.FieldFps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFps(v)
+		return nil
+	case media.FieldHasAudio:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasAudio(v)
+		return nil
+	case media.FieldRotation:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotation(v)
+		return nil
+	case media.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case media.FieldRating:
+		v, ok := value.(media.Rating)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case media.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case media.FieldFavCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFavCount(v)
+		return nil
+	case media.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case media.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case media.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaMutation) AddedFields() []string {
+	var fields []string
+	if m.addwidth != nil {
+		fields = append(fields, media.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, media.FieldHeight)
+	}
+	if m.addduration != nil {
+		fields = append(fields, media.FieldDuration)
+	}
+	if m.addframes != nil {
+		fields = append(fields, media.FieldFrames)
+	}
+	if m.addbitrate != nil {
+		fields = append(fields, media.FieldBitrate)
+	}
+	if m.addfps != nil {
+		fields = append(fields, media.FieldFps)
+	}
+	if m.addrotation != nil {
+		fields = append(fields, media.FieldRotation)
+	}
+	if m.addversion != nil {
+		fields = append(fields, media.FieldVersion)
+	}
+	if m.addscore != nil {
+		fields = append(fields, media.FieldScore)
+	}
+	if m.addfav_count != nil {
+		fields = append(fields, media.FieldFavCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldWidth:
+		return m.AddedWidth()
+	case media.FieldHeight:
+		return m.AddedHeight()
+	case media.FieldDuration:
+		return m.AddedDuration()
+	case media.FieldFrames:
+		return m.AddedFrames()
+	case media.FieldBitrate:
+		return m.AddedBitrate()
+	case media.FieldFps:
+		return m.AddedFps()
+	case media.FieldRotation:
+		return m.AddedRotation()
+	case media.FieldVersion:
+		return m.AddedVersion()
+	case media.FieldScore:
+		return m.AddedScore()
+	case media.FieldFavCount:
+		return m.AddedFavCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case media.FieldWidth:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case media.FieldHeight:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case media.FieldDuration:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	case media.FieldFrames:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFrames(v)
+		return nil
+	case media.FieldBitrate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBitrate(v)
+		return nil
+	case media.FieldFps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFps(v)
+		return nil
+	case media.FieldRotation:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRotation(v)
+		return nil
+	case media.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case media.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case media.FieldFavCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFavCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(media.FieldDuration) {
+		fields = append(fields, media.FieldDuration)
+	}
+	if m.FieldCleared(media.FieldFrames) {
+		fields = append(fields, media.FieldFrames)
+	}
+	if m.FieldCleared(media.FieldBitrate) {
+		fields = append(fields, media.FieldBitrate)
+	}
+	if m.FieldCleared(media.FieldVideoCodec) {
+		fields = append(fields, media.FieldVideoCodec)
+	}
+	if m.FieldCleared(media.FieldAudioCodec) {
+		fields = append(fields, media.FieldAudioCodec)
+	}
+	if m.FieldCleared(media.FieldFps) {
+		fields = append(fields, media.FieldFps)
+	}
+	if m.FieldCleared(media.FieldHasAudio) {
+		fields = append(fields, media.FieldHasAudio)
+	}
+	if m.FieldCleared(media.FieldRotation) {
+		fields = append(fields, media.FieldRotation)
+	}
+	if m.FieldCleared(media.FieldRating) {
+		fields = append(fields, media.FieldRating)
+	}
+	if m.FieldCleared(media.FieldTitle) {
+		fields = append(fields, media.FieldTitle)
+	}
+	if m.FieldCleared(media.FieldDescription) {
+		fields = append(fields, media.FieldDescription)
+	}
+	if m.FieldCleared(media.FieldDeletedAt) {
+		fields = append(fields, media.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaMutation) ClearField(name string) error {
+	switch name {
+	case media.FieldDuration:
+		m.ClearDuration()
+		return nil
+	case media.FieldFrames:
+		m.ClearFrames()
+		return nil
+	case media.FieldBitrate:
+		m.ClearBitrate()
+		return nil
+	case media.FieldVideoCodec:
+		m.ClearVideoCodec()
+		return nil
+	case media.FieldAudioCodec:
+		m.ClearAudioCodec()
+		return nil
+	case media.FieldFps:
+		m.ClearFps()
+		return nil
+	case media.FieldHasAudio:
+		m.ClearHasAudio()
+		return nil
+	case media.FieldRotation:
+		m.ClearRotation()
+		return nil
+	case media.FieldRating:
+		m.ClearRating()
+		return nil
+	case media.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case media.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case media.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Media nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaMutation) ResetField(name string) error {
+	switch name {
+	case media.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case media.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case media.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case media.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case media.FieldFrames:
+		m.ResetFrames()
+		return nil
+	case media.FieldBitrate:
+		m.ResetBitrate()
+		return nil
+	case media.FieldVideoCodec:
+		m.ResetVideoCodec()
+		return nil
+	case media.FieldAudioCodec:
+		m.ResetAudioCodec()
+		return nil
+	case media.FieldFps:
+		m.ResetFps()
+		return nil
+	case media.FieldHasAudio:
+		m.ResetHasAudio()
+		return nil
+	case media.FieldRotation:
+		m.ResetRotation()
+		return nil
+	case media.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case media.FieldRating:
+		m.ResetRating()
+		return nil
+	case media.FieldScore:
+		m.ResetScore()
+		return nil
+	case media.FieldFavCount:
+		m.ResetFavCount()
+		return nil
+	case media.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case media.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case media.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.tags != nil {
+		edges = append(edges, media.EdgeTags)
+	}
+	if m.dates != nil {
+		edges = append(edges, media.EdgeDates)
+	}
+	if m.vectors != nil {
+		edges = append(edges, media.EdgeVectors)
+	}
+	if m.meta_fields != nil {
+		edges = append(edges, media.EdgeMetaFields)
+	}
+	if m.pools != nil {
+		edges = append(edges, media.EdgePools)
+	}
+	if m.favorites != nil {
+		edges = append(edges, media.EdgeFavorites)
+	}
+	if m.comments != nil {
+		edges = append(edges, media.EdgeComments)
+	}
+	if m.notes != nil {
+		edges = append(edges, media.EdgeNotes)
+	}
+	if m.sources != nil {
+		edges = append(edges, media.EdgeSources)
+	}
+	if m.media_dates != nil {
+		edges = append(edges, media.EdgeMediaDates)
+	}
+	if m.media_vectors != nil {
+		edges = append(edges, media.EdgeMediaVectors)
+	}
+	if m.media_fields != nil {
+		edges = append(edges, media.EdgeMediaFields)
+	}
+	if m.pool_media != nil {
+		edges = append(edges, media.EdgePoolMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeDates:
+		ids := make([]ent.Value, 0, len(m.dates))
+		for id := range m.dates {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeVectors:
+		ids := make([]ent.Value, 0, len(m.vectors))
+		for id := range m.vectors {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMetaFields:
+		ids := make([]ent.Value, 0, len(m.meta_fields))
+		for id := range m.meta_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgePools:
+		ids := make([]ent.Value, 0, len(m.pools))
+		for id := range m.pools {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.favorites))
+		for id := range m.favorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.notes))
+		for id := range m.notes {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeSources:
+		ids := make([]ent.Value, 0, len(m.sources))
+		for id := range m.sources {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMediaDates:
+		ids := make([]ent.Value, 0, len(m.media_dates))
+		for id := range m.media_dates {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMediaVectors:
+		ids := make([]ent.Value, 0, len(m.media_vectors))
+		for id := range m.media_vectors {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMediaFields:
+		ids := make([]ent.Value, 0, len(m.media_fields))
+		for id := range m.media_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgePoolMedia:
+		ids := make([]ent.Value, 0, len(m.pool_media))
+		for id := range m.pool_media {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.removedtags != nil {
+		edges = append(edges, media.EdgeTags)
+	}
+	if m.removeddates != nil {
+		edges = append(edges, media.EdgeDates)
+	}
+	if m.removedvectors != nil {
+		edges = append(edges, media.EdgeVectors)
+	}
+	if m.removedmeta_fields != nil {
+		edges = append(edges, media.EdgeMetaFields)
+	}
+	if m.removedpools != nil {
+		edges = append(edges, media.EdgePools)
+	}
+	if m.removedfavorites != nil {
+		edges = append(edges, media.EdgeFavorites)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, media.EdgeComments)
+	}
+	if m.removednotes != nil {
+		edges = append(edges, media.EdgeNotes)
+	}
+	if m.removedsources != nil {
+		edges = append(edges, media.EdgeSources)
+	}
+	if m.removedmedia_dates != nil {
+		edges = append(edges, media.EdgeMediaDates)
+	}
+	if m.removedmedia_vectors != nil {
+		edges = append(edges, media.EdgeMediaVectors)
+	}
+	if m.removedmedia_fields != nil {
+		edges = append(edges, media.EdgeMediaFields)
+	}
+	if m.removedpool_media != nil {
+		edges = append(edges, media.EdgePoolMedia)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeDates:
+		ids := make([]ent.Value, 0, len(m.removeddates))
+		for id := range m.removeddates {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeVectors:
+		ids := make([]ent.Value, 0, len(m.removedvectors))
+		for id := range m.removedvectors {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMetaFields:
+		ids := make([]ent.Value, 0, len(m.removedmeta_fields))
+		for id := range m.removedmeta_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgePools:
+		ids := make([]ent.Value, 0, len(m.removedpools))
+		for id := range m.removedpools {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.removedfavorites))
+		for id := range m.removedfavorites {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.removednotes))
+		for id := range m.removednotes {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeSources:
+		ids := make([]ent.Value, 0, len(m.removedsources))
+		for id := range m.removedsources {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMediaDates:
+		ids := make([]ent.Value, 0, len(m.removedmedia_dates))
+		for id := range m.removedmedia_dates {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMediaVectors:
+		ids := make([]ent.Value, 0, len(m.removedmedia_vectors))
+		for id := range m.removedmedia_vectors {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMediaFields:
+		ids := make([]ent.Value, 0, len(m.removedmedia_fields))
+		for id := range m.removedmedia_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgePoolMedia:
+		ids := make([]ent.Value, 0, len(m.removedpool_media))
+		for id := range m.removedpool_media {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.clearedtags {
+		edges = append(edges, media.EdgeTags)
+	}
+	if m.cleareddates {
+		edges = append(edges, media.EdgeDates)
+	}
+	if m.clearedvectors {
+		edges = append(edges, media.EdgeVectors)
+	}
+	if m.clearedmeta_fields {
+		edges = append(edges, media.EdgeMetaFields)
+	}
+	if m.clearedpools {
+		edges = append(edges, media.EdgePools)
+	}
+	if m.clearedfavorites {
+		edges = append(edges, media.EdgeFavorites)
+	}
+	if m.clearedcomments {
+		edges = append(edges, media.EdgeComments)
+	}
+	if m.clearednotes {
+		edges = append(edges, media.EdgeNotes)
+	}
+	if m.clearedsources {
+		edges = append(edges, media.EdgeSources)
+	}
+	if m.clearedmedia_dates {
+		edges = append(edges, media.EdgeMediaDates)
+	}
+	if m.clearedmedia_vectors {
+		edges = append(edges, media.EdgeMediaVectors)
+	}
+	if m.clearedmedia_fields {
+		edges = append(edges, media.EdgeMediaFields)
+	}
+	if m.clearedpool_media {
+		edges = append(edges, media.EdgePoolMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case media.EdgeTags:
+		return m.clearedtags
+	case media.EdgeDates:
+		return m.cleareddates
+	case media.EdgeVectors:
+		return m.clearedvectors
+	case media.EdgeMetaFields:
+		return m.clearedmeta_fields
+	case media.EdgePools:
+		return m.clearedpools
+	case media.EdgeFavorites:
+		return m.clearedfavorites
+	case media.EdgeComments:
+		return m.clearedcomments
+	case media.EdgeNotes:
+		return m.clearednotes
+	case media.EdgeSources:
+		return m.clearedsources
+	case media.EdgeMediaDates:
+		return m.clearedmedia_dates
+	case media.EdgeMediaVectors:
+		return m.clearedmedia_vectors
+	case media.EdgeMediaFields:
+		return m.clearedmedia_fields
+	case media.EdgePoolMedia:
+		return m.clearedpool_media
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Media unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaMutation) ResetEdge(name string) error {
+	switch name {
+	case media.EdgeTags:
+		m.ResetTags()
+		return nil
+	case media.EdgeDates:
+		m.ResetDates()
+		return nil
+	case media.EdgeVectors:
+		m.ResetVectors()
+		return nil
+	case media.EdgeMetaFields:
+		m.ResetMetaFields()
+		return nil
+	case media.EdgePools:
+		m.ResetPools()
+		return nil
+	case media.EdgeFavorites:
+		m.ResetFavorites()
+		return nil
+	case media.EdgeComments:
+		m.ResetComments()
+		return nil
+	case media.EdgeNotes:
+		m.ResetNotes()
+		return nil
+	case media.EdgeSources:
+		m.ResetSources()
+		return nil
+	case media.EdgeMediaDates:
+		m.ResetMediaDates()
+		return nil
+	case media.EdgeMediaVectors:
+		m.ResetMediaVectors()
+		return nil
+	case media.EdgeMediaFields:
+		m.ResetMediaFields()
+		return nil
+	case media.EdgePoolMedia:
+		m.ResetPoolMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Media edge %s", name)
+}
+
+// MediaDateMutation represents an operation that mutates the MediaDate nodes in the graph.
+type MediaDateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	value         *time.Time
+	clearedFields map[string]struct{}
+	media         *string
+	clearedmedia  bool
+	date          *int
+	cleareddate   bool
+	done          bool
+	oldValue      func(context.Context) (*MediaDate, error)
+	predicates    []predicate.MediaDate
+}
+
+var _ ent.Mutation = (*MediaDateMutation)(nil)
+
+// mediadateOption allows management of the mutation configuration using functional options.
+type mediadateOption func(*MediaDateMutation)
+
+// newMediaDateMutation creates new mutation for the MediaDate entity.
+func newMediaDateMutation(c config, op Op, opts ...mediadateOption) *MediaDateMutation {
+	m := &MediaDateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMediaDate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaDateID sets the ID field of the mutation.
+func withMediaDateID(id int) mediadateOption {
+	return func(m *MediaDateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MediaDate
+		)
+		m.oldValue = func(ctx context.Context) (*MediaDate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MediaDate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMediaDate sets the old MediaDate of the mutation.
+func withMediaDate(node *MediaDate) mediadateOption {
+	return func(m *MediaDateMutation) {
+		m.oldValue = func(context.Context) (*MediaDate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaDateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaDateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaDateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaDateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MediaDate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *MediaDateMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *MediaDateMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the MediaDate entity.
+// If the MediaDate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaDateMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *MediaDateMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetDateID sets the "date_id" field.
+func (m *MediaDateMutation) SetDateID(i int) {
+	m.date = &i
+}
+
+// DateID returns the value of the "date_id" field in the mutation.
+func (m *MediaDateMutation) DateID() (r int, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDateID returns the old "date_id" field's value of the MediaDate entity.
+// If the MediaDate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaDateMutation) OldDateID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDateID: %w", err)
+	}
+	return oldValue.DateID, nil
+}
+
+// ResetDateID resets all changes to the "date_id" field.
+func (m *MediaDateMutation) ResetDateID() {
+	m.date = nil
+}
+
+// SetValue sets the "value" field.
+func (m *MediaDateMutation) SetValue(t time.Time) {
+	m.value = &t
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *MediaDateMutation) Value() (r time.Time, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the MediaDate entity.
+// If the MediaDate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaDateMutation) OldValue(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *MediaDateMutation) ResetValue() {
+	m.value = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *MediaDateMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[mediadate.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *MediaDateMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *MediaDateMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *MediaDateMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// ClearDate clears the "date" edge to the Date entity.
+func (m *MediaDateMutation) ClearDate() {
+	m.cleareddate = true
+	m.clearedFields[mediadate.FieldDateID] = struct{}{}
+}
+
+// DateCleared reports if the "date" edge to the Date entity was cleared.
+func (m *MediaDateMutation) DateCleared() bool {
+	return m.cleareddate
+}
+
+// DateIDs returns the "date" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DateID instead. It exists only for internal usage by the builders.
+func (m *MediaDateMutation) DateIDs() (ids []int) {
+	if id := m.date; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDate resets all changes to the "date" edge.
+func (m *MediaDateMutation) ResetDate() {
+	m.date = nil
+	m.cleareddate = false
+}
+
+// Where appends a list predicates to the MediaDateMutation builder.
+func (m *MediaDateMutation) Where(ps ...predicate.MediaDate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaDateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaDateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MediaDate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaDateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaDateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MediaDate).
+func (m *MediaDateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaDateMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.media != nil {
+		fields = append(fields, mediadate.FieldMediaID)
+	}
+	if m.date != nil {
+		fields = append(fields, mediadate.FieldDateID)
+	}
+	if m.value != nil {
+		fields = append(fields, mediadate.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaDateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mediadate.FieldMediaID:
+		return m.MediaID()
+	case mediadate.FieldDateID:
+		return m.DateID()
+	case mediadate.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaDateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mediadate.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case mediadate.FieldDateID:
+		return m.OldDateID(ctx)
+	case mediadate.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown MediaDate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaDateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mediadate.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case mediadate.FieldDateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDateID(v)
+		return nil
+	case mediadate.FieldValue:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MediaDate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaDateMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaDateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaDateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MediaDate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaDateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaDateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaDateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MediaDate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaDateMutation) ResetField(name string) error {
+	switch name {
+	case mediadate.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case mediadate.FieldDateID:
+		m.ResetDateID()
+		return nil
+	case mediadate.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaDate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaDateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.media != nil {
+		edges = append(edges, mediadate.EdgeMedia)
+	}
+	if m.date != nil {
+		edges = append(edges, mediadate.EdgeDate)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaDateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mediadate.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	case mediadate.EdgeDate:
+		if id := m.date; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaDateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaDateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaDateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmedia {
+		edges = append(edges, mediadate.EdgeMedia)
+	}
+	if m.cleareddate {
+		edges = append(edges, mediadate.EdgeDate)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaDateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mediadate.EdgeMedia:
+		return m.clearedmedia
+	case mediadate.EdgeDate:
+		return m.cleareddate
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaDateMutation) ClearEdge(name string) error {
+	switch name {
+	case mediadate.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	case mediadate.EdgeDate:
+		m.ClearDate()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaDate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaDateMutation) ResetEdge(name string) error {
+	switch name {
+	case mediadate.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case mediadate.EdgeDate:
+		m.ResetDate()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaDate edge %s", name)
+}
+
+// MediaFieldMutation represents an operation that mutates the MediaField nodes in the graph.
+type MediaFieldMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	value             *jsontext.Value
+	appendvalue       jsontext.Value
+	clearedFields     map[string]struct{}
+	media             *string
+	clearedmedia      bool
+	meta_field        *int
+	clearedmeta_field bool
+	done              bool
+	oldValue          func(context.Context) (*MediaField, error)
+	predicates        []predicate.MediaField
+}
+
+var _ ent.Mutation = (*MediaFieldMutation)(nil)
+
+// mediafieldOption allows management of the mutation configuration using functional options.
+type mediafieldOption func(*MediaFieldMutation)
+
+// newMediaFieldMutation creates new mutation for the MediaField entity.
+func newMediaFieldMutation(c config, op Op, opts ...mediafieldOption) *MediaFieldMutation {
+	m := &MediaFieldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMediaField,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaFieldID sets the ID field of the mutation.
+func withMediaFieldID(id int) mediafieldOption {
+	return func(m *MediaFieldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MediaField
+		)
+		m.oldValue = func(ctx context.Context) (*MediaField, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MediaField.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMediaField sets the old MediaField of the mutation.
+func withMediaField(node *MediaField) mediafieldOption {
+	return func(m *MediaFieldMutation) {
+		m.oldValue = func(context.Context) (*MediaField, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaFieldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaFieldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaFieldMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaFieldMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MediaField.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *MediaFieldMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *MediaFieldMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the MediaField entity.
+// If the MediaField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaFieldMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *MediaFieldMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetMetaFieldID sets the "meta_field_id" field.
+func (m *MediaFieldMutation) SetMetaFieldID(i int) {
+	m.meta_field = &i
+}
+
+// MetaFieldID returns the value of the "meta_field_id" field in the mutation.
+func (m *MediaFieldMutation) MetaFieldID() (r int, exists bool) {
+	v := m.meta_field
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetaFieldID returns the old "meta_field_id" field's value of the MediaField entity.
+// If the MediaField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaFieldMutation) OldMetaFieldID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetaFieldID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetaFieldID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetaFieldID: %w", err)
+	}
+	return oldValue.MetaFieldID, nil
+}
+
+// ResetMetaFieldID resets all changes to the "meta_field_id" field.
+func (m *MediaFieldMutation) ResetMetaFieldID() {
+	m.meta_field = nil
+}
+
+// SetValue sets the "value" field.
+func (m *MediaFieldMutation) SetValue(j jsontext.Value) {
+	m.value = &j
+	m.appendvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *MediaFieldMutation) Value() (r jsontext.Value, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the MediaField entity.
+// If the MediaField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaFieldMutation) OldValue(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AppendValue adds j to the "value" field.
+func (m *MediaFieldMutation) AppendValue(j jsontext.Value) {
+	m.appendvalue = append(m.appendvalue, j...)
+}
+
+// AppendedValue returns the list of values that were appended to the "value" field in this mutation.
+func (m *MediaFieldMutation) AppendedValue() (jsontext.Value, bool) {
+	if len(m.appendvalue) == 0 {
+		return nil, false
+	}
+	return m.appendvalue, true
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *MediaFieldMutation) ResetValue() {
+	m.value = nil
+	m.appendvalue = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *MediaFieldMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[mediafield.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *MediaFieldMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *MediaFieldMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *MediaFieldMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// ClearMetaField clears the "meta_field" edge to the MetaField entity.
+func (m *MediaFieldMutation) ClearMetaField() {
+	m.clearedmeta_field = true
+	m.clearedFields[mediafield.FieldMetaFieldID] = struct{}{}
+}
+
+// MetaFieldCleared reports if the "meta_field" edge to the MetaField entity was cleared.
+func (m *MediaFieldMutation) MetaFieldCleared() bool {
+	return m.clearedmeta_field
+}
+
+// MetaFieldIDs returns the "meta_field" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MetaFieldID instead. It exists only for internal usage by the builders.
+func (m *MediaFieldMutation) MetaFieldIDs() (ids []int) {
+	if id := m.meta_field; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMetaField resets all changes to the "meta_field" edge.
+func (m *MediaFieldMutation) ResetMetaField() {
+	m.meta_field = nil
+	m.clearedmeta_field = false
+}
+
+// Where appends a list predicates to the MediaFieldMutation builder.
+func (m *MediaFieldMutation) Where(ps ...predicate.MediaField) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaFieldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaFieldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MediaField, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaFieldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaFieldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MediaField).
+func (m *MediaFieldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaFieldMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.media != nil {
+		fields = append(fields, mediafield.FieldMediaID)
+	}
+	if m.meta_field != nil {
+		fields = append(fields, mediafield.FieldMetaFieldID)
+	}
+	if m.value != nil {
+		fields = append(fields, mediafield.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaFieldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mediafield.FieldMediaID:
+		return m.MediaID()
+	case mediafield.FieldMetaFieldID:
+		return m.MetaFieldID()
+	case mediafield.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mediafield.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case mediafield.FieldMetaFieldID:
+		return m.OldMetaFieldID(ctx)
+	case mediafield.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown MediaField field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaFieldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mediafield.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case mediafield.FieldMetaFieldID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetaFieldID(v)
+		return nil
+	case mediafield.FieldValue:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MediaField field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaFieldMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaFieldMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaFieldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MediaField numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaFieldMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaFieldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaFieldMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MediaField nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaFieldMutation) ResetField(name string) error {
+	switch name {
+	case mediafield.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case mediafield.FieldMetaFieldID:
+		m.ResetMetaFieldID()
+		return nil
+	case mediafield.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaField field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaFieldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.media != nil {
+		edges = append(edges, mediafield.EdgeMedia)
+	}
+	if m.meta_field != nil {
+		edges = append(edges, mediafield.EdgeMetaField)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaFieldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mediafield.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	case mediafield.EdgeMetaField:
+		if id := m.meta_field; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaFieldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaFieldMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaFieldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmedia {
+		edges = append(edges, mediafield.EdgeMedia)
+	}
+	if m.clearedmeta_field {
+		edges = append(edges, mediafield.EdgeMetaField)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaFieldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mediafield.EdgeMedia:
+		return m.clearedmedia
+	case mediafield.EdgeMetaField:
+		return m.clearedmeta_field
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaFieldMutation) ClearEdge(name string) error {
+	switch name {
+	case mediafield.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	case mediafield.EdgeMetaField:
+		m.ClearMetaField()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaField unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaFieldMutation) ResetEdge(name string) error {
+	switch name {
+	case mediafield.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case mediafield.EdgeMetaField:
+		m.ResetMetaField()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaField edge %s", name)
+}
+
+// MediaRevisionMutation represents an operation that mutates the MediaRevision nodes in the graph.
+type MediaRevisionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	action            *string
+	actor             *string
+	version           *int
+	addversion        *int
+	tags_before       *[]string
+	appendtags_before []string
+	tags_after        *[]string
+	appendtags_after  []string
+	dates_before      *map[string]string
+	dates_after       *map[string]string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	media             *string
+	clearedmedia      bool
+	done              bool
+	oldValue          func(context.Context) (*MediaRevision, error)
+	predicates        []predicate.MediaRevision
+}
+
+var _ ent.Mutation = (*MediaRevisionMutation)(nil)
+
+// mediarevisionOption allows management of the mutation configuration using functional options.
+type mediarevisionOption func(*MediaRevisionMutation)
+
+// newMediaRevisionMutation creates new mutation for the MediaRevision entity.
+func newMediaRevisionMutation(c config, op Op, opts ...mediarevisionOption) *MediaRevisionMutation {
+	m := &MediaRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMediaRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaRevisionID sets the ID field of the mutation.
+func withMediaRevisionID(id int) mediarevisionOption {
+	return func(m *MediaRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MediaRevision
+		)
+		m.oldValue = func(ctx context.Context) (*MediaRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MediaRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMediaRevision sets the old MediaRevision of the mutation.
+func withMediaRevision(node *MediaRevision) mediarevisionOption {
+	return func(m *MediaRevisionMutation) {
+		m.oldValue = func(context.Context) (*MediaRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaRevisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaRevisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MediaRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *MediaRevisionMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *MediaRevisionMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *MediaRevisionMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetAction sets the "action" field.
+func (m *MediaRevisionMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *MediaRevisionMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *MediaRevisionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *MediaRevisionMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *MediaRevisionMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *MediaRevisionMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *MediaRevisionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *MediaRevisionMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *MediaRevisionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *MediaRevisionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *MediaRevisionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetTagsBefore sets the "tags_before" field.
+func (m *MediaRevisionMutation) SetTagsBefore(s []string) {
+	m.tags_before = &s
+	m.appendtags_before = nil
+}
+
+// TagsBefore returns the value of the "tags_before" field in the mutation.
+func (m *MediaRevisionMutation) TagsBefore() (r []string, exists bool) {
+	v := m.tags_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsBefore returns the old "tags_before" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldTagsBefore(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsBefore: %w", err)
+	}
+	return oldValue.TagsBefore, nil
+}
+
+// AppendTagsBefore adds s to the "tags_before" field.
+func (m *MediaRevisionMutation) AppendTagsBefore(s []string) {
+	m.appendtags_before = append(m.appendtags_before, s...)
+}
+
+// AppendedTagsBefore returns the list of values that were appended to the "tags_before" field in this mutation.
+func (m *MediaRevisionMutation) AppendedTagsBefore() ([]string, bool) {
+	if len(m.appendtags_before) == 0 {
+		return nil, false
+	}
+	return m.appendtags_before, true
+}
+
+// ResetTagsBefore resets all changes to the "tags_before" field.
+func (m *MediaRevisionMutation) ResetTagsBefore() {
+	m.tags_before = nil
+	m.appendtags_before = nil
+}
+
+// SetTagsAfter sets the "tags_after" field.
+func (m *MediaRevisionMutation) SetTagsAfter(s []string) {
+	m.tags_after = &s
+	m.appendtags_after = nil
+}
+
+// TagsAfter returns the value of the "tags_after" field in the mutation.
+func (m *MediaRevisionMutation) TagsAfter() (r []string, exists bool) {
+	v := m.tags_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsAfter returns the old "tags_after" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldTagsAfter(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsAfter: %w", err)
+	}
+	return oldValue.TagsAfter, nil
+}
+
+// AppendTagsAfter adds s to the "tags_after" field.
+func (m *MediaRevisionMutation) AppendTagsAfter(s []string) {
+	m.appendtags_after = append(m.appendtags_after, s...)
+}
+
+// AppendedTagsAfter returns the list of values that were appended to the "tags_after" field in this mutation.
+func (m *MediaRevisionMutation) AppendedTagsAfter() ([]string, bool) {
+	if len(m.appendtags_after) == 0 {
+		return nil, false
+	}
+	return m.appendtags_after, true
+}
+
+// ResetTagsAfter resets all changes to the "tags_after" field.
+func (m *MediaRevisionMutation) ResetTagsAfter() {
+	m.tags_after = nil
+	m.appendtags_after = nil
+}
+
+// SetDatesBefore sets the "dates_before" field.
+func (m *MediaRevisionMutation) SetDatesBefore(value map[string]string) {
+	m.dates_before = &value
+}
+
+// DatesBefore returns the value of the "dates_before" field in the mutation.
+func (m *MediaRevisionMutation) DatesBefore() (r map[string]string, exists bool) {
+	v := m.dates_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDatesBefore returns the old "dates_before" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldDatesBefore(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatesBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatesBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatesBefore: %w", err)
+	}
+	return oldValue.DatesBefore, nil
+}
+
+// ResetDatesBefore resets all changes to the "dates_before" field.
+func (m *MediaRevisionMutation) ResetDatesBefore() {
+	m.dates_before = nil
+}
+
+// SetDatesAfter sets the "dates_after" field.
+func (m *MediaRevisionMutation) SetDatesAfter(value map[string]string) {
+	m.dates_after = &value
+}
+
+// DatesAfter returns the value of the "dates_after" field in the mutation.
+func (m *MediaRevisionMutation) DatesAfter() (r map[string]string, exists bool) {
+	v := m.dates_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDatesAfter returns the old "dates_after" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldDatesAfter(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatesAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatesAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatesAfter: %w", err)
+	}
+	return oldValue.DatesAfter, nil
+}
+
+// ResetDatesAfter resets all changes to the "dates_after" field.
+func (m *MediaRevisionMutation) ResetDatesAfter() {
+	m.dates_after = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MediaRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MediaRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MediaRevision entity.
+// If the MediaRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MediaRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *MediaRevisionMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[mediarevision.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *MediaRevisionMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *MediaRevisionMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *MediaRevisionMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// Where appends a list predicates to the MediaRevisionMutation builder.
+func (m *MediaRevisionMutation) Where(ps ...predicate.MediaRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MediaRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MediaRevision).
+func (m *MediaRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.media != nil {
+		fields = append(fields, mediarevision.FieldMediaID)
+	}
+	if m.action != nil {
+		fields = append(fields, mediarevision.FieldAction)
+	}
+	if m.actor != nil {
+		fields = append(fields, mediarevision.FieldActor)
+	}
+	if m.version != nil {
+		fields = append(fields, mediarevision.FieldVersion)
+	}
+	if m.tags_before != nil {
+		fields = append(fields, mediarevision.FieldTagsBefore)
+	}
+	if m.tags_after != nil {
+		fields = append(fields, mediarevision.FieldTagsAfter)
+	}
+	if m.dates_before != nil {
+		fields = append(fields, mediarevision.FieldDatesBefore)
+	}
+	if m.dates_after != nil {
+		fields = append(fields, mediarevision.FieldDatesAfter)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mediarevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mediarevision.FieldMediaID:
+		return m.MediaID()
+	case mediarevision.FieldAction:
+		return m.Action()
+	case mediarevision.FieldActor:
+		return m.Actor()
+	case mediarevision.FieldVersion:
+		return m.Version()
+	case mediarevision.FieldTagsBefore:
+		return m.TagsBefore()
+	case mediarevision.FieldTagsAfter:
+		return m.TagsAfter()
+	case mediarevision.FieldDatesBefore:
+		return m.DatesBefore()
+	case mediarevision.FieldDatesAfter:
+		return m.DatesAfter()
+	case mediarevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mediarevision.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case mediarevision.FieldAction:
+		return m.OldAction(ctx)
+	case mediarevision.FieldActor:
+		return m.OldActor(ctx)
+	case mediarevision.FieldVersion:
+		return m.OldVersion(ctx)
+	case mediarevision.FieldTagsBefore:
+		return m.OldTagsBefore(ctx)
+	case mediarevision.FieldTagsAfter:
+		return m.OldTagsAfter(ctx)
+	case mediarevision.FieldDatesBefore:
+		return m.OldDatesBefore(ctx)
+	case mediarevision.FieldDatesAfter:
+		return m.OldDatesAfter(ctx)
+	case mediarevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MediaRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mediarevision.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case mediarevision.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case mediarevision.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case mediarevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case mediarevision.FieldTagsBefore:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsBefore(v)
+		return nil
+	case mediarevision.FieldTagsAfter:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsAfter(v)
+		return nil
+	case mediarevision.FieldDatesBefore:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatesBefore(v)
+		return nil
+	case mediarevision.FieldDatesAfter:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatesAfter(v)
+		return nil
+	case mediarevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MediaRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, mediarevision.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mediarevision.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mediarevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MediaRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaRevisionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaRevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MediaRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaRevisionMutation) ResetField(name string) error {
+	switch name {
+	case mediarevision.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case mediarevision.FieldAction:
+		m.ResetAction()
+		return nil
+	case mediarevision.FieldActor:
+		m.ResetActor()
+		return nil
+	case mediarevision.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case mediarevision.FieldTagsBefore:
+		m.ResetTagsBefore()
+		return nil
+	case mediarevision.FieldTagsAfter:
+		m.ResetTagsAfter()
+		return nil
+	case mediarevision.FieldDatesBefore:
+		m.ResetDatesBefore()
+		return nil
+	case mediarevision.FieldDatesAfter:
+		m.ResetDatesAfter()
+		return nil
+	case mediarevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.media != nil {
+		edges = append(edges, mediarevision.EdgeMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mediarevision.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmedia {
+		edges = append(edges, mediarevision.EdgeMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mediarevision.EdgeMedia:
+		return m.clearedmedia
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case mediarevision.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case mediarevision.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaRevision edge %s", name)
+}
+
+// MediaVectorMutation represents an operation that mutates the MediaVector nodes in the graph.
+type MediaVectorMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	value         *pgvector.Vector
+	clearedFields map[string]struct{}
+	media         *string
+	clearedmedia  bool
+	vector        *int
+	clearedvector bool
+	done          bool
+	oldValue      func(context.Context) (*MediaVector, error)
+	predicates    []predicate.MediaVector
+}
+
+var _ ent.Mutation = (*MediaVectorMutation)(nil)
+
+// mediavectorOption allows management of the mutation configuration using functional options.
+type mediavectorOption func(*MediaVectorMutation)
+
+// newMediaVectorMutation creates new mutation for the MediaVector entity.
+func newMediaVectorMutation(c config, op Op, opts ...mediavectorOption) *MediaVectorMutation {
+	m := &MediaVectorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMediaVector,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaVectorID sets the ID field of the mutation.
+func withMediaVectorID(id int) mediavectorOption {
+	return func(m *MediaVectorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MediaVector
+		)
+		m.oldValue = func(ctx context.Context) (*MediaVector, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MediaVector.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMediaVector sets the old MediaVector of the mutation.
+func withMediaVector(node *MediaVector) mediavectorOption {
+	return func(m *MediaVectorMutation) {
+		m.oldValue = func(context.Context) (*MediaVector, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaVectorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaVectorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaVectorMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaVectorMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MediaVector.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *MediaVectorMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *MediaVectorMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the MediaVector entity.
+// If the MediaVector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaVectorMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *MediaVectorMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetVectorID sets the "vector_id" field.
+func (m *MediaVectorMutation) SetVectorID(i int) {
+	m.vector = &i
+}
+
+// VectorID returns the value of the "vector_id" field in the mutation.
+func (m *MediaVectorMutation) VectorID() (r int, exists bool) {
+	v := m.vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVectorID returns the old "vector_id" field's value of the MediaVector entity.
+// If the MediaVector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaVectorMutation) OldVectorID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVectorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVectorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVectorID: %w", err)
+	}
+	return oldValue.VectorID, nil
+}
+
+// ResetVectorID resets all changes to the "vector_id" field.
+func (m *MediaVectorMutation) ResetVectorID() {
+	m.vector = nil
+}
+
+// SetValue sets the "value" field.
+func (m *MediaVectorMutation) SetValue(pg pgvector.Vector) {
+	m.value = &pg
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *MediaVectorMutation) Value() (r pgvector.Vector, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the MediaVector entity.
+// If the MediaVector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaVectorMutation) OldValue(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *MediaVectorMutation) ResetValue() {
+	m.value = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *MediaVectorMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[mediavector.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *MediaVectorMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *MediaVectorMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *MediaVectorMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// ClearVector clears the "vector" edge to the Vector entity.
+func (m *MediaVectorMutation) ClearVector() {
+	m.clearedvector = true
+	m.clearedFields[mediavector.FieldVectorID] = struct{}{}
+}
+
+// VectorCleared reports if the "vector" edge to the Vector entity was cleared.
+func (m *MediaVectorMutation) VectorCleared() bool {
+	return m.clearedvector
+}
+
+// VectorIDs returns the "vector" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VectorID instead. It exists only for internal usage by the builders.
+func (m *MediaVectorMutation) VectorIDs() (ids []int) {
+	if id := m.vector; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVector resets all changes to the "vector" edge.
+func (m *MediaVectorMutation) ResetVector() {
+	m.vector = nil
+	m.clearedvector = false
+}
+
+// Where appends a list predicates to the MediaVectorMutation builder.
+func (m *MediaVectorMutation) Where(ps ...predicate.MediaVector) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaVectorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaVectorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MediaVector, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaVectorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaVectorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MediaVector).
+func (m *MediaVectorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaVectorMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.media != nil {
+		fields = append(fields, mediavector.FieldMediaID)
+	}
+	if m.vector != nil {
+		fields = append(fields, mediavector.FieldVectorID)
+	}
+	if m.value != nil {
+		fields = append(fields, mediavector.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaVectorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mediavector.FieldMediaID:
+		return m.MediaID()
+	case mediavector.FieldVectorID:
+		return m.VectorID()
+	case mediavector.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaVectorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mediavector.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case mediavector.FieldVectorID:
+		return m.OldVectorID(ctx)
+	case mediavector.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown MediaVector field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaVectorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mediavector.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case mediavector.FieldVectorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVectorID(v)
+		return nil
+	case mediavector.FieldValue:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVector field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaVectorMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaVectorMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaVectorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MediaVector numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaVectorMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaVectorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaVectorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MediaVector nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaVectorMutation) ResetField(name string) error {
+	switch name {
+	case mediavector.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case mediavector.FieldVectorID:
+		m.ResetVectorID()
+		return nil
+	case mediavector.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVector field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaVectorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.media != nil {
+		edges = append(edges, mediavector.EdgeMedia)
+	}
+	if m.vector != nil {
+		edges = append(edges, mediavector.EdgeVector)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaVectorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mediavector.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	case mediavector.EdgeVector:
+		if id := m.vector; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaVectorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaVectorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaVectorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmedia {
+		edges = append(edges, mediavector.EdgeMedia)
+	}
+	if m.clearedvector {
+		edges = append(edges, mediavector.EdgeVector)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaVectorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mediavector.EdgeMedia:
+		return m.clearedmedia
+	case mediavector.EdgeVector:
+		return m.clearedvector
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaVectorMutation) ClearEdge(name string) error {
+	switch name {
+	case mediavector.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	case mediavector.EdgeVector:
+		m.ClearVector()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVector unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaVectorMutation) ResetEdge(name string) error {
+	switch name {
+	case mediavector.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case mediavector.EdgeVector:
+		m.ResetVector()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVector edge %s", name)
+}
+
+// MediaVoteMutation represents an operation that mutates the MediaVote nodes in the graph.
+type MediaVoteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	actor         *string
+	value         *int
+	addvalue      *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	media         *string
+	clearedmedia  bool
+	done          bool
+	oldValue      func(context.Context) (*MediaVote, error)
+	predicates    []predicate.MediaVote
+}
+
+var _ ent.Mutation = (*MediaVoteMutation)(nil)
+
+// mediavoteOption allows management of the mutation configuration using functional options.
+type mediavoteOption func(*MediaVoteMutation)
+
+// newMediaVoteMutation creates new mutation for the MediaVote entity.
+func newMediaVoteMutation(c config, op Op, opts ...mediavoteOption) *MediaVoteMutation {
+	m := &MediaVoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMediaVote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaVoteID sets the ID field of the mutation.
+func withMediaVoteID(id int) mediavoteOption {
+	return func(m *MediaVoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MediaVote
+		)
+		m.oldValue = func(ctx context.Context) (*MediaVote, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MediaVote.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMediaVote sets the old MediaVote of the mutation.
+func withMediaVote(node *MediaVote) mediavoteOption {
+	return func(m *MediaVoteMutation) {
+		m.oldValue = func(context.Context) (*MediaVote, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaVoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaVoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaVoteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaVoteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MediaVote.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetActor sets the "actor" field.
+func (m *MediaVoteMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *MediaVoteMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the MediaVote entity.
+// If the MediaVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaVoteMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *MediaVoteMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *MediaVoteMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *MediaVoteMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the MediaVote entity.
+// If the MediaVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaVoteMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *MediaVoteMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetValue sets the "value" field.
+func (m *MediaVoteMutation) SetValue(i int) {
+	m.value = &i
+	m.addvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *MediaVoteMutation) Value() (r int, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the MediaVote entity.
+// If the MediaVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaVoteMutation) OldValue(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AddValue adds i to the "value" field.
+func (m *MediaVoteMutation) AddValue(i int) {
+	if m.addvalue != nil {
+		*m.addvalue += i
+	} else {
+		m.addvalue = &i
+	}
+}
+
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *MediaVoteMutation) AddedValue() (r int, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *MediaVoteMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MediaVoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MediaVoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MediaVote entity.
+// If the MediaVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaVoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MediaVoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *MediaVoteMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[mediavote.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *MediaVoteMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *MediaVoteMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *MediaVoteMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// Where appends a list predicates to the MediaVoteMutation builder.
+func (m *MediaVoteMutation) Where(ps ...predicate.MediaVote) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaVoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaVoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MediaVote, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaVoteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaVoteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MediaVote).
+func (m *MediaVoteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaVoteMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.actor != nil {
+		fields = append(fields, mediavote.FieldActor)
+	}
+	if m.media != nil {
+		fields = append(fields, mediavote.FieldMediaID)
+	}
+	if m.value != nil {
+		fields = append(fields, mediavote.FieldValue)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mediavote.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaVoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mediavote.FieldActor:
+		return m.Actor()
+	case mediavote.FieldMediaID:
+		return m.MediaID()
+	case mediavote.FieldValue:
+		return m.Value()
+	case mediavote.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaVoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mediavote.FieldActor:
+		return m.OldActor(ctx)
+	case mediavote.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case mediavote.FieldValue:
+		return m.OldValue(ctx)
+	case mediavote.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MediaVote field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaVoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mediavote.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case mediavote.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case mediavote.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case mediavote.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVote field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaVoteMutation) AddedFields() []string {
+	var fields []string
+	if m.addvalue != nil {
+		fields = append(fields, mediavote.FieldValue)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaVoteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mediavote.FieldValue:
+		return m.AddedValue()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaVoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mediavote.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVote numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaVoteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaVoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaVoteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MediaVote nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaVoteMutation) ResetField(name string) error {
+	switch name {
+	case mediavote.FieldActor:
+		m.ResetActor()
+		return nil
+	case mediavote.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case mediavote.FieldValue:
+		m.ResetValue()
+		return nil
+	case mediavote.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVote field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaVoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.media != nil {
+		edges = append(edges, mediavote.EdgeMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaVoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mediavote.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaVoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaVoteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaVoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmedia {
+		edges = append(edges, mediavote.EdgeMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaVoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mediavote.EdgeMedia:
+		return m.clearedmedia
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaVoteMutation) ClearEdge(name string) error {
+	switch name {
+	case mediavote.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVote unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaVoteMutation) ResetEdge(name string) error {
+	switch name {
+	case mediavote.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaVote edge %s", name)
+}
+
+// MetaFieldMutation represents an operation that mutates the MetaField nodes in the graph.
+type MetaFieldMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	_type               *metafield.Type
+	values              *[]string
+	appendvalues        []string
+	description         *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	media               map[string]struct{}
+	removedmedia        map[string]struct{}
+	clearedmedia        bool
+	media_fields        map[int]struct{}
+	removedmedia_fields map[int]struct{}
+	clearedmedia_fields bool
+	done                bool
+	oldValue            func(context.Context) (*MetaField, error)
+	predicates          []predicate.MetaField
+}
+
+var _ ent.Mutation = (*MetaFieldMutation)(nil)
+
+// metafieldOption allows management of the mutation configuration using functional options.
+type metafieldOption func(*MetaFieldMutation)
+
+// newMetaFieldMutation creates new mutation for the MetaField entity.
+func newMetaFieldMutation(c config, op Op, opts ...metafieldOption) *MetaFieldMutation {
+	m := &MetaFieldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMetaField,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMetaFieldID sets the ID field of the mutation.
+func withMetaFieldID(id int) metafieldOption {
+	return func(m *MetaFieldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MetaField
+		)
+		m.oldValue = func(ctx context.Context) (*MetaField, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MetaField.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMetaField sets the old MetaField of the mutation.
+func withMetaField(node *MetaField) metafieldOption {
+	return func(m *MetaFieldMutation) {
+		m.oldValue = func(context.Context) (*MetaField, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MetaFieldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MetaFieldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MetaFieldMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MetaFieldMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MetaField.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MetaFieldMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MetaFieldMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MetaField entity.
+// If the MetaField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaFieldMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MetaFieldMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *MetaFieldMutation) SetType(value metafield.Type) {
+	m._type = &value
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *MetaFieldMutation) GetType() (r metafield.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the MetaField entity.
+// If the MetaField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaFieldMutation) OldType(ctx context.Context) (v metafield.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *MetaFieldMutation) ResetType() {
+	m._type = nil
+}
+
+// SetValues sets the "values" field.
+func (m *MetaFieldMutation) SetValues(s []string) {
+	m.values = &s
+	m.appendvalues = nil
+}
+
+// Values returns the value of the "values" field in the mutation.
+func (m *MetaFieldMutation) Values() (r []string, exists bool) {
+	v := m.values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValues returns the old "values" field's value of the MetaField entity.
+// If the MetaField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaFieldMutation) OldValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValues: %w", err)
+	}
+	return oldValue.Values, nil
+}
+
+// AppendValues adds s to the "values" field.
+func (m *MetaFieldMutation) AppendValues(s []string) {
+	m.appendvalues = append(m.appendvalues, s...)
+}
+
+// AppendedValues returns the list of values that were appended to the "values" field in this mutation.
+func (m *MetaFieldMutation) AppendedValues() ([]string, bool) {
+	if len(m.appendvalues) == 0 {
+		return nil, false
+	}
+	return m.appendvalues, true
+}
+
+// ClearValues clears the value of the "values" field.
+func (m *MetaFieldMutation) ClearValues() {
+	m.values = nil
+	m.appendvalues = nil
+	m.clearedFields[metafield.FieldValues] = struct{}{}
+}
+
+// ValuesCleared returns if the "values" field was cleared in this mutation.
+func (m *MetaFieldMutation) ValuesCleared() bool {
+	_, ok := m.clearedFields[metafield.FieldValues]
+	return ok
+}
+
+// ResetValues resets all changes to the "values" field.
+func (m *MetaFieldMutation) ResetValues() {
+	m.values = nil
+	m.appendvalues = nil
+	delete(m.clearedFields, metafield.FieldValues)
+}
+
+// SetDescription sets the "description" field.
+func (m *MetaFieldMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MetaFieldMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the MetaField entity.
+// If the MetaField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaFieldMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MetaFieldMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[metafield.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MetaFieldMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[metafield.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MetaFieldMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, metafield.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MetaFieldMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MetaFieldMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MetaField entity.
+// If the MetaField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MetaFieldMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by ids.
+func (m *MetaFieldMutation) AddMediumIDs(ids ...string) {
+	if m.media == nil {
+		m.media = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *MetaFieldMutation) ClearMedia() {
+	m.clearedmedia = true
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *MetaFieldMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
+func (m *MetaFieldMutation) RemoveMediumIDs(ids ...string) {
+	if m.removedmedia == nil {
+		m.removedmedia = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.media, ids[i])
+		m.removedmedia[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
+func (m *MetaFieldMutation) RemovedMediaIDs() (ids []string) {
+	for id := range m.removedmedia {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+func (m *MetaFieldMutation) MediaIDs() (ids []string) {
+	for id := range m.media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *MetaFieldMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+	m.removedmedia = nil
+}
+
+// AddMediaFieldIDs adds the "media_fields" edge to the MediaField entity by ids.
+func (m *MetaFieldMutation) AddMediaFieldIDs(ids ...int) {
+	if m.media_fields == nil {
+		m.media_fields = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.media_fields[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMediaFields clears the "media_fields" edge to the MediaField entity.
+func (m *MetaFieldMutation) ClearMediaFields() {
+	m.clearedmedia_fields = true
+}
+
+// MediaFieldsCleared reports if the "media_fields" edge to the MediaField entity was cleared.
+func (m *MetaFieldMutation) MediaFieldsCleared() bool {
+	return m.clearedmedia_fields
+}
+
+// RemoveMediaFieldIDs removes the "media_fields" edge to the MediaField entity by IDs.
+func (m *MetaFieldMutation) RemoveMediaFieldIDs(ids ...int) {
+	if m.removedmedia_fields == nil {
+		m.removedmedia_fields = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.media_fields, ids[i])
+		m.removedmedia_fields[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMediaFields returns the removed IDs of the "media_fields" edge to the MediaField entity.
+func (m *MetaFieldMutation) RemovedMediaFieldsIDs() (ids []int) {
+	for id := range m.removedmedia_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaFieldsIDs returns the "media_fields" edge IDs in the mutation.
+func (m *MetaFieldMutation) MediaFieldsIDs() (ids []int) {
+	for id := range m.media_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMediaFields resets all changes to the "media_fields" edge.
+func (m *MetaFieldMutation) ResetMediaFields() {
+	m.media_fields = nil
+	m.clearedmedia_fields = false
+	m.removedmedia_fields = nil
+}
+
+// Where appends a list predicates to the MetaFieldMutation builder.
+func (m *MetaFieldMutation) Where(ps ...predicate.MetaField) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MetaFieldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MetaFieldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MetaField, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MetaFieldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MetaFieldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MetaField).
+func (m *MetaFieldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MetaFieldMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, metafield.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, metafield.FieldType)
+	}
+	if m.values != nil {
+		fields = append(fields, metafield.FieldValues)
+	}
+	if m.description != nil {
+		fields = append(fields, metafield.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, metafield.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MetaFieldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case metafield.FieldName:
+		return m.Name()
+	case metafield.FieldType:
+		return m.GetType()
+	case metafield.FieldValues:
+		return m.Values()
+	case metafield.FieldDescription:
+		return m.Description()
+	case metafield.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MetaFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case metafield.FieldName:
+		return m.OldName(ctx)
+	case metafield.FieldType:
+		return m.OldType(ctx)
+	case metafield.FieldValues:
+		return m.OldValues(ctx)
+	case metafield.FieldDescription:
+		return m.OldDescription(ctx)
+	case metafield.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MetaField field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetaFieldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case metafield.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case metafield.FieldType:
+		v, ok := value.(metafield.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case metafield.FieldValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValues(v)
+		return nil
+	case metafield.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case metafield.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MetaField field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MetaFieldMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MetaFieldMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetaFieldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MetaField numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MetaFieldMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(metafield.FieldValues) {
+		fields = append(fields, metafield.FieldValues)
+	}
+	if m.FieldCleared(metafield.FieldDescription) {
+		fields = append(fields, metafield.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MetaFieldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MetaFieldMutation) ClearField(name string) error {
+	switch name {
+	case metafield.FieldValues:
+		m.ClearValues()
+		return nil
+	case metafield.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown MetaField nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MetaFieldMutation) ResetField(name string) error {
+	switch name {
+	case metafield.FieldName:
+		m.ResetName()
+		return nil
+	case metafield.FieldType:
+		m.ResetType()
+		return nil
+	case metafield.FieldValues:
+		m.ResetValues()
+		return nil
+	case metafield.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case metafield.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MetaField field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MetaFieldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.media != nil {
+		edges = append(edges, metafield.EdgeMedia)
+	}
+	if m.media_fields != nil {
+		edges = append(edges, metafield.EdgeMediaFields)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MetaFieldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case metafield.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.media))
+		for id := range m.media {
+			ids = append(ids, id)
+		}
+		return ids
+	case metafield.EdgeMediaFields:
+		ids := make([]ent.Value, 0, len(m.media_fields))
+		for id := range m.media_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MetaFieldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmedia != nil {
+		edges = append(edges, metafield.EdgeMedia)
+	}
+	if m.removedmedia_fields != nil {
+		edges = append(edges, metafield.EdgeMediaFields)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MetaFieldMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case metafield.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.removedmedia))
+		for id := range m.removedmedia {
+			ids = append(ids, id)
+		}
+		return ids
+	case metafield.EdgeMediaFields:
+		ids := make([]ent.Value, 0, len(m.removedmedia_fields))
+		for id := range m.removedmedia_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MetaFieldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmedia {
+		edges = append(edges, metafield.EdgeMedia)
+	}
+	if m.clearedmedia_fields {
+		edges = append(edges, metafield.EdgeMediaFields)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MetaFieldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case metafield.EdgeMedia:
+		return m.clearedmedia
+	case metafield.EdgeMediaFields:
+		return m.clearedmedia_fields
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MetaFieldMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MetaField unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MetaFieldMutation) ResetEdge(name string) error {
+	switch name {
+	case metafield.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case metafield.EdgeMediaFields:
+		m.ResetMediaFields()
+		return nil
+	}
+	return fmt.Errorf("unknown MetaField edge %s", name)
+}
+
+// NoteMutation represents an operation that mutates the Note nodes in the graph.
+type NoteMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	kind            *note.Kind
+	x               *float64
+	addx            *float64
+	y               *float64
+	addy            *float64
+	width           *float64
+	addwidth        *float64
+	height          *float64
+	addheight       *float64
+	text            *string
+	author          *string
+	embedding       *pgvector.Vector
+	embedding_model *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	media           *string
+	clearedmedia    bool
+	tag             *int
+	clearedtag      bool
+	done            bool
+	oldValue        func(context.Context) (*Note, error)
+	predicates      []predicate.Note
+}
+
+var _ ent.Mutation = (*NoteMutation)(nil)
+
+// noteOption allows management of the mutation configuration using functional options.
+type noteOption func(*NoteMutation)
+
+// newNoteMutation creates new mutation for the Note entity.
+func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
+	m := &NoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoteID sets the ID field of the mutation.
+func withNoteID(id int) noteOption {
+	return func(m *NoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Note
+		)
+		m.oldValue = func(ctx context.Context) (*Note, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Note.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNote sets the old Note of the mutation.
+func withNote(node *Note) noteOption {
+	return func(m *NoteMutation) {
+		m.oldValue = func(context.Context) (*Note, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Note.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *NoteMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *NoteMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *NoteMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *NoteMutation) SetKind(n note.Kind) {
+	m.kind = &n
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NoteMutation) Kind() (r note.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldKind(ctx context.Context) (v note.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NoteMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetX sets the "x" field.
+func (m *NoteMutation) SetX(f float64) {
+	m.x = &f
+	m.addx = nil
+}
+
+// X returns the value of the "x" field in the mutation.
+func (m *NoteMutation) X() (r float64, exists bool) {
+	v := m.x
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldX returns the old "x" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldX(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldX is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldX requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldX: %w", err)
+	}
+	return oldValue.X, nil
+}
+
+// AddX adds f to the "x" field.
+func (m *NoteMutation) AddX(f float64) {
+	if m.addx != nil {
+		*m.addx += f
+	} else {
+		m.addx = &f
+	}
+}
+
+// AddedX returns the value that was added to the "x" field in this mutation.
+func (m *NoteMutation) AddedX() (r float64, exists bool) {
+	v := m.addx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetX resets all changes to the "x" field.
+func (m *NoteMutation) ResetX() {
+	m.x = nil
+	m.addx = nil
+}
+
+// SetY sets the "y" field.
+func (m *NoteMutation) SetY(f float64) {
+	m.y = &f
+	m.addy = nil
+}
+
+// Y returns the value of the "y" field in the mutation.
+func (m *NoteMutation) Y() (r float64, exists bool) {
+	v := m.y
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldY returns the old "y" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldY(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldY is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldY requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldY: %w", err)
+	}
+	return oldValue.Y, nil
+}
+
+// AddY adds f to the "y" field.
+func (m *NoteMutation) AddY(f float64) {
+	if m.addy != nil {
+		*m.addy += f
+	} else {
+		m.addy = &f
+	}
+}
+
+// AddedY returns the value that was added to the "y" field in this mutation.
+func (m *NoteMutation) AddedY() (r float64, exists bool) {
+	v := m.addy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetY resets all changes to the "y" field.
+func (m *NoteMutation) ResetY() {
+	m.y = nil
+	m.addy = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *NoteMutation) SetWidth(f float64) {
+	m.width = &f
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *NoteMutation) Width() (r float64, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldWidth(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds f to the "width" field.
+func (m *NoteMutation) AddWidth(f float64) {
+	if m.addwidth != nil {
+		*m.addwidth += f
+	} else {
+		m.addwidth = &f
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *NoteMutation) AddedWidth() (r float64, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *NoteMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *NoteMutation) SetHeight(f float64) {
+	m.height = &f
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *NoteMutation) Height() (r float64, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldHeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds f to the "height" field.
+func (m *NoteMutation) AddHeight(f float64) {
+	if m.addheight != nil {
+		*m.addheight += f
+	} else {
+		m.addheight = &f
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *NoteMutation) AddedHeight() (r float64, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *NoteMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetText sets the "text" field.
+func (m *NoteMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *NoteMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *NoteMutation) ResetText() {
+	m.text = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *NoteMutation) SetTagID(i int) {
+	m.tag = &i
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *NoteMutation) TagID() (r int, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagID returns the old "tag_id" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldTagID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+	}
+	return oldValue.TagID, nil
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (m *NoteMutation) ClearTagID() {
+	m.tag = nil
+	m.clearedFields[note.FieldTagID] = struct{}{}
+}
+
+// TagIDCleared returns if the "tag_id" field was cleared in this mutation.
+func (m *NoteMutation) TagIDCleared() bool {
+	_, ok := m.clearedFields[note.FieldTagID]
+	return ok
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *NoteMutation) ResetTagID() {
+	m.tag = nil
+	delete(m.clearedFields, note.FieldTagID)
+}
+
+// SetAuthor sets the "author" field.
+func (m *NoteMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *NoteMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *NoteMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *NoteMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *NoteMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldEmbedding(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *NoteMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.clearedFields[note.FieldEmbedding] = struct{}{}
+}
+
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *NoteMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[note.FieldEmbedding]
+	return ok
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *NoteMutation) ResetEmbedding() {
+	m.embedding = nil
+	delete(m.clearedFields, note.FieldEmbedding)
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (m *NoteMutation) SetEmbeddingModel(s string) {
+	m.embedding_model = &s
+}
+
+// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
+func (m *NoteMutation) EmbeddingModel() (r string, exists bool) {
+	v := m.embedding_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingModel returns the old "embedding_model" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldEmbeddingModel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+	}
+	return oldValue.EmbeddingModel, nil
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (m *NoteMutation) ClearEmbeddingModel() {
+	m.embedding_model = nil
+	m.clearedFields[note.FieldEmbeddingModel] = struct{}{}
+}
+
+// EmbeddingModelCleared returns if the "embedding_model" field was cleared in this mutation.
+func (m *NoteMutation) EmbeddingModelCleared() bool {
+	_, ok := m.clearedFields[note.FieldEmbeddingModel]
+	return ok
+}
+
+// ResetEmbeddingModel resets all changes to the "embedding_model" field.
+func (m *NoteMutation) ResetEmbeddingModel() {
+	m.embedding_model = nil
+	delete(m.clearedFields, note.FieldEmbeddingModel)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NoteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NoteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NoteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *NoteMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[note.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *NoteMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *NoteMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *NoteMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *NoteMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[note.FieldTagID] = struct{}{}
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *NoteMutation) TagCleared() bool {
+	return m.TagIDCleared() || m.clearedtag
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *NoteMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTag resets all changes to the "tag" edge.
+func (m *NoteMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
+}
+
+// Where appends a list predicates to the NoteMutation builder.
+func (m *NoteMutation) Where(ps ...predicate.Note) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Note, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NoteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NoteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Note).
+func (m *NoteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NoteMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.media != nil {
+		fields = append(fields, note.FieldMediaID)
+	}
+	if m.kind != nil {
+		fields = append(fields, note.FieldKind)
+	}
+	if m.x != nil {
+		fields = append(fields, note.FieldX)
+	}
+	if m.y != nil {
+		fields = append(fields, note.FieldY)
+	}
+	if m.width != nil {
+		fields = append(fields, note.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, note.FieldHeight)
+	}
+	if m.text != nil {
+		fields = append(fields, note.FieldText)
+	}
+	if m.tag != nil {
+		fields = append(fields, note.FieldTagID)
+	}
+	if m.author != nil {
+		fields = append(fields, note.FieldAuthor)
+	}
+	if m.embedding != nil {
+		fields = append(fields, note.FieldEmbedding)
+	}
+	if m.embedding_model != nil {
+		fields = append(fields, note.FieldEmbeddingModel)
+	}
+	if m.created_at != nil {
+		fields = append(fields, note.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, note.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case note.FieldMediaID:
+		return m.MediaID()
+	case note.FieldKind:
+		return m.Kind()
+	case note.FieldX:
+		return m.X()
+	case note.FieldY:
+		return m.Y()
+	case note.FieldWidth:
+		return m.Width()
+	case note.FieldHeight:
+		return m.Height()
+	case note.FieldText:
+		return m.Text()
+	case note.FieldTagID:
+		return m.TagID()
+	case note.FieldAuthor:
+		return m.Author()
+	case note.FieldEmbedding:
+		return m.Embedding()
+	case note.FieldEmbeddingModel:
+		return m.EmbeddingModel()
+	case note.FieldCreatedAt:
+		return m.CreatedAt()
+	case note.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case note.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case note.FieldKind:
+		return m.OldKind(ctx)
+	case note.FieldX:
+		return m.OldX(ctx)
+	case note.FieldY:
+		return m.OldY(ctx)
+	case note.FieldWidth:
+		return m.OldWidth(ctx)
+	case note.FieldHeight:
+		return m.OldHeight(ctx)
+	case note.FieldText:
+		return m.OldText(ctx)
+	case note.FieldTagID:
+		return m.OldTagID(ctx)
+	case note.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case note.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case note.FieldEmbeddingModel:
+		return m.OldEmbeddingModel(ctx)
+	case note.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case note.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Note field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case note.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case note.FieldKind:
+		v, ok := value.(note.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case note.FieldX:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetX(v)
+		return nil
+	case note.FieldY:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetY(v)
+		return nil
+	case note.FieldWidth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case note.FieldHeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case note.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case note.FieldTagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case note.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case note.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case note.FieldEmbeddingModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingModel(v)
+		return nil
+	case note.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case note.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Note field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NoteMutation) AddedFields() []string {
+	var fields []string
+	if m.addx != nil {
+		fields = append(fields, note.FieldX)
+	}
+	if m.addy != nil {
+		fields = append(fields, note.FieldY)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, note.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, note.FieldHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NoteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case note.FieldX:
+		return m.AddedX()
+	case note.FieldY:
+		return m.AddedY()
+	case note.FieldWidth:
+		return m.AddedWidth()
+	case note.FieldHeight:
+		return m.AddedHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case note.FieldX:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddX(v)
+		return nil
+	case note.FieldY:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddY(v)
+		return nil
+	case note.FieldWidth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case note.FieldHeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Note numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NoteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(note.FieldTagID) {
+		fields = append(fields, note.FieldTagID)
+	}
+	if m.FieldCleared(note.FieldEmbedding) {
+		fields = append(fields, note.FieldEmbedding)
+	}
+	if m.FieldCleared(note.FieldEmbeddingModel) {
+		fields = append(fields, note.FieldEmbeddingModel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NoteMutation) ClearField(name string) error {
+	switch name {
+	case note.FieldTagID:
+		m.ClearTagID()
+		return nil
+	case note.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	case note.FieldEmbeddingModel:
+		m.ClearEmbeddingModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Note nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NoteMutation) ResetField(name string) error {
+	switch name {
+	case note.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case note.FieldKind:
+		m.ResetKind()
+		return nil
+	case note.FieldX:
+		m.ResetX()
+		return nil
+	case note.FieldY:
+		m.ResetY()
+		return nil
+	case note.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case note.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case note.FieldText:
+		m.ResetText()
+		return nil
+	case note.FieldTagID:
+		m.ResetTagID()
+		return nil
+	case note.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case note.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case note.FieldEmbeddingModel:
+		m.ResetEmbeddingModel()
+		return nil
+	case note.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case note.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Note field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.media != nil {
+		edges = append(edges, note.EdgeMedia)
+	}
+	if m.tag != nil {
+		edges = append(edges, note.EdgeTag)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case note.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	case note.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmedia {
+		edges = append(edges, note.EdgeMedia)
+	}
+	if m.clearedtag {
+		edges = append(edges, note.EdgeTag)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case note.EdgeMedia:
+		return m.clearedmedia
+	case note.EdgeTag:
+		return m.clearedtag
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NoteMutation) ClearEdge(name string) error {
+	switch name {
+	case note.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	case note.EdgeTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown Note unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NoteMutation) ResetEdge(name string) error {
+	switch name {
+	case note.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case note.EdgeTag:
+		m.ResetTag()
+		return nil
+	}
+	return fmt.Errorf("unknown Note edge %s", name)
+}
+
+// PoolMutation represents an operation that mutates the Pool nodes in the graph.
+type PoolMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	description       *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	media             map[string]struct{}
+	removedmedia      map[string]struct{}
+	clearedmedia      bool
+	cover             *string
+	clearedcover      bool
+	pool_media        map[int]struct{}
+	removedpool_media map[int]struct{}
+	clearedpool_media bool
+	done              bool
+	oldValue          func(context.Context) (*Pool, error)
+	predicates        []predicate.Pool
+}
+
+var _ ent.Mutation = (*PoolMutation)(nil)
+
+// poolOption allows management of the mutation configuration using functional options.
+type poolOption func(*PoolMutation)
+
+// newPoolMutation creates new mutation for the Pool entity.
+func newPoolMutation(c config, op Op, opts ...poolOption) *PoolMutation {
+	m := &PoolMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePool,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPoolID sets the ID field of the mutation.
+func withPoolID(id int) poolOption {
+	return func(m *PoolMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pool
+		)
+		m.oldValue = func(ctx context.Context) (*Pool, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pool.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPool sets the old Pool of the mutation.
+func withPool(node *Pool) poolOption {
+	return func(m *PoolMutation) {
+		m.oldValue = func(context.Context) (*Pool, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PoolMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PoolMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PoolMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PoolMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pool.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PoolMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PoolMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Pool entity.
+// If the Pool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PoolMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PoolMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PoolMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Pool entity.
+// If the Pool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PoolMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetCoverID sets the "cover_id" field.
+func (m *PoolMutation) SetCoverID(s string) {
+	m.cover = &s
+}
+
+// CoverID returns the value of the "cover_id" field in the mutation.
+func (m *PoolMutation) CoverID() (r string, exists bool) {
+	v := m.cover
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoverID returns the old "cover_id" field's value of the Pool entity.
+// If the Pool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMutation) OldCoverID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoverID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoverID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoverID: %w", err)
+	}
+	return oldValue.CoverID, nil
+}
+
+// ClearCoverID clears the value of the "cover_id" field.
+func (m *PoolMutation) ClearCoverID() {
+	m.cover = nil
+	m.clearedFields[pool.FieldCoverID] = struct{}{}
+}
+
+// CoverIDCleared returns if the "cover_id" field was cleared in this mutation.
+func (m *PoolMutation) CoverIDCleared() bool {
+	_, ok := m.clearedFields[pool.FieldCoverID]
+	return ok
+}
+
+// ResetCoverID resets all changes to the "cover_id" field.
+func (m *PoolMutation) ResetCoverID() {
+	m.cover = nil
+	delete(m.clearedFields, pool.FieldCoverID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PoolMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PoolMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Pool entity.
+// If the Pool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PoolMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PoolMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PoolMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Pool entity.
+// If the Pool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PoolMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by ids.
+func (m *PoolMutation) AddMediumIDs(ids ...string) {
+	if m.media == nil {
+		m.media = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *PoolMutation) ClearMedia() {
+	m.clearedmedia = true
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *PoolMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
+func (m *PoolMutation) RemoveMediumIDs(ids ...string) {
+	if m.removedmedia == nil {
+		m.removedmedia = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.media, ids[i])
+		m.removedmedia[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
+func (m *PoolMutation) RemovedMediaIDs() (ids []string) {
+	for id := range m.removedmedia {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+func (m *PoolMutation) MediaIDs() (ids []string) {
+	for id := range m.media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *PoolMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+	m.removedmedia = nil
+}
+
+// ClearCover clears the "cover" edge to the Media entity.
+func (m *PoolMutation) ClearCover() {
+	m.clearedcover = true
+	m.clearedFields[pool.FieldCoverID] = struct{}{}
+}
+
+// CoverCleared reports if the "cover" edge to the Media entity was cleared.
+func (m *PoolMutation) CoverCleared() bool {
+	return m.CoverIDCleared() || m.clearedcover
+}
+
+// CoverIDs returns the "cover" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CoverID instead. It exists only for internal usage by the builders.
+func (m *PoolMutation) CoverIDs() (ids []string) {
+	if id := m.cover; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCover resets all changes to the "cover" edge.
+func (m *PoolMutation) ResetCover() {
+	m.cover = nil
+	m.clearedcover = false
+}
+
+// AddPoolMediumIDs adds the "pool_media" edge to the PoolMedia entity by ids.
+func (m *PoolMutation) AddPoolMediumIDs(ids ...int) {
+	if m.pool_media == nil {
+		m.pool_media = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pool_media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPoolMedia clears the "pool_media" edge to the PoolMedia entity.
+func (m *PoolMutation) ClearPoolMedia() {
+	m.clearedpool_media = true
+}
+
+// PoolMediaCleared reports if the "pool_media" edge to the PoolMedia entity was cleared.
+func (m *PoolMutation) PoolMediaCleared() bool {
+	return m.clearedpool_media
+}
+
+// RemovePoolMediumIDs removes the "pool_media" edge to the PoolMedia entity by IDs.
+func (m *PoolMutation) RemovePoolMediumIDs(ids ...int) {
+	if m.removedpool_media == nil {
+		m.removedpool_media = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pool_media, ids[i])
+		m.removedpool_media[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPoolMedia returns the removed IDs of the "pool_media" edge to the PoolMedia entity.
+func (m *PoolMutation) RemovedPoolMediaIDs() (ids []int) {
+	for id := range m.removedpool_media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PoolMediaIDs returns the "pool_media" edge IDs in the mutation.
+func (m *PoolMutation) PoolMediaIDs() (ids []int) {
+	for id := range m.pool_media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPoolMedia resets all changes to the "pool_media" edge.
+func (m *PoolMutation) ResetPoolMedia() {
+	m.pool_media = nil
+	m.clearedpool_media = false
+	m.removedpool_media = nil
+}
+
+// Where appends a list predicates to the PoolMutation builder.
+func (m *PoolMutation) Where(ps ...predicate.Pool) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PoolMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PoolMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pool, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PoolMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PoolMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Pool).
+func (m *PoolMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PoolMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, pool.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, pool.FieldDescription)
+	}
+	if m.cover != nil {
+		fields = append(fields, pool.FieldCoverID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pool.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pool.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PoolMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pool.FieldName:
+		return m.Name()
+	case pool.FieldDescription:
+		return m.Description()
+	case pool.FieldCoverID:
+		return m.CoverID()
+	case pool.FieldCreatedAt:
+		return m.CreatedAt()
+	case pool.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PoolMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pool.FieldName:
+		return m.OldName(ctx)
+	case pool.FieldDescription:
+		return m.OldDescription(ctx)
+	case pool.FieldCoverID:
+		return m.OldCoverID(ctx)
+	case pool.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pool.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pool field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PoolMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pool.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case pool.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case pool.FieldCoverID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoverID(v)
+		return nil
+	case pool.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pool.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pool field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PoolMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PoolMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PoolMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Pool numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PoolMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pool.FieldCoverID) {
+		fields = append(fields, pool.FieldCoverID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PoolMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PoolMutation) ClearField(name string) error {
+	switch name {
+	case pool.FieldCoverID:
+		m.ClearCoverID()
+		return nil
+	}
+	return fmt.Errorf("unknown Pool nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PoolMutation) ResetField(name string) error {
+	switch name {
+	case pool.FieldName:
+		m.ResetName()
+		return nil
+	case pool.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case pool.FieldCoverID:
+		m.ResetCoverID()
+		return nil
+	case pool.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pool.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Pool field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PoolMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.media != nil {
+		edges = append(edges, pool.EdgeMedia)
+	}
+	if m.cover != nil {
+		edges = append(edges, pool.EdgeCover)
+	}
+	if m.pool_media != nil {
+		edges = append(edges, pool.EdgePoolMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PoolMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pool.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.media))
+		for id := range m.media {
+			ids = append(ids, id)
+		}
+		return ids
+	case pool.EdgeCover:
+		if id := m.cover; id != nil {
+			return []ent.Value{*id}
+		}
+	case pool.EdgePoolMedia:
+		ids := make([]ent.Value, 0, len(m.pool_media))
+		for id := range m.pool_media {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PoolMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedmedia != nil {
+		edges = append(edges, pool.EdgeMedia)
+	}
+	if m.removedpool_media != nil {
+		edges = append(edges, pool.EdgePoolMedia)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PoolMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case pool.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.removedmedia))
+		for id := range m.removedmedia {
+			ids = append(ids, id)
+		}
+		return ids
+	case pool.EdgePoolMedia:
+		ids := make([]ent.Value, 0, len(m.removedpool_media))
+		for id := range m.removedpool_media {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PoolMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedmedia {
+		edges = append(edges, pool.EdgeMedia)
+	}
+	if m.clearedcover {
+		edges = append(edges, pool.EdgeCover)
+	}
+	if m.clearedpool_media {
+		edges = append(edges, pool.EdgePoolMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PoolMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pool.EdgeMedia:
+		return m.clearedmedia
+	case pool.EdgeCover:
+		return m.clearedcover
+	case pool.EdgePoolMedia:
+		return m.clearedpool_media
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PoolMutation) ClearEdge(name string) error {
+	switch name {
+	case pool.EdgeCover:
+		m.ClearCover()
+		return nil
+	}
+	return fmt.Errorf("unknown Pool unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PoolMutation) ResetEdge(name string) error {
+	switch name {
+	case pool.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case pool.EdgeCover:
+		m.ResetCover()
+		return nil
+	case pool.EdgePoolMedia:
+		m.ResetPoolMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Pool edge %s", name)
+}
+
+// PoolMediaMutation represents an operation that mutates the PoolMedia nodes in the graph.
+type PoolMediaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	position      *int
+	addposition   *int
+	clearedFields map[string]struct{}
+	pool          *int
+	clearedpool   bool
+	media         *string
+	clearedmedia  bool
+	done          bool
+	oldValue      func(context.Context) (*PoolMedia, error)
+	predicates    []predicate.PoolMedia
+}
+
+var _ ent.Mutation = (*PoolMediaMutation)(nil)
+
+// poolmediaOption allows management of the mutation configuration using functional options.
+type poolmediaOption func(*PoolMediaMutation)
+
+// newPoolMediaMutation creates new mutation for the PoolMedia entity.
+func newPoolMediaMutation(c config, op Op, opts ...poolmediaOption) *PoolMediaMutation {
+	m := &PoolMediaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePoolMedia,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPoolMediaID sets the ID field of the mutation.
+func withPoolMediaID(id int) poolmediaOption {
+	return func(m *PoolMediaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PoolMedia
+		)
+		m.oldValue = func(ctx context.Context) (*PoolMedia, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PoolMedia.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPoolMedia sets the old PoolMedia of the mutation.
+func withPoolMedia(node *PoolMedia) poolmediaOption {
+	return func(m *PoolMediaMutation) {
+		m.oldValue = func(context.Context) (*PoolMedia, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PoolMediaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PoolMediaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PoolMediaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PoolMediaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PoolMedia.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPoolID sets the "pool_id" field.
+func (m *PoolMediaMutation) SetPoolID(i int) {
+	m.pool = &i
+}
+
+// PoolID returns the value of the "pool_id" field in the mutation.
+func (m *PoolMediaMutation) PoolID() (r int, exists bool) {
+	v := m.pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoolID returns the old "pool_id" field's value of the PoolMedia entity.
+// If the PoolMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMediaMutation) OldPoolID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoolID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoolID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoolID: %w", err)
+	}
+	return oldValue.PoolID, nil
+}
+
+// ResetPoolID resets all changes to the "pool_id" field.
+func (m *PoolMediaMutation) ResetPoolID() {
+	m.pool = nil
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *PoolMediaMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *PoolMediaMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the PoolMedia entity.
+// If the PoolMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMediaMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *PoolMediaMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *PoolMediaMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *PoolMediaMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the PoolMedia entity.
+// If the PoolMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMediaMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *PoolMediaMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *PoolMediaMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *PoolMediaMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// ClearPool clears the "pool" edge to the Pool entity.
+func (m *PoolMediaMutation) ClearPool() {
+	m.clearedpool = true
+	m.clearedFields[poolmedia.FieldPoolID] = struct{}{}
+}
+
+// PoolCleared reports if the "pool" edge to the Pool entity was cleared.
+func (m *PoolMediaMutation) PoolCleared() bool {
+	return m.clearedpool
+}
+
+// PoolIDs returns the "pool" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PoolID instead. It exists only for internal usage by the builders.
+func (m *PoolMediaMutation) PoolIDs() (ids []int) {
+	if id := m.pool; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPool resets all changes to the "pool" edge.
+func (m *PoolMediaMutation) ResetPool() {
+	m.pool = nil
+	m.clearedpool = false
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *PoolMediaMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[poolmedia.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *PoolMediaMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *PoolMediaMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *PoolMediaMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// Where appends a list predicates to the PoolMediaMutation builder.
+func (m *PoolMediaMutation) Where(ps ...predicate.PoolMedia) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PoolMediaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PoolMediaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PoolMedia, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PoolMediaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PoolMediaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PoolMedia).
+func (m *PoolMediaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PoolMediaMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.pool != nil {
+		fields = append(fields, poolmedia.FieldPoolID)
+	}
+	if m.media != nil {
+		fields = append(fields, poolmedia.FieldMediaID)
+	}
+	if m.position != nil {
+		fields = append(fields, poolmedia.FieldPosition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PoolMediaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case poolmedia.FieldPoolID:
+		return m.PoolID()
+	case poolmedia.FieldMediaID:
+		return m.MediaID()
+	case poolmedia.FieldPosition:
+		return m.Position()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PoolMediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case poolmedia.FieldPoolID:
+		return m.OldPoolID(ctx)
+	case poolmedia.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case poolmedia.FieldPosition:
+		return m.OldPosition(ctx)
+	}
+	return nil, fmt.Errorf("unknown PoolMedia field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PoolMediaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case poolmedia.FieldPoolID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoolID(v)
+		return nil
+	case poolmedia.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case poolmedia.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PoolMedia field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PoolMediaMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, poolmedia.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PoolMediaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case poolmedia.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PoolMediaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case poolmedia.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PoolMedia numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PoolMediaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PoolMediaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PoolMediaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PoolMedia nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PoolMediaMutation) ResetField(name string) error {
+	switch name {
+	case poolmedia.FieldPoolID:
+		m.ResetPoolID()
+		return nil
+	case poolmedia.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case poolmedia.FieldPosition:
+		m.ResetPosition()
+		return nil
+	}
+	return fmt.Errorf("unknown PoolMedia field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PoolMediaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.pool != nil {
+		edges = append(edges, poolmedia.EdgePool)
+	}
+	if m.media != nil {
+		edges = append(edges, poolmedia.EdgeMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PoolMediaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case poolmedia.EdgePool:
+		if id := m.pool; id != nil {
+			return []ent.Value{*id}
+		}
+	case poolmedia.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PoolMediaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PoolMediaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PoolMediaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpool {
+		edges = append(edges, poolmedia.EdgePool)
+	}
+	if m.clearedmedia {
+		edges = append(edges, poolmedia.EdgeMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PoolMediaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case poolmedia.EdgePool:
+		return m.clearedpool
+	case poolmedia.EdgeMedia:
+		return m.clearedmedia
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PoolMediaMutation) ClearEdge(name string) error {
+	switch name {
+	case poolmedia.EdgePool:
+		m.ClearPool()
+		return nil
+	case poolmedia.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown PoolMedia unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PoolMediaMutation) ResetEdge(name string) error {
+	switch name {
+	case poolmedia.EdgePool:
+		m.ResetPool()
+		return nil
+	case poolmedia.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown PoolMedia edge %s", name)
+}
+
+// RenditionMutation represents an operation that mutates the Rendition nodes in the graph.
+type RenditionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	format        *rendition.Format
+	status        *rendition.Status
+	progress      *float64
+	addprogress   *float64
+	size          *int64
+	addsize       *int64
+	error         *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	media         *string
+	clearedmedia  bool
+	done          bool
+	oldValue      func(context.Context) (*Rendition, error)
+	predicates    []predicate.Rendition
+}
+
+var _ ent.Mutation = (*RenditionMutation)(nil)
+
+// renditionOption allows management of the mutation configuration using functional options.
+type renditionOption func(*RenditionMutation)
+
+// newRenditionMutation creates new mutation for the Rendition entity.
+func newRenditionMutation(c config, op Op, opts ...renditionOption) *RenditionMutation {
+	m := &RenditionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRendition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRenditionID sets the ID field of the mutation.
+func withRenditionID(id int) renditionOption {
+	return func(m *RenditionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Rendition
+		)
+		m.oldValue = func(ctx context.Context) (*Rendition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Rendition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRendition sets the old Rendition of the mutation.
+func withRendition(node *Rendition) renditionOption {
+	return func(m *RenditionMutation) {
+		m.oldValue = func(context.Context) (*Rendition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RenditionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RenditionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RenditionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RenditionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Rendition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *RenditionMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *RenditionMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *RenditionMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *RenditionMutation) SetFormat(r rendition.Format) {
+	m.format = &r
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *RenditionMutation) Format() (r rendition.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldFormat(ctx context.Context) (v rendition.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *RenditionMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RenditionMutation) SetStatus(r rendition.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RenditionMutation) Status() (r rendition.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldStatus(ctx context.Context) (v rendition.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RenditionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetProgress sets the "progress" field.
+func (m *RenditionMutation) SetProgress(f float64) {
+	m.progress = &f
+	m.addprogress = nil
+}
+
+// Progress returns the value of the "progress" field in the mutation.
+func (m *RenditionMutation) Progress() (r float64, exists bool) {
+	v := m.progress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgress returns the old "progress" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldProgress(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+	}
+	return oldValue.Progress, nil
+}
+
+// AddProgress adds f to the "progress" field.
+func (m *RenditionMutation) AddProgress(f float64) {
+	if m.addprogress != nil {
+		*m.addprogress += f
+	} else {
+		m.addprogress = &f
+	}
+}
+
+// AddedProgress returns the value that was added to the "progress" field in this mutation.
+func (m *RenditionMutation) AddedProgress() (r float64, exists bool) {
+	v := m.addprogress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProgress resets all changes to the "progress" field.
+func (m *RenditionMutation) ResetProgress() {
+	m.progress = nil
+	m.addprogress = nil
+}
+
+// SetSize sets the "size" field.
+func (m *RenditionMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *RenditionMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldSize(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *RenditionMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *RenditionMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSize clears the value of the "size" field.
+func (m *RenditionMutation) ClearSize() {
+	m.size = nil
+	m.addsize = nil
+	m.clearedFields[rendition.FieldSize] = struct{}{}
+}
+
+// SizeCleared returns if the "size" field was cleared in this mutation.
+func (m *RenditionMutation) SizeCleared() bool {
+	_, ok := m.clearedFields[rendition.FieldSize]
+	return ok
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *RenditionMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+	delete(m.clearedFields, rendition.FieldSize)
+}
+
+// SetError sets the "error" field.
+func (m *RenditionMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *RenditionMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *RenditionMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[rendition.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *RenditionMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[rendition.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *RenditionMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, rendition.FieldError)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RenditionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RenditionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Rendition entity.
+// If the Rendition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenditionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RenditionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *RenditionMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[rendition.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *RenditionMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *RenditionMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *RenditionMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// Where appends a list predicates to the RenditionMutation builder.
+func (m *RenditionMutation) Where(ps ...predicate.Rendition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RenditionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RenditionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rendition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RenditionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RenditionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Rendition).
+func (m *RenditionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RenditionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.media != nil {
+		fields = append(fields, rendition.FieldMediaID)
+	}
+	if m.format != nil {
+		fields = append(fields, rendition.FieldFormat)
+	}
+	if m.status != nil {
+		fields = append(fields, rendition.FieldStatus)
+	}
+	if m.progress != nil {
+		fields = append(fields, rendition.FieldProgress)
+	}
+	if m.size != nil {
+		fields = append(fields, rendition.FieldSize)
+	}
+	if m.error != nil {
+		fields = append(fields, rendition.FieldError)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rendition.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RenditionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rendition.FieldMediaID:
+		return m.MediaID()
+	case rendition.FieldFormat:
+		return m.Format()
+	case rendition.FieldStatus:
+		return m.Status()
+	case rendition.FieldProgress:
+		return m.Progress()
+	case rendition.FieldSize:
+		return m.Size()
+	case rendition.FieldError:
+		return m.Error()
+	case rendition.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RenditionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rendition.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case rendition.FieldFormat:
+		return m.OldFormat(ctx)
+	case rendition.FieldStatus:
+		return m.OldStatus(ctx)
+	case rendition.FieldProgress:
+		return m.OldProgress(ctx)
+	case rendition.FieldSize:
+		return m.OldSize(ctx)
+	case rendition.FieldError:
+		return m.OldError(ctx)
+	case rendition.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Rendition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenditionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rendition.FieldMediaID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case rendition.FieldFormat:
+		v, ok := value.(rendition.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case rendition.FieldStatus:
+		v, ok := value.(rendition.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case rendition.FieldProgress:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case rendition.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case rendition.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case rendition.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RenditionMutation) AddedFields() []string {
+	var fields []string
+	if m.addprogress != nil {
+		fields = append(fields, rendition.FieldProgress)
+	}
+	if m.addsize != nil {
+		fields = append(fields, rendition.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RenditionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rendition.FieldProgress:
+		return m.AddedProgress()
+	case rendition.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenditionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rendition.FieldProgress:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
+	case rendition.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RenditionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rendition.FieldSize) {
+		fields = append(fields, rendition.FieldSize)
+	}
+	if m.FieldCleared(rendition.FieldError) {
+		fields = append(fields, rendition.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RenditionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RenditionMutation) ClearField(name string) error {
+	switch name {
+	case rendition.FieldSize:
+		m.ClearSize()
+		return nil
+	case rendition.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RenditionMutation) ResetField(name string) error {
+	switch name {
+	case rendition.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case rendition.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case rendition.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case rendition.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case rendition.FieldSize:
+		m.ResetSize()
+		return nil
+	case rendition.FieldError:
+		m.ResetError()
+		return nil
+	case rendition.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RenditionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.media != nil {
+		edges = append(edges, rendition.EdgeMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RenditionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rendition.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RenditionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RenditionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RenditionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmedia {
+		edges = append(edges, rendition.EdgeMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RenditionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rendition.EdgeMedia:
+		return m.clearedmedia
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RenditionMutation) ClearEdge(name string) error {
+	switch name {
+	case rendition.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RenditionMutation) ResetEdge(name string) error {
+	switch name {
+	case rendition.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown Rendition edge %s", name)
+}
+
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
+}
+
+var _ ent.Mutation = (*SettingMutation)(nil)
+
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
+
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id int) settingOption {
+	return func(m *SettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Setting
+		)
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Setting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *SettingMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *SettingMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *SettingMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveMediaVectorIDs removes the "media_vectors" edge to the MediaVector entity by IDs.
-func (m *MediaMutation) RemoveMediaVectorIDs(ids ...int) {
-	if m.removedmedia_vectors == nil {
-		m.removedmedia_vectors = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.media_vectors, ids[i])
-		m.removedmedia_vectors[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedMediaVectors returns the removed IDs of the "media_vectors" edge to the MediaVector entity.
-func (m *MediaMutation) RemovedMediaVectorsIDs() (ids []int) {
-	for id := range m.removedmedia_vectors {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// MediaVectorsIDs returns the "media_vectors" edge IDs in the mutation.
-func (m *MediaMutation) MediaVectorsIDs() (ids []int) {
-	for id := range m.media_vectors {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetMediaVectors resets all changes to the "media_vectors" edge.
-func (m *MediaMutation) ResetMediaVectors() {
-	m.media_vectors = nil
-	m.clearedmedia_vectors = false
-	m.removedmedia_vectors = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the MediaMutation builder.
-func (m *MediaMutation) Where(ps ...predicate.Media) {
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Media, len(ps))
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1626,36 +14246,33 @@ func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MediaMutation) Op() Op {
+func (m *SettingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MediaMutation) SetOp(op Op) {
+func (m *SettingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Media).
-func (m *MediaMutation) Type() string {
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MediaMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.format != nil {
-		fields = append(fields, media.FieldFormat)
-	}
-	if m.width != nil {
-		fields = append(fields, media.FieldWidth)
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.key != nil {
+		fields = append(fields, setting.FieldKey)
 	}
-	if m.height != nil {
-		fields = append(fields, media.FieldHeight)
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
 	}
-	if m.duration != nil {
-		fields = append(fields, media.FieldDuration)
+	if m.updated_at != nil {
+		fields = append(fields, setting.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1663,16 +14280,14 @@ func (m *MediaMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MediaMutation) Field(name string) (ent.Value, bool) {
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case media.FieldFormat:
-		return m.Format()
-	case media.FieldWidth:
-		return m.Width()
-	case media.FieldHeight:
-		return m.Height()
-	case media.FieldDuration:
-		return m.Duration()
+	case setting.FieldKey:
+		return m.Key()
+	case setting.FieldValue:
+		return m.Value()
+	case setting.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1680,382 +14295,182 @@ func (m *MediaMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case media.FieldFormat:
-		return m.OldFormat(ctx)
-	case media.FieldWidth:
-		return m.OldWidth(ctx)
-	case media.FieldHeight:
-		return m.OldHeight(ctx)
-	case media.FieldDuration:
-		return m.OldDuration(ctx)
+	case setting.FieldKey:
+		return m.OldKey(ctx)
+	case setting.FieldValue:
+		return m.OldValue(ctx)
+	case setting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Media field %s", name)
+	return nil, fmt.Errorf("unknown Setting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MediaMutation) SetField(name string, value ent.Value) error {
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case media.FieldFormat:
+	case setting.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFormat(v)
-		return nil
-	case media.FieldWidth:
-		v, ok := value.(int16)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWidth(v)
+		m.SetKey(v)
 		return nil
-	case media.FieldHeight:
-		v, ok := value.(int16)
+	case setting.FieldValue:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHeight(v)
+		m.SetValue(v)
 		return nil
-	case media.FieldDuration:
-		v, ok := value.(int16)
+	case setting.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDuration(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Media field %s", name)
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MediaMutation) AddedFields() []string {
-	var fields []string
-	if m.addwidth != nil {
-		fields = append(fields, media.FieldWidth)
-	}
-	if m.addheight != nil {
-		fields = append(fields, media.FieldHeight)
-	}
-	if m.addduration != nil {
-		fields = append(fields, media.FieldDuration)
-	}
-	return fields
+func (m *SettingMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MediaMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case media.FieldWidth:
-		return m.AddedWidth()
-	case media.FieldHeight:
-		return m.AddedHeight()
-	case media.FieldDuration:
-		return m.AddedDuration()
-	}
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MediaMutation) AddField(name string, value ent.Value) error {
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case media.FieldWidth:
-		v, ok := value.(int16)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWidth(v)
-		return nil
-	case media.FieldHeight:
-		v, ok := value.(int16)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddHeight(v)
-		return nil
-	case media.FieldDuration:
-		v, ok := value.(int16)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDuration(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Media numeric field %s", name)
+	return fmt.Errorf("unknown Setting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MediaMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(media.FieldDuration) {
-		fields = append(fields, media.FieldDuration)
-	}
-	return fields
+func (m *SettingMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MediaMutation) FieldCleared(name string) bool {
+func (m *SettingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MediaMutation) ClearField(name string) error {
-	switch name {
-	case media.FieldDuration:
-		m.ClearDuration()
-		return nil
-	}
-	return fmt.Errorf("unknown Media nullable field %s", name)
+func (m *SettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Setting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MediaMutation) ResetField(name string) error {
+func (m *SettingMutation) ResetField(name string) error {
 	switch name {
-	case media.FieldFormat:
-		m.ResetFormat()
-		return nil
-	case media.FieldWidth:
-		m.ResetWidth()
-		return nil
-	case media.FieldHeight:
-		m.ResetHeight()
-		return nil
-	case media.FieldDuration:
-		m.ResetDuration()
-		return nil
-	}
-	return fmt.Errorf("unknown Media field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MediaMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.tags != nil {
-		edges = append(edges, media.EdgeTags)
-	}
-	if m.dates != nil {
-		edges = append(edges, media.EdgeDates)
-	}
-	if m.vectors != nil {
-		edges = append(edges, media.EdgeVectors)
-	}
-	if m.media_dates != nil {
-		edges = append(edges, media.EdgeMediaDates)
-	}
-	if m.media_vectors != nil {
-		edges = append(edges, media.EdgeMediaVectors)
+	case setting.FieldKey:
+		m.ResetKey()
+		return nil
+	case setting.FieldValue:
+		m.ResetValue()
+		return nil
+	case setting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MediaMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case media.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
-			ids = append(ids, id)
-		}
-		return ids
-	case media.EdgeDates:
-		ids := make([]ent.Value, 0, len(m.dates))
-		for id := range m.dates {
-			ids = append(ids, id)
-		}
-		return ids
-	case media.EdgeVectors:
-		ids := make([]ent.Value, 0, len(m.vectors))
-		for id := range m.vectors {
-			ids = append(ids, id)
-		}
-		return ids
-	case media.EdgeMediaDates:
-		ids := make([]ent.Value, 0, len(m.media_dates))
-		for id := range m.media_dates {
-			ids = append(ids, id)
-		}
-		return ids
-	case media.EdgeMediaVectors:
-		ids := make([]ent.Value, 0, len(m.media_vectors))
-		for id := range m.media_vectors {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MediaMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedtags != nil {
-		edges = append(edges, media.EdgeTags)
-	}
-	if m.removeddates != nil {
-		edges = append(edges, media.EdgeDates)
-	}
-	if m.removedvectors != nil {
-		edges = append(edges, media.EdgeVectors)
-	}
-	if m.removedmedia_dates != nil {
-		edges = append(edges, media.EdgeMediaDates)
-	}
-	if m.removedmedia_vectors != nil {
-		edges = append(edges, media.EdgeMediaVectors)
-	}
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MediaMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case media.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
-			ids = append(ids, id)
-		}
-		return ids
-	case media.EdgeDates:
-		ids := make([]ent.Value, 0, len(m.removeddates))
-		for id := range m.removeddates {
-			ids = append(ids, id)
-		}
-		return ids
-	case media.EdgeVectors:
-		ids := make([]ent.Value, 0, len(m.removedvectors))
-		for id := range m.removedvectors {
-			ids = append(ids, id)
-		}
-		return ids
-	case media.EdgeMediaDates:
-		ids := make([]ent.Value, 0, len(m.removedmedia_dates))
-		for id := range m.removedmedia_dates {
-			ids = append(ids, id)
-		}
-		return ids
-	case media.EdgeMediaVectors:
-		ids := make([]ent.Value, 0, len(m.removedmedia_vectors))
-		for id := range m.removedmedia_vectors {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MediaMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedtags {
-		edges = append(edges, media.EdgeTags)
-	}
-	if m.cleareddates {
-		edges = append(edges, media.EdgeDates)
-	}
-	if m.clearedvectors {
-		edges = append(edges, media.EdgeVectors)
-	}
-	if m.clearedmedia_dates {
-		edges = append(edges, media.EdgeMediaDates)
-	}
-	if m.clearedmedia_vectors {
-		edges = append(edges, media.EdgeMediaVectors)
-	}
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MediaMutation) EdgeCleared(name string) bool {
-	switch name {
-	case media.EdgeTags:
-		return m.clearedtags
-	case media.EdgeDates:
-		return m.cleareddates
-	case media.EdgeVectors:
-		return m.clearedvectors
-	case media.EdgeMediaDates:
-		return m.clearedmedia_dates
-	case media.EdgeMediaVectors:
-		return m.clearedmedia_vectors
-	}
+func (m *SettingMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MediaMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Media unique edge %s", name)
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MediaMutation) ResetEdge(name string) error {
-	switch name {
-	case media.EdgeTags:
-		m.ResetTags()
-		return nil
-	case media.EdgeDates:
-		m.ResetDates()
-		return nil
-	case media.EdgeVectors:
-		m.ResetVectors()
-		return nil
-	case media.EdgeMediaDates:
-		m.ResetMediaDates()
-		return nil
-	case media.EdgeMediaVectors:
-		m.ResetMediaVectors()
-		return nil
-	}
-	return fmt.Errorf("unknown Media edge %s", name)
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
 }
 
-// MediaDateMutation represents an operation that mutates the MediaDate nodes in the graph.
-type MediaDateMutation struct {
+// SourceMutation represents an operation that mutates the Source nodes in the graph.
+type SourceMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	value         *time.Time
+	url           *string
+	domain        *string
+	created_at    *time.Time
 	clearedFields map[string]struct{}
 	media         *string
 	clearedmedia  bool
-	date          *int
-	cleareddate   bool
 	done          bool
-	oldValue      func(context.Context) (*MediaDate, error)
-	predicates    []predicate.MediaDate
+	oldValue      func(context.Context) (*Source, error)
+	predicates    []predicate.Source
 }
 
-var _ ent.Mutation = (*MediaDateMutation)(nil)
+var _ ent.Mutation = (*SourceMutation)(nil)
 
-// mediadateOption allows management of the mutation configuration using functional options.
-type mediadateOption func(*MediaDateMutation)
+// sourceOption allows management of the mutation configuration using functional options.
+type sourceOption func(*SourceMutation)
 
-// newMediaDateMutation creates new mutation for the MediaDate entity.
-func newMediaDateMutation(c config, op Op, opts ...mediadateOption) *MediaDateMutation {
-	m := &MediaDateMutation{
+// newSourceMutation creates new mutation for the Source entity.
+func newSourceMutation(c config, op Op, opts ...sourceOption) *SourceMutation {
+	m := &SourceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMediaDate,
+		typ:           TypeSource,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2064,20 +14479,20 @@ func newMediaDateMutation(c config, op Op, opts ...mediadateOption) *MediaDateMu
 	return m
 }
 
-// withMediaDateID sets the ID field of the mutation.
-func withMediaDateID(id int) mediadateOption {
-	return func(m *MediaDateMutation) {
+// withSourceID sets the ID field of the mutation.
+func withSourceID(id int) sourceOption {
+	return func(m *SourceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MediaDate
+			value *Source
 		)
-		m.oldValue = func(ctx context.Context) (*MediaDate, error) {
+		m.oldValue = func(ctx context.Context) (*Source, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MediaDate.Get(ctx, id)
+					value, err = m.Client().Source.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2086,10 +14501,10 @@ func withMediaDateID(id int) mediadateOption {
 	}
 }
 
-// withMediaDate sets the old MediaDate of the mutation.
-func withMediaDate(node *MediaDate) mediadateOption {
-	return func(m *MediaDateMutation) {
-		m.oldValue = func(context.Context) (*MediaDate, error) {
+// withSource sets the old Source of the mutation.
+func withSource(node *Source) sourceOption {
+	return func(m *SourceMutation) {
+		m.oldValue = func(context.Context) (*Source, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2098,7 +14513,7 @@ func withMediaDate(node *MediaDate) mediadateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MediaDateMutation) Client() *Client {
+func (m SourceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2106,7 +14521,7 @@ func (m MediaDateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MediaDateMutation) Tx() (*Tx, error) {
+func (m SourceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2117,7 +14532,7 @@ func (m MediaDateMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MediaDateMutation) ID() (id int, exists bool) {
+func (m *SourceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2128,7 +14543,7 @@ func (m *MediaDateMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MediaDateMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SourceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2137,19 +14552,19 @@ func (m *MediaDateMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MediaDate.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Source.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetMediaID sets the "media_id" field.
-func (m *MediaDateMutation) SetMediaID(s string) {
+func (m *SourceMutation) SetMediaID(s string) {
 	m.media = &s
 }
 
 // MediaID returns the value of the "media_id" field in the mutation.
-func (m *MediaDateMutation) MediaID() (r string, exists bool) {
+func (m *SourceMutation) MediaID() (r string, exists bool) {
 	v := m.media
 	if v == nil {
 		return
@@ -2157,10 +14572,10 @@ func (m *MediaDateMutation) MediaID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldMediaID returns the old "media_id" field's value of the MediaDate entity.
-// If the MediaDate object wasn't provided to the builder, the object is fetched from the database.
+// OldMediaID returns the old "media_id" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaDateMutation) OldMediaID(ctx context.Context) (v string, err error) {
+func (m *SourceMutation) OldMediaID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
 	}
@@ -2175,97 +14590,133 @@ func (m *MediaDateMutation) OldMediaID(ctx context.Context) (v string, err error
 }
 
 // ResetMediaID resets all changes to the "media_id" field.
-func (m *MediaDateMutation) ResetMediaID() {
+func (m *SourceMutation) ResetMediaID() {
 	m.media = nil
 }
 
-// SetDateID sets the "date_id" field.
-func (m *MediaDateMutation) SetDateID(i int) {
-	m.date = &i
+// SetURL sets the "url" field.
+func (m *SourceMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// DateID returns the value of the "date_id" field in the mutation.
-func (m *MediaDateMutation) DateID() (r int, exists bool) {
-	v := m.date
+// URL returns the value of the "url" field in the mutation.
+func (m *SourceMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDateID returns the old "date_id" field's value of the MediaDate entity.
-// If the MediaDate object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaDateMutation) OldDateID(ctx context.Context) (v int, err error) {
+func (m *SourceMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDateID is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDateID requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDateID: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.DateID, nil
+	return oldValue.URL, nil
 }
 
-// ResetDateID resets all changes to the "date_id" field.
-func (m *MediaDateMutation) ResetDateID() {
-	m.date = nil
+// ResetURL resets all changes to the "url" field.
+func (m *SourceMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetValue sets the "value" field.
-func (m *MediaDateMutation) SetValue(t time.Time) {
-	m.value = &t
+// SetDomain sets the "domain" field.
+func (m *SourceMutation) SetDomain(s string) {
+	m.domain = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *MediaDateMutation) Value() (r time.Time, exists bool) {
-	v := m.value
+// Domain returns the value of the "domain" field in the mutation.
+func (m *SourceMutation) Domain() (r string, exists bool) {
+	v := m.domain
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the MediaDate entity.
-// If the MediaDate object wasn't provided to the builder, the object is fetched from the database.
+// OldDomain returns the old "domain" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaDateMutation) OldValue(ctx context.Context) (v time.Time, err error) {
+func (m *SourceMutation) OldDomain(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldDomain requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Domain, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *MediaDateMutation) ResetValue() {
-	m.value = nil
+// ResetDomain resets all changes to the "domain" field.
+func (m *SourceMutation) ResetDomain() {
+	m.domain = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SourceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SourceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SourceMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // ClearMedia clears the "media" edge to the Media entity.
-func (m *MediaDateMutation) ClearMedia() {
+func (m *SourceMutation) ClearMedia() {
 	m.clearedmedia = true
-	m.clearedFields[mediadate.FieldMediaID] = struct{}{}
+	m.clearedFields[source.FieldMediaID] = struct{}{}
 }
 
 // MediaCleared reports if the "media" edge to the Media entity was cleared.
-func (m *MediaDateMutation) MediaCleared() bool {
+func (m *SourceMutation) MediaCleared() bool {
 	return m.clearedmedia
 }
 
 // MediaIDs returns the "media" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // MediaID instead. It exists only for internal usage by the builders.
-func (m *MediaDateMutation) MediaIDs() (ids []string) {
+func (m *SourceMutation) MediaIDs() (ids []string) {
 	if id := m.media; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2273,47 +14724,20 @@ func (m *MediaDateMutation) MediaIDs() (ids []string) {
 }
 
 // ResetMedia resets all changes to the "media" edge.
-func (m *MediaDateMutation) ResetMedia() {
+func (m *SourceMutation) ResetMedia() {
 	m.media = nil
 	m.clearedmedia = false
 }
 
-// ClearDate clears the "date" edge to the Date entity.
-func (m *MediaDateMutation) ClearDate() {
-	m.cleareddate = true
-	m.clearedFields[mediadate.FieldDateID] = struct{}{}
-}
-
-// DateCleared reports if the "date" edge to the Date entity was cleared.
-func (m *MediaDateMutation) DateCleared() bool {
-	return m.cleareddate
-}
-
-// DateIDs returns the "date" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DateID instead. It exists only for internal usage by the builders.
-func (m *MediaDateMutation) DateIDs() (ids []int) {
-	if id := m.date; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetDate resets all changes to the "date" edge.
-func (m *MediaDateMutation) ResetDate() {
-	m.date = nil
-	m.cleareddate = false
-}
-
-// Where appends a list predicates to the MediaDateMutation builder.
-func (m *MediaDateMutation) Where(ps ...predicate.MediaDate) {
+// Where appends a list predicates to the SourceMutation builder.
+func (m *SourceMutation) Where(ps ...predicate.Source) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MediaDateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SourceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MediaDateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MediaDate, len(ps))
+func (m *SourceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Source, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2321,33 +14745,36 @@ func (m *MediaDateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MediaDateMutation) Op() Op {
+func (m *SourceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MediaDateMutation) SetOp(op Op) {
+func (m *SourceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MediaDate).
-func (m *MediaDateMutation) Type() string {
+// Type returns the node type of this mutation (Source).
+func (m *SourceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MediaDateMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *SourceMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.media != nil {
-		fields = append(fields, mediadate.FieldMediaID)
+		fields = append(fields, source.FieldMediaID)
 	}
-	if m.date != nil {
-		fields = append(fields, mediadate.FieldDateID)
+	if m.url != nil {
+		fields = append(fields, source.FieldURL)
 	}
-	if m.value != nil {
-		fields = append(fields, mediadate.FieldValue)
+	if m.domain != nil {
+		fields = append(fields, source.FieldDomain)
+	}
+	if m.created_at != nil {
+		fields = append(fields, source.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2355,14 +14782,16 @@ func (m *MediaDateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MediaDateMutation) Field(name string) (ent.Value, bool) {
+func (m *SourceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case mediadate.FieldMediaID:
+	case source.FieldMediaID:
 		return m.MediaID()
-	case mediadate.FieldDateID:
-		return m.DateID()
-	case mediadate.FieldValue:
-		return m.Value()
+	case source.FieldURL:
+		return m.URL()
+	case source.FieldDomain:
+		return m.Domain()
+	case source.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -2370,229 +14799,220 @@ func (m *MediaDateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MediaDateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case mediadate.FieldMediaID:
+	case source.FieldMediaID:
 		return m.OldMediaID(ctx)
-	case mediadate.FieldDateID:
-		return m.OldDateID(ctx)
-	case mediadate.FieldValue:
-		return m.OldValue(ctx)
+	case source.FieldURL:
+		return m.OldURL(ctx)
+	case source.FieldDomain:
+		return m.OldDomain(ctx)
+	case source.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MediaDate field %s", name)
+	return nil, fmt.Errorf("unknown Source field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MediaDateMutation) SetField(name string, value ent.Value) error {
+func (m *SourceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case mediadate.FieldMediaID:
+	case source.FieldMediaID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMediaID(v)
 		return nil
-	case mediadate.FieldDateID:
-		v, ok := value.(int)
+	case source.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDateID(v)
+		m.SetURL(v)
 		return nil
-	case mediadate.FieldValue:
+	case source.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case source.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MediaDate field %s", name)
+	return fmt.Errorf("unknown Source field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MediaDateMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *SourceMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MediaDateMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *SourceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MediaDateMutation) AddField(name string, value ent.Value) error {
+func (m *SourceMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MediaDate numeric field %s", name)
+	return fmt.Errorf("unknown Source numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MediaDateMutation) ClearedFields() []string {
+func (m *SourceMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MediaDateMutation) FieldCleared(name string) bool {
+func (m *SourceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MediaDateMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MediaDate nullable field %s", name)
+func (m *SourceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Source nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MediaDateMutation) ResetField(name string) error {
+func (m *SourceMutation) ResetField(name string) error {
 	switch name {
-	case mediadate.FieldMediaID:
+	case source.FieldMediaID:
 		m.ResetMediaID()
 		return nil
-	case mediadate.FieldDateID:
-		m.ResetDateID()
+	case source.FieldURL:
+		m.ResetURL()
 		return nil
-	case mediadate.FieldValue:
-		m.ResetValue()
+	case source.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case source.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MediaDate field %s", name)
+	return fmt.Errorf("unknown Source field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MediaDateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SourceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.media != nil {
-		edges = append(edges, mediadate.EdgeMedia)
-	}
-	if m.date != nil {
-		edges = append(edges, mediadate.EdgeDate)
+		edges = append(edges, source.EdgeMedia)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MediaDateMutation) AddedIDs(name string) []ent.Value {
+func (m *SourceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case mediadate.EdgeMedia:
+	case source.EdgeMedia:
 		if id := m.media; id != nil {
 			return []ent.Value{*id}
 		}
-	case mediadate.EdgeDate:
-		if id := m.date; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MediaDateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SourceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MediaDateMutation) RemovedIDs(name string) []ent.Value {
+func (m *SourceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MediaDateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SourceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedmedia {
-		edges = append(edges, mediadate.EdgeMedia)
-	}
-	if m.cleareddate {
-		edges = append(edges, mediadate.EdgeDate)
+		edges = append(edges, source.EdgeMedia)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MediaDateMutation) EdgeCleared(name string) bool {
+func (m *SourceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case mediadate.EdgeMedia:
+	case source.EdgeMedia:
 		return m.clearedmedia
-	case mediadate.EdgeDate:
-		return m.cleareddate
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MediaDateMutation) ClearEdge(name string) error {
+func (m *SourceMutation) ClearEdge(name string) error {
 	switch name {
-	case mediadate.EdgeMedia:
+	case source.EdgeMedia:
 		m.ClearMedia()
 		return nil
-	case mediadate.EdgeDate:
-		m.ClearDate()
-		return nil
 	}
-	return fmt.Errorf("unknown MediaDate unique edge %s", name)
+	return fmt.Errorf("unknown Source unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MediaDateMutation) ResetEdge(name string) error {
+func (m *SourceMutation) ResetEdge(name string) error {
 	switch name {
-	case mediadate.EdgeMedia:
+	case source.EdgeMedia:
 		m.ResetMedia()
 		return nil
-	case mediadate.EdgeDate:
-		m.ResetDate()
-		return nil
 	}
-	return fmt.Errorf("unknown MediaDate edge %s", name)
+	return fmt.Errorf("unknown Source edge %s", name)
 }
 
-// MediaVectorMutation represents an operation that mutates the MediaVector nodes in the graph.
-type MediaVectorMutation struct {
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	value         *pgvector.Vector
+	name          *string
+	_type         *tag.Type
 	clearedFields map[string]struct{}
-	media         *string
+	media         map[string]struct{}
+	removedmedia  map[string]struct{}
 	clearedmedia  bool
-	vector        *int
-	clearedvector bool
 	done          bool
-	oldValue      func(context.Context) (*MediaVector, error)
-	predicates    []predicate.MediaVector
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
 }
 
-var _ ent.Mutation = (*MediaVectorMutation)(nil)
+var _ ent.Mutation = (*TagMutation)(nil)
 
-// mediavectorOption allows management of the mutation configuration using functional options.
-type mediavectorOption func(*MediaVectorMutation)
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
 
-// newMediaVectorMutation creates new mutation for the MediaVector entity.
-func newMediaVectorMutation(c config, op Op, opts ...mediavectorOption) *MediaVectorMutation {
-	m := &MediaVectorMutation{
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMediaVector,
+		typ:           TypeTag,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2601,20 +15021,20 @@ func newMediaVectorMutation(c config, op Op, opts ...mediavectorOption) *MediaVe
 	return m
 }
 
-// withMediaVectorID sets the ID field of the mutation.
-func withMediaVectorID(id int) mediavectorOption {
-	return func(m *MediaVectorMutation) {
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MediaVector
+			value *Tag
 		)
-		m.oldValue = func(ctx context.Context) (*MediaVector, error) {
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MediaVector.Get(ctx, id)
+					value, err = m.Client().Tag.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2623,10 +15043,10 @@ func withMediaVectorID(id int) mediavectorOption {
 	}
 }
 
-// withMediaVector sets the old MediaVector of the mutation.
-func withMediaVector(node *MediaVector) mediavectorOption {
-	return func(m *MediaVectorMutation) {
-		m.oldValue = func(context.Context) (*MediaVector, error) {
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2635,7 +15055,7 @@ func withMediaVector(node *MediaVector) mediavectorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MediaVectorMutation) Client() *Client {
+func (m TagMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2643,7 +15063,7 @@ func (m MediaVectorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MediaVectorMutation) Tx() (*Tx, error) {
+func (m TagMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2652,205 +15072,175 @@ func (m MediaVectorMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MediaVectorMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MediaVectorMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MediaVector.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetMediaID sets the "media_id" field.
-func (m *MediaVectorMutation) SetMediaID(s string) {
-	m.media = &s
-}
-
-// MediaID returns the value of the "media_id" field in the mutation.
-func (m *MediaVectorMutation) MediaID() (r string, exists bool) {
-	v := m.media
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id int) {
+	m.id = &id
 }
 
-// OldMediaID returns the old "media_id" field's value of the MediaVector entity.
-// If the MediaVector object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaVectorMutation) OldMediaID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMediaID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.MediaID, nil
+	return *m.id, true
 }
 
-// ResetMediaID resets all changes to the "media_id" field.
-func (m *MediaVectorMutation) ResetMediaID() {
-	m.media = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetVectorID sets the "vector_id" field.
-func (m *MediaVectorMutation) SetVectorID(i int) {
-	m.vector = &i
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
 }
 
-// VectorID returns the value of the "vector_id" field in the mutation.
-func (m *MediaVectorMutation) VectorID() (r int, exists bool) {
-	v := m.vector
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVectorID returns the old "vector_id" field's value of the MediaVector entity.
-// If the MediaVector object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaVectorMutation) OldVectorID(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVectorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVectorID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVectorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.VectorID, nil
+	return oldValue.Name, nil
 }
 
-// ResetVectorID resets all changes to the "vector_id" field.
-func (m *MediaVectorMutation) ResetVectorID() {
-	m.vector = nil
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
 }
 
-// SetValue sets the "value" field.
-func (m *MediaVectorMutation) SetValue(pg pgvector.Vector) {
-	m.value = &pg
+// SetType sets the "type" field.
+func (m *TagMutation) SetType(t tag.Type) {
+	m._type = &t
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *MediaVectorMutation) Value() (r pgvector.Vector, exists bool) {
-	v := m.value
+// GetType returns the value of the "type" field in the mutation.
+func (m *TagMutation) GetType() (r tag.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the MediaVector entity.
-// If the MediaVector object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaVectorMutation) OldValue(ctx context.Context) (v pgvector.Vector, err error) {
+func (m *TagMutation) OldType(ctx context.Context) (v tag.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Type, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *MediaVectorMutation) ResetValue() {
-	m.value = nil
+// ResetType resets all changes to the "type" field.
+func (m *TagMutation) ResetType() {
+	m._type = nil
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by ids.
+func (m *TagMutation) AddMediumIDs(ids ...string) {
+	if m.media == nil {
+		m.media = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.media[ids[i]] = struct{}{}
+	}
 }
 
 // ClearMedia clears the "media" edge to the Media entity.
-func (m *MediaVectorMutation) ClearMedia() {
+func (m *TagMutation) ClearMedia() {
 	m.clearedmedia = true
-	m.clearedFields[mediavector.FieldMediaID] = struct{}{}
 }
 
 // MediaCleared reports if the "media" edge to the Media entity was cleared.
-func (m *MediaVectorMutation) MediaCleared() bool {
+func (m *TagMutation) MediaCleared() bool {
 	return m.clearedmedia
 }
 
-// MediaIDs returns the "media" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MediaID instead. It exists only for internal usage by the builders.
-func (m *MediaVectorMutation) MediaIDs() (ids []string) {
-	if id := m.media; id != nil {
-		ids = append(ids, *id)
+// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
+func (m *TagMutation) RemoveMediumIDs(ids ...string) {
+	if m.removedmedia == nil {
+		m.removedmedia = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.media, ids[i])
+		m.removedmedia[ids[i]] = struct{}{}
 	}
-	return
-}
-
-// ResetMedia resets all changes to the "media" edge.
-func (m *MediaVectorMutation) ResetMedia() {
-	m.media = nil
-	m.clearedmedia = false
-}
-
-// ClearVector clears the "vector" edge to the Vector entity.
-func (m *MediaVectorMutation) ClearVector() {
-	m.clearedvector = true
-	m.clearedFields[mediavector.FieldVectorID] = struct{}{}
 }
 
-// VectorCleared reports if the "vector" edge to the Vector entity was cleared.
-func (m *MediaVectorMutation) VectorCleared() bool {
-	return m.clearedvector
+// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
+func (m *TagMutation) RemovedMediaIDs() (ids []string) {
+	for id := range m.removedmedia {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// VectorIDs returns the "vector" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// VectorID instead. It exists only for internal usage by the builders.
-func (m *MediaVectorMutation) VectorIDs() (ids []int) {
-	if id := m.vector; id != nil {
-		ids = append(ids, *id)
+// MediaIDs returns the "media" edge IDs in the mutation.
+func (m *TagMutation) MediaIDs() (ids []string) {
+	for id := range m.media {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetVector resets all changes to the "vector" edge.
-func (m *MediaVectorMutation) ResetVector() {
-	m.vector = nil
-	m.clearedvector = false
+// ResetMedia resets all changes to the "media" edge.
+func (m *TagMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+	m.removedmedia = nil
 }
 
-// Where appends a list predicates to the MediaVectorMutation builder.
-func (m *MediaVectorMutation) Where(ps ...predicate.MediaVector) {
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MediaVectorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MediaVectorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MediaVector, len(ps))
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2858,33 +15248,30 @@ func (m *MediaVectorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MediaVectorMutation) Op() Op {
+func (m *TagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MediaVectorMutation) SetOp(op Op) {
+func (m *TagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MediaVector).
-func (m *MediaVectorMutation) Type() string {
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MediaVectorMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.media != nil {
-		fields = append(fields, mediavector.FieldMediaID)
-	}
-	if m.vector != nil {
-		fields = append(fields, mediavector.FieldVectorID)
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
 	}
-	if m.value != nil {
-		fields = append(fields, mediavector.FieldValue)
+	if m._type != nil {
+		fields = append(fields, tag.FieldType)
 	}
 	return fields
 }
@@ -2892,14 +15279,12 @@ func (m *MediaVectorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MediaVectorMutation) Field(name string) (ent.Value, bool) {
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case mediavector.FieldMediaID:
-		return m.MediaID()
-	case mediavector.FieldVectorID:
-		return m.VectorID()
-	case mediavector.FieldValue:
-		return m.Value()
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldType:
+		return m.GetType()
 	}
 	return nil, false
 }
@@ -2907,227 +15292,212 @@ func (m *MediaVectorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MediaVectorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case mediavector.FieldMediaID:
-		return m.OldMediaID(ctx)
-	case mediavector.FieldVectorID:
-		return m.OldVectorID(ctx)
-	case mediavector.FieldValue:
-		return m.OldValue(ctx)
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldType:
+		return m.OldType(ctx)
 	}
-	return nil, fmt.Errorf("unknown MediaVector field %s", name)
+	return nil, fmt.Errorf("unknown Tag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MediaVectorMutation) SetField(name string, value ent.Value) error {
+func (m *TagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case mediavector.FieldMediaID:
+	case tag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMediaID(v)
-		return nil
-	case mediavector.FieldVectorID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVectorID(v)
+		m.SetName(v)
 		return nil
-	case mediavector.FieldValue:
-		v, ok := value.(pgvector.Vector)
+	case tag.FieldType:
+		v, ok := value.(tag.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetType(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MediaVector field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MediaVectorMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *TagMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MediaVectorMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MediaVectorMutation) AddField(name string, value ent.Value) error {
+func (m *TagMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MediaVector numeric field %s", name)
+	return fmt.Errorf("unknown Tag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MediaVectorMutation) ClearedFields() []string {
+func (m *TagMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MediaVectorMutation) FieldCleared(name string) bool {
+func (m *TagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MediaVectorMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MediaVector nullable field %s", name)
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MediaVectorMutation) ResetField(name string) error {
+func (m *TagMutation) ResetField(name string) error {
 	switch name {
-	case mediavector.FieldMediaID:
-		m.ResetMediaID()
-		return nil
-	case mediavector.FieldVectorID:
-		m.ResetVectorID()
+	case tag.FieldName:
+		m.ResetName()
 		return nil
-	case mediavector.FieldValue:
-		m.ResetValue()
+	case tag.FieldType:
+		m.ResetType()
 		return nil
 	}
-	return fmt.Errorf("unknown MediaVector field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MediaVectorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.media != nil {
-		edges = append(edges, mediavector.EdgeMedia)
-	}
-	if m.vector != nil {
-		edges = append(edges, mediavector.EdgeVector)
+		edges = append(edges, tag.EdgeMedia)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MediaVectorMutation) AddedIDs(name string) []ent.Value {
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case mediavector.EdgeMedia:
-		if id := m.media; id != nil {
-			return []ent.Value{*id}
-		}
-	case mediavector.EdgeVector:
-		if id := m.vector; id != nil {
-			return []ent.Value{*id}
+	case tag.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.media))
+		for id := range m.media {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MediaVectorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmedia != nil {
+		edges = append(edges, tag.EdgeMedia)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MediaVectorMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.removedmedia))
+		for id := range m.removedmedia {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MediaVectorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedmedia {
-		edges = append(edges, mediavector.EdgeMedia)
-	}
-	if m.clearedvector {
-		edges = append(edges, mediavector.EdgeVector)
+		edges = append(edges, tag.EdgeMedia)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MediaVectorMutation) EdgeCleared(name string) bool {
+func (m *TagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case mediavector.EdgeMedia:
+	case tag.EdgeMedia:
 		return m.clearedmedia
-	case mediavector.EdgeVector:
-		return m.clearedvector
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MediaVectorMutation) ClearEdge(name string) error {
+func (m *TagMutation) ClearEdge(name string) error {
 	switch name {
-	case mediavector.EdgeMedia:
-		m.ClearMedia()
-		return nil
-	case mediavector.EdgeVector:
-		m.ClearVector()
-		return nil
 	}
-	return fmt.Errorf("unknown MediaVector unique edge %s", name)
+	return fmt.Errorf("unknown Tag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MediaVectorMutation) ResetEdge(name string) error {
+func (m *TagMutation) ResetEdge(name string) error {
 	switch name {
-	case mediavector.EdgeMedia:
+	case tag.EdgeMedia:
 		m.ResetMedia()
 		return nil
-	case mediavector.EdgeVector:
-		m.ResetVector()
-		return nil
 	}
-	return fmt.Errorf("unknown MediaVector edge %s", name)
+	return fmt.Errorf("unknown Tag edge %s", name)
 }
 
-// SettingMutation represents an operation that mutates the Setting nodes in the graph.
-type SettingMutation struct {
+// TagSuggestionMutation represents an operation that mutates the TagSuggestion nodes in the graph.
+type TagSuggestionMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	key           *string
-	value         *string
-	updated_at    *time.Time
+	score         *float64
+	addscore      *float64
+	source        *tagsuggestion.Source
+	status        *tagsuggestion.Status
+	decided_by    *string
+	decided_at    *time.Time
+	created_at    *time.Time
 	clearedFields map[string]struct{}
+	media         *string
+	clearedmedia  bool
+	tag           *int
+	clearedtag    bool
 	done          bool
-	oldValue      func(context.Context) (*Setting, error)
-	predicates    []predicate.Setting
+	oldValue      func(context.Context) (*TagSuggestion, error)
+	predicates    []predicate.TagSuggestion
 }
 
-var _ ent.Mutation = (*SettingMutation)(nil)
+var _ ent.Mutation = (*TagSuggestionMutation)(nil)
 
-// settingOption allows management of the mutation configuration using functional options.
-type settingOption func(*SettingMutation)
+// tagsuggestionOption allows management of the mutation configuration using functional options.
+type tagsuggestionOption func(*TagSuggestionMutation)
 
-// newSettingMutation creates new mutation for the Setting entity.
-func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
-	m := &SettingMutation{
+// newTagSuggestionMutation creates new mutation for the TagSuggestion entity.
+func newTagSuggestionMutation(c config, op Op, opts ...tagsuggestionOption) *TagSuggestionMutation {
+	m := &TagSuggestionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSetting,
+		typ:           TypeTagSuggestion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3136,20 +15506,20 @@ func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation
 	return m
 }
 
-// withSettingID sets the ID field of the mutation.
-func withSettingID(id int) settingOption {
-	return func(m *SettingMutation) {
+// withTagSuggestionID sets the ID field of the mutation.
+func withTagSuggestionID(id int) tagsuggestionOption {
+	return func(m *TagSuggestionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Setting
+			value *TagSuggestion
 		)
-		m.oldValue = func(ctx context.Context) (*Setting, error) {
+		m.oldValue = func(ctx context.Context) (*TagSuggestion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Setting.Get(ctx, id)
+					value, err = m.Client().TagSuggestion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3158,180 +15528,460 @@ func withSettingID(id int) settingOption {
 	}
 }
 
-// withSetting sets the old Setting of the mutation.
-func withSetting(node *Setting) settingOption {
-	return func(m *SettingMutation) {
-		m.oldValue = func(context.Context) (*Setting, error) {
+// withTagSuggestion sets the old TagSuggestion of the mutation.
+func withTagSuggestion(node *TagSuggestion) tagsuggestionOption {
+	return func(m *TagSuggestionMutation) {
+		m.oldValue = func(context.Context) (*TagSuggestion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagSuggestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagSuggestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagSuggestionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagSuggestionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TagSuggestion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *TagSuggestionMutation) SetMediaID(s string) {
+	m.media = &s
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *TagSuggestionMutation) MediaID() (r string, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSuggestionMutation) OldMediaID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *TagSuggestionMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *TagSuggestionMutation) SetTagID(i int) {
+	m.tag = &i
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *TagSuggestionMutation) TagID() (r int, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagID returns the old "tag_id" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSuggestionMutation) OldTagID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+	}
+	return oldValue.TagID, nil
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *TagSuggestionMutation) ResetTagID() {
+	m.tag = nil
+}
+
+// SetScore sets the "score" field.
+func (m *TagSuggestionMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *TagSuggestionMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSuggestionMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *TagSuggestionMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *TagSuggestionMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *TagSuggestionMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetSource sets the "source" field.
+func (m *TagSuggestionMutation) SetSource(t tagsuggestion.Source) {
+	m.source = &t
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *TagSuggestionMutation) Source() (r tagsuggestion.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSuggestionMutation) OldSource(ctx context.Context) (v tagsuggestion.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *TagSuggestionMutation) ResetSource() {
+	m.source = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SettingMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetStatus sets the "status" field.
+func (m *TagSuggestionMutation) SetStatus(t tagsuggestion.Status) {
+	m.status = &t
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SettingMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// Status returns the value of the "status" field in the mutation.
+func (m *TagSuggestionMutation) Status() (r tagsuggestion.Status, exists bool) {
+	v := m.status
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldStatus returns the old "status" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSuggestionMutation) OldStatus(ctx context.Context) (v tagsuggestion.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
+	return oldValue.Status, nil
 }
 
-// SetKey sets the "key" field.
-func (m *SettingMutation) SetKey(s string) {
-	m.key = &s
+// ResetStatus resets all changes to the "status" field.
+func (m *TagSuggestionMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *SettingMutation) Key() (r string, exists bool) {
-	v := m.key
+// SetDecidedBy sets the "decided_by" field.
+func (m *TagSuggestionMutation) SetDecidedBy(s string) {
+	m.decided_by = &s
+}
+
+// DecidedBy returns the value of the "decided_by" field in the mutation.
+func (m *TagSuggestionMutation) DecidedBy() (r string, exists bool) {
+	v := m.decided_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldDecidedBy returns the old "decided_by" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *TagSuggestionMutation) OldDecidedBy(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldDecidedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldDecidedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldDecidedBy: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.DecidedBy, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *SettingMutation) ResetKey() {
-	m.key = nil
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (m *TagSuggestionMutation) ClearDecidedBy() {
+	m.decided_by = nil
+	m.clearedFields[tagsuggestion.FieldDecidedBy] = struct{}{}
 }
 
-// SetValue sets the "value" field.
-func (m *SettingMutation) SetValue(s string) {
-	m.value = &s
+// DecidedByCleared returns if the "decided_by" field was cleared in this mutation.
+func (m *TagSuggestionMutation) DecidedByCleared() bool {
+	_, ok := m.clearedFields[tagsuggestion.FieldDecidedBy]
+	return ok
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingMutation) Value() (r string, exists bool) {
-	v := m.value
+// ResetDecidedBy resets all changes to the "decided_by" field.
+func (m *TagSuggestionMutation) ResetDecidedBy() {
+	m.decided_by = nil
+	delete(m.clearedFields, tagsuggestion.FieldDecidedBy)
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (m *TagSuggestionMutation) SetDecidedAt(t time.Time) {
+	m.decided_at = &t
+}
+
+// DecidedAt returns the value of the "decided_at" field in the mutation.
+func (m *TagSuggestionMutation) DecidedAt() (r time.Time, exists bool) {
+	v := m.decided_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldDecidedAt returns the old "decided_at" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *TagSuggestionMutation) OldDecidedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldDecidedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldDecidedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldDecidedAt: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.DecidedAt, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingMutation) ResetValue() {
-	m.value = nil
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (m *TagSuggestionMutation) ClearDecidedAt() {
+	m.decided_at = nil
+	m.clearedFields[tagsuggestion.FieldDecidedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SettingMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// DecidedAtCleared returns if the "decided_at" field was cleared in this mutation.
+func (m *TagSuggestionMutation) DecidedAtCleared() bool {
+	_, ok := m.clearedFields[tagsuggestion.FieldDecidedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetDecidedAt resets all changes to the "decided_at" field.
+func (m *TagSuggestionMutation) ResetDecidedAt() {
+	m.decided_at = nil
+	delete(m.clearedFields, tagsuggestion.FieldDecidedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagSuggestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagSuggestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TagSuggestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SettingMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagSuggestionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the SettingMutation builder.
-func (m *SettingMutation) Where(ps ...predicate.Setting) {
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *TagSuggestionMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[tagsuggestion.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *TagSuggestionMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *TagSuggestionMutation) MediaIDs() (ids []string) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *TagSuggestionMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *TagSuggestionMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[tagsuggestion.FieldTagID] = struct{}{}
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *TagSuggestionMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *TagSuggestionMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTag resets all changes to the "tag" edge.
+func (m *TagSuggestionMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
+}
+
+// Where appends a list predicates to the TagSuggestionMutation builder.
+func (m *TagSuggestionMutation) Where(ps ...predicate.TagSuggestion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagSuggestionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Setting, len(ps))
+func (m *TagSuggestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagSuggestion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3339,33 +15989,48 @@ func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingMutation) Op() Op {
+func (m *TagSuggestionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingMutation) SetOp(op Op) {
+func (m *TagSuggestionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Setting).
-func (m *SettingMutation) Type() string {
+// Type returns the node type of this mutation (TagSuggestion).
+func (m *TagSuggestionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.key != nil {
-		fields = append(fields, setting.FieldKey)
+func (m *TagSuggestionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.media != nil {
+		fields = append(fields, tagsuggestion.FieldMediaID)
 	}
-	if m.value != nil {
-		fields = append(fields, setting.FieldValue)
+	if m.tag != nil {
+		fields = append(fields, tagsuggestion.FieldTagID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, setting.FieldUpdatedAt)
+	if m.score != nil {
+		fields = append(fields, tagsuggestion.FieldScore)
+	}
+	if m.source != nil {
+		fields = append(fields, tagsuggestion.FieldSource)
+	}
+	if m.status != nil {
+		fields = append(fields, tagsuggestion.FieldStatus)
+	}
+	if m.decided_by != nil {
+		fields = append(fields, tagsuggestion.FieldDecidedBy)
+	}
+	if m.decided_at != nil {
+		fields = append(fields, tagsuggestion.FieldDecidedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tagsuggestion.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3373,14 +16038,24 @@ func (m *SettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+func (m *TagSuggestionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case setting.FieldKey:
-		return m.Key()
-	case setting.FieldValue:
-		return m.Value()
-	case setting.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case tagsuggestion.FieldMediaID:
+		return m.MediaID()
+	case tagsuggestion.FieldTagID:
+		return m.TagID()
+	case tagsuggestion.FieldScore:
+		return m.Score()
+	case tagsuggestion.FieldSource:
+		return m.Source()
+	case tagsuggestion.FieldStatus:
+		return m.Status()
+	case tagsuggestion.FieldDecidedBy:
+		return m.DecidedBy()
+	case tagsuggestion.FieldDecidedAt:
+		return m.DecidedAt()
+	case tagsuggestion.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -3388,182 +16063,319 @@ func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagSuggestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case setting.FieldKey:
-		return m.OldKey(ctx)
-	case setting.FieldValue:
-		return m.OldValue(ctx)
-	case setting.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case tagsuggestion.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case tagsuggestion.FieldTagID:
+		return m.OldTagID(ctx)
+	case tagsuggestion.FieldScore:
+		return m.OldScore(ctx)
+	case tagsuggestion.FieldSource:
+		return m.OldSource(ctx)
+	case tagsuggestion.FieldStatus:
+		return m.OldStatus(ctx)
+	case tagsuggestion.FieldDecidedBy:
+		return m.OldDecidedBy(ctx)
+	case tagsuggestion.FieldDecidedAt:
+		return m.OldDecidedAt(ctx)
+	case tagsuggestion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Setting field %s", name)
+	return nil, fmt.Errorf("unknown TagSuggestion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) SetField(name string, value ent.Value) error {
+func (m *TagSuggestionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case setting.FieldKey:
+	case tagsuggestion.FieldMediaID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetMediaID(v)
 		return nil
-	case setting.FieldValue:
+	case tagsuggestion.FieldTagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case tagsuggestion.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case tagsuggestion.FieldSource:
+		v, ok := value.(tagsuggestion.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case tagsuggestion.FieldStatus:
+		v, ok := value.(tagsuggestion.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tagsuggestion.FieldDecidedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetDecidedBy(v)
 		return nil
-	case setting.FieldUpdatedAt:
+	case tagsuggestion.FieldDecidedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetDecidedAt(v)
+		return nil
+	case tagsuggestion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown TagSuggestion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettingMutation) AddedFields() []string {
-	return nil
+func (m *TagSuggestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, tagsuggestion.FieldScore)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TagSuggestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tagsuggestion.FieldScore:
+		return m.AddedScore()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) AddField(name string, value ent.Value) error {
+func (m *TagSuggestionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tagsuggestion.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Setting numeric field %s", name)
+	return fmt.Errorf("unknown TagSuggestion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingMutation) ClearedFields() []string {
-	return nil
+func (m *TagSuggestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tagsuggestion.FieldDecidedBy) {
+		fields = append(fields, tagsuggestion.FieldDecidedBy)
+	}
+	if m.FieldCleared(tagsuggestion.FieldDecidedAt) {
+		fields = append(fields, tagsuggestion.FieldDecidedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingMutation) FieldCleared(name string) bool {
+func (m *TagSuggestionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Setting nullable field %s", name)
+func (m *TagSuggestionMutation) ClearField(name string) error {
+	switch name {
+	case tagsuggestion.FieldDecidedBy:
+		m.ClearDecidedBy()
+		return nil
+	case tagsuggestion.FieldDecidedAt:
+		m.ClearDecidedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TagSuggestion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingMutation) ResetField(name string) error {
+func (m *TagSuggestionMutation) ResetField(name string) error {
 	switch name {
-	case setting.FieldKey:
-		m.ResetKey()
+	case tagsuggestion.FieldMediaID:
+		m.ResetMediaID()
 		return nil
-	case setting.FieldValue:
-		m.ResetValue()
+	case tagsuggestion.FieldTagID:
+		m.ResetTagID()
 		return nil
-	case setting.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case tagsuggestion.FieldScore:
+		m.ResetScore()
+		return nil
+	case tagsuggestion.FieldSource:
+		m.ResetSource()
+		return nil
+	case tagsuggestion.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tagsuggestion.FieldDecidedBy:
+		m.ResetDecidedBy()
+		return nil
+	case tagsuggestion.FieldDecidedAt:
+		m.ResetDecidedAt()
+		return nil
+	case tagsuggestion.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown TagSuggestion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TagSuggestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.media != nil {
+		edges = append(edges, tagsuggestion.EdgeMedia)
+	}
+	if m.tag != nil {
+		edges = append(edges, tagsuggestion.EdgeTag)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+func (m *TagSuggestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tagsuggestion.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	case tagsuggestion.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TagSuggestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagSuggestionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TagSuggestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmedia {
+		edges = append(edges, tagsuggestion.EdgeMedia)
+	}
+	if m.clearedtag {
+		edges = append(edges, tagsuggestion.EdgeTag)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingMutation) EdgeCleared(name string) bool {
+func (m *TagSuggestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tagsuggestion.EdgeMedia:
+		return m.clearedmedia
+	case tagsuggestion.EdgeTag:
+		return m.clearedtag
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Setting unique edge %s", name)
+func (m *TagSuggestionMutation) ClearEdge(name string) error {
+	switch name {
+	case tagsuggestion.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	case tagsuggestion.EdgeTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown TagSuggestion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Setting edge %s", name)
+func (m *TagSuggestionMutation) ResetEdge(name string) error {
+	switch name {
+	case tagsuggestion.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case tagsuggestion.EdgeTag:
+		m.ResetTag()
+		return nil
+	}
+	return fmt.Errorf("unknown TagSuggestion edge %s", name)
 }
 
-// TagMutation represents an operation that mutates the Tag nodes in the graph.
-type TagMutation struct {
+// TagVectorMutation represents an operation that mutates the TagVector nodes in the graph.
+type TagVectorMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	_type         *tag.Type
-	clearedFields map[string]struct{}
-	media         map[string]struct{}
-	removedmedia  map[string]struct{}
-	clearedmedia  bool
-	done          bool
-	oldValue      func(context.Context) (*Tag, error)
-	predicates    []predicate.Tag
-}
-
-var _ ent.Mutation = (*TagMutation)(nil)
-
-// tagOption allows management of the mutation configuration using functional options.
-type tagOption func(*TagMutation)
-
-// newTagMutation creates new mutation for the Tag entity.
-func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
-	m := &TagMutation{
+	op             Op
+	typ            string
+	id             *int
+	value          *pgvector.Vector
+	media_count    *int
+	addmedia_count *int
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	tag            *int
+	clearedtag     bool
+	vector         *int
+	clearedvector  bool
+	done           bool
+	oldValue       func(context.Context) (*TagVector, error)
+	predicates     []predicate.TagVector
+}
+
+var _ ent.Mutation = (*TagVectorMutation)(nil)
+
+// tagvectorOption allows management of the mutation configuration using functional options.
+type tagvectorOption func(*TagVectorMutation)
+
+// newTagVectorMutation creates new mutation for the TagVector entity.
+func newTagVectorMutation(c config, op Op, opts ...tagvectorOption) *TagVectorMutation {
+	m := &TagVectorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTag,
+		typ:           TypeTagVector,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3572,20 +16384,20 @@ func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
 	return m
 }
 
-// withTagID sets the ID field of the mutation.
-func withTagID(id int) tagOption {
-	return func(m *TagMutation) {
+// withTagVectorID sets the ID field of the mutation.
+func withTagVectorID(id int) tagvectorOption {
+	return func(m *TagVectorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tag
+			value *TagVector
 		)
-		m.oldValue = func(ctx context.Context) (*Tag, error) {
+		m.oldValue = func(ctx context.Context) (*TagVector, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tag.Get(ctx, id)
+					value, err = m.Client().TagVector.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3594,10 +16406,10 @@ func withTagID(id int) tagOption {
 	}
 }
 
-// withTag sets the old Tag of the mutation.
-func withTag(node *Tag) tagOption {
-	return func(m *TagMutation) {
-		m.oldValue = func(context.Context) (*Tag, error) {
+// withTagVector sets the old TagVector of the mutation.
+func withTagVector(node *TagVector) tagvectorOption {
+	return func(m *TagVectorMutation) {
+		m.oldValue = func(context.Context) (*TagVector, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3606,7 +16418,7 @@ func withTag(node *Tag) tagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TagMutation) Client() *Client {
+func (m TagVectorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3614,7 +16426,7 @@ func (m TagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TagMutation) Tx() (*Tx, error) {
+func (m TagVectorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3623,15 +16435,9 @@ func (m TagMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tag entities.
-func (m *TagMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TagMutation) ID() (id int, exists bool) {
+func (m *TagVectorMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3642,7 +16448,7 @@ func (m *TagMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TagVectorMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3651,147 +16457,275 @@ func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TagVector.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TagMutation) SetName(s string) {
-	m.name = &s
+// SetTagID sets the "tag_id" field.
+func (m *TagVectorMutation) SetTagID(i int) {
+	m.tag = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TagMutation) Name() (r string, exists bool) {
-	v := m.name
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *TagVectorMutation) TagID() (r int, exists bool) {
+	v := m.tag
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldTagID returns the old "tag_id" field's value of the TagVector entity.
+// If the TagVector object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TagVectorMutation) OldTagID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTagID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TagID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TagMutation) ResetName() {
-	m.name = nil
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *TagVectorMutation) ResetTagID() {
+	m.tag = nil
 }
 
-// SetType sets the "type" field.
-func (m *TagMutation) SetType(t tag.Type) {
-	m._type = &t
+// SetVectorID sets the "vector_id" field.
+func (m *TagVectorMutation) SetVectorID(i int) {
+	m.vector = &i
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *TagMutation) GetType() (r tag.Type, exists bool) {
-	v := m._type
+// VectorID returns the value of the "vector_id" field in the mutation.
+func (m *TagVectorMutation) VectorID() (r int, exists bool) {
+	v := m.vector
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldVectorID returns the old "vector_id" field's value of the TagVector entity.
+// If the TagVector object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldType(ctx context.Context) (v tag.Type, err error) {
+func (m *TagVectorMutation) OldVectorID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldVectorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldVectorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldVectorID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.VectorID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *TagMutation) ResetType() {
-	m._type = nil
+// ResetVectorID resets all changes to the "vector_id" field.
+func (m *TagVectorMutation) ResetVectorID() {
+	m.vector = nil
 }
 
-// AddMediumIDs adds the "media" edge to the Media entity by ids.
-func (m *TagMutation) AddMediumIDs(ids ...string) {
-	if m.media == nil {
-		m.media = make(map[string]struct{})
+// SetValue sets the "value" field.
+func (m *TagVectorMutation) SetValue(pg pgvector.Vector) {
+	m.value = &pg
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *TagVectorMutation) Value() (r pgvector.Vector, exists bool) {
+	v := m.value
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.media[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the TagVector entity.
+// If the TagVector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagVectorMutation) OldValue(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
+	return oldValue.Value, nil
 }
 
-// ClearMedia clears the "media" edge to the Media entity.
-func (m *TagMutation) ClearMedia() {
-	m.clearedmedia = true
+// ResetValue resets all changes to the "value" field.
+func (m *TagVectorMutation) ResetValue() {
+	m.value = nil
 }
 
-// MediaCleared reports if the "media" edge to the Media entity was cleared.
-func (m *TagMutation) MediaCleared() bool {
-	return m.clearedmedia
+// SetMediaCount sets the "media_count" field.
+func (m *TagVectorMutation) SetMediaCount(i int) {
+	m.media_count = &i
+	m.addmedia_count = nil
 }
 
-// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
-func (m *TagMutation) RemoveMediumIDs(ids ...string) {
-	if m.removedmedia == nil {
-		m.removedmedia = make(map[string]struct{})
+// MediaCount returns the value of the "media_count" field in the mutation.
+func (m *TagVectorMutation) MediaCount() (r int, exists bool) {
+	v := m.media_count
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.media, ids[i])
-		m.removedmedia[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldMediaCount returns the old "media_count" field's value of the TagVector entity.
+// If the TagVector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagVectorMutation) OldMediaCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaCount: %w", err)
+	}
+	return oldValue.MediaCount, nil
+}
+
+// AddMediaCount adds i to the "media_count" field.
+func (m *TagVectorMutation) AddMediaCount(i int) {
+	if m.addmedia_count != nil {
+		*m.addmedia_count += i
+	} else {
+		m.addmedia_count = &i
+	}
+}
+
+// AddedMediaCount returns the value that was added to the "media_count" field in this mutation.
+func (m *TagVectorMutation) AddedMediaCount() (r int, exists bool) {
+	v := m.addmedia_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMediaCount resets all changes to the "media_count" field.
+func (m *TagVectorMutation) ResetMediaCount() {
+	m.media_count = nil
+	m.addmedia_count = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TagVectorMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TagVectorMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TagVector entity.
+// If the TagVector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagVectorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TagVectorMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *TagVectorMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[tagvector.FieldTagID] = struct{}{}
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *TagVectorMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *TagVectorMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
 	}
+	return
+}
+
+// ResetTag resets all changes to the "tag" edge.
+func (m *TagVectorMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
+}
+
+// ClearVector clears the "vector" edge to the Vector entity.
+func (m *TagVectorMutation) ClearVector() {
+	m.clearedvector = true
+	m.clearedFields[tagvector.FieldVectorID] = struct{}{}
 }
 
-// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
-func (m *TagMutation) RemovedMediaIDs() (ids []string) {
-	for id := range m.removedmedia {
-		ids = append(ids, id)
-	}
-	return
+// VectorCleared reports if the "vector" edge to the Vector entity was cleared.
+func (m *TagVectorMutation) VectorCleared() bool {
+	return m.clearedvector
 }
 
-// MediaIDs returns the "media" edge IDs in the mutation.
-func (m *TagMutation) MediaIDs() (ids []string) {
-	for id := range m.media {
-		ids = append(ids, id)
+// VectorIDs returns the "vector" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VectorID instead. It exists only for internal usage by the builders.
+func (m *TagVectorMutation) VectorIDs() (ids []int) {
+	if id := m.vector; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMedia resets all changes to the "media" edge.
-func (m *TagMutation) ResetMedia() {
-	m.media = nil
-	m.clearedmedia = false
-	m.removedmedia = nil
+// ResetVector resets all changes to the "vector" edge.
+func (m *TagVectorMutation) ResetVector() {
+	m.vector = nil
+	m.clearedvector = false
 }
 
-// Where appends a list predicates to the TagMutation builder.
-func (m *TagMutation) Where(ps ...predicate.Tag) {
+// Where appends a list predicates to the TagVectorMutation builder.
+func (m *TagVectorMutation) Where(ps ...predicate.TagVector) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagVectorMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tag, len(ps))
+func (m *TagVectorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagVector, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3799,30 +16733,39 @@ func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TagMutation) Op() Op {
+func (m *TagVectorMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TagMutation) SetOp(op Op) {
+func (m *TagVectorMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tag).
-func (m *TagMutation) Type() string {
+// Type returns the node type of this mutation (TagVector).
+func (m *TagVectorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TagMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.name != nil {
-		fields = append(fields, tag.FieldName)
+func (m *TagVectorMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tag != nil {
+		fields = append(fields, tagvector.FieldTagID)
 	}
-	if m._type != nil {
-		fields = append(fields, tag.FieldType)
+	if m.vector != nil {
+		fields = append(fields, tagvector.FieldVectorID)
+	}
+	if m.value != nil {
+		fields = append(fields, tagvector.FieldValue)
+	}
+	if m.media_count != nil {
+		fields = append(fields, tagvector.FieldMediaCount)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tagvector.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3830,12 +16773,18 @@ func (m *TagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TagMutation) Field(name string) (ent.Value, bool) {
+func (m *TagVectorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tag.FieldName:
-		return m.Name()
-	case tag.FieldType:
-		return m.GetType()
+	case tagvector.FieldTagID:
+		return m.TagID()
+	case tagvector.FieldVectorID:
+		return m.VectorID()
+	case tagvector.FieldValue:
+		return m.Value()
+	case tagvector.FieldMediaCount:
+		return m.MediaCount()
+	case tagvector.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3843,176 +16792,235 @@ func (m *TagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagVectorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tag.FieldName:
-		return m.OldName(ctx)
-	case tag.FieldType:
-		return m.OldType(ctx)
+	case tagvector.FieldTagID:
+		return m.OldTagID(ctx)
+	case tagvector.FieldVectorID:
+		return m.OldVectorID(ctx)
+	case tagvector.FieldValue:
+		return m.OldValue(ctx)
+	case tagvector.FieldMediaCount:
+		return m.OldMediaCount(ctx)
+	case tagvector.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tag field %s", name)
+	return nil, fmt.Errorf("unknown TagVector field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TagMutation) SetField(name string, value ent.Value) error {
+func (m *TagVectorMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tag.FieldName:
-		v, ok := value.(string)
+	case tagvector.FieldTagID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTagID(v)
 		return nil
-	case tag.FieldType:
-		v, ok := value.(tag.Type)
+	case tagvector.FieldVectorID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetVectorID(v)
+		return nil
+	case tagvector.FieldValue:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case tagvector.FieldMediaCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaCount(v)
+		return nil
+	case tagvector.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown TagVector field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TagMutation) AddedFields() []string {
-	return nil
+func (m *TagVectorMutation) AddedFields() []string {
+	var fields []string
+	if m.addmedia_count != nil {
+		fields = append(fields, tagvector.FieldMediaCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TagVectorMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tagvector.FieldMediaCount:
+		return m.AddedMediaCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TagMutation) AddField(name string, value ent.Value) error {
+func (m *TagVectorMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tagvector.FieldMediaCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMediaCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Tag numeric field %s", name)
+	return fmt.Errorf("unknown TagVector numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TagMutation) ClearedFields() []string {
+func (m *TagVectorMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TagMutation) FieldCleared(name string) bool {
+func (m *TagVectorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tag nullable field %s", name)
+func (m *TagVectorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TagVector nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TagMutation) ResetField(name string) error {
+func (m *TagVectorMutation) ResetField(name string) error {
 	switch name {
-	case tag.FieldName:
-		m.ResetName()
+	case tagvector.FieldTagID:
+		m.ResetTagID()
 		return nil
-	case tag.FieldType:
-		m.ResetType()
+	case tagvector.FieldVectorID:
+		m.ResetVectorID()
+		return nil
+	case tagvector.FieldValue:
+		m.ResetValue()
+		return nil
+	case tagvector.FieldMediaCount:
+		m.ResetMediaCount()
+		return nil
+	case tagvector.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown TagVector field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.media != nil {
-		edges = append(edges, tag.EdgeMedia)
+func (m *TagVectorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tag != nil {
+		edges = append(edges, tagvector.EdgeTag)
+	}
+	if m.vector != nil {
+		edges = append(edges, tagvector.EdgeVector)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TagMutation) AddedIDs(name string) []ent.Value {
+func (m *TagVectorMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tag.EdgeMedia:
-		ids := make([]ent.Value, 0, len(m.media))
-		for id := range m.media {
-			ids = append(ids, id)
+	case tagvector.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
+		}
+	case tagvector.EdgeVector:
+		if id := m.vector; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedmedia != nil {
-		edges = append(edges, tag.EdgeMedia)
-	}
+func (m *TagVectorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tag.EdgeMedia:
-		ids := make([]ent.Value, 0, len(m.removedmedia))
-		for id := range m.removedmedia {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TagVectorMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmedia {
-		edges = append(edges, tag.EdgeMedia)
+func (m *TagVectorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtag {
+		edges = append(edges, tagvector.EdgeTag)
+	}
+	if m.clearedvector {
+		edges = append(edges, tagvector.EdgeVector)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TagMutation) EdgeCleared(name string) bool {
+func (m *TagVectorMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tag.EdgeMedia:
-		return m.clearedmedia
+	case tagvector.EdgeTag:
+		return m.clearedtag
+	case tagvector.EdgeVector:
+		return m.clearedvector
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TagMutation) ClearEdge(name string) error {
+func (m *TagVectorMutation) ClearEdge(name string) error {
 	switch name {
+	case tagvector.EdgeTag:
+		m.ClearTag()
+		return nil
+	case tagvector.EdgeVector:
+		m.ClearVector()
+		return nil
 	}
-	return fmt.Errorf("unknown Tag unique edge %s", name)
+	return fmt.Errorf("unknown TagVector unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TagMutation) ResetEdge(name string) error {
+func (m *TagVectorMutation) ResetEdge(name string) error {
 	switch name {
-	case tag.EdgeMedia:
-		m.ResetMedia()
+	case tagvector.EdgeTag:
+		m.ResetTag()
+		return nil
+	case tagvector.EdgeVector:
+		m.ResetVector()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag edge %s", name)
+	return fmt.Errorf("unknown TagVector edge %s", name)
 }
 
 // VectorMutation represents an operation that mutates the Vector nodes in the graph.
@@ -4022,6 +17030,9 @@ type VectorMutation struct {
 	typ                  string
 	id                   *int
 	name                 *string
+	model                *string
+	dimension            *int
+	adddimension         *int
 	clearedFields        map[string]struct{}
 	media                map[string]struct{}
 	removedmedia         map[string]struct{}
@@ -4174,6 +17185,98 @@ func (m *VectorMutation) ResetName() {
 	m.name = nil
 }
 
+// SetModel sets the "model" field.
+func (m *VectorMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *VectorMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the Vector entity.
+// If the Vector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VectorMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *VectorMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetDimension sets the "dimension" field.
+func (m *VectorMutation) SetDimension(i int) {
+	m.dimension = &i
+	m.adddimension = nil
+}
+
+// Dimension returns the value of the "dimension" field in the mutation.
+func (m *VectorMutation) Dimension() (r int, exists bool) {
+	v := m.dimension
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDimension returns the old "dimension" field's value of the Vector entity.
+// If the Vector object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VectorMutation) OldDimension(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDimension is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDimension requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDimension: %w", err)
+	}
+	return oldValue.Dimension, nil
+}
+
+// AddDimension adds i to the "dimension" field.
+func (m *VectorMutation) AddDimension(i int) {
+	if m.adddimension != nil {
+		*m.adddimension += i
+	} else {
+		m.adddimension = &i
+	}
+}
+
+// AddedDimension returns the value that was added to the "dimension" field in this mutation.
+func (m *VectorMutation) AddedDimension() (r int, exists bool) {
+	v := m.adddimension
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDimension resets all changes to the "dimension" field.
+func (m *VectorMutation) ResetDimension() {
+	m.dimension = nil
+	m.adddimension = nil
+}
+
 // AddMediumIDs adds the "media" edge to the Media entity by ids.
 func (m *VectorMutation) AddMediumIDs(ids ...string) {
 	if m.media == nil {
@@ -4316,10 +17419,16 @@ func (m *VectorMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VectorMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+	fields := make([]string, 0, 3)
 	if m.name != nil {
 		fields = append(fields, vector.FieldName)
 	}
+	if m.model != nil {
+		fields = append(fields, vector.FieldModel)
+	}
+	if m.dimension != nil {
+		fields = append(fields, vector.FieldDimension)
+	}
 	return fields
 }
 
@@ -4330,6 +17439,10 @@ func (m *VectorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case vector.FieldName:
 		return m.Name()
+	case vector.FieldModel:
+		return m.Model()
+	case vector.FieldDimension:
+		return m.Dimension()
 	}
 	return nil, false
 }
@@ -4341,6 +17454,10 @@ func (m *VectorMutation) OldField(ctx context.Context, name string) (ent.Value,
 	switch name {
 	case vector.FieldName:
 		return m.OldName(ctx)
+	case vector.FieldModel:
+		return m.OldModel(ctx)
+	case vector.FieldDimension:
+		return m.OldDimension(ctx)
 	}
 	return nil, fmt.Errorf("unknown Vector field %s", name)
 }
@@ -4357,6 +17474,20 @@ func (m *VectorMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case vector.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case vector.FieldDimension:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDimension(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Vector field %s", name)
 }
@@ -4364,13 +17495,21 @@ func (m *VectorMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *VectorMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.adddimension != nil {
+		fields = append(fields, vector.FieldDimension)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *VectorMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vector.FieldDimension:
+		return m.AddedDimension()
+	}
 	return nil, false
 }
 
@@ -4379,6 +17518,13 @@ func (m *VectorMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *VectorMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case vector.FieldDimension:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDimension(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Vector numeric field %s", name)
 }
@@ -4409,6 +17555,12 @@ func (m *VectorMutation) ResetField(name string) error {
 	case vector.FieldName:
 		m.ResetName()
 		return nil
+	case vector.FieldModel:
+		m.ResetModel()
+		return nil
+	case vector.FieldDimension:
+		m.ResetDimension()
+		return nil
 	}
 	return fmt.Errorf("unknown Vector field %s", name)
 }
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/media"
+	"era/booru/ent/note"
+	"era/booru/ent/tag"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// Note is the model entity for the Note schema.
+type Note struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID string `json:"media_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind note.Kind `json:"kind,omitempty"`
+	// Left edge as a fraction of the image width
+	X float64 `json:"x,omitempty"`
+	// Top edge as a fraction of the image height
+	Y float64 `json:"y,omitempty"`
+	// Width holds the value of the "width" field.
+	Width float64 `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height float64 `json:"height,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Tag the region shows, e.g. the character in a box
+	TagID *int `json:"tag_id,omitempty"`
+	// User who created the note, as reported by the request
+	Author string `json:"author,omitempty"`
+	// Vision embedding of the region; cleared when the region moves
+	Embedding *pgvector.Vector `json:"embedding,omitempty"`
+	// Model that computed the embedding; regions are only compared within a model
+	EmbeddingModel *string `json:"embedding_model,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NoteQuery when eager-loading is set.
+	Edges        NoteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NoteEdges holds the relations/edges for other nodes in the graph.
+type NoteEdges struct {
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Note) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case note.FieldEmbedding:
+			values[i] = &sql.NullScanner{S: new(pgvector.Vector)}
+		case note.FieldX, note.FieldY, note.FieldWidth, note.FieldHeight:
+			values[i] = new(sql.NullFloat64)
+		case note.FieldID, note.FieldTagID:
+			values[i] = new(sql.NullInt64)
+		case note.FieldMediaID, note.FieldKind, note.FieldText, note.FieldAuthor, note.FieldEmbeddingModel:
+			values[i] = new(sql.NullString)
+		case note.FieldCreatedAt, note.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Note fields.
+func (n *Note) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case note.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			n.ID = int(value.Int64)
+		case note.FieldMediaID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value.Valid {
+				n.MediaID = value.String
+			}
+		case note.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				n.Kind = note.Kind(value.String)
+			}
+		case note.FieldX:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field x", values[i])
+			} else if value.Valid {
+				n.X = value.Float64
+			}
+		case note.FieldY:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field y", values[i])
+			} else if value.Valid {
+				n.Y = value.Float64
+			}
+		case note.FieldWidth:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				n.Width = value.Float64
+			}
+		case note.FieldHeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				n.Height = value.Float64
+			}
+		case note.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				n.Text = value.String
+			}
+		case note.FieldTagID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				n.TagID = new(int)
+				*n.TagID = int(value.Int64)
+			}
+		case note.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				n.Author = value.String
+			}
+		case note.FieldEmbedding:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value.Valid {
+				n.Embedding = new(pgvector.Vector)
+				*n.Embedding = *value.S.(*pgvector.Vector)
+			}
+		case note.FieldEmbeddingModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_model", values[i])
+			} else if value.Valid {
+				n.EmbeddingModel = new(string)
+				*n.EmbeddingModel = value.String
+			}
+		case note.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				n.CreatedAt = value.Time
+			}
+		case note.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				n.UpdatedAt = value.Time
+			}
+		default:
+			n.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Note.
+// This includes values selected through modifiers, order, etc.
+func (n *Note) Value(name string) (ent.Value, error) {
+	return n.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the Note entity.
+func (n *Note) QueryMedia() *MediaQuery {
+	return NewNoteClient(n.config).QueryMedia(n)
+}
+
+// QueryTag queries the "tag" edge of the Note entity.
+func (n *Note) QueryTag() *TagQuery {
+	return NewNoteClient(n.config).QueryTag(n)
+}
+
+// Update returns a builder for updating this Note.
+// Note that you need to call Note.Unwrap() before calling this method if this Note
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (n *Note) Update() *NoteUpdateOne {
+	return NewNoteClient(n.config).UpdateOne(n)
+}
+
+// Unwrap unwraps the Note entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (n *Note) Unwrap() *Note {
+	_tx, ok := n.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Note is not a transactional entity")
+	}
+	n.config.driver = _tx.drv
+	return n
+}
+
+// String implements the fmt.Stringer.
+func (n *Note) String() string {
+	var builder strings.Builder
+	builder.WriteString("Note(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", n.ID))
+	builder.WriteString("media_id=")
+	builder.WriteString(n.MediaID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", n.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("x=")
+	builder.WriteString(fmt.Sprintf("%v", n.X))
+	builder.WriteString(", ")
+	builder.WriteString("y=")
+	builder.WriteString(fmt.Sprintf("%v", n.Y))
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", n.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", n.Height))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(n.Text)
+	builder.WriteString(", ")
+	if v := n.TagID; v != nil {
+		builder.WriteString("tag_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(n.Author)
+	builder.WriteString(", ")
+	if v := n.Embedding; v != nil {
+		builder.WriteString("embedding=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := n.EmbeddingModel; v != nil {
+		builder.WriteString("embedding_model=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(n.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(n.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Notes is a parsable slice of Note.
+type Notes []*Note
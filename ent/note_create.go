@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/note"
+	"era/booru/ent/tag"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// NoteCreate is the builder for creating a Note entity.
+type NoteCreate struct {
+	config
+	mutation *NoteMutation
+	hooks    []Hook
+}
+
+// SetMediaID sets the "media_id" field.
+func (nc *NoteCreate) SetMediaID(s string) *NoteCreate {
+	nc.mutation.SetMediaID(s)
+	return nc
+}
+
+// SetKind sets the "kind" field.
+func (nc *NoteCreate) SetKind(n note.Kind) *NoteCreate {
+	nc.mutation.SetKind(n)
+	return nc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nc *NoteCreate) SetNillableKind(n *note.Kind) *NoteCreate {
+	if n != nil {
+		nc.SetKind(*n)
+	}
+	return nc
+}
+
+// SetX sets the "x" field.
+func (nc *NoteCreate) SetX(f float64) *NoteCreate {
+	nc.mutation.SetX(f)
+	return nc
+}
+
+// SetY sets the "y" field.
+func (nc *NoteCreate) SetY(f float64) *NoteCreate {
+	nc.mutation.SetY(f)
+	return nc
+}
+
+// SetWidth sets the "width" field.
+func (nc *NoteCreate) SetWidth(f float64) *NoteCreate {
+	nc.mutation.SetWidth(f)
+	return nc
+}
+
+// SetHeight sets the "height" field.
+func (nc *NoteCreate) SetHeight(f float64) *NoteCreate {
+	nc.mutation.SetHeight(f)
+	return nc
+}
+
+// SetText sets the "text" field.
+func (nc *NoteCreate) SetText(s string) *NoteCreate {
+	nc.mutation.SetText(s)
+	return nc
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (nc *NoteCreate) SetNillableText(s *string) *NoteCreate {
+	if s != nil {
+		nc.SetText(*s)
+	}
+	return nc
+}
+
+// SetTagID sets the "tag_id" field.
+func (nc *NoteCreate) SetTagID(i int) *NoteCreate {
+	nc.mutation.SetTagID(i)
+	return nc
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (nc *NoteCreate) SetNillableTagID(i *int) *NoteCreate {
+	if i != nil {
+		nc.SetTagID(*i)
+	}
+	return nc
+}
+
+// SetAuthor sets the "author" field.
+func (nc *NoteCreate) SetAuthor(s string) *NoteCreate {
+	nc.mutation.SetAuthor(s)
+	return nc
+}
+
+// SetEmbedding sets the "embedding" field.
+func (nc *NoteCreate) SetEmbedding(pg pgvector.Vector) *NoteCreate {
+	nc.mutation.SetEmbedding(pg)
+	return nc
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (nc *NoteCreate) SetNillableEmbedding(pg *pgvector.Vector) *NoteCreate {
+	if pg != nil {
+		nc.SetEmbedding(*pg)
+	}
+	return nc
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (nc *NoteCreate) SetEmbeddingModel(s string) *NoteCreate {
+	nc.mutation.SetEmbeddingModel(s)
+	return nc
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (nc *NoteCreate) SetNillableEmbeddingModel(s *string) *NoteCreate {
+	if s != nil {
+		nc.SetEmbeddingModel(*s)
+	}
+	return nc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NoteCreate) SetCreatedAt(t time.Time) *NoteCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nc *NoteCreate) SetNillableCreatedAt(t *time.Time) *NoteCreate {
+	if t != nil {
+		nc.SetCreatedAt(*t)
+	}
+	return nc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nc *NoteCreate) SetUpdatedAt(t time.Time) *NoteCreate {
+	nc.mutation.SetUpdatedAt(t)
+	return nc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nc *NoteCreate) SetNillableUpdatedAt(t *time.Time) *NoteCreate {
+	if t != nil {
+		nc.SetUpdatedAt(*t)
+	}
+	return nc
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (nc *NoteCreate) SetMedia(m *Media) *NoteCreate {
+	return nc.SetMediaID(m.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (nc *NoteCreate) SetTag(t *Tag) *NoteCreate {
+	return nc.SetTagID(t.ID)
+}
+
+// Mutation returns the NoteMutation object of the builder.
+func (nc *NoteCreate) Mutation() *NoteMutation {
+	return nc.mutation
+}
+
+// Save creates the Note in the database.
+func (nc *NoteCreate) Save(ctx context.Context) (*Note, error) {
+	nc.defaults()
+	return withHooks(ctx, nc.sqlSave, nc.mutation, nc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NoteCreate) SaveX(ctx context.Context) *Note {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NoteCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NoteCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NoteCreate) defaults() {
+	if _, ok := nc.mutation.Kind(); !ok {
+		v := note.DefaultKind
+		nc.mutation.SetKind(v)
+	}
+	if _, ok := nc.mutation.Text(); !ok {
+		v := note.DefaultText
+		nc.mutation.SetText(v)
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		v := note.DefaultCreatedAt()
+		nc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nc.mutation.UpdatedAt(); !ok {
+		v := note.DefaultUpdatedAt()
+		nc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NoteCreate) check() error {
+	if _, ok := nc.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "Note.media_id"`)}
+	}
+	if _, ok := nc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Note.kind"`)}
+	}
+	if v, ok := nc.mutation.Kind(); ok {
+		if err := note.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Note.kind": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.X(); !ok {
+		return &ValidationError{Name: "x", err: errors.New(`ent: missing required field "Note.x"`)}
+	}
+	if v, ok := nc.mutation.X(); ok {
+		if err := note.XValidator(v); err != nil {
+			return &ValidationError{Name: "x", err: fmt.Errorf(`ent: validator failed for field "Note.x": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.Y(); !ok {
+		return &ValidationError{Name: "y", err: errors.New(`ent: missing required field "Note.y"`)}
+	}
+	if v, ok := nc.mutation.Y(); ok {
+		if err := note.YValidator(v); err != nil {
+			return &ValidationError{Name: "y", err: fmt.Errorf(`ent: validator failed for field "Note.y": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Note.width"`)}
+	}
+	if v, ok := nc.mutation.Width(); ok {
+		if err := note.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Note.width": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Note.height"`)}
+	}
+	if v, ok := nc.mutation.Height(); ok {
+		if err := note.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Note.height": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Note.text"`)}
+	}
+	if _, ok := nc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "Note.author"`)}
+	}
+	if v, ok := nc.mutation.Author(); ok {
+		if err := note.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "Note.author": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Note.created_at"`)}
+	}
+	if _, ok := nc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Note.updated_at"`)}
+	}
+	if len(nc.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "Note.media"`)}
+	}
+	return nil
+}
+
+func (nc *NoteCreate) sqlSave(ctx context.Context) (*Note, error) {
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nc.mutation.id = &_node.ID
+	nc.mutation.done = true
+	return _node, nil
+}
+
+func (nc *NoteCreate) createSpec() (*Note, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Note{config: nc.config}
+		_spec = sqlgraph.NewCreateSpec(note.Table, sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt))
+	)
+	if value, ok := nc.mutation.Kind(); ok {
+		_spec.SetField(note.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := nc.mutation.X(); ok {
+		_spec.SetField(note.FieldX, field.TypeFloat64, value)
+		_node.X = value
+	}
+	if value, ok := nc.mutation.Y(); ok {
+		_spec.SetField(note.FieldY, field.TypeFloat64, value)
+		_node.Y = value
+	}
+	if value, ok := nc.mutation.Width(); ok {
+		_spec.SetField(note.FieldWidth, field.TypeFloat64, value)
+		_node.Width = value
+	}
+	if value, ok := nc.mutation.Height(); ok {
+		_spec.SetField(note.FieldHeight, field.TypeFloat64, value)
+		_node.Height = value
+	}
+	if value, ok := nc.mutation.Text(); ok {
+		_spec.SetField(note.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := nc.mutation.Author(); ok {
+		_spec.SetField(note.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := nc.mutation.Embedding(); ok {
+		_spec.SetField(note.FieldEmbedding, field.TypeOther, value)
+		_node.Embedding = &value
+	}
+	if value, ok := nc.mutation.EmbeddingModel(); ok {
+		_spec.SetField(note.FieldEmbeddingModel, field.TypeString, value)
+		_node.EmbeddingModel = &value
+	}
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.SetField(note.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := nc.mutation.UpdatedAt(); ok {
+		_spec.SetField(note.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := nc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   note.MediaTable,
+			Columns: []string{note.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   note.TagTable,
+			Columns: []string{note.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NoteCreateBulk is the builder for creating many Note entities in bulk.
+type NoteCreateBulk struct {
+	config
+	err      error
+	builders []*NoteCreate
+}
+
+// Save creates the Note entities in the database.
+func (ncb *NoteCreateBulk) Save(ctx context.Context) ([]*Note, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Note, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NoteCreateBulk) SaveX(ctx context.Context) []*Note {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NoteCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,794 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/note"
+	"era/booru/ent/predicate"
+	"era/booru/ent/tag"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// NoteUpdate is the builder for updating Note entities.
+type NoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NoteMutation
+}
+
+// Where appends a list predicates to the NoteUpdate builder.
+func (nu *NoteUpdate) Where(ps ...predicate.Note) *NoteUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetKind sets the "kind" field.
+func (nu *NoteUpdate) SetKind(n note.Kind) *NoteUpdate {
+	nu.mutation.SetKind(n)
+	return nu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableKind(n *note.Kind) *NoteUpdate {
+	if n != nil {
+		nu.SetKind(*n)
+	}
+	return nu
+}
+
+// SetX sets the "x" field.
+func (nu *NoteUpdate) SetX(f float64) *NoteUpdate {
+	nu.mutation.ResetX()
+	nu.mutation.SetX(f)
+	return nu
+}
+
+// SetNillableX sets the "x" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableX(f *float64) *NoteUpdate {
+	if f != nil {
+		nu.SetX(*f)
+	}
+	return nu
+}
+
+// AddX adds f to the "x" field.
+func (nu *NoteUpdate) AddX(f float64) *NoteUpdate {
+	nu.mutation.AddX(f)
+	return nu
+}
+
+// SetY sets the "y" field.
+func (nu *NoteUpdate) SetY(f float64) *NoteUpdate {
+	nu.mutation.ResetY()
+	nu.mutation.SetY(f)
+	return nu
+}
+
+// SetNillableY sets the "y" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableY(f *float64) *NoteUpdate {
+	if f != nil {
+		nu.SetY(*f)
+	}
+	return nu
+}
+
+// AddY adds f to the "y" field.
+func (nu *NoteUpdate) AddY(f float64) *NoteUpdate {
+	nu.mutation.AddY(f)
+	return nu
+}
+
+// SetWidth sets the "width" field.
+func (nu *NoteUpdate) SetWidth(f float64) *NoteUpdate {
+	nu.mutation.ResetWidth()
+	nu.mutation.SetWidth(f)
+	return nu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableWidth(f *float64) *NoteUpdate {
+	if f != nil {
+		nu.SetWidth(*f)
+	}
+	return nu
+}
+
+// AddWidth adds f to the "width" field.
+func (nu *NoteUpdate) AddWidth(f float64) *NoteUpdate {
+	nu.mutation.AddWidth(f)
+	return nu
+}
+
+// SetHeight sets the "height" field.
+func (nu *NoteUpdate) SetHeight(f float64) *NoteUpdate {
+	nu.mutation.ResetHeight()
+	nu.mutation.SetHeight(f)
+	return nu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableHeight(f *float64) *NoteUpdate {
+	if f != nil {
+		nu.SetHeight(*f)
+	}
+	return nu
+}
+
+// AddHeight adds f to the "height" field.
+func (nu *NoteUpdate) AddHeight(f float64) *NoteUpdate {
+	nu.mutation.AddHeight(f)
+	return nu
+}
+
+// SetText sets the "text" field.
+func (nu *NoteUpdate) SetText(s string) *NoteUpdate {
+	nu.mutation.SetText(s)
+	return nu
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableText(s *string) *NoteUpdate {
+	if s != nil {
+		nu.SetText(*s)
+	}
+	return nu
+}
+
+// SetTagID sets the "tag_id" field.
+func (nu *NoteUpdate) SetTagID(i int) *NoteUpdate {
+	nu.mutation.SetTagID(i)
+	return nu
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableTagID(i *int) *NoteUpdate {
+	if i != nil {
+		nu.SetTagID(*i)
+	}
+	return nu
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (nu *NoteUpdate) ClearTagID() *NoteUpdate {
+	nu.mutation.ClearTagID()
+	return nu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (nu *NoteUpdate) SetEmbedding(pg pgvector.Vector) *NoteUpdate {
+	nu.mutation.SetEmbedding(pg)
+	return nu
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableEmbedding(pg *pgvector.Vector) *NoteUpdate {
+	if pg != nil {
+		nu.SetEmbedding(*pg)
+	}
+	return nu
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (nu *NoteUpdate) ClearEmbedding() *NoteUpdate {
+	nu.mutation.ClearEmbedding()
+	return nu
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (nu *NoteUpdate) SetEmbeddingModel(s string) *NoteUpdate {
+	nu.mutation.SetEmbeddingModel(s)
+	return nu
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableEmbeddingModel(s *string) *NoteUpdate {
+	if s != nil {
+		nu.SetEmbeddingModel(*s)
+	}
+	return nu
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (nu *NoteUpdate) ClearEmbeddingModel() *NoteUpdate {
+	nu.mutation.ClearEmbeddingModel()
+	return nu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nu *NoteUpdate) SetUpdatedAt(t time.Time) *NoteUpdate {
+	nu.mutation.SetUpdatedAt(t)
+	return nu
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (nu *NoteUpdate) SetTag(t *Tag) *NoteUpdate {
+	return nu.SetTagID(t.ID)
+}
+
+// Mutation returns the NoteMutation object of the builder.
+func (nu *NoteUpdate) Mutation() *NoteMutation {
+	return nu.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (nu *NoteUpdate) ClearTag() *NoteUpdate {
+	nu.mutation.ClearTag()
+	return nu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NoteUpdate) Save(ctx context.Context) (int, error) {
+	nu.defaults()
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NoteUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NoteUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nu *NoteUpdate) defaults() {
+	if _, ok := nu.mutation.UpdatedAt(); !ok {
+		v := note.UpdateDefaultUpdatedAt()
+		nu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NoteUpdate) check() error {
+	if v, ok := nu.mutation.Kind(); ok {
+		if err := note.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Note.kind": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.X(); ok {
+		if err := note.XValidator(v); err != nil {
+			return &ValidationError{Name: "x", err: fmt.Errorf(`ent: validator failed for field "Note.x": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.Y(); ok {
+		if err := note.YValidator(v); err != nil {
+			return &ValidationError{Name: "y", err: fmt.Errorf(`ent: validator failed for field "Note.y": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.Width(); ok {
+		if err := note.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Note.width": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.Height(); ok {
+		if err := note.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Note.height": %w`, err)}
+		}
+	}
+	if nu.mutation.MediaCleared() && len(nu.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Note.media"`)
+	}
+	return nil
+}
+
+func (nu *NoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(note.Table, note.Columns, sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Kind(); ok {
+		_spec.SetField(note.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := nu.mutation.X(); ok {
+		_spec.SetField(note.FieldX, field.TypeFloat64, value)
+	}
+	if value, ok := nu.mutation.AddedX(); ok {
+		_spec.AddField(note.FieldX, field.TypeFloat64, value)
+	}
+	if value, ok := nu.mutation.Y(); ok {
+		_spec.SetField(note.FieldY, field.TypeFloat64, value)
+	}
+	if value, ok := nu.mutation.AddedY(); ok {
+		_spec.AddField(note.FieldY, field.TypeFloat64, value)
+	}
+	if value, ok := nu.mutation.Width(); ok {
+		_spec.SetField(note.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := nu.mutation.AddedWidth(); ok {
+		_spec.AddField(note.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := nu.mutation.Height(); ok {
+		_spec.SetField(note.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := nu.mutation.AddedHeight(); ok {
+		_spec.AddField(note.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := nu.mutation.Text(); ok {
+		_spec.SetField(note.FieldText, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Embedding(); ok {
+		_spec.SetField(note.FieldEmbedding, field.TypeOther, value)
+	}
+	if nu.mutation.EmbeddingCleared() {
+		_spec.ClearField(note.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := nu.mutation.EmbeddingModel(); ok {
+		_spec.SetField(note.FieldEmbeddingModel, field.TypeString, value)
+	}
+	if nu.mutation.EmbeddingModelCleared() {
+		_spec.ClearField(note.FieldEmbeddingModel, field.TypeString)
+	}
+	if value, ok := nu.mutation.UpdatedAt(); ok {
+		_spec.SetField(note.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nu.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   note.TagTable,
+			Columns: []string{note.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   note.TagTable,
+			Columns: []string{note.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{note.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NoteUpdateOne is the builder for updating a single Note entity.
+type NoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NoteMutation
+}
+
+// SetKind sets the "kind" field.
+func (nuo *NoteUpdateOne) SetKind(n note.Kind) *NoteUpdateOne {
+	nuo.mutation.SetKind(n)
+	return nuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableKind(n *note.Kind) *NoteUpdateOne {
+	if n != nil {
+		nuo.SetKind(*n)
+	}
+	return nuo
+}
+
+// SetX sets the "x" field.
+func (nuo *NoteUpdateOne) SetX(f float64) *NoteUpdateOne {
+	nuo.mutation.ResetX()
+	nuo.mutation.SetX(f)
+	return nuo
+}
+
+// SetNillableX sets the "x" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableX(f *float64) *NoteUpdateOne {
+	if f != nil {
+		nuo.SetX(*f)
+	}
+	return nuo
+}
+
+// AddX adds f to the "x" field.
+func (nuo *NoteUpdateOne) AddX(f float64) *NoteUpdateOne {
+	nuo.mutation.AddX(f)
+	return nuo
+}
+
+// SetY sets the "y" field.
+func (nuo *NoteUpdateOne) SetY(f float64) *NoteUpdateOne {
+	nuo.mutation.ResetY()
+	nuo.mutation.SetY(f)
+	return nuo
+}
+
+// SetNillableY sets the "y" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableY(f *float64) *NoteUpdateOne {
+	if f != nil {
+		nuo.SetY(*f)
+	}
+	return nuo
+}
+
+// AddY adds f to the "y" field.
+func (nuo *NoteUpdateOne) AddY(f float64) *NoteUpdateOne {
+	nuo.mutation.AddY(f)
+	return nuo
+}
+
+// SetWidth sets the "width" field.
+func (nuo *NoteUpdateOne) SetWidth(f float64) *NoteUpdateOne {
+	nuo.mutation.ResetWidth()
+	nuo.mutation.SetWidth(f)
+	return nuo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableWidth(f *float64) *NoteUpdateOne {
+	if f != nil {
+		nuo.SetWidth(*f)
+	}
+	return nuo
+}
+
+// AddWidth adds f to the "width" field.
+func (nuo *NoteUpdateOne) AddWidth(f float64) *NoteUpdateOne {
+	nuo.mutation.AddWidth(f)
+	return nuo
+}
+
+// SetHeight sets the "height" field.
+func (nuo *NoteUpdateOne) SetHeight(f float64) *NoteUpdateOne {
+	nuo.mutation.ResetHeight()
+	nuo.mutation.SetHeight(f)
+	return nuo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableHeight(f *float64) *NoteUpdateOne {
+	if f != nil {
+		nuo.SetHeight(*f)
+	}
+	return nuo
+}
+
+// AddHeight adds f to the "height" field.
+func (nuo *NoteUpdateOne) AddHeight(f float64) *NoteUpdateOne {
+	nuo.mutation.AddHeight(f)
+	return nuo
+}
+
+// SetText sets the "text" field.
+func (nuo *NoteUpdateOne) SetText(s string) *NoteUpdateOne {
+	nuo.mutation.SetText(s)
+	return nuo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableText(s *string) *NoteUpdateOne {
+	if s != nil {
+		nuo.SetText(*s)
+	}
+	return nuo
+}
+
+// SetTagID sets the "tag_id" field.
+func (nuo *NoteUpdateOne) SetTagID(i int) *NoteUpdateOne {
+	nuo.mutation.SetTagID(i)
+	return nuo
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableTagID(i *int) *NoteUpdateOne {
+	if i != nil {
+		nuo.SetTagID(*i)
+	}
+	return nuo
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (nuo *NoteUpdateOne) ClearTagID() *NoteUpdateOne {
+	nuo.mutation.ClearTagID()
+	return nuo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (nuo *NoteUpdateOne) SetEmbedding(pg pgvector.Vector) *NoteUpdateOne {
+	nuo.mutation.SetEmbedding(pg)
+	return nuo
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableEmbedding(pg *pgvector.Vector) *NoteUpdateOne {
+	if pg != nil {
+		nuo.SetEmbedding(*pg)
+	}
+	return nuo
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (nuo *NoteUpdateOne) ClearEmbedding() *NoteUpdateOne {
+	nuo.mutation.ClearEmbedding()
+	return nuo
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (nuo *NoteUpdateOne) SetEmbeddingModel(s string) *NoteUpdateOne {
+	nuo.mutation.SetEmbeddingModel(s)
+	return nuo
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableEmbeddingModel(s *string) *NoteUpdateOne {
+	if s != nil {
+		nuo.SetEmbeddingModel(*s)
+	}
+	return nuo
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (nuo *NoteUpdateOne) ClearEmbeddingModel() *NoteUpdateOne {
+	nuo.mutation.ClearEmbeddingModel()
+	return nuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nuo *NoteUpdateOne) SetUpdatedAt(t time.Time) *NoteUpdateOne {
+	nuo.mutation.SetUpdatedAt(t)
+	return nuo
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (nuo *NoteUpdateOne) SetTag(t *Tag) *NoteUpdateOne {
+	return nuo.SetTagID(t.ID)
+}
+
+// Mutation returns the NoteMutation object of the builder.
+func (nuo *NoteUpdateOne) Mutation() *NoteMutation {
+	return nuo.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (nuo *NoteUpdateOne) ClearTag() *NoteUpdateOne {
+	nuo.mutation.ClearTag()
+	return nuo
+}
+
+// Where appends a list predicates to the NoteUpdate builder.
+func (nuo *NoteUpdateOne) Where(ps ...predicate.Note) *NoteUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NoteUpdateOne) Select(field string, fields ...string) *NoteUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Note entity.
+func (nuo *NoteUpdateOne) Save(ctx context.Context) (*Note, error) {
+	nuo.defaults()
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NoteUpdateOne) SaveX(ctx context.Context) *Note {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NoteUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nuo *NoteUpdateOne) defaults() {
+	if _, ok := nuo.mutation.UpdatedAt(); !ok {
+		v := note.UpdateDefaultUpdatedAt()
+		nuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NoteUpdateOne) check() error {
+	if v, ok := nuo.mutation.Kind(); ok {
+		if err := note.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Note.kind": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.X(); ok {
+		if err := note.XValidator(v); err != nil {
+			return &ValidationError{Name: "x", err: fmt.Errorf(`ent: validator failed for field "Note.x": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.Y(); ok {
+		if err := note.YValidator(v); err != nil {
+			return &ValidationError{Name: "y", err: fmt.Errorf(`ent: validator failed for field "Note.y": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.Width(); ok {
+		if err := note.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Note.width": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.Height(); ok {
+		if err := note.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Note.height": %w`, err)}
+		}
+	}
+	if nuo.mutation.MediaCleared() && len(nuo.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Note.media"`)
+	}
+	return nil
+}
+
+func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(note.Table, note.Columns, sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Note.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, note.FieldID)
+		for _, f := range fields {
+			if !note.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != note.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Kind(); ok {
+		_spec.SetField(note.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.X(); ok {
+		_spec.SetField(note.FieldX, field.TypeFloat64, value)
+	}
+	if value, ok := nuo.mutation.AddedX(); ok {
+		_spec.AddField(note.FieldX, field.TypeFloat64, value)
+	}
+	if value, ok := nuo.mutation.Y(); ok {
+		_spec.SetField(note.FieldY, field.TypeFloat64, value)
+	}
+	if value, ok := nuo.mutation.AddedY(); ok {
+		_spec.AddField(note.FieldY, field.TypeFloat64, value)
+	}
+	if value, ok := nuo.mutation.Width(); ok {
+		_spec.SetField(note.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := nuo.mutation.AddedWidth(); ok {
+		_spec.AddField(note.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := nuo.mutation.Height(); ok {
+		_spec.SetField(note.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := nuo.mutation.AddedHeight(); ok {
+		_spec.AddField(note.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := nuo.mutation.Text(); ok {
+		_spec.SetField(note.FieldText, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Embedding(); ok {
+		_spec.SetField(note.FieldEmbedding, field.TypeOther, value)
+	}
+	if nuo.mutation.EmbeddingCleared() {
+		_spec.ClearField(note.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := nuo.mutation.EmbeddingModel(); ok {
+		_spec.SetField(note.FieldEmbeddingModel, field.TypeString, value)
+	}
+	if nuo.mutation.EmbeddingModelCleared() {
+		_spec.ClearField(note.FieldEmbeddingModel, field.TypeString)
+	}
+	if value, ok := nuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(note.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nuo.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   note.TagTable,
+			Columns: []string{note.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   note.TagTable,
+			Columns: []string{note.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Note{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{note.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
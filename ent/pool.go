@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/media"
+	"era/booru/ent/pool"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Pool is the model entity for the Pool schema.
+type Pool struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Media shown as the pool's thumbnail; the first member if unset
+	CoverID *string `json:"cover_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PoolQuery when eager-loading is set.
+	Edges        PoolEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PoolEdges holds the relations/edges for other nodes in the graph.
+type PoolEdges struct {
+	// Members of the pool, ordered by PoolMedia.position
+	Media []*Media `json:"media,omitempty"`
+	// Cover holds the value of the cover edge.
+	Cover *Media `json:"cover,omitempty"`
+	// PoolMedia holds the value of the pool_media edge.
+	PoolMedia []*PoolMedia `json:"pool_media,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading.
+func (e PoolEdges) MediaOrErr() ([]*Media, error) {
+	if e.loadedTypes[0] {
+		return e.Media, nil
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// CoverOrErr returns the Cover value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PoolEdges) CoverOrErr() (*Media, error) {
+	if e.Cover != nil {
+		return e.Cover, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "cover"}
+}
+
+// PoolMediaOrErr returns the PoolMedia value or an error if the edge
+// was not loaded in eager-loading.
+func (e PoolEdges) PoolMediaOrErr() ([]*PoolMedia, error) {
+	if e.loadedTypes[2] {
+		return e.PoolMedia, nil
+	}
+	return nil, &NotLoadedError{edge: "pool_media"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Pool) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pool.FieldID:
+			values[i] = new(sql.NullInt64)
+		case pool.FieldName, pool.FieldDescription, pool.FieldCoverID:
+			values[i] = new(sql.NullString)
+		case pool.FieldCreatedAt, pool.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Pool fields.
+func (po *Pool) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pool.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			po.ID = int(value.Int64)
+		case pool.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				po.Name = value.String
+			}
+		case pool.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				po.Description = value.String
+			}
+		case pool.FieldCoverID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cover_id", values[i])
+			} else if value.Valid {
+				po.CoverID = new(string)
+				*po.CoverID = value.String
+			}
+		case pool.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				po.CreatedAt = value.Time
+			}
+		case pool.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				po.UpdatedAt = value.Time
+			}
+		default:
+			po.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Pool.
+// This includes values selected through modifiers, order, etc.
+func (po *Pool) Value(name string) (ent.Value, error) {
+	return po.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the Pool entity.
+func (po *Pool) QueryMedia() *MediaQuery {
+	return NewPoolClient(po.config).QueryMedia(po)
+}
+
+// QueryCover queries the "cover" edge of the Pool entity.
+func (po *Pool) QueryCover() *MediaQuery {
+	return NewPoolClient(po.config).QueryCover(po)
+}
+
+// QueryPoolMedia queries the "pool_media" edge of the Pool entity.
+func (po *Pool) QueryPoolMedia() *PoolMediaQuery {
+	return NewPoolClient(po.config).QueryPoolMedia(po)
+}
+
+// Update returns a builder for updating this Pool.
+// Note that you need to call Pool.Unwrap() before calling this method if this Pool
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (po *Pool) Update() *PoolUpdateOne {
+	return NewPoolClient(po.config).UpdateOne(po)
+}
+
+// Unwrap unwraps the Pool entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (po *Pool) Unwrap() *Pool {
+	_tx, ok := po.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Pool is not a transactional entity")
+	}
+	po.config.driver = _tx.drv
+	return po
+}
+
+// String implements the fmt.Stringer.
+func (po *Pool) String() string {
+	var builder strings.Builder
+	builder.WriteString("Pool(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", po.ID))
+	builder.WriteString("name=")
+	builder.WriteString(po.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(po.Description)
+	builder.WriteString(", ")
+	if v := po.CoverID; v != nil {
+		builder.WriteString("cover_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(po.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(po.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Pools is a parsable slice of Pool.
+type Pools []*Pool
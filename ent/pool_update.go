@@ -0,0 +1,752 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"era/booru/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PoolUpdate is the builder for updating Pool entities.
+type PoolUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PoolMutation
+}
+
+// Where appends a list predicates to the PoolUpdate builder.
+func (pu *PoolUpdate) Where(ps ...predicate.Pool) *PoolUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PoolUpdate) SetName(s string) *PoolUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PoolUpdate) SetNillableName(s *string) *PoolUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetDescription sets the "description" field.
+func (pu *PoolUpdate) SetDescription(s string) *PoolUpdate {
+	pu.mutation.SetDescription(s)
+	return pu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pu *PoolUpdate) SetNillableDescription(s *string) *PoolUpdate {
+	if s != nil {
+		pu.SetDescription(*s)
+	}
+	return pu
+}
+
+// SetCoverID sets the "cover_id" field.
+func (pu *PoolUpdate) SetCoverID(s string) *PoolUpdate {
+	pu.mutation.SetCoverID(s)
+	return pu
+}
+
+// SetNillableCoverID sets the "cover_id" field if the given value is not nil.
+func (pu *PoolUpdate) SetNillableCoverID(s *string) *PoolUpdate {
+	if s != nil {
+		pu.SetCoverID(*s)
+	}
+	return pu
+}
+
+// ClearCoverID clears the value of the "cover_id" field.
+func (pu *PoolUpdate) ClearCoverID() *PoolUpdate {
+	pu.mutation.ClearCoverID()
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PoolUpdate) SetUpdatedAt(t time.Time) *PoolUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by IDs.
+func (pu *PoolUpdate) AddMediumIDs(ids ...string) *PoolUpdate {
+	pu.mutation.AddMediumIDs(ids...)
+	return pu
+}
+
+// AddMedia adds the "media" edges to the Media entity.
+func (pu *PoolUpdate) AddMedia(m ...*Media) *PoolUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pu.AddMediumIDs(ids...)
+}
+
+// SetCover sets the "cover" edge to the Media entity.
+func (pu *PoolUpdate) SetCover(m *Media) *PoolUpdate {
+	return pu.SetCoverID(m.ID)
+}
+
+// AddPoolMediumIDs adds the "pool_media" edge to the PoolMedia entity by IDs.
+func (pu *PoolUpdate) AddPoolMediumIDs(ids ...int) *PoolUpdate {
+	pu.mutation.AddPoolMediumIDs(ids...)
+	return pu
+}
+
+// AddPoolMedia adds the "pool_media" edges to the PoolMedia entity.
+func (pu *PoolUpdate) AddPoolMedia(p ...*PoolMedia) *PoolUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddPoolMediumIDs(ids...)
+}
+
+// Mutation returns the PoolMutation object of the builder.
+func (pu *PoolUpdate) Mutation() *PoolMutation {
+	return pu.mutation
+}
+
+// ClearMedia clears all "media" edges to the Media entity.
+func (pu *PoolUpdate) ClearMedia() *PoolUpdate {
+	pu.mutation.ClearMedia()
+	return pu
+}
+
+// RemoveMediumIDs removes the "media" edge to Media entities by IDs.
+func (pu *PoolUpdate) RemoveMediumIDs(ids ...string) *PoolUpdate {
+	pu.mutation.RemoveMediumIDs(ids...)
+	return pu
+}
+
+// RemoveMedia removes "media" edges to Media entities.
+func (pu *PoolUpdate) RemoveMedia(m ...*Media) *PoolUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pu.RemoveMediumIDs(ids...)
+}
+
+// ClearCover clears the "cover" edge to the Media entity.
+func (pu *PoolUpdate) ClearCover() *PoolUpdate {
+	pu.mutation.ClearCover()
+	return pu
+}
+
+// ClearPoolMedia clears all "pool_media" edges to the PoolMedia entity.
+func (pu *PoolUpdate) ClearPoolMedia() *PoolUpdate {
+	pu.mutation.ClearPoolMedia()
+	return pu
+}
+
+// RemovePoolMediumIDs removes the "pool_media" edge to PoolMedia entities by IDs.
+func (pu *PoolUpdate) RemovePoolMediumIDs(ids ...int) *PoolUpdate {
+	pu.mutation.RemovePoolMediumIDs(ids...)
+	return pu
+}
+
+// RemovePoolMedia removes "pool_media" edges to PoolMedia entities.
+func (pu *PoolUpdate) RemovePoolMedia(p ...*PoolMedia) *PoolUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemovePoolMediumIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PoolUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PoolUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PoolUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PoolUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PoolUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := pool.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PoolUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := pool.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Pool.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PoolUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pool.Table, pool.Columns, sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(pool.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Description(); ok {
+		_spec.SetField(pool.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(pool.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pool.MediaTable,
+			Columns: pool.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMediaIDs(); len(nodes) > 0 && !pu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pool.MediaTable,
+			Columns: pool.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pool.MediaTable,
+			Columns: pool.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.CoverCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pool.CoverTable,
+			Columns: []string{pool.CoverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CoverIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pool.CoverTable,
+			Columns: []string{pool.CoverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PoolMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   pool.PoolMediaTable,
+			Columns: []string{pool.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPoolMediaIDs(); len(nodes) > 0 && !pu.mutation.PoolMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   pool.PoolMediaTable,
+			Columns: []string{pool.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PoolMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   pool.PoolMediaTable,
+			Columns: []string{pool.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pool.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PoolUpdateOne is the builder for updating a single Pool entity.
+type PoolUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PoolMutation
+}
+
+// SetName sets the "name" field.
+func (puo *PoolUpdateOne) SetName(s string) *PoolUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PoolUpdateOne) SetNillableName(s *string) *PoolUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetDescription sets the "description" field.
+func (puo *PoolUpdateOne) SetDescription(s string) *PoolUpdateOne {
+	puo.mutation.SetDescription(s)
+	return puo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (puo *PoolUpdateOne) SetNillableDescription(s *string) *PoolUpdateOne {
+	if s != nil {
+		puo.SetDescription(*s)
+	}
+	return puo
+}
+
+// SetCoverID sets the "cover_id" field.
+func (puo *PoolUpdateOne) SetCoverID(s string) *PoolUpdateOne {
+	puo.mutation.SetCoverID(s)
+	return puo
+}
+
+// SetNillableCoverID sets the "cover_id" field if the given value is not nil.
+func (puo *PoolUpdateOne) SetNillableCoverID(s *string) *PoolUpdateOne {
+	if s != nil {
+		puo.SetCoverID(*s)
+	}
+	return puo
+}
+
+// ClearCoverID clears the value of the "cover_id" field.
+func (puo *PoolUpdateOne) ClearCoverID() *PoolUpdateOne {
+	puo.mutation.ClearCoverID()
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PoolUpdateOne) SetUpdatedAt(t time.Time) *PoolUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by IDs.
+func (puo *PoolUpdateOne) AddMediumIDs(ids ...string) *PoolUpdateOne {
+	puo.mutation.AddMediumIDs(ids...)
+	return puo
+}
+
+// AddMedia adds the "media" edges to the Media entity.
+func (puo *PoolUpdateOne) AddMedia(m ...*Media) *PoolUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return puo.AddMediumIDs(ids...)
+}
+
+// SetCover sets the "cover" edge to the Media entity.
+func (puo *PoolUpdateOne) SetCover(m *Media) *PoolUpdateOne {
+	return puo.SetCoverID(m.ID)
+}
+
+// AddPoolMediumIDs adds the "pool_media" edge to the PoolMedia entity by IDs.
+func (puo *PoolUpdateOne) AddPoolMediumIDs(ids ...int) *PoolUpdateOne {
+	puo.mutation.AddPoolMediumIDs(ids...)
+	return puo
+}
+
+// AddPoolMedia adds the "pool_media" edges to the PoolMedia entity.
+func (puo *PoolUpdateOne) AddPoolMedia(p ...*PoolMedia) *PoolUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddPoolMediumIDs(ids...)
+}
+
+// Mutation returns the PoolMutation object of the builder.
+func (puo *PoolUpdateOne) Mutation() *PoolMutation {
+	return puo.mutation
+}
+
+// ClearMedia clears all "media" edges to the Media entity.
+func (puo *PoolUpdateOne) ClearMedia() *PoolUpdateOne {
+	puo.mutation.ClearMedia()
+	return puo
+}
+
+// RemoveMediumIDs removes the "media" edge to Media entities by IDs.
+func (puo *PoolUpdateOne) RemoveMediumIDs(ids ...string) *PoolUpdateOne {
+	puo.mutation.RemoveMediumIDs(ids...)
+	return puo
+}
+
+// RemoveMedia removes "media" edges to Media entities.
+func (puo *PoolUpdateOne) RemoveMedia(m ...*Media) *PoolUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return puo.RemoveMediumIDs(ids...)
+}
+
+// ClearCover clears the "cover" edge to the Media entity.
+func (puo *PoolUpdateOne) ClearCover() *PoolUpdateOne {
+	puo.mutation.ClearCover()
+	return puo
+}
+
+// ClearPoolMedia clears all "pool_media" edges to the PoolMedia entity.
+func (puo *PoolUpdateOne) ClearPoolMedia() *PoolUpdateOne {
+	puo.mutation.ClearPoolMedia()
+	return puo
+}
+
+// RemovePoolMediumIDs removes the "pool_media" edge to PoolMedia entities by IDs.
+func (puo *PoolUpdateOne) RemovePoolMediumIDs(ids ...int) *PoolUpdateOne {
+	puo.mutation.RemovePoolMediumIDs(ids...)
+	return puo
+}
+
+// RemovePoolMedia removes "pool_media" edges to PoolMedia entities.
+func (puo *PoolUpdateOne) RemovePoolMedia(p ...*PoolMedia) *PoolUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemovePoolMediumIDs(ids...)
+}
+
+// Where appends a list predicates to the PoolUpdate builder.
+func (puo *PoolUpdateOne) Where(ps ...predicate.Pool) *PoolUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PoolUpdateOne) Select(field string, fields ...string) *PoolUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Pool entity.
+func (puo *PoolUpdateOne) Save(ctx context.Context) (*Pool, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PoolUpdateOne) SaveX(ctx context.Context) *Pool {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PoolUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PoolUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PoolUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := pool.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PoolUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := pool.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Pool.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PoolUpdateOne) sqlSave(ctx context.Context) (_node *Pool, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pool.Table, pool.Columns, sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Pool.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pool.FieldID)
+		for _, f := range fields {
+			if !pool.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pool.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(pool.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Description(); ok {
+		_spec.SetField(pool.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(pool.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pool.MediaTable,
+			Columns: pool.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMediaIDs(); len(nodes) > 0 && !puo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pool.MediaTable,
+			Columns: pool.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pool.MediaTable,
+			Columns: pool.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.CoverCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pool.CoverTable,
+			Columns: []string{pool.CoverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CoverIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pool.CoverTable,
+			Columns: []string{pool.CoverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.PoolMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   pool.PoolMediaTable,
+			Columns: []string{pool.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedPoolMediaIDs(); len(nodes) > 0 && !puo.mutation.PoolMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   pool.PoolMediaTable,
+			Columns: []string{pool.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PoolMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   pool.PoolMediaTable,
+			Columns: []string{pool.PoolMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Pool{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pool.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/media"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PoolMedia is the model entity for the PoolMedia schema.
+type PoolMedia struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PoolID holds the value of the "pool_id" field.
+	PoolID int `json:"pool_id,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID string `json:"media_id,omitempty"`
+	// Zero-based position of the media in the pool
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PoolMediaQuery when eager-loading is set.
+	Edges        PoolMediaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PoolMediaEdges holds the relations/edges for other nodes in the graph.
+type PoolMediaEdges struct {
+	// Pool holds the value of the pool edge.
+	Pool *Pool `json:"pool,omitempty"`
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PoolOrErr returns the Pool value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PoolMediaEdges) PoolOrErr() (*Pool, error) {
+	if e.Pool != nil {
+		return e.Pool, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: pool.Label}
+	}
+	return nil, &NotLoadedError{edge: "pool"}
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PoolMediaEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PoolMedia) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case poolmedia.FieldID, poolmedia.FieldPoolID, poolmedia.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case poolmedia.FieldMediaID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PoolMedia fields.
+func (pm *PoolMedia) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case poolmedia.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pm.ID = int(value.Int64)
+		case poolmedia.FieldPoolID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pool_id", values[i])
+			} else if value.Valid {
+				pm.PoolID = int(value.Int64)
+			}
+		case poolmedia.FieldMediaID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value.Valid {
+				pm.MediaID = value.String
+			}
+		case poolmedia.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pm.Position = int(value.Int64)
+			}
+		default:
+			pm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PoolMedia.
+// This includes values selected through modifiers, order, etc.
+func (pm *PoolMedia) Value(name string) (ent.Value, error) {
+	return pm.selectValues.Get(name)
+}
+
+// QueryPool queries the "pool" edge of the PoolMedia entity.
+func (pm *PoolMedia) QueryPool() *PoolQuery {
+	return NewPoolMediaClient(pm.config).QueryPool(pm)
+}
+
+// QueryMedia queries the "media" edge of the PoolMedia entity.
+func (pm *PoolMedia) QueryMedia() *MediaQuery {
+	return NewPoolMediaClient(pm.config).QueryMedia(pm)
+}
+
+// Update returns a builder for updating this PoolMedia.
+// Note that you need to call PoolMedia.Unwrap() before calling this method if this PoolMedia
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *PoolMedia) Update() *PoolMediaUpdateOne {
+	return NewPoolMediaClient(pm.config).UpdateOne(pm)
+}
+
+// Unwrap unwraps the PoolMedia entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *PoolMedia) Unwrap() *PoolMedia {
+	_tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PoolMedia is not a transactional entity")
+	}
+	pm.config.driver = _tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *PoolMedia) String() string {
+	var builder strings.Builder
+	builder.WriteString("PoolMedia(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pm.ID))
+	builder.WriteString("pool_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.PoolID))
+	builder.WriteString(", ")
+	builder.WriteString("media_id=")
+	builder.WriteString(pm.MediaID)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", pm.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PoolMediaSlice is a parsable slice of PoolMedia.
+type PoolMediaSlice []*PoolMedia
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PoolMediaCreate is the builder for creating a PoolMedia entity.
+type PoolMediaCreate struct {
+	config
+	mutation *PoolMediaMutation
+	hooks    []Hook
+}
+
+// SetPoolID sets the "pool_id" field.
+func (pmc *PoolMediaCreate) SetPoolID(i int) *PoolMediaCreate {
+	pmc.mutation.SetPoolID(i)
+	return pmc
+}
+
+// SetMediaID sets the "media_id" field.
+func (pmc *PoolMediaCreate) SetMediaID(s string) *PoolMediaCreate {
+	pmc.mutation.SetMediaID(s)
+	return pmc
+}
+
+// SetPosition sets the "position" field.
+func (pmc *PoolMediaCreate) SetPosition(i int) *PoolMediaCreate {
+	pmc.mutation.SetPosition(i)
+	return pmc
+}
+
+// SetPool sets the "pool" edge to the Pool entity.
+func (pmc *PoolMediaCreate) SetPool(p *Pool) *PoolMediaCreate {
+	return pmc.SetPoolID(p.ID)
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (pmc *PoolMediaCreate) SetMedia(m *Media) *PoolMediaCreate {
+	return pmc.SetMediaID(m.ID)
+}
+
+// Mutation returns the PoolMediaMutation object of the builder.
+func (pmc *PoolMediaCreate) Mutation() *PoolMediaMutation {
+	return pmc.mutation
+}
+
+// Save creates the PoolMedia in the database.
+func (pmc *PoolMediaCreate) Save(ctx context.Context) (*PoolMedia, error) {
+	return withHooks(ctx, pmc.sqlSave, pmc.mutation, pmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *PoolMediaCreate) SaveX(ctx context.Context) *PoolMedia {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *PoolMediaCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *PoolMediaCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *PoolMediaCreate) check() error {
+	if _, ok := pmc.mutation.PoolID(); !ok {
+		return &ValidationError{Name: "pool_id", err: errors.New(`ent: missing required field "PoolMedia.pool_id"`)}
+	}
+	if _, ok := pmc.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "PoolMedia.media_id"`)}
+	}
+	if _, ok := pmc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "PoolMedia.position"`)}
+	}
+	if len(pmc.mutation.PoolIDs()) == 0 {
+		return &ValidationError{Name: "pool", err: errors.New(`ent: missing required edge "PoolMedia.pool"`)}
+	}
+	if len(pmc.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "PoolMedia.media"`)}
+	}
+	return nil
+}
+
+func (pmc *PoolMediaCreate) sqlSave(ctx context.Context) (*PoolMedia, error) {
+	if err := pmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pmc.mutation.id = &_node.ID
+	pmc.mutation.done = true
+	return _node, nil
+}
+
+func (pmc *PoolMediaCreate) createSpec() (*PoolMedia, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PoolMedia{config: pmc.config}
+		_spec = sqlgraph.NewCreateSpec(poolmedia.Table, sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt))
+	)
+	if value, ok := pmc.mutation.Position(); ok {
+		_spec.SetField(poolmedia.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := pmc.mutation.PoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.PoolTable,
+			Columns: []string{poolmedia.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PoolID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pmc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.MediaTable,
+			Columns: []string{poolmedia.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PoolMediaCreateBulk is the builder for creating many PoolMedia entities in bulk.
+type PoolMediaCreateBulk struct {
+	config
+	err      error
+	builders []*PoolMediaCreate
+}
+
+// Save creates the PoolMedia entities in the database.
+func (pmcb *PoolMediaCreateBulk) Save(ctx context.Context) ([]*PoolMedia, error) {
+	if pmcb.err != nil {
+		return nil, pmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*PoolMedia, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PoolMediaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *PoolMediaCreateBulk) SaveX(ctx context.Context) []*PoolMedia {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *PoolMediaCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *PoolMediaCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"era/booru/ent/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PoolMediaQuery is the builder for querying PoolMedia entities.
+type PoolMediaQuery struct {
+	config
+	ctx        *QueryContext
+	order      []poolmedia.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PoolMedia
+	withPool   *PoolQuery
+	withMedia  *MediaQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PoolMediaQuery builder.
+func (pmq *PoolMediaQuery) Where(ps ...predicate.PoolMedia) *PoolMediaQuery {
+	pmq.predicates = append(pmq.predicates, ps...)
+	return pmq
+}
+
+// Limit the number of records to be returned by this query.
+func (pmq *PoolMediaQuery) Limit(limit int) *PoolMediaQuery {
+	pmq.ctx.Limit = &limit
+	return pmq
+}
+
+// Offset to start from.
+func (pmq *PoolMediaQuery) Offset(offset int) *PoolMediaQuery {
+	pmq.ctx.Offset = &offset
+	return pmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pmq *PoolMediaQuery) Unique(unique bool) *PoolMediaQuery {
+	pmq.ctx.Unique = &unique
+	return pmq
+}
+
+// Order specifies how the records should be ordered.
+func (pmq *PoolMediaQuery) Order(o ...poolmedia.OrderOption) *PoolMediaQuery {
+	pmq.order = append(pmq.order, o...)
+	return pmq
+}
+
+// QueryPool chains the current query on the "pool" edge.
+func (pmq *PoolMediaQuery) QueryPool() *PoolQuery {
+	query := (&PoolClient{config: pmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poolmedia.Table, poolmedia.FieldID, selector),
+			sqlgraph.To(pool.Table, pool.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, poolmedia.PoolTable, poolmedia.PoolColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMedia chains the current query on the "media" edge.
+func (pmq *PoolMediaQuery) QueryMedia() *MediaQuery {
+	query := (&MediaClient{config: pmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poolmedia.Table, poolmedia.FieldID, selector),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, poolmedia.MediaTable, poolmedia.MediaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PoolMedia entity from the query.
+// Returns a *NotFoundError when no PoolMedia was found.
+func (pmq *PoolMediaQuery) First(ctx context.Context) (*PoolMedia, error) {
+	nodes, err := pmq.Limit(1).All(setContextOp(ctx, pmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{poolmedia.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pmq *PoolMediaQuery) FirstX(ctx context.Context) *PoolMedia {
+	node, err := pmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PoolMedia ID from the query.
+// Returns a *NotFoundError when no PoolMedia ID was found.
+func (pmq *PoolMediaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmq.Limit(1).IDs(setContextOp(ctx, pmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{poolmedia.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pmq *PoolMediaQuery) FirstIDX(ctx context.Context) int {
+	id, err := pmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PoolMedia entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PoolMedia entity is found.
+// Returns a *NotFoundError when no PoolMedia entities are found.
+func (pmq *PoolMediaQuery) Only(ctx context.Context) (*PoolMedia, error) {
+	nodes, err := pmq.Limit(2).All(setContextOp(ctx, pmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{poolmedia.Label}
+	default:
+		return nil, &NotSingularError{poolmedia.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pmq *PoolMediaQuery) OnlyX(ctx context.Context) *PoolMedia {
+	node, err := pmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PoolMedia ID in the query.
+// Returns a *NotSingularError when more than one PoolMedia ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pmq *PoolMediaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmq.Limit(2).IDs(setContextOp(ctx, pmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{poolmedia.Label}
+	default:
+		err = &NotSingularError{poolmedia.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pmq *PoolMediaQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PoolMediaSlice.
+func (pmq *PoolMediaQuery) All(ctx context.Context) ([]*PoolMedia, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryAll)
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PoolMedia, *PoolMediaQuery]()
+	return withInterceptors[[]*PoolMedia](ctx, pmq, qr, pmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pmq *PoolMediaQuery) AllX(ctx context.Context) []*PoolMedia {
+	nodes, err := pmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PoolMedia IDs.
+func (pmq *PoolMediaQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pmq.ctx.Unique == nil && pmq.path != nil {
+		pmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryIDs)
+	if err = pmq.Select(poolmedia.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pmq *PoolMediaQuery) IDsX(ctx context.Context) []int {
+	ids, err := pmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pmq *PoolMediaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryCount)
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pmq, querierCount[*PoolMediaQuery](), pmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pmq *PoolMediaQuery) CountX(ctx context.Context) int {
+	count, err := pmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pmq *PoolMediaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryExist)
+	switch _, err := pmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pmq *PoolMediaQuery) ExistX(ctx context.Context) bool {
+	exist, err := pmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PoolMediaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pmq *PoolMediaQuery) Clone() *PoolMediaQuery {
+	if pmq == nil {
+		return nil
+	}
+	return &PoolMediaQuery{
+		config:     pmq.config,
+		ctx:        pmq.ctx.Clone(),
+		order:      append([]poolmedia.OrderOption{}, pmq.order...),
+		inters:     append([]Interceptor{}, pmq.inters...),
+		predicates: append([]predicate.PoolMedia{}, pmq.predicates...),
+		withPool:   pmq.withPool.Clone(),
+		withMedia:  pmq.withMedia.Clone(),
+		// clone intermediate query.
+		sql:  pmq.sql.Clone(),
+		path: pmq.path,
+	}
+}
+
+// WithPool tells the query-builder to eager-load the nodes that are connected to
+// the "pool" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmq *PoolMediaQuery) WithPool(opts ...func(*PoolQuery)) *PoolMediaQuery {
+	query := (&PoolClient{config: pmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmq.withPool = query
+	return pmq
+}
+
+// WithMedia tells the query-builder to eager-load the nodes that are connected to
+// the "media" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmq *PoolMediaQuery) WithMedia(opts ...func(*MediaQuery)) *PoolMediaQuery {
+	query := (&MediaClient{config: pmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmq.withMedia = query
+	return pmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PoolID int `json:"pool_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PoolMedia.Query().
+//		GroupBy(poolmedia.FieldPoolID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pmq *PoolMediaQuery) GroupBy(field string, fields ...string) *PoolMediaGroupBy {
+	pmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PoolMediaGroupBy{build: pmq}
+	grbuild.flds = &pmq.ctx.Fields
+	grbuild.label = poolmedia.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PoolID int `json:"pool_id,omitempty"`
+//	}
+//
+//	client.PoolMedia.Query().
+//		Select(poolmedia.FieldPoolID).
+//		Scan(ctx, &v)
+func (pmq *PoolMediaQuery) Select(fields ...string) *PoolMediaSelect {
+	pmq.ctx.Fields = append(pmq.ctx.Fields, fields...)
+	sbuild := &PoolMediaSelect{PoolMediaQuery: pmq}
+	sbuild.label = poolmedia.Label
+	sbuild.flds, sbuild.scan = &pmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PoolMediaSelect configured with the given aggregations.
+func (pmq *PoolMediaQuery) Aggregate(fns ...AggregateFunc) *PoolMediaSelect {
+	return pmq.Select().Aggregate(fns...)
+}
+
+func (pmq *PoolMediaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pmq.ctx.Fields {
+		if !poolmedia.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pmq.path != nil {
+		prev, err := pmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pmq.sql = prev
+	}
+	return nil
+}
+
+func (pmq *PoolMediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PoolMedia, error) {
+	var (
+		nodes       = []*PoolMedia{}
+		_spec       = pmq.querySpec()
+		loadedTypes = [2]bool{
+			pmq.withPool != nil,
+			pmq.withMedia != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PoolMedia).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PoolMedia{config: pmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pmq.withPool; query != nil {
+		if err := pmq.loadPool(ctx, query, nodes, nil,
+			func(n *PoolMedia, e *Pool) { n.Edges.Pool = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pmq.withMedia; query != nil {
+		if err := pmq.loadMedia(ctx, query, nodes, nil,
+			func(n *PoolMedia, e *Media) { n.Edges.Media = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pmq *PoolMediaQuery) loadPool(ctx context.Context, query *PoolQuery, nodes []*PoolMedia, init func(*PoolMedia), assign func(*PoolMedia, *Pool)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PoolMedia)
+	for i := range nodes {
+		fk := nodes[i].PoolID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pool.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pool_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pmq *PoolMediaQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes []*PoolMedia, init func(*PoolMedia), assign func(*PoolMedia, *Media)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*PoolMedia)
+	for i := range nodes {
+		fk := nodes[i].MediaID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(media.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "media_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pmq *PoolMediaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pmq.querySpec()
+	_spec.Node.Columns = pmq.ctx.Fields
+	if len(pmq.ctx.Fields) > 0 {
+		_spec.Unique = pmq.ctx.Unique != nil && *pmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pmq.driver, _spec)
+}
+
+func (pmq *PoolMediaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(poolmedia.Table, poolmedia.Columns, sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt))
+	_spec.From = pmq.sql
+	if unique := pmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, poolmedia.FieldID)
+		for i := range fields {
+			if fields[i] != poolmedia.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pmq.withPool != nil {
+			_spec.Node.AddColumnOnce(poolmedia.FieldPoolID)
+		}
+		if pmq.withMedia != nil {
+			_spec.Node.AddColumnOnce(poolmedia.FieldMediaID)
+		}
+	}
+	if ps := pmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pmq *PoolMediaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pmq.driver.Dialect())
+	t1 := builder.Table(poolmedia.Table)
+	columns := pmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = poolmedia.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pmq.sql != nil {
+		selector = pmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pmq.ctx.Unique != nil && *pmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pmq.predicates {
+		p(selector)
+	}
+	for _, p := range pmq.order {
+		p(selector)
+	}
+	if offset := pmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PoolMediaGroupBy is the group-by builder for PoolMedia entities.
+type PoolMediaGroupBy struct {
+	selector
+	build *PoolMediaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pmgb *PoolMediaGroupBy) Aggregate(fns ...AggregateFunc) *PoolMediaGroupBy {
+	pmgb.fns = append(pmgb.fns, fns...)
+	return pmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pmgb *PoolMediaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PoolMediaQuery, *PoolMediaGroupBy](ctx, pmgb.build, pmgb, pmgb.build.inters, v)
+}
+
+func (pmgb *PoolMediaGroupBy) sqlScan(ctx context.Context, root *PoolMediaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pmgb.fns))
+	for _, fn := range pmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pmgb.flds)+len(pmgb.fns))
+		for _, f := range *pmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PoolMediaSelect is the builder for selecting fields of PoolMedia entities.
+type PoolMediaSelect struct {
+	*PoolMediaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pms *PoolMediaSelect) Aggregate(fns ...AggregateFunc) *PoolMediaSelect {
+	pms.fns = append(pms.fns, fns...)
+	return pms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pms *PoolMediaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pms.ctx, ent.OpQuerySelect)
+	if err := pms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PoolMediaQuery, *PoolMediaSelect](ctx, pms.PoolMediaQuery, pms, pms.inters, v)
+}
+
+func (pms *PoolMediaSelect) sqlScan(ctx context.Context, root *PoolMediaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pms.fns))
+	for _, fn := range pms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
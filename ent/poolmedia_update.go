@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"era/booru/ent/predicate"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PoolMediaUpdate is the builder for updating PoolMedia entities.
+type PoolMediaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PoolMediaMutation
+}
+
+// Where appends a list predicates to the PoolMediaUpdate builder.
+func (pmu *PoolMediaUpdate) Where(ps ...predicate.PoolMedia) *PoolMediaUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// SetPoolID sets the "pool_id" field.
+func (pmu *PoolMediaUpdate) SetPoolID(i int) *PoolMediaUpdate {
+	pmu.mutation.SetPoolID(i)
+	return pmu
+}
+
+// SetNillablePoolID sets the "pool_id" field if the given value is not nil.
+func (pmu *PoolMediaUpdate) SetNillablePoolID(i *int) *PoolMediaUpdate {
+	if i != nil {
+		pmu.SetPoolID(*i)
+	}
+	return pmu
+}
+
+// SetMediaID sets the "media_id" field.
+func (pmu *PoolMediaUpdate) SetMediaID(s string) *PoolMediaUpdate {
+	pmu.mutation.SetMediaID(s)
+	return pmu
+}
+
+// SetNillableMediaID sets the "media_id" field if the given value is not nil.
+func (pmu *PoolMediaUpdate) SetNillableMediaID(s *string) *PoolMediaUpdate {
+	if s != nil {
+		pmu.SetMediaID(*s)
+	}
+	return pmu
+}
+
+// SetPosition sets the "position" field.
+func (pmu *PoolMediaUpdate) SetPosition(i int) *PoolMediaUpdate {
+	pmu.mutation.ResetPosition()
+	pmu.mutation.SetPosition(i)
+	return pmu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pmu *PoolMediaUpdate) SetNillablePosition(i *int) *PoolMediaUpdate {
+	if i != nil {
+		pmu.SetPosition(*i)
+	}
+	return pmu
+}
+
+// AddPosition adds i to the "position" field.
+func (pmu *PoolMediaUpdate) AddPosition(i int) *PoolMediaUpdate {
+	pmu.mutation.AddPosition(i)
+	return pmu
+}
+
+// SetPool sets the "pool" edge to the Pool entity.
+func (pmu *PoolMediaUpdate) SetPool(p *Pool) *PoolMediaUpdate {
+	return pmu.SetPoolID(p.ID)
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (pmu *PoolMediaUpdate) SetMedia(m *Media) *PoolMediaUpdate {
+	return pmu.SetMediaID(m.ID)
+}
+
+// Mutation returns the PoolMediaMutation object of the builder.
+func (pmu *PoolMediaUpdate) Mutation() *PoolMediaMutation {
+	return pmu.mutation
+}
+
+// ClearPool clears the "pool" edge to the Pool entity.
+func (pmu *PoolMediaUpdate) ClearPool() *PoolMediaUpdate {
+	pmu.mutation.ClearPool()
+	return pmu
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (pmu *PoolMediaUpdate) ClearMedia() *PoolMediaUpdate {
+	pmu.mutation.ClearMedia()
+	return pmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *PoolMediaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmu.sqlSave, pmu.mutation, pmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *PoolMediaUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *PoolMediaUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *PoolMediaUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmu *PoolMediaUpdate) check() error {
+	if pmu.mutation.PoolCleared() && len(pmu.mutation.PoolIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PoolMedia.pool"`)
+	}
+	if pmu.mutation.MediaCleared() && len(pmu.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PoolMedia.media"`)
+	}
+	return nil
+}
+
+func (pmu *PoolMediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(poolmedia.Table, poolmedia.Columns, sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt))
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmu.mutation.Position(); ok {
+		_spec.SetField(poolmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmu.mutation.AddedPosition(); ok {
+		_spec.AddField(poolmedia.FieldPosition, field.TypeInt, value)
+	}
+	if pmu.mutation.PoolCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.PoolTable,
+			Columns: []string{poolmedia.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.PoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.PoolTable,
+			Columns: []string{poolmedia.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pmu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.MediaTable,
+			Columns: []string{poolmedia.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.MediaTable,
+			Columns: []string{poolmedia.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poolmedia.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmu.mutation.done = true
+	return n, nil
+}
+
+// PoolMediaUpdateOne is the builder for updating a single PoolMedia entity.
+type PoolMediaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PoolMediaMutation
+}
+
+// SetPoolID sets the "pool_id" field.
+func (pmuo *PoolMediaUpdateOne) SetPoolID(i int) *PoolMediaUpdateOne {
+	pmuo.mutation.SetPoolID(i)
+	return pmuo
+}
+
+// SetNillablePoolID sets the "pool_id" field if the given value is not nil.
+func (pmuo *PoolMediaUpdateOne) SetNillablePoolID(i *int) *PoolMediaUpdateOne {
+	if i != nil {
+		pmuo.SetPoolID(*i)
+	}
+	return pmuo
+}
+
+// SetMediaID sets the "media_id" field.
+func (pmuo *PoolMediaUpdateOne) SetMediaID(s string) *PoolMediaUpdateOne {
+	pmuo.mutation.SetMediaID(s)
+	return pmuo
+}
+
+// SetNillableMediaID sets the "media_id" field if the given value is not nil.
+func (pmuo *PoolMediaUpdateOne) SetNillableMediaID(s *string) *PoolMediaUpdateOne {
+	if s != nil {
+		pmuo.SetMediaID(*s)
+	}
+	return pmuo
+}
+
+// SetPosition sets the "position" field.
+func (pmuo *PoolMediaUpdateOne) SetPosition(i int) *PoolMediaUpdateOne {
+	pmuo.mutation.ResetPosition()
+	pmuo.mutation.SetPosition(i)
+	return pmuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pmuo *PoolMediaUpdateOne) SetNillablePosition(i *int) *PoolMediaUpdateOne {
+	if i != nil {
+		pmuo.SetPosition(*i)
+	}
+	return pmuo
+}
+
+// AddPosition adds i to the "position" field.
+func (pmuo *PoolMediaUpdateOne) AddPosition(i int) *PoolMediaUpdateOne {
+	pmuo.mutation.AddPosition(i)
+	return pmuo
+}
+
+// SetPool sets the "pool" edge to the Pool entity.
+func (pmuo *PoolMediaUpdateOne) SetPool(p *Pool) *PoolMediaUpdateOne {
+	return pmuo.SetPoolID(p.ID)
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (pmuo *PoolMediaUpdateOne) SetMedia(m *Media) *PoolMediaUpdateOne {
+	return pmuo.SetMediaID(m.ID)
+}
+
+// Mutation returns the PoolMediaMutation object of the builder.
+func (pmuo *PoolMediaUpdateOne) Mutation() *PoolMediaMutation {
+	return pmuo.mutation
+}
+
+// ClearPool clears the "pool" edge to the Pool entity.
+func (pmuo *PoolMediaUpdateOne) ClearPool() *PoolMediaUpdateOne {
+	pmuo.mutation.ClearPool()
+	return pmuo
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (pmuo *PoolMediaUpdateOne) ClearMedia() *PoolMediaUpdateOne {
+	pmuo.mutation.ClearMedia()
+	return pmuo
+}
+
+// Where appends a list predicates to the PoolMediaUpdate builder.
+func (pmuo *PoolMediaUpdateOne) Where(ps ...predicate.PoolMedia) *PoolMediaUpdateOne {
+	pmuo.mutation.Where(ps...)
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *PoolMediaUpdateOne) Select(field string, fields ...string) *PoolMediaUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated PoolMedia entity.
+func (pmuo *PoolMediaUpdateOne) Save(ctx context.Context) (*PoolMedia, error) {
+	return withHooks(ctx, pmuo.sqlSave, pmuo.mutation, pmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *PoolMediaUpdateOne) SaveX(ctx context.Context) *PoolMedia {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *PoolMediaUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *PoolMediaUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmuo *PoolMediaUpdateOne) check() error {
+	if pmuo.mutation.PoolCleared() && len(pmuo.mutation.PoolIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PoolMedia.pool"`)
+	}
+	if pmuo.mutation.MediaCleared() && len(pmuo.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PoolMedia.media"`)
+	}
+	return nil
+}
+
+func (pmuo *PoolMediaUpdateOne) sqlSave(ctx context.Context) (_node *PoolMedia, err error) {
+	if err := pmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(poolmedia.Table, poolmedia.Columns, sqlgraph.NewFieldSpec(poolmedia.FieldID, field.TypeInt))
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PoolMedia.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, poolmedia.FieldID)
+		for _, f := range fields {
+			if !poolmedia.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != poolmedia.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmuo.mutation.Position(); ok {
+		_spec.SetField(poolmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmuo.mutation.AddedPosition(); ok {
+		_spec.AddField(poolmedia.FieldPosition, field.TypeInt, value)
+	}
+	if pmuo.mutation.PoolCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.PoolTable,
+			Columns: []string{poolmedia.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.PoolIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.PoolTable,
+			Columns: []string{poolmedia.PoolColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pool.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pmuo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.MediaTable,
+			Columns: []string{poolmedia.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   poolmedia.MediaTable,
+			Columns: []string{poolmedia.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PoolMedia{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poolmedia.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmuo.mutation.done = true
+	return _node, nil
+}
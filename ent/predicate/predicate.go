@@ -6,9 +6,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// AutoTag is the predicate function for autotag builders.
+type AutoTag func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
 // Date is the predicate function for date builders.
 type Date func(*sql.Selector)
 
+// Favorite is the predicate function for favorite builders.
+type Favorite func(*sql.Selector)
+
 // HiddenTagFilter is the predicate function for hiddentagfilter builders.
 type HiddenTagFilter func(*sql.Selector)
 
@@ -18,14 +30,47 @@ type Media func(*sql.Selector)
 // MediaDate is the predicate function for mediadate builders.
 type MediaDate func(*sql.Selector)
 
+// MediaField is the predicate function for mediafield builders.
+type MediaField func(*sql.Selector)
+
+// MediaRevision is the predicate function for mediarevision builders.
+type MediaRevision func(*sql.Selector)
+
 // MediaVector is the predicate function for mediavector builders.
 type MediaVector func(*sql.Selector)
 
+// MediaVote is the predicate function for mediavote builders.
+type MediaVote func(*sql.Selector)
+
+// MetaField is the predicate function for metafield builders.
+type MetaField func(*sql.Selector)
+
+// Note is the predicate function for note builders.
+type Note func(*sql.Selector)
+
+// Pool is the predicate function for pool builders.
+type Pool func(*sql.Selector)
+
+// PoolMedia is the predicate function for poolmedia builders.
+type PoolMedia func(*sql.Selector)
+
+// Rendition is the predicate function for rendition builders.
+type Rendition func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
+// Source is the predicate function for source builders.
+type Source func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
+// TagSuggestion is the predicate function for tagsuggestion builders.
+type TagSuggestion func(*sql.Selector)
+
+// TagVector is the predicate function for tagvector builders.
+type TagVector func(*sql.Selector)
+
 // Vector is the predicate function for vector builders.
 type Vector func(*sql.Selector)
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/media"
+	"era/booru/ent/rendition"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Rendition is the model entity for the Rendition schema.
+type Rendition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID string `json:"media_id,omitempty"`
+	// Container of the rendition: H.264/AAC MP4 or VP9/Opus WebM
+	Format rendition.Format `json:"format,omitempty"`
+	// Transcoding state of the rendition
+	Status rendition.Status `json:"status,omitempty"`
+	// Transcoding progress between 0 and 1
+	Progress float64 `json:"progress,omitempty"`
+	// Size of the rendition object in bytes
+	Size *int64 `json:"size,omitempty"`
+	// Last transcoding error, if any
+	Error string `json:"error,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RenditionQuery when eager-loading is set.
+	Edges        RenditionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RenditionEdges holds the relations/edges for other nodes in the graph.
+type RenditionEdges struct {
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RenditionEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Rendition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rendition.FieldProgress:
+			values[i] = new(sql.NullFloat64)
+		case rendition.FieldID, rendition.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case rendition.FieldMediaID, rendition.FieldFormat, rendition.FieldStatus, rendition.FieldError:
+			values[i] = new(sql.NullString)
+		case rendition.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Rendition fields.
+func (r *Rendition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rendition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case rendition.FieldMediaID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value.Valid {
+				r.MediaID = value.String
+			}
+		case rendition.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				r.Format = rendition.Format(value.String)
+			}
+		case rendition.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = rendition.Status(value.String)
+			}
+		case rendition.FieldProgress:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress", values[i])
+			} else if value.Valid {
+				r.Progress = value.Float64
+			}
+		case rendition.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				r.Size = new(int64)
+				*r.Size = value.Int64
+			}
+		case rendition.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				r.Error = value.String
+			}
+		case rendition.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Rendition.
+// This includes values selected through modifiers, order, etc.
+func (r *Rendition) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the Rendition entity.
+func (r *Rendition) QueryMedia() *MediaQuery {
+	return NewRenditionClient(r.config).QueryMedia(r)
+}
+
+// Update returns a builder for updating this Rendition.
+// Note that you need to call Rendition.Unwrap() before calling this method if this Rendition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Rendition) Update() *RenditionUpdateOne {
+	return NewRenditionClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Rendition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Rendition) Unwrap() *Rendition {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Rendition is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Rendition) String() string {
+	var builder strings.Builder
+	builder.WriteString("Rendition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("media_id=")
+	builder.WriteString(r.MediaID)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", r.Format))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("progress=")
+	builder.WriteString(fmt.Sprintf("%v", r.Progress))
+	builder.WriteString(", ")
+	if v := r.Size; v != nil {
+		builder.WriteString("size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(r.Error)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Renditions is a parsable slice of Rendition.
+type Renditions []*Rendition
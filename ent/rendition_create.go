@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/rendition"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RenditionCreate is the builder for creating a Rendition entity.
+type RenditionCreate struct {
+	config
+	mutation *RenditionMutation
+	hooks    []Hook
+}
+
+// SetMediaID sets the "media_id" field.
+func (rc *RenditionCreate) SetMediaID(s string) *RenditionCreate {
+	rc.mutation.SetMediaID(s)
+	return rc
+}
+
+// SetFormat sets the "format" field.
+func (rc *RenditionCreate) SetFormat(r rendition.Format) *RenditionCreate {
+	rc.mutation.SetFormat(r)
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *RenditionCreate) SetStatus(r rendition.Status) *RenditionCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *RenditionCreate) SetNillableStatus(r *rendition.Status) *RenditionCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
+// SetProgress sets the "progress" field.
+func (rc *RenditionCreate) SetProgress(f float64) *RenditionCreate {
+	rc.mutation.SetProgress(f)
+	return rc
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (rc *RenditionCreate) SetNillableProgress(f *float64) *RenditionCreate {
+	if f != nil {
+		rc.SetProgress(*f)
+	}
+	return rc
+}
+
+// SetSize sets the "size" field.
+func (rc *RenditionCreate) SetSize(i int64) *RenditionCreate {
+	rc.mutation.SetSize(i)
+	return rc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (rc *RenditionCreate) SetNillableSize(i *int64) *RenditionCreate {
+	if i != nil {
+		rc.SetSize(*i)
+	}
+	return rc
+}
+
+// SetError sets the "error" field.
+func (rc *RenditionCreate) SetError(s string) *RenditionCreate {
+	rc.mutation.SetError(s)
+	return rc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rc *RenditionCreate) SetNillableError(s *string) *RenditionCreate {
+	if s != nil {
+		rc.SetError(*s)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *RenditionCreate) SetUpdatedAt(t time.Time) *RenditionCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *RenditionCreate) SetNillableUpdatedAt(t *time.Time) *RenditionCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (rc *RenditionCreate) SetMedia(m *Media) *RenditionCreate {
+	return rc.SetMediaID(m.ID)
+}
+
+// Mutation returns the RenditionMutation object of the builder.
+func (rc *RenditionCreate) Mutation() *RenditionMutation {
+	return rc.mutation
+}
+
+// Save creates the Rendition in the database.
+func (rc *RenditionCreate) Save(ctx context.Context) (*Rendition, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RenditionCreate) SaveX(ctx context.Context) *Rendition {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RenditionCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RenditionCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RenditionCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := rendition.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.Progress(); !ok {
+		v := rendition.DefaultProgress
+		rc.mutation.SetProgress(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := rendition.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RenditionCreate) check() error {
+	if _, ok := rc.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "Rendition.media_id"`)}
+	}
+	if _, ok := rc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Rendition.format"`)}
+	}
+	if v, ok := rc.mutation.Format(); ok {
+		if err := rendition.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Rendition.format": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Rendition.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := rendition.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Rendition.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Progress(); !ok {
+		return &ValidationError{Name: "progress", err: errors.New(`ent: missing required field "Rendition.progress"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Rendition.updated_at"`)}
+	}
+	if len(rc.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "Rendition.media"`)}
+	}
+	return nil
+}
+
+func (rc *RenditionCreate) sqlSave(ctx context.Context) (*Rendition, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RenditionCreate) createSpec() (*Rendition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Rendition{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(rendition.Table, sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.Format(); ok {
+		_spec.SetField(rendition.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(rendition.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.Progress(); ok {
+		_spec.SetField(rendition.FieldProgress, field.TypeFloat64, value)
+		_node.Progress = value
+	}
+	if value, ok := rc.mutation.Size(); ok {
+		_spec.SetField(rendition.FieldSize, field.TypeInt64, value)
+		_node.Size = &value
+	}
+	if value, ok := rc.mutation.Error(); ok {
+		_spec.SetField(rendition.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rendition.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := rc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rendition.MediaTable,
+			Columns: []string{rendition.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RenditionCreateBulk is the builder for creating many Rendition entities in bulk.
+type RenditionCreateBulk struct {
+	config
+	err      error
+	builders []*RenditionCreate
+}
+
+// Save creates the Rendition entities in the database.
+func (rcb *RenditionCreateBulk) Save(ctx context.Context) ([]*Rendition, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Rendition, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RenditionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RenditionCreateBulk) SaveX(ctx context.Context) []*Rendition {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RenditionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RenditionCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/predicate"
+	"era/booru/ent/rendition"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RenditionUpdate is the builder for updating Rendition entities.
+type RenditionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RenditionMutation
+}
+
+// Where appends a list predicates to the RenditionUpdate builder.
+func (ru *RenditionUpdate) Where(ps ...predicate.Rendition) *RenditionUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetMediaID sets the "media_id" field.
+func (ru *RenditionUpdate) SetMediaID(s string) *RenditionUpdate {
+	ru.mutation.SetMediaID(s)
+	return ru
+}
+
+// SetNillableMediaID sets the "media_id" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableMediaID(s *string) *RenditionUpdate {
+	if s != nil {
+		ru.SetMediaID(*s)
+	}
+	return ru
+}
+
+// SetFormat sets the "format" field.
+func (ru *RenditionUpdate) SetFormat(r rendition.Format) *RenditionUpdate {
+	ru.mutation.SetFormat(r)
+	return ru
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableFormat(r *rendition.Format) *RenditionUpdate {
+	if r != nil {
+		ru.SetFormat(*r)
+	}
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *RenditionUpdate) SetStatus(r rendition.Status) *RenditionUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableStatus(r *rendition.Status) *RenditionUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetProgress sets the "progress" field.
+func (ru *RenditionUpdate) SetProgress(f float64) *RenditionUpdate {
+	ru.mutation.ResetProgress()
+	ru.mutation.SetProgress(f)
+	return ru
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableProgress(f *float64) *RenditionUpdate {
+	if f != nil {
+		ru.SetProgress(*f)
+	}
+	return ru
+}
+
+// AddProgress adds f to the "progress" field.
+func (ru *RenditionUpdate) AddProgress(f float64) *RenditionUpdate {
+	ru.mutation.AddProgress(f)
+	return ru
+}
+
+// SetSize sets the "size" field.
+func (ru *RenditionUpdate) SetSize(i int64) *RenditionUpdate {
+	ru.mutation.ResetSize()
+	ru.mutation.SetSize(i)
+	return ru
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableSize(i *int64) *RenditionUpdate {
+	if i != nil {
+		ru.SetSize(*i)
+	}
+	return ru
+}
+
+// AddSize adds i to the "size" field.
+func (ru *RenditionUpdate) AddSize(i int64) *RenditionUpdate {
+	ru.mutation.AddSize(i)
+	return ru
+}
+
+// ClearSize clears the value of the "size" field.
+func (ru *RenditionUpdate) ClearSize() *RenditionUpdate {
+	ru.mutation.ClearSize()
+	return ru
+}
+
+// SetError sets the "error" field.
+func (ru *RenditionUpdate) SetError(s string) *RenditionUpdate {
+	ru.mutation.SetError(s)
+	return ru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ru *RenditionUpdate) SetNillableError(s *string) *RenditionUpdate {
+	if s != nil {
+		ru.SetError(*s)
+	}
+	return ru
+}
+
+// ClearError clears the value of the "error" field.
+func (ru *RenditionUpdate) ClearError() *RenditionUpdate {
+	ru.mutation.ClearError()
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *RenditionUpdate) SetUpdatedAt(t time.Time) *RenditionUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (ru *RenditionUpdate) SetMedia(m *Media) *RenditionUpdate {
+	return ru.SetMediaID(m.ID)
+}
+
+// Mutation returns the RenditionMutation object of the builder.
+func (ru *RenditionUpdate) Mutation() *RenditionMutation {
+	return ru.mutation
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (ru *RenditionUpdate) ClearMedia() *RenditionUpdate {
+	ru.mutation.ClearMedia()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RenditionUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RenditionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RenditionUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RenditionUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *RenditionUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := rendition.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RenditionUpdate) check() error {
+	if v, ok := ru.mutation.Format(); ok {
+		if err := rendition.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Rendition.format": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Status(); ok {
+		if err := rendition.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Rendition.status": %w`, err)}
+		}
+	}
+	if ru.mutation.MediaCleared() && len(ru.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rendition.media"`)
+	}
+	return nil
+}
+
+func (ru *RenditionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rendition.Table, rendition.Columns, sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Format(); ok {
+		_spec.SetField(rendition.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(rendition.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Progress(); ok {
+		_spec.SetField(rendition.FieldProgress, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedProgress(); ok {
+		_spec.AddField(rendition.FieldProgress, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.Size(); ok {
+		_spec.SetField(rendition.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedSize(); ok {
+		_spec.AddField(rendition.FieldSize, field.TypeInt64, value)
+	}
+	if ru.mutation.SizeCleared() {
+		_spec.ClearField(rendition.FieldSize, field.TypeInt64)
+	}
+	if value, ok := ru.mutation.Error(); ok {
+		_spec.SetField(rendition.FieldError, field.TypeString, value)
+	}
+	if ru.mutation.ErrorCleared() {
+		_spec.ClearField(rendition.FieldError, field.TypeString)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(rendition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ru.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rendition.MediaTable,
+			Columns: []string{rendition.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rendition.MediaTable,
+			Columns: []string{rendition.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rendition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RenditionUpdateOne is the builder for updating a single Rendition entity.
+type RenditionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RenditionMutation
+}
+
+// SetMediaID sets the "media_id" field.
+func (ruo *RenditionUpdateOne) SetMediaID(s string) *RenditionUpdateOne {
+	ruo.mutation.SetMediaID(s)
+	return ruo
+}
+
+// SetNillableMediaID sets the "media_id" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableMediaID(s *string) *RenditionUpdateOne {
+	if s != nil {
+		ruo.SetMediaID(*s)
+	}
+	return ruo
+}
+
+// SetFormat sets the "format" field.
+func (ruo *RenditionUpdateOne) SetFormat(r rendition.Format) *RenditionUpdateOne {
+	ruo.mutation.SetFormat(r)
+	return ruo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableFormat(r *rendition.Format) *RenditionUpdateOne {
+	if r != nil {
+		ruo.SetFormat(*r)
+	}
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *RenditionUpdateOne) SetStatus(r rendition.Status) *RenditionUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableStatus(r *rendition.Status) *RenditionUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetProgress sets the "progress" field.
+func (ruo *RenditionUpdateOne) SetProgress(f float64) *RenditionUpdateOne {
+	ruo.mutation.ResetProgress()
+	ruo.mutation.SetProgress(f)
+	return ruo
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableProgress(f *float64) *RenditionUpdateOne {
+	if f != nil {
+		ruo.SetProgress(*f)
+	}
+	return ruo
+}
+
+// AddProgress adds f to the "progress" field.
+func (ruo *RenditionUpdateOne) AddProgress(f float64) *RenditionUpdateOne {
+	ruo.mutation.AddProgress(f)
+	return ruo
+}
+
+// SetSize sets the "size" field.
+func (ruo *RenditionUpdateOne) SetSize(i int64) *RenditionUpdateOne {
+	ruo.mutation.ResetSize()
+	ruo.mutation.SetSize(i)
+	return ruo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableSize(i *int64) *RenditionUpdateOne {
+	if i != nil {
+		ruo.SetSize(*i)
+	}
+	return ruo
+}
+
+// AddSize adds i to the "size" field.
+func (ruo *RenditionUpdateOne) AddSize(i int64) *RenditionUpdateOne {
+	ruo.mutation.AddSize(i)
+	return ruo
+}
+
+// ClearSize clears the value of the "size" field.
+func (ruo *RenditionUpdateOne) ClearSize() *RenditionUpdateOne {
+	ruo.mutation.ClearSize()
+	return ruo
+}
+
+// SetError sets the "error" field.
+func (ruo *RenditionUpdateOne) SetError(s string) *RenditionUpdateOne {
+	ruo.mutation.SetError(s)
+	return ruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ruo *RenditionUpdateOne) SetNillableError(s *string) *RenditionUpdateOne {
+	if s != nil {
+		ruo.SetError(*s)
+	}
+	return ruo
+}
+
+// ClearError clears the value of the "error" field.
+func (ruo *RenditionUpdateOne) ClearError() *RenditionUpdateOne {
+	ruo.mutation.ClearError()
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *RenditionUpdateOne) SetUpdatedAt(t time.Time) *RenditionUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (ruo *RenditionUpdateOne) SetMedia(m *Media) *RenditionUpdateOne {
+	return ruo.SetMediaID(m.ID)
+}
+
+// Mutation returns the RenditionMutation object of the builder.
+func (ruo *RenditionUpdateOne) Mutation() *RenditionMutation {
+	return ruo.mutation
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (ruo *RenditionUpdateOne) ClearMedia() *RenditionUpdateOne {
+	ruo.mutation.ClearMedia()
+	return ruo
+}
+
+// Where appends a list predicates to the RenditionUpdate builder.
+func (ruo *RenditionUpdateOne) Where(ps ...predicate.Rendition) *RenditionUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RenditionUpdateOne) Select(field string, fields ...string) *RenditionUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Rendition entity.
+func (ruo *RenditionUpdateOne) Save(ctx context.Context) (*Rendition, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RenditionUpdateOne) SaveX(ctx context.Context) *Rendition {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RenditionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RenditionUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *RenditionUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := rendition.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RenditionUpdateOne) check() error {
+	if v, ok := ruo.mutation.Format(); ok {
+		if err := rendition.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Rendition.format": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := rendition.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Rendition.status": %w`, err)}
+		}
+	}
+	if ruo.mutation.MediaCleared() && len(ruo.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rendition.media"`)
+	}
+	return nil
+}
+
+func (ruo *RenditionUpdateOne) sqlSave(ctx context.Context) (_node *Rendition, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rendition.Table, rendition.Columns, sqlgraph.NewFieldSpec(rendition.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Rendition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rendition.FieldID)
+		for _, f := range fields {
+			if !rendition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rendition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Format(); ok {
+		_spec.SetField(rendition.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(rendition.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Progress(); ok {
+		_spec.SetField(rendition.FieldProgress, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedProgress(); ok {
+		_spec.AddField(rendition.FieldProgress, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.Size(); ok {
+		_spec.SetField(rendition.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedSize(); ok {
+		_spec.AddField(rendition.FieldSize, field.TypeInt64, value)
+	}
+	if ruo.mutation.SizeCleared() {
+		_spec.ClearField(rendition.FieldSize, field.TypeInt64)
+	}
+	if value, ok := ruo.mutation.Error(); ok {
+		_spec.SetField(rendition.FieldError, field.TypeString, value)
+	}
+	if ruo.mutation.ErrorCleared() {
+		_spec.ClearField(rendition.FieldError, field.TypeString)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rendition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rendition.MediaTable,
+			Columns: []string{rendition.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rendition.MediaTable,
+			Columns: []string{rendition.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Rendition{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rendition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -3,10 +3,24 @@
 package ent
 
 import (
+	"era/booru/ent/auditlog"
+	"era/booru/ent/autotag"
+	"era/booru/ent/comment"
+	"era/booru/ent/favorite"
 	"era/booru/ent/hiddentagfilter"
 	"era/booru/ent/media"
+	"era/booru/ent/mediarevision"
+	"era/booru/ent/mediavote"
+	"era/booru/ent/metafield"
+	"era/booru/ent/note"
+	"era/booru/ent/pool"
+	"era/booru/ent/rendition"
 	"era/booru/ent/schema"
 	"era/booru/ent/setting"
+	"era/booru/ent/source"
+	"era/booru/ent/tagsuggestion"
+	"era/booru/ent/tagvector"
+	"era/booru/ent/vector"
 	"time"
 )
 
@@ -14,6 +28,80 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	autotagFields := schema.AutoTag{}.Fields()
+	_ = autotagFields
+	// autotagDescPrompt is the schema descriptor for prompt field.
+	autotagDescPrompt := autotagFields[1].Descriptor()
+	// autotag.PromptValidator is a validator for the "prompt" field. It is called by the builders before save.
+	autotag.PromptValidator = autotagDescPrompt.Validators[0].(func(string) error)
+	// autotagDescThreshold is the schema descriptor for threshold field.
+	autotagDescThreshold := autotagFields[2].Descriptor()
+	// autotag.ThresholdValidator is a validator for the "threshold" field. It is called by the builders before save.
+	autotag.ThresholdValidator = func() func(float64) error {
+		validators := autotagDescThreshold.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(threshold float64) error {
+			for _, fn := range fns {
+				if err := fn(threshold); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// autotagDescModel is the schema descriptor for model field.
+	autotagDescModel := autotagFields[4].Descriptor()
+	// autotag.DefaultModel holds the default value on creation for the model field.
+	autotag.DefaultModel = autotagDescModel.Default.(string)
+	// autotagDescCreatedAt is the schema descriptor for created_at field.
+	autotagDescCreatedAt := autotagFields[5].Descriptor()
+	// autotag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	autotag.DefaultCreatedAt = autotagDescCreatedAt.Default.(func() time.Time)
+	// autotagDescUpdatedAt is the schema descriptor for updated_at field.
+	autotagDescUpdatedAt := autotagFields[6].Descriptor()
+	// autotag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	autotag.DefaultUpdatedAt = autotagDescUpdatedAt.Default.(func() time.Time)
+	// autotag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	autotag.UpdateDefaultUpdatedAt = autotagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescAuthor is the schema descriptor for author field.
+	commentDescAuthor := commentFields[1].Descriptor()
+	// comment.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	comment.AuthorValidator = commentDescAuthor.Validators[0].(func(string) error)
+	// commentDescBody is the schema descriptor for body field.
+	commentDescBody := commentFields[2].Descriptor()
+	// comment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	comment.BodyValidator = commentDescBody.Validators[0].(func(string) error)
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[3].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[4].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	favoriteFields := schema.Favorite{}.Fields()
+	_ = favoriteFields
+	// favoriteDescActor is the schema descriptor for actor field.
+	favoriteDescActor := favoriteFields[0].Descriptor()
+	// favorite.ActorValidator is a validator for the "actor" field. It is called by the builders before save.
+	favorite.ActorValidator = favoriteDescActor.Validators[0].(func(string) error)
+	// favoriteDescCreatedAt is the schema descriptor for created_at field.
+	favoriteDescCreatedAt := favoriteFields[2].Descriptor()
+	// favorite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	favorite.DefaultCreatedAt = favoriteDescCreatedAt.Default.(func() time.Time)
 	hiddentagfilterFields := schema.HiddenTagFilter{}.Fields()
 	_ = hiddentagfilterFields
 	// hiddentagfilterDescValue is the schema descriptor for value field.
@@ -32,10 +120,190 @@ func init() {
 	hiddentagfilter.UpdateDefaultUpdatedAt = hiddentagfilterDescUpdatedAt.UpdateDefault.(func() time.Time)
 	mediaFields := schema.Media{}.Fields()
 	_ = mediaFields
+	// mediaDescVersion is the schema descriptor for version field.
+	mediaDescVersion := mediaFields[12].Descriptor()
+	// media.DefaultVersion holds the default value on creation for the version field.
+	media.DefaultVersion = mediaDescVersion.Default.(int)
+	// mediaDescScore is the schema descriptor for score field.
+	mediaDescScore := mediaFields[14].Descriptor()
+	// media.DefaultScore holds the default value on creation for the score field.
+	media.DefaultScore = mediaDescScore.Default.(int)
+	// mediaDescFavCount is the schema descriptor for fav_count field.
+	mediaDescFavCount := mediaFields[15].Descriptor()
+	// media.DefaultFavCount holds the default value on creation for the fav_count field.
+	media.DefaultFavCount = mediaDescFavCount.Default.(int)
+	// mediaDescTitle is the schema descriptor for title field.
+	mediaDescTitle := mediaFields[16].Descriptor()
+	// media.DefaultTitle holds the default value on creation for the title field.
+	media.DefaultTitle = mediaDescTitle.Default.(string)
+	// mediaDescDescription is the schema descriptor for description field.
+	mediaDescDescription := mediaFields[17].Descriptor()
+	// media.DefaultDescription holds the default value on creation for the description field.
+	media.DefaultDescription = mediaDescDescription.Default.(string)
 	// mediaDescID is the schema descriptor for id field.
 	mediaDescID := mediaFields[0].Descriptor()
 	// media.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	media.IDValidator = mediaDescID.Validators[0].(func(string) error)
+	mediarevisionFields := schema.MediaRevision{}.Fields()
+	_ = mediarevisionFields
+	// mediarevisionDescCreatedAt is the schema descriptor for created_at field.
+	mediarevisionDescCreatedAt := mediarevisionFields[8].Descriptor()
+	// mediarevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mediarevision.DefaultCreatedAt = mediarevisionDescCreatedAt.Default.(func() time.Time)
+	mediavoteFields := schema.MediaVote{}.Fields()
+	_ = mediavoteFields
+	// mediavoteDescActor is the schema descriptor for actor field.
+	mediavoteDescActor := mediavoteFields[0].Descriptor()
+	// mediavote.ActorValidator is a validator for the "actor" field. It is called by the builders before save.
+	mediavote.ActorValidator = mediavoteDescActor.Validators[0].(func(string) error)
+	// mediavoteDescValue is the schema descriptor for value field.
+	mediavoteDescValue := mediavoteFields[2].Descriptor()
+	// mediavote.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	mediavote.ValueValidator = mediavoteDescValue.Validators[0].(func(int) error)
+	// mediavoteDescCreatedAt is the schema descriptor for created_at field.
+	mediavoteDescCreatedAt := mediavoteFields[3].Descriptor()
+	// mediavote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mediavote.DefaultCreatedAt = mediavoteDescCreatedAt.Default.(func() time.Time)
+	// mediavote.UpdateDefaultCreatedAt holds the default value on update for the created_at field.
+	mediavote.UpdateDefaultCreatedAt = mediavoteDescCreatedAt.UpdateDefault.(func() time.Time)
+	metafieldFields := schema.MetaField{}.Fields()
+	_ = metafieldFields
+	// metafieldDescName is the schema descriptor for name field.
+	metafieldDescName := metafieldFields[0].Descriptor()
+	// metafield.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	metafield.NameValidator = metafieldDescName.Validators[0].(func(string) error)
+	// metafieldDescDescription is the schema descriptor for description field.
+	metafieldDescDescription := metafieldFields[3].Descriptor()
+	// metafield.DefaultDescription holds the default value on creation for the description field.
+	metafield.DefaultDescription = metafieldDescDescription.Default.(string)
+	// metafieldDescCreatedAt is the schema descriptor for created_at field.
+	metafieldDescCreatedAt := metafieldFields[4].Descriptor()
+	// metafield.DefaultCreatedAt holds the default value on creation for the created_at field.
+	metafield.DefaultCreatedAt = metafieldDescCreatedAt.Default.(func() time.Time)
+	noteFields := schema.Note{}.Fields()
+	_ = noteFields
+	// noteDescX is the schema descriptor for x field.
+	noteDescX := noteFields[2].Descriptor()
+	// note.XValidator is a validator for the "x" field. It is called by the builders before save.
+	note.XValidator = func() func(float64) error {
+		validators := noteDescX.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(x float64) error {
+			for _, fn := range fns {
+				if err := fn(x); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// noteDescY is the schema descriptor for y field.
+	noteDescY := noteFields[3].Descriptor()
+	// note.YValidator is a validator for the "y" field. It is called by the builders before save.
+	note.YValidator = func() func(float64) error {
+		validators := noteDescY.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(y float64) error {
+			for _, fn := range fns {
+				if err := fn(y); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// noteDescWidth is the schema descriptor for width field.
+	noteDescWidth := noteFields[4].Descriptor()
+	// note.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	note.WidthValidator = func() func(float64) error {
+		validators := noteDescWidth.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(width float64) error {
+			for _, fn := range fns {
+				if err := fn(width); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// noteDescHeight is the schema descriptor for height field.
+	noteDescHeight := noteFields[5].Descriptor()
+	// note.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	note.HeightValidator = func() func(float64) error {
+		validators := noteDescHeight.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(height float64) error {
+			for _, fn := range fns {
+				if err := fn(height); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// noteDescText is the schema descriptor for text field.
+	noteDescText := noteFields[6].Descriptor()
+	// note.DefaultText holds the default value on creation for the text field.
+	note.DefaultText = noteDescText.Default.(string)
+	// noteDescAuthor is the schema descriptor for author field.
+	noteDescAuthor := noteFields[8].Descriptor()
+	// note.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	note.AuthorValidator = noteDescAuthor.Validators[0].(func(string) error)
+	// noteDescCreatedAt is the schema descriptor for created_at field.
+	noteDescCreatedAt := noteFields[11].Descriptor()
+	// note.DefaultCreatedAt holds the default value on creation for the created_at field.
+	note.DefaultCreatedAt = noteDescCreatedAt.Default.(func() time.Time)
+	// noteDescUpdatedAt is the schema descriptor for updated_at field.
+	noteDescUpdatedAt := noteFields[12].Descriptor()
+	// note.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	note.DefaultUpdatedAt = noteDescUpdatedAt.Default.(func() time.Time)
+	// note.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	note.UpdateDefaultUpdatedAt = noteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	poolFields := schema.Pool{}.Fields()
+	_ = poolFields
+	// poolDescName is the schema descriptor for name field.
+	poolDescName := poolFields[0].Descriptor()
+	// pool.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	pool.NameValidator = poolDescName.Validators[0].(func(string) error)
+	// poolDescDescription is the schema descriptor for description field.
+	poolDescDescription := poolFields[1].Descriptor()
+	// pool.DefaultDescription holds the default value on creation for the description field.
+	pool.DefaultDescription = poolDescDescription.Default.(string)
+	// poolDescCreatedAt is the schema descriptor for created_at field.
+	poolDescCreatedAt := poolFields[3].Descriptor()
+	// pool.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pool.DefaultCreatedAt = poolDescCreatedAt.Default.(func() time.Time)
+	// poolDescUpdatedAt is the schema descriptor for updated_at field.
+	poolDescUpdatedAt := poolFields[4].Descriptor()
+	// pool.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pool.DefaultUpdatedAt = poolDescUpdatedAt.Default.(func() time.Time)
+	// pool.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pool.UpdateDefaultUpdatedAt = poolDescUpdatedAt.UpdateDefault.(func() time.Time)
+	renditionFields := schema.Rendition{}.Fields()
+	_ = renditionFields
+	// renditionDescProgress is the schema descriptor for progress field.
+	renditionDescProgress := renditionFields[3].Descriptor()
+	// rendition.DefaultProgress holds the default value on creation for the progress field.
+	rendition.DefaultProgress = renditionDescProgress.Default.(float64)
+	// renditionDescUpdatedAt is the schema descriptor for updated_at field.
+	renditionDescUpdatedAt := renditionFields[6].Descriptor()
+	// rendition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rendition.DefaultUpdatedAt = renditionDescUpdatedAt.Default.(func() time.Time)
+	// rendition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rendition.UpdateDefaultUpdatedAt = renditionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	settingFields := schema.Setting{}.Fields()
 	_ = settingFields
 	// settingDescKey is the schema descriptor for key field.
@@ -54,4 +322,56 @@ func init() {
 	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
 	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	setting.UpdateDefaultUpdatedAt = settingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sourceFields := schema.Source{}.Fields()
+	_ = sourceFields
+	// sourceDescURL is the schema descriptor for url field.
+	sourceDescURL := sourceFields[1].Descriptor()
+	// source.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	source.URLValidator = func() func(string) error {
+		validators := sourceDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// sourceDescDomain is the schema descriptor for domain field.
+	sourceDescDomain := sourceFields[2].Descriptor()
+	// source.DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	source.DomainValidator = sourceDescDomain.Validators[0].(func(string) error)
+	// sourceDescCreatedAt is the schema descriptor for created_at field.
+	sourceDescCreatedAt := sourceFields[3].Descriptor()
+	// source.DefaultCreatedAt holds the default value on creation for the created_at field.
+	source.DefaultCreatedAt = sourceDescCreatedAt.Default.(func() time.Time)
+	tagsuggestionFields := schema.TagSuggestion{}.Fields()
+	_ = tagsuggestionFields
+	// tagsuggestionDescCreatedAt is the schema descriptor for created_at field.
+	tagsuggestionDescCreatedAt := tagsuggestionFields[7].Descriptor()
+	// tagsuggestion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tagsuggestion.DefaultCreatedAt = tagsuggestionDescCreatedAt.Default.(func() time.Time)
+	tagvectorFields := schema.TagVector{}.Fields()
+	_ = tagvectorFields
+	// tagvectorDescUpdatedAt is the schema descriptor for updated_at field.
+	tagvectorDescUpdatedAt := tagvectorFields[4].Descriptor()
+	// tagvector.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tagvector.DefaultUpdatedAt = tagvectorDescUpdatedAt.Default.(func() time.Time)
+	// tagvector.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tagvector.UpdateDefaultUpdatedAt = tagvectorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	vectorFields := schema.Vector{}.Fields()
+	_ = vectorFields
+	// vectorDescModel is the schema descriptor for model field.
+	vectorDescModel := vectorFields[2].Descriptor()
+	// vector.DefaultModel holds the default value on creation for the model field.
+	vector.DefaultModel = vectorDescModel.Default.(string)
+	// vectorDescDimension is the schema descriptor for dimension field.
+	vectorDescDimension := vectorFields[3].Descriptor()
+	// vector.DefaultDimension holds the default value on creation for the dimension field.
+	vector.DefaultDimension = vectorDescDimension.Default.(int)
 }
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog records one administrative request.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.String("actor").
+			Immutable().
+			Comment("User reported by the reverse proxy, or the client address"),
+		field.String("action").
+			Immutable().
+			Comment("Name of the operation, e.g. regenerate or import_tags"),
+		field.String("method").
+			Immutable(),
+		field.String("path").
+			Immutable(),
+		field.JSON("params", map[string]any{}).
+			Optional().
+			Immutable().
+			Comment("Path and query parameters and the JSON request body"),
+		field.Int("status").
+			Immutable().
+			Comment("HTTP status of the response"),
+		field.Bool("success").
+			Immutable(),
+		field.JSON("result", json.RawMessage{}).
+			Optional().
+			Immutable().
+			Comment("JSON response body, truncated responses are omitted"),
+		field.Int64("duration_ms").
+			Immutable(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("action", "created_at"),
+		index.Fields("actor", "created_at"),
+	}
+}
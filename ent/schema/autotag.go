@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// AutoTag is a candidate tag of the zero-shot auto-tagger. Its prompt is
+// embedded with the text model and compared with the vision embedding of
+// every image; matches above the threshold become tag suggestions.
+type AutoTag struct {
+	ent.Schema
+}
+
+// Fields of the AutoTag.
+func (AutoTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tag_id").
+			Unique().
+			Immutable(),
+		field.Text("prompt").
+			NotEmpty().
+			Comment("Text embedded for the tag, e.g. \"a photo of a cat\""),
+		field.Float("threshold").
+			Min(-1).
+			Max(1).
+			Comment("Minimum cosine similarity for a suggestion"),
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{dialect.Postgres: "vector"}).
+			Optional().
+			Nillable().
+			Comment("Text embedding of the prompt; cleared when the prompt changes"),
+		field.String("model").
+			Default("").
+			Comment("Model that produced the embedding"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the AutoTag.
+func (AutoTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tag", Tag.Type).
+			Field("tag_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Favorite marks a media item as a favorite of one user.
+type Favorite struct {
+	ent.Schema
+}
+
+// Fields of the Favorite.
+func (Favorite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("actor").
+			NotEmpty().
+			Immutable().
+			Comment("User who favorited the media, as reported by the request"),
+		field.String("media_id").
+			Immutable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Favorite.
+func (Favorite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Favorite.
+func (Favorite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("actor", "media_id").Unique(),
+		index.Fields("media_id"),
+	}
+}
@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Media holds the schema definition for the Media entity.
@@ -28,10 +30,76 @@ func (Media) Fields() []ent.Field {
 		field.Int16("height").
 			Immutable().
 			Comment("Image height in pixels"),
-		field.Int16("duration").
+		field.Int32("duration").
 			Optional().
 			Nillable().
-			Comment("Duration in seconds for video or audio"),
+			Comment("Duration in seconds for video, audio or animated images"),
+		field.Int32("frames").
+			Optional().
+			Nillable().
+			Comment("Number of frames for animated images"),
+		field.Int("bitrate").
+			Optional().
+			Nillable().
+			Comment("Bitrate in bits per second for video or audio"),
+		field.String("video_codec").
+			Optional().
+			Nillable().
+			Comment("Codec of the primary video stream, e.g. h264"),
+		field.String("audio_codec").
+			Optional().
+			Nillable().
+			Comment("Codec of the primary audio stream, e.g. aac"),
+		field.Float("fps").
+			Optional().
+			Nillable().
+			Comment("Average frame rate of the primary video stream"),
+		field.Bool("has_audio").
+			Optional().
+			Nillable().
+			Comment("Whether a video or audio file contains an audio track"),
+		field.Int16("rotation").
+			Optional().
+			Nillable().
+			Comment("Display rotation of the video in degrees clockwise"),
+		field.Int("version").
+			Default(0).
+			Comment("Incremented on every tag or date edit; used for optimistic concurrency"),
+		field.Enum("rating").
+			Values("safe", "questionable", "explicit").
+			Optional().
+			Nillable().
+			Comment("Content rating; nil until someone rates the media"),
+		field.Int("score").
+			Default(0).
+			Comment("Sum of the up and down votes in MediaVote"),
+		field.Int("fav_count").
+			Default(0).
+			Comment("Number of users who favorited the media"),
+		field.String("title").
+			Optional().
+			Default("").
+			// The API limits titles to 300 characters; MaxLen would count
+			// bytes instead.
+			SchemaType(map[string]string{dialect.Postgres: "varchar(300)"}).
+			Comment("Optional human-readable title"),
+		field.Text("description").
+			Optional().
+			Default("").
+			Comment("Optional free-form description, searched as prose"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("When the media was moved to the trash; nil while it is live"),
+	}
+}
+
+// Indexes of the Media.
+func (Media) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+		index.Fields("score"),
+		index.Fields("fav_count"),
 	}
 }
 
@@ -46,5 +114,24 @@ func (Media) Edges() []ent.Edge {
 		edge.To("vectors", Vector.Type).
 			Through("media_vectors", MediaVector.Type).
 			Comment("Vector entries associated with the media item"),
+		edge.To("meta_fields", MetaField.Type).
+			Through("media_fields", MediaField.Type).
+			Comment("Custom metadata fields set on the media item"),
+		edge.From("pools", Pool.Type).
+			Ref("media").
+			Through("pool_media", PoolMedia.Type).
+			Comment("Pools the media item belongs to"),
+		edge.From("favorites", Favorite.Type).
+			Ref("media").
+			Comment("Users who favorited the media item"),
+		edge.From("comments", Comment.Type).
+			Ref("media").
+			Comment("Discussion thread of the media item"),
+		edge.From("notes", Note.Type).
+			Ref("media").
+			Comment("Region annotations on the media item"),
+		edge.From("sources", Source.Type).
+			Ref("media").
+			Comment("URLs the media item was obtained from"),
 	}
 }
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MediaField is the join table between Media and MetaField with a value field.
+type MediaField struct {
+	ent.Schema
+}
+
+func (MediaField) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("media_id"),
+		field.Int("meta_field_id"),
+		field.JSON("value", json.RawMessage{}).
+			Comment("JSON value of the field's type"),
+	}
+}
+
+func (MediaField) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("meta_field", MetaField.Type).
+			Field("meta_field_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (MediaField) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("media", "meta_field").
+			Unique(),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MediaRevision records the tags and dates of a media item before and after
+// one edit.
+type MediaRevision struct {
+	ent.Schema
+}
+
+// Fields of the MediaRevision.
+func (MediaRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("media_id").
+			Immutable(),
+		field.String("action").
+			Immutable().
+			Comment("Kind of edit, e.g. set_tags, edit_tags, set_dates, import, bulk_edit or revert"),
+		field.String("actor").
+			Immutable().
+			Comment("Who made the edit, as reported by the request or job"),
+		field.Int("version").
+			Immutable().
+			Comment("Media version after the edit"),
+		field.Strings("tags_before").
+			Immutable(),
+		field.Strings("tags_after").
+			Immutable(),
+		field.JSON("dates_before", map[string]string{}).
+			Immutable().
+			Comment("Date name to YYYY-MM-DD value"),
+		field.JSON("dates_after", map[string]string{}).
+			Immutable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the MediaRevision.
+func (MediaRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (MediaRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("media_id", "created_at"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MediaVote is one user's up or down vote on a media item. The votes of a
+// media item add up to its score.
+type MediaVote struct {
+	ent.Schema
+}
+
+// Fields of the MediaVote.
+func (MediaVote) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("actor").
+			NotEmpty().
+			Immutable().
+			Comment("User who voted, as reported by the request"),
+		field.String("media_id").
+			Immutable(),
+		field.Int("value").
+			Range(-1, 1).
+			Comment("+1 for an up vote, -1 for a down vote"),
+		field.Time("created_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the MediaVote.
+func (MediaVote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the MediaVote.
+func (MediaVote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("actor", "media_id").Unique(),
+		index.Fields("media_id"),
+	}
+}
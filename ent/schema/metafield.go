@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// MetaField defines a typed metadata field that admins add to media, in the
+// manner of Date and Vector.
+type MetaField struct {
+	ent.Schema
+}
+
+// Fields of the MetaField.
+func (MetaField) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Field name, also used as the search field"),
+		field.Enum("type").
+			Values("int", "float", "string", "enum", "bool").
+			Immutable().
+			Comment("Type that values must have"),
+		field.Strings("values").
+			Optional().
+			Comment("Allowed values of an enum field"),
+		field.String("description").
+			Optional().
+			Default(""),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the MetaField.
+func (MetaField) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("media", Media.Type).
+			Ref("meta_fields").
+			Through("media_fields", MediaField.Type).
+			Comment("Media items with a value for this field"),
+	}
+}
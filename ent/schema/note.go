@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// Note annotates a rectangular region of an image, such as a translation, a
+// box around a character or a crop reference. Coordinates are fractions of
+// the image width and height, so they survive rescaled renditions.
+type Note struct {
+	ent.Schema
+}
+
+// Fields of the Note.
+func (Note) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("media_id").
+			Immutable(),
+		field.Enum("kind").
+			Values("note", "box", "crop").
+			Default("note"),
+		field.Float("x").
+			Min(0).
+			Max(1).
+			Comment("Left edge as a fraction of the image width"),
+		field.Float("y").
+			Min(0).
+			Max(1).
+			Comment("Top edge as a fraction of the image height"),
+		field.Float("width").
+			Min(0).
+			Max(1),
+		field.Float("height").
+			Min(0).
+			Max(1),
+		field.Text("text").
+			Default(""),
+		field.Int("tag_id").
+			Optional().
+			Nillable().
+			Comment("Tag the region shows, e.g. the character in a box"),
+		field.String("author").
+			NotEmpty().
+			Immutable().
+			Comment("User who created the note, as reported by the request"),
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{dialect.Postgres: "vector"}).
+			Optional().
+			Nillable().
+			Comment("Vision embedding of the region; cleared when the region moves"),
+		field.String("embedding_model").
+			Optional().
+			Nillable().
+			Comment("Model that computed the embedding; regions are only compared within a model"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Note.
+func (Note) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tag", Tag.Type).
+			Field("tag_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Indexes of the Note.
+func (Note) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("media_id"),
+	}
+}
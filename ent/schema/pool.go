@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Pool is an ordered collection of media such as comic pages or a photo
+// series.
+type Pool struct {
+	ent.Schema
+}
+
+// Fields of the Pool.
+func (Pool) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("description").
+			Default(""),
+		field.String("cover_id").
+			Optional().
+			Nillable().
+			Comment("Media shown as the pool's thumbnail; the first member if unset"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Pool.
+func (Pool) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Through("pool_media", PoolMedia.Type).
+			Comment("Members of the pool, ordered by PoolMedia.position"),
+		edge.To("cover", Media.Type).
+			Field("cover_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
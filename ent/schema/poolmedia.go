@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PoolMedia is the ordered join table between Pool and Media.
+type PoolMedia struct {
+	ent.Schema
+}
+
+func (PoolMedia) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("pool_id"),
+		field.String("media_id"),
+		field.Int("position").
+			Comment("Zero-based position of the media in the pool"),
+	}
+}
+
+func (PoolMedia) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pool", Pool.Type).
+			Field("pool_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (PoolMedia) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("pool", "media").
+			Unique(),
+		index.Fields("pool_id", "position"),
+	}
+}
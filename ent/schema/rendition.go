@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Rendition holds a browser-playable transcode of a media item.
+type Rendition struct {
+	ent.Schema
+}
+
+// Fields of the Rendition.
+func (Rendition) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("media_id"),
+		field.Enum("format").
+			Values("mp4", "webm").
+			Comment("Container of the rendition: H.264/AAC MP4 or VP9/Opus WebM"),
+		field.Enum("status").
+			Values("pending", "processing", "ready", "failed").
+			Default("pending").
+			Comment("Transcoding state of the rendition"),
+		field.Float("progress").
+			Default(0).
+			Comment("Transcoding progress between 0 and 1"),
+		field.Int64("size").
+			Optional().
+			Nillable().
+			Comment("Size of the rendition object in bytes"),
+		field.String("error").
+			Optional().
+			Comment("Last transcoding error, if any"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Rendition.
+func (Rendition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Rendition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("media_id", "format").
+			Unique(),
+	}
+}
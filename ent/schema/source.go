@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Source records a URL a media item was obtained from.
+type Source struct {
+	ent.Schema
+}
+
+// Fields of the Source.
+func (Source) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("media_id").
+			Immutable(),
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Immutable().
+			Comment("Normalised http(s) URL"),
+		field.String("domain").
+			NotEmpty().
+			Immutable().
+			Comment("Lower-case host of the URL without a leading www."),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Source.
+func (Source) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Source.
+func (Source) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("media_id", "url").Unique(),
+		index.Fields("url"),
+		index.Fields("domain"),
+	}
+}
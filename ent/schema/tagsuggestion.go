@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TagSuggestion is a tag proposed for a media item by a machine. It is kept
+// apart from the confirmed tags until a user accepts or rejects it; decided
+// suggestions stay so the same tag is not proposed again.
+type TagSuggestion struct {
+	ent.Schema
+}
+
+// Fields of the TagSuggestion.
+func (TagSuggestion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("media_id").
+			Immutable(),
+		field.Int("tag_id").
+			Immutable(),
+		field.Float("score").
+			Comment("Confidence of the suggester; higher is better"),
+		field.Enum("source").
+			Values("zero_shot").
+			Comment("What proposed the tag"),
+		field.Enum("status").
+			Values("pending", "accepted", "rejected").
+			Default("pending"),
+		field.String("decided_by").
+			Optional().
+			Nillable(),
+		field.Time("decided_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the TagSuggestion.
+func (TagSuggestion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("media", Media.Type).
+			Field("media_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tag", Tag.Type).
+			Field("tag_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the TagSuggestion.
+func (TagSuggestion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("media_id", "tag_id").Unique(),
+		index.Fields("status", "score"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// TagVector is the centroid of the named vectors of the media with a tag,
+// recomputed periodically.
+type TagVector struct {
+	ent.Schema
+}
+
+func (TagVector) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tag_id"),
+		field.Int("vector_id"),
+		field.Other("value", pgvector.Vector{}).
+			SchemaType(map[string]string{dialect.Postgres: "vector"}).
+			Comment("L2-normalised mean of the media vectors"),
+		field.Int("media_count").
+			Comment("Number of media the centroid was computed from"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (TagVector) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tag", Tag.Type).
+			Field("tag_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("vector", Vector.Type).
+			Field("vector_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (TagVector) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("tag", "vector").
+			Unique(),
+	}
+}
@@ -21,6 +21,14 @@ func (Vector) Fields() []ent.Field {
 			Unique().
 			Immutable().
 			Comment("Name of the vector entry"),
+		field.String("model").
+			Default("").
+			Immutable().
+			Comment("Identifier of the model that produces the vectors; empty if unknown"),
+		field.Int("dimension").
+			Default(0).
+			Immutable().
+			Comment("Number of dimensions of the vectors; 0 if unknown"),
 	}
 }
 
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/source"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SourceCreate is the builder for creating a Source entity.
+type SourceCreate struct {
+	config
+	mutation *SourceMutation
+	hooks    []Hook
+}
+
+// SetMediaID sets the "media_id" field.
+func (sc *SourceCreate) SetMediaID(s string) *SourceCreate {
+	sc.mutation.SetMediaID(s)
+	return sc
+}
+
+// SetURL sets the "url" field.
+func (sc *SourceCreate) SetURL(s string) *SourceCreate {
+	sc.mutation.SetURL(s)
+	return sc
+}
+
+// SetDomain sets the "domain" field.
+func (sc *SourceCreate) SetDomain(s string) *SourceCreate {
+	sc.mutation.SetDomain(s)
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SourceCreate) SetCreatedAt(t time.Time) *SourceCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableCreatedAt(t *time.Time) *SourceCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (sc *SourceCreate) SetMedia(m *Media) *SourceCreate {
+	return sc.SetMediaID(m.ID)
+}
+
+// Mutation returns the SourceMutation object of the builder.
+func (sc *SourceCreate) Mutation() *SourceMutation {
+	return sc.mutation
+}
+
+// Save creates the Source in the database.
+func (sc *SourceCreate) Save(ctx context.Context) (*Source, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SourceCreate) SaveX(ctx context.Context) *Source {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SourceCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SourceCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SourceCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := source.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SourceCreate) check() error {
+	if _, ok := sc.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "Source.media_id"`)}
+	}
+	if _, ok := sc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Source.url"`)}
+	}
+	if v, ok := sc.mutation.URL(); ok {
+		if err := source.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Source.url": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Domain(); !ok {
+		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required field "Source.domain"`)}
+	}
+	if v, ok := sc.mutation.Domain(); ok {
+		if err := source.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "Source.domain": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Source.created_at"`)}
+	}
+	if len(sc.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "Source.media"`)}
+	}
+	return nil
+}
+
+func (sc *SourceCreate) sqlSave(ctx context.Context) (*Source, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SourceCreate) createSpec() (*Source, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Source{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(source.Table, sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.URL(); ok {
+		_spec.SetField(source.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := sc.mutation.Domain(); ok {
+		_spec.SetField(source.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(source.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   source.MediaTable,
+			Columns: []string{source.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SourceCreateBulk is the builder for creating many Source entities in bulk.
+type SourceCreateBulk struct {
+	config
+	err      error
+	builders []*SourceCreate
+}
+
+// Save creates the Source entities in the database.
+func (scb *SourceCreateBulk) Save(ctx context.Context) ([]*Source, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Source, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SourceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SourceCreateBulk) SaveX(ctx context.Context) []*Source {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SourceCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SourceCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
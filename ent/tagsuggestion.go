@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/media"
+	"era/booru/ent/tag"
+	"era/booru/ent/tagsuggestion"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TagSuggestion is the model entity for the TagSuggestion schema.
+type TagSuggestion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID string `json:"media_id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID int `json:"tag_id,omitempty"`
+	// Confidence of the suggester; higher is better
+	Score float64 `json:"score,omitempty"`
+	// What proposed the tag
+	Source tagsuggestion.Source `json:"source,omitempty"`
+	// Status holds the value of the "status" field.
+	Status tagsuggestion.Status `json:"status,omitempty"`
+	// DecidedBy holds the value of the "decided_by" field.
+	DecidedBy *string `json:"decided_by,omitempty"`
+	// DecidedAt holds the value of the "decided_at" field.
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TagSuggestionQuery when eager-loading is set.
+	Edges        TagSuggestionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TagSuggestionEdges holds the relations/edges for other nodes in the graph.
+type TagSuggestionEdges struct {
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagSuggestionEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagSuggestionEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TagSuggestion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tagsuggestion.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case tagsuggestion.FieldID, tagsuggestion.FieldTagID:
+			values[i] = new(sql.NullInt64)
+		case tagsuggestion.FieldMediaID, tagsuggestion.FieldSource, tagsuggestion.FieldStatus, tagsuggestion.FieldDecidedBy:
+			values[i] = new(sql.NullString)
+		case tagsuggestion.FieldDecidedAt, tagsuggestion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TagSuggestion fields.
+func (ts *TagSuggestion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tagsuggestion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ts.ID = int(value.Int64)
+		case tagsuggestion.FieldMediaID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value.Valid {
+				ts.MediaID = value.String
+			}
+		case tagsuggestion.FieldTagID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				ts.TagID = int(value.Int64)
+			}
+		case tagsuggestion.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				ts.Score = value.Float64
+			}
+		case tagsuggestion.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				ts.Source = tagsuggestion.Source(value.String)
+			}
+		case tagsuggestion.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ts.Status = tagsuggestion.Status(value.String)
+			}
+		case tagsuggestion.FieldDecidedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_by", values[i])
+			} else if value.Valid {
+				ts.DecidedBy = new(string)
+				*ts.DecidedBy = value.String
+			}
+		case tagsuggestion.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				ts.DecidedAt = new(time.Time)
+				*ts.DecidedAt = value.Time
+			}
+		case tagsuggestion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ts.CreatedAt = value.Time
+			}
+		default:
+			ts.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TagSuggestion.
+// This includes values selected through modifiers, order, etc.
+func (ts *TagSuggestion) Value(name string) (ent.Value, error) {
+	return ts.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the TagSuggestion entity.
+func (ts *TagSuggestion) QueryMedia() *MediaQuery {
+	return NewTagSuggestionClient(ts.config).QueryMedia(ts)
+}
+
+// QueryTag queries the "tag" edge of the TagSuggestion entity.
+func (ts *TagSuggestion) QueryTag() *TagQuery {
+	return NewTagSuggestionClient(ts.config).QueryTag(ts)
+}
+
+// Update returns a builder for updating this TagSuggestion.
+// Note that you need to call TagSuggestion.Unwrap() before calling this method if this TagSuggestion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ts *TagSuggestion) Update() *TagSuggestionUpdateOne {
+	return NewTagSuggestionClient(ts.config).UpdateOne(ts)
+}
+
+// Unwrap unwraps the TagSuggestion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ts *TagSuggestion) Unwrap() *TagSuggestion {
+	_tx, ok := ts.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TagSuggestion is not a transactional entity")
+	}
+	ts.config.driver = _tx.drv
+	return ts
+}
+
+// String implements the fmt.Stringer.
+func (ts *TagSuggestion) String() string {
+	var builder strings.Builder
+	builder.WriteString("TagSuggestion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ts.ID))
+	builder.WriteString("media_id=")
+	builder.WriteString(ts.MediaID)
+	builder.WriteString(", ")
+	builder.WriteString("tag_id=")
+	builder.WriteString(fmt.Sprintf("%v", ts.TagID))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", ts.Score))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", ts.Source))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ts.Status))
+	builder.WriteString(", ")
+	if v := ts.DecidedBy; v != nil {
+		builder.WriteString("decided_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ts.DecidedAt; v != nil {
+		builder.WriteString("decided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ts.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TagSuggestions is a parsable slice of TagSuggestion.
+type TagSuggestions []*TagSuggestion
@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/media"
+	"era/booru/ent/tag"
+	"era/booru/ent/tagsuggestion"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagSuggestionCreate is the builder for creating a TagSuggestion entity.
+type TagSuggestionCreate struct {
+	config
+	mutation *TagSuggestionMutation
+	hooks    []Hook
+}
+
+// SetMediaID sets the "media_id" field.
+func (tsc *TagSuggestionCreate) SetMediaID(s string) *TagSuggestionCreate {
+	tsc.mutation.SetMediaID(s)
+	return tsc
+}
+
+// SetTagID sets the "tag_id" field.
+func (tsc *TagSuggestionCreate) SetTagID(i int) *TagSuggestionCreate {
+	tsc.mutation.SetTagID(i)
+	return tsc
+}
+
+// SetScore sets the "score" field.
+func (tsc *TagSuggestionCreate) SetScore(f float64) *TagSuggestionCreate {
+	tsc.mutation.SetScore(f)
+	return tsc
+}
+
+// SetSource sets the "source" field.
+func (tsc *TagSuggestionCreate) SetSource(t tagsuggestion.Source) *TagSuggestionCreate {
+	tsc.mutation.SetSource(t)
+	return tsc
+}
+
+// SetStatus sets the "status" field.
+func (tsc *TagSuggestionCreate) SetStatus(t tagsuggestion.Status) *TagSuggestionCreate {
+	tsc.mutation.SetStatus(t)
+	return tsc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tsc *TagSuggestionCreate) SetNillableStatus(t *tagsuggestion.Status) *TagSuggestionCreate {
+	if t != nil {
+		tsc.SetStatus(*t)
+	}
+	return tsc
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (tsc *TagSuggestionCreate) SetDecidedBy(s string) *TagSuggestionCreate {
+	tsc.mutation.SetDecidedBy(s)
+	return tsc
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (tsc *TagSuggestionCreate) SetNillableDecidedBy(s *string) *TagSuggestionCreate {
+	if s != nil {
+		tsc.SetDecidedBy(*s)
+	}
+	return tsc
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (tsc *TagSuggestionCreate) SetDecidedAt(t time.Time) *TagSuggestionCreate {
+	tsc.mutation.SetDecidedAt(t)
+	return tsc
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (tsc *TagSuggestionCreate) SetNillableDecidedAt(t *time.Time) *TagSuggestionCreate {
+	if t != nil {
+		tsc.SetDecidedAt(*t)
+	}
+	return tsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsc *TagSuggestionCreate) SetCreatedAt(t time.Time) *TagSuggestionCreate {
+	tsc.mutation.SetCreatedAt(t)
+	return tsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsc *TagSuggestionCreate) SetNillableCreatedAt(t *time.Time) *TagSuggestionCreate {
+	if t != nil {
+		tsc.SetCreatedAt(*t)
+	}
+	return tsc
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (tsc *TagSuggestionCreate) SetMedia(m *Media) *TagSuggestionCreate {
+	return tsc.SetMediaID(m.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tsc *TagSuggestionCreate) SetTag(t *Tag) *TagSuggestionCreate {
+	return tsc.SetTagID(t.ID)
+}
+
+// Mutation returns the TagSuggestionMutation object of the builder.
+func (tsc *TagSuggestionCreate) Mutation() *TagSuggestionMutation {
+	return tsc.mutation
+}
+
+// Save creates the TagSuggestion in the database.
+func (tsc *TagSuggestionCreate) Save(ctx context.Context) (*TagSuggestion, error) {
+	tsc.defaults()
+	return withHooks(ctx, tsc.sqlSave, tsc.mutation, tsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsc *TagSuggestionCreate) SaveX(ctx context.Context) *TagSuggestion {
+	v, err := tsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsc *TagSuggestionCreate) Exec(ctx context.Context) error {
+	_, err := tsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsc *TagSuggestionCreate) ExecX(ctx context.Context) {
+	if err := tsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsc *TagSuggestionCreate) defaults() {
+	if _, ok := tsc.mutation.Status(); !ok {
+		v := tagsuggestion.DefaultStatus
+		tsc.mutation.SetStatus(v)
+	}
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		v := tagsuggestion.DefaultCreatedAt()
+		tsc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsc *TagSuggestionCreate) check() error {
+	if _, ok := tsc.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "TagSuggestion.media_id"`)}
+	}
+	if _, ok := tsc.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "TagSuggestion.tag_id"`)}
+	}
+	if _, ok := tsc.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "TagSuggestion.score"`)}
+	}
+	if _, ok := tsc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "TagSuggestion.source"`)}
+	}
+	if v, ok := tsc.mutation.Source(); ok {
+		if err := tagsuggestion.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "TagSuggestion.source": %w`, err)}
+		}
+	}
+	if _, ok := tsc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TagSuggestion.status"`)}
+	}
+	if v, ok := tsc.mutation.Status(); ok {
+		if err := tagsuggestion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TagSuggestion.status": %w`, err)}
+		}
+	}
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TagSuggestion.created_at"`)}
+	}
+	if len(tsc.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "TagSuggestion.media"`)}
+	}
+	if len(tsc.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TagSuggestion.tag"`)}
+	}
+	return nil
+}
+
+func (tsc *TagSuggestionCreate) sqlSave(ctx context.Context) (*TagSuggestion, error) {
+	if err := tsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tsc.mutation.id = &_node.ID
+	tsc.mutation.done = true
+	return _node, nil
+}
+
+func (tsc *TagSuggestionCreate) createSpec() (*TagSuggestion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagSuggestion{config: tsc.config}
+		_spec = sqlgraph.NewCreateSpec(tagsuggestion.Table, sqlgraph.NewFieldSpec(tagsuggestion.FieldID, field.TypeInt))
+	)
+	if value, ok := tsc.mutation.Score(); ok {
+		_spec.SetField(tagsuggestion.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := tsc.mutation.Source(); ok {
+		_spec.SetField(tagsuggestion.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := tsc.mutation.Status(); ok {
+		_spec.SetField(tagsuggestion.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tsc.mutation.DecidedBy(); ok {
+		_spec.SetField(tagsuggestion.FieldDecidedBy, field.TypeString, value)
+		_node.DecidedBy = &value
+	}
+	if value, ok := tsc.mutation.DecidedAt(); ok {
+		_spec.SetField(tagsuggestion.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = &value
+	}
+	if value, ok := tsc.mutation.CreatedAt(); ok {
+		_spec.SetField(tagsuggestion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tsc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagsuggestion.MediaTable,
+			Columns: []string{tagsuggestion.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagsuggestion.TagTable,
+			Columns: []string{tagsuggestion.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TagSuggestionCreateBulk is the builder for creating many TagSuggestion entities in bulk.
+type TagSuggestionCreateBulk struct {
+	config
+	err      error
+	builders []*TagSuggestionCreate
+}
+
+// Save creates the TagSuggestion entities in the database.
+func (tscb *TagSuggestionCreateBulk) Save(ctx context.Context) ([]*TagSuggestion, error) {
+	if tscb.err != nil {
+		return nil, tscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tscb.builders))
+	nodes := make([]*TagSuggestion, len(tscb.builders))
+	mutators := make([]Mutator, len(tscb.builders))
+	for i := range tscb.builders {
+		func(i int, root context.Context) {
+			builder := tscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagSuggestionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscb *TagSuggestionCreateBulk) SaveX(ctx context.Context) []*TagSuggestion {
+	v, err := tscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tscb *TagSuggestionCreateBulk) Exec(ctx context.Context) error {
+	_, err := tscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscb *TagSuggestionCreateBulk) ExecX(ctx context.Context) {
+	if err := tscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
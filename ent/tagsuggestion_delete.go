@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/predicate"
+	"era/booru/ent/tagsuggestion"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagSuggestionDelete is the builder for deleting a TagSuggestion entity.
+type TagSuggestionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TagSuggestionMutation
+}
+
+// Where appends a list predicates to the TagSuggestionDelete builder.
+func (tsd *TagSuggestionDelete) Where(ps ...predicate.TagSuggestion) *TagSuggestionDelete {
+	tsd.mutation.Where(ps...)
+	return tsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsd *TagSuggestionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsd.sqlExec, tsd.mutation, tsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsd *TagSuggestionDelete) ExecX(ctx context.Context) int {
+	n, err := tsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsd *TagSuggestionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tagsuggestion.Table, sqlgraph.NewFieldSpec(tagsuggestion.FieldID, field.TypeInt))
+	if ps := tsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsd.mutation.done = true
+	return affected, err
+}
+
+// TagSuggestionDeleteOne is the builder for deleting a single TagSuggestion entity.
+type TagSuggestionDeleteOne struct {
+	tsd *TagSuggestionDelete
+}
+
+// Where appends a list predicates to the TagSuggestionDelete builder.
+func (tsdo *TagSuggestionDeleteOne) Where(ps ...predicate.TagSuggestion) *TagSuggestionDeleteOne {
+	tsdo.tsd.mutation.Where(ps...)
+	return tsdo
+}
+
+// Exec executes the deletion query.
+func (tsdo *TagSuggestionDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsdo.tsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tagsuggestion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsdo *TagSuggestionDeleteOne) ExecX(ctx context.Context) {
+	if err := tsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
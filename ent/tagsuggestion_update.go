@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/predicate"
+	"era/booru/ent/tagsuggestion"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagSuggestionUpdate is the builder for updating TagSuggestion entities.
+type TagSuggestionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TagSuggestionMutation
+}
+
+// Where appends a list predicates to the TagSuggestionUpdate builder.
+func (tsu *TagSuggestionUpdate) Where(ps ...predicate.TagSuggestion) *TagSuggestionUpdate {
+	tsu.mutation.Where(ps...)
+	return tsu
+}
+
+// SetScore sets the "score" field.
+func (tsu *TagSuggestionUpdate) SetScore(f float64) *TagSuggestionUpdate {
+	tsu.mutation.ResetScore()
+	tsu.mutation.SetScore(f)
+	return tsu
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (tsu *TagSuggestionUpdate) SetNillableScore(f *float64) *TagSuggestionUpdate {
+	if f != nil {
+		tsu.SetScore(*f)
+	}
+	return tsu
+}
+
+// AddScore adds f to the "score" field.
+func (tsu *TagSuggestionUpdate) AddScore(f float64) *TagSuggestionUpdate {
+	tsu.mutation.AddScore(f)
+	return tsu
+}
+
+// SetSource sets the "source" field.
+func (tsu *TagSuggestionUpdate) SetSource(t tagsuggestion.Source) *TagSuggestionUpdate {
+	tsu.mutation.SetSource(t)
+	return tsu
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (tsu *TagSuggestionUpdate) SetNillableSource(t *tagsuggestion.Source) *TagSuggestionUpdate {
+	if t != nil {
+		tsu.SetSource(*t)
+	}
+	return tsu
+}
+
+// SetStatus sets the "status" field.
+func (tsu *TagSuggestionUpdate) SetStatus(t tagsuggestion.Status) *TagSuggestionUpdate {
+	tsu.mutation.SetStatus(t)
+	return tsu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tsu *TagSuggestionUpdate) SetNillableStatus(t *tagsuggestion.Status) *TagSuggestionUpdate {
+	if t != nil {
+		tsu.SetStatus(*t)
+	}
+	return tsu
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (tsu *TagSuggestionUpdate) SetDecidedBy(s string) *TagSuggestionUpdate {
+	tsu.mutation.SetDecidedBy(s)
+	return tsu
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (tsu *TagSuggestionUpdate) SetNillableDecidedBy(s *string) *TagSuggestionUpdate {
+	if s != nil {
+		tsu.SetDecidedBy(*s)
+	}
+	return tsu
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (tsu *TagSuggestionUpdate) ClearDecidedBy() *TagSuggestionUpdate {
+	tsu.mutation.ClearDecidedBy()
+	return tsu
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (tsu *TagSuggestionUpdate) SetDecidedAt(t time.Time) *TagSuggestionUpdate {
+	tsu.mutation.SetDecidedAt(t)
+	return tsu
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (tsu *TagSuggestionUpdate) SetNillableDecidedAt(t *time.Time) *TagSuggestionUpdate {
+	if t != nil {
+		tsu.SetDecidedAt(*t)
+	}
+	return tsu
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (tsu *TagSuggestionUpdate) ClearDecidedAt() *TagSuggestionUpdate {
+	tsu.mutation.ClearDecidedAt()
+	return tsu
+}
+
+// Mutation returns the TagSuggestionMutation object of the builder.
+func (tsu *TagSuggestionUpdate) Mutation() *TagSuggestionMutation {
+	return tsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsu *TagSuggestionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsu.sqlSave, tsu.mutation, tsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsu *TagSuggestionUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsu *TagSuggestionUpdate) Exec(ctx context.Context) error {
+	_, err := tsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsu *TagSuggestionUpdate) ExecX(ctx context.Context) {
+	if err := tsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsu *TagSuggestionUpdate) check() error {
+	if v, ok := tsu.mutation.Source(); ok {
+		if err := tagsuggestion.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "TagSuggestion.source": %w`, err)}
+		}
+	}
+	if v, ok := tsu.mutation.Status(); ok {
+		if err := tagsuggestion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TagSuggestion.status": %w`, err)}
+		}
+	}
+	if tsu.mutation.MediaCleared() && len(tsu.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagSuggestion.media"`)
+	}
+	if tsu.mutation.TagCleared() && len(tsu.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagSuggestion.tag"`)
+	}
+	return nil
+}
+
+func (tsu *TagSuggestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagsuggestion.Table, tagsuggestion.Columns, sqlgraph.NewFieldSpec(tagsuggestion.FieldID, field.TypeInt))
+	if ps := tsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsu.mutation.Score(); ok {
+		_spec.SetField(tagsuggestion.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := tsu.mutation.AddedScore(); ok {
+		_spec.AddField(tagsuggestion.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := tsu.mutation.Source(); ok {
+		_spec.SetField(tagsuggestion.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := tsu.mutation.Status(); ok {
+		_spec.SetField(tagsuggestion.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tsu.mutation.DecidedBy(); ok {
+		_spec.SetField(tagsuggestion.FieldDecidedBy, field.TypeString, value)
+	}
+	if tsu.mutation.DecidedByCleared() {
+		_spec.ClearField(tagsuggestion.FieldDecidedBy, field.TypeString)
+	}
+	if value, ok := tsu.mutation.DecidedAt(); ok {
+		_spec.SetField(tagsuggestion.FieldDecidedAt, field.TypeTime, value)
+	}
+	if tsu.mutation.DecidedAtCleared() {
+		_spec.ClearField(tagsuggestion.FieldDecidedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagsuggestion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsu.mutation.done = true
+	return n, nil
+}
+
+// TagSuggestionUpdateOne is the builder for updating a single TagSuggestion entity.
+type TagSuggestionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TagSuggestionMutation
+}
+
+// SetScore sets the "score" field.
+func (tsuo *TagSuggestionUpdateOne) SetScore(f float64) *TagSuggestionUpdateOne {
+	tsuo.mutation.ResetScore()
+	tsuo.mutation.SetScore(f)
+	return tsuo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (tsuo *TagSuggestionUpdateOne) SetNillableScore(f *float64) *TagSuggestionUpdateOne {
+	if f != nil {
+		tsuo.SetScore(*f)
+	}
+	return tsuo
+}
+
+// AddScore adds f to the "score" field.
+func (tsuo *TagSuggestionUpdateOne) AddScore(f float64) *TagSuggestionUpdateOne {
+	tsuo.mutation.AddScore(f)
+	return tsuo
+}
+
+// SetSource sets the "source" field.
+func (tsuo *TagSuggestionUpdateOne) SetSource(t tagsuggestion.Source) *TagSuggestionUpdateOne {
+	tsuo.mutation.SetSource(t)
+	return tsuo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (tsuo *TagSuggestionUpdateOne) SetNillableSource(t *tagsuggestion.Source) *TagSuggestionUpdateOne {
+	if t != nil {
+		tsuo.SetSource(*t)
+	}
+	return tsuo
+}
+
+// SetStatus sets the "status" field.
+func (tsuo *TagSuggestionUpdateOne) SetStatus(t tagsuggestion.Status) *TagSuggestionUpdateOne {
+	tsuo.mutation.SetStatus(t)
+	return tsuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tsuo *TagSuggestionUpdateOne) SetNillableStatus(t *tagsuggestion.Status) *TagSuggestionUpdateOne {
+	if t != nil {
+		tsuo.SetStatus(*t)
+	}
+	return tsuo
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (tsuo *TagSuggestionUpdateOne) SetDecidedBy(s string) *TagSuggestionUpdateOne {
+	tsuo.mutation.SetDecidedBy(s)
+	return tsuo
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (tsuo *TagSuggestionUpdateOne) SetNillableDecidedBy(s *string) *TagSuggestionUpdateOne {
+	if s != nil {
+		tsuo.SetDecidedBy(*s)
+	}
+	return tsuo
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (tsuo *TagSuggestionUpdateOne) ClearDecidedBy() *TagSuggestionUpdateOne {
+	tsuo.mutation.ClearDecidedBy()
+	return tsuo
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (tsuo *TagSuggestionUpdateOne) SetDecidedAt(t time.Time) *TagSuggestionUpdateOne {
+	tsuo.mutation.SetDecidedAt(t)
+	return tsuo
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (tsuo *TagSuggestionUpdateOne) SetNillableDecidedAt(t *time.Time) *TagSuggestionUpdateOne {
+	if t != nil {
+		tsuo.SetDecidedAt(*t)
+	}
+	return tsuo
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (tsuo *TagSuggestionUpdateOne) ClearDecidedAt() *TagSuggestionUpdateOne {
+	tsuo.mutation.ClearDecidedAt()
+	return tsuo
+}
+
+// Mutation returns the TagSuggestionMutation object of the builder.
+func (tsuo *TagSuggestionUpdateOne) Mutation() *TagSuggestionMutation {
+	return tsuo.mutation
+}
+
+// Where appends a list predicates to the TagSuggestionUpdate builder.
+func (tsuo *TagSuggestionUpdateOne) Where(ps ...predicate.TagSuggestion) *TagSuggestionUpdateOne {
+	tsuo.mutation.Where(ps...)
+	return tsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsuo *TagSuggestionUpdateOne) Select(field string, fields ...string) *TagSuggestionUpdateOne {
+	tsuo.fields = append([]string{field}, fields...)
+	return tsuo
+}
+
+// Save executes the query and returns the updated TagSuggestion entity.
+func (tsuo *TagSuggestionUpdateOne) Save(ctx context.Context) (*TagSuggestion, error) {
+	return withHooks(ctx, tsuo.sqlSave, tsuo.mutation, tsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsuo *TagSuggestionUpdateOne) SaveX(ctx context.Context) *TagSuggestion {
+	node, err := tsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsuo *TagSuggestionUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsuo *TagSuggestionUpdateOne) ExecX(ctx context.Context) {
+	if err := tsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsuo *TagSuggestionUpdateOne) check() error {
+	if v, ok := tsuo.mutation.Source(); ok {
+		if err := tagsuggestion.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "TagSuggestion.source": %w`, err)}
+		}
+	}
+	if v, ok := tsuo.mutation.Status(); ok {
+		if err := tagsuggestion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TagSuggestion.status": %w`, err)}
+		}
+	}
+	if tsuo.mutation.MediaCleared() && len(tsuo.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagSuggestion.media"`)
+	}
+	if tsuo.mutation.TagCleared() && len(tsuo.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagSuggestion.tag"`)
+	}
+	return nil
+}
+
+func (tsuo *TagSuggestionUpdateOne) sqlSave(ctx context.Context) (_node *TagSuggestion, err error) {
+	if err := tsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagsuggestion.Table, tagsuggestion.Columns, sqlgraph.NewFieldSpec(tagsuggestion.FieldID, field.TypeInt))
+	id, ok := tsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagSuggestion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagsuggestion.FieldID)
+		for _, f := range fields {
+			if !tagsuggestion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagsuggestion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsuo.mutation.Score(); ok {
+		_spec.SetField(tagsuggestion.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := tsuo.mutation.AddedScore(); ok {
+		_spec.AddField(tagsuggestion.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := tsuo.mutation.Source(); ok {
+		_spec.SetField(tagsuggestion.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := tsuo.mutation.Status(); ok {
+		_spec.SetField(tagsuggestion.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tsuo.mutation.DecidedBy(); ok {
+		_spec.SetField(tagsuggestion.FieldDecidedBy, field.TypeString, value)
+	}
+	if tsuo.mutation.DecidedByCleared() {
+		_spec.ClearField(tagsuggestion.FieldDecidedBy, field.TypeString)
+	}
+	if value, ok := tsuo.mutation.DecidedAt(); ok {
+		_spec.SetField(tagsuggestion.FieldDecidedAt, field.TypeTime, value)
+	}
+	if tsuo.mutation.DecidedAtCleared() {
+		_spec.ClearField(tagsuggestion.FieldDecidedAt, field.TypeTime)
+	}
+	_node = &TagSuggestion{config: tsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagsuggestion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"era/booru/ent/tag"
+	"era/booru/ent/tagvector"
+	"era/booru/ent/vector"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// TagVector is the model entity for the TagVector schema.
+type TagVector struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID int `json:"tag_id,omitempty"`
+	// VectorID holds the value of the "vector_id" field.
+	VectorID int `json:"vector_id,omitempty"`
+	// L2-normalised mean of the media vectors
+	Value pgvector.Vector `json:"value,omitempty"`
+	// Number of media the centroid was computed from
+	MediaCount int `json:"media_count,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TagVectorQuery when eager-loading is set.
+	Edges        TagVectorEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TagVectorEdges holds the relations/edges for other nodes in the graph.
+type TagVectorEdges struct {
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// Vector holds the value of the vector edge.
+	Vector *Vector `json:"vector,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagVectorEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// VectorOrErr returns the Vector value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagVectorEdges) VectorOrErr() (*Vector, error) {
+	if e.Vector != nil {
+		return e.Vector, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: vector.Label}
+	}
+	return nil, &NotLoadedError{edge: "vector"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TagVector) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tagvector.FieldValue:
+			values[i] = new(pgvector.Vector)
+		case tagvector.FieldID, tagvector.FieldTagID, tagvector.FieldVectorID, tagvector.FieldMediaCount:
+			values[i] = new(sql.NullInt64)
+		case tagvector.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TagVector fields.
+func (tv *TagVector) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tagvector.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tv.ID = int(value.Int64)
+		case tagvector.FieldTagID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				tv.TagID = int(value.Int64)
+			}
+		case tagvector.FieldVectorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vector_id", values[i])
+			} else if value.Valid {
+				tv.VectorID = int(value.Int64)
+			}
+		case tagvector.FieldValue:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil {
+				tv.Value = *value
+			}
+		case tagvector.FieldMediaCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field media_count", values[i])
+			} else if value.Valid {
+				tv.MediaCount = int(value.Int64)
+			}
+		case tagvector.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tv.UpdatedAt = value.Time
+			}
+		default:
+			tv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the TagVector.
+// This includes values selected through modifiers, order, etc.
+func (tv *TagVector) GetValue(name string) (ent.Value, error) {
+	return tv.selectValues.Get(name)
+}
+
+// QueryTag queries the "tag" edge of the TagVector entity.
+func (tv *TagVector) QueryTag() *TagQuery {
+	return NewTagVectorClient(tv.config).QueryTag(tv)
+}
+
+// QueryVector queries the "vector" edge of the TagVector entity.
+func (tv *TagVector) QueryVector() *VectorQuery {
+	return NewTagVectorClient(tv.config).QueryVector(tv)
+}
+
+// Update returns a builder for updating this TagVector.
+// Note that you need to call TagVector.Unwrap() before calling this method if this TagVector
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tv *TagVector) Update() *TagVectorUpdateOne {
+	return NewTagVectorClient(tv.config).UpdateOne(tv)
+}
+
+// Unwrap unwraps the TagVector entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tv *TagVector) Unwrap() *TagVector {
+	_tx, ok := tv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TagVector is not a transactional entity")
+	}
+	tv.config.driver = _tx.drv
+	return tv
+}
+
+// String implements the fmt.Stringer.
+func (tv *TagVector) String() string {
+	var builder strings.Builder
+	builder.WriteString("TagVector(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tv.ID))
+	builder.WriteString("tag_id=")
+	builder.WriteString(fmt.Sprintf("%v", tv.TagID))
+	builder.WriteString(", ")
+	builder.WriteString("vector_id=")
+	builder.WriteString(fmt.Sprintf("%v", tv.VectorID))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", tv.Value))
+	builder.WriteString(", ")
+	builder.WriteString("media_count=")
+	builder.WriteString(fmt.Sprintf("%v", tv.MediaCount))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TagVectors is a parsable slice of TagVector.
+type TagVectors []*TagVector
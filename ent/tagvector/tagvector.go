@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tagvector
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the tagvector type in the database.
+	Label = "tag_vector"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTagID holds the string denoting the tag_id field in the database.
+	FieldTagID = "tag_id"
+	// FieldVectorID holds the string denoting the vector_id field in the database.
+	FieldVectorID = "vector_id"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldMediaCount holds the string denoting the media_count field in the database.
+	FieldMediaCount = "media_count"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeTag holds the string denoting the tag edge name in mutations.
+	EdgeTag = "tag"
+	// EdgeVector holds the string denoting the vector edge name in mutations.
+	EdgeVector = "vector"
+	// Table holds the table name of the tagvector in the database.
+	Table = "tag_vectors"
+	// TagTable is the table that holds the tag relation/edge.
+	TagTable = "tag_vectors"
+	// TagInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagInverseTable = "tags"
+	// TagColumn is the table column denoting the tag relation/edge.
+	TagColumn = "tag_id"
+	// VectorTable is the table that holds the vector relation/edge.
+	VectorTable = "tag_vectors"
+	// VectorInverseTable is the table name for the Vector entity.
+	// It exists in this package in order to avoid circular dependency with the "vector" package.
+	VectorInverseTable = "vectors"
+	// VectorColumn is the table column denoting the vector relation/edge.
+	VectorColumn = "vector_id"
+)
+
+// Columns holds all SQL columns for tagvector fields.
+var Columns = []string{
+	FieldID,
+	FieldTagID,
+	FieldVectorID,
+	FieldValue,
+	FieldMediaCount,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TagVector queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTagID orders the results by the tag_id field.
+func ByTagID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTagID, opts...).ToFunc()
+}
+
+// ByVectorID orders the results by the vector_id field.
+func ByVectorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVectorID, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByMediaCount orders the results by the media_count field.
+func ByMediaCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMediaCount, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTagField orders the results by tag field.
+func ByTagField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByVectorField orders the results by vector field.
+func ByVectorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVectorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTagStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TagTable, TagColumn),
+	)
+}
+func newVectorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VectorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, VectorTable, VectorColumn),
+	)
+}
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tagvector
+
+import (
+	"era/booru/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldLTE(FieldID, id))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldTagID, v))
+}
+
+// VectorID applies equality check predicate on the "vector_id" field. It's identical to VectorIDEQ.
+func VectorID(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldVectorID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldValue, v))
+}
+
+// MediaCount applies equality check predicate on the "media_count" field. It's identical to MediaCountEQ.
+func MediaCount(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldMediaCount, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// VectorIDEQ applies the EQ predicate on the "vector_id" field.
+func VectorIDEQ(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldVectorID, v))
+}
+
+// VectorIDNEQ applies the NEQ predicate on the "vector_id" field.
+func VectorIDNEQ(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNEQ(FieldVectorID, v))
+}
+
+// VectorIDIn applies the In predicate on the "vector_id" field.
+func VectorIDIn(vs ...int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldIn(FieldVectorID, vs...))
+}
+
+// VectorIDNotIn applies the NotIn predicate on the "vector_id" field.
+func VectorIDNotIn(vs ...int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNotIn(FieldVectorID, vs...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v pgvector.Vector) predicate.TagVector {
+	return predicate.TagVector(sql.FieldLTE(FieldValue, v))
+}
+
+// MediaCountEQ applies the EQ predicate on the "media_count" field.
+func MediaCountEQ(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldMediaCount, v))
+}
+
+// MediaCountNEQ applies the NEQ predicate on the "media_count" field.
+func MediaCountNEQ(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNEQ(FieldMediaCount, v))
+}
+
+// MediaCountIn applies the In predicate on the "media_count" field.
+func MediaCountIn(vs ...int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldIn(FieldMediaCount, vs...))
+}
+
+// MediaCountNotIn applies the NotIn predicate on the "media_count" field.
+func MediaCountNotIn(vs ...int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNotIn(FieldMediaCount, vs...))
+}
+
+// MediaCountGT applies the GT predicate on the "media_count" field.
+func MediaCountGT(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldGT(FieldMediaCount, v))
+}
+
+// MediaCountGTE applies the GTE predicate on the "media_count" field.
+func MediaCountGTE(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldGTE(FieldMediaCount, v))
+}
+
+// MediaCountLT applies the LT predicate on the "media_count" field.
+func MediaCountLT(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldLT(FieldMediaCount, v))
+}
+
+// MediaCountLTE applies the LTE predicate on the "media_count" field.
+func MediaCountLTE(v int) predicate.TagVector {
+	return predicate.TagVector(sql.FieldLTE(FieldMediaCount, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TagVector {
+	return predicate.TagVector(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.TagVector {
+	return predicate.TagVector(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.TagVector {
+	return predicate.TagVector(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVector applies the HasEdge predicate on the "vector" edge.
+func HasVector() predicate.TagVector {
+	return predicate.TagVector(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, VectorTable, VectorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVectorWith applies the HasEdge predicate on the "vector" edge with a given conditions (other predicates).
+func HasVectorWith(preds ...predicate.Vector) predicate.TagVector {
+	return predicate.TagVector(func(s *sql.Selector) {
+		step := newVectorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TagVector) predicate.TagVector {
+	return predicate.TagVector(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TagVector) predicate.TagVector {
+	return predicate.TagVector(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TagVector) predicate.TagVector {
+	return predicate.TagVector(sql.NotPredicates(p))
+}
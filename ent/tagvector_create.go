@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/tag"
+	"era/booru/ent/tagvector"
+	"era/booru/ent/vector"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// TagVectorCreate is the builder for creating a TagVector entity.
+type TagVectorCreate struct {
+	config
+	mutation *TagVectorMutation
+	hooks    []Hook
+}
+
+// SetTagID sets the "tag_id" field.
+func (tvc *TagVectorCreate) SetTagID(i int) *TagVectorCreate {
+	tvc.mutation.SetTagID(i)
+	return tvc
+}
+
+// SetVectorID sets the "vector_id" field.
+func (tvc *TagVectorCreate) SetVectorID(i int) *TagVectorCreate {
+	tvc.mutation.SetVectorID(i)
+	return tvc
+}
+
+// SetValue sets the "value" field.
+func (tvc *TagVectorCreate) SetValue(pg pgvector.Vector) *TagVectorCreate {
+	tvc.mutation.SetValue(pg)
+	return tvc
+}
+
+// SetMediaCount sets the "media_count" field.
+func (tvc *TagVectorCreate) SetMediaCount(i int) *TagVectorCreate {
+	tvc.mutation.SetMediaCount(i)
+	return tvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvc *TagVectorCreate) SetUpdatedAt(t time.Time) *TagVectorCreate {
+	tvc.mutation.SetUpdatedAt(t)
+	return tvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tvc *TagVectorCreate) SetNillableUpdatedAt(t *time.Time) *TagVectorCreate {
+	if t != nil {
+		tvc.SetUpdatedAt(*t)
+	}
+	return tvc
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tvc *TagVectorCreate) SetTag(t *Tag) *TagVectorCreate {
+	return tvc.SetTagID(t.ID)
+}
+
+// SetVector sets the "vector" edge to the Vector entity.
+func (tvc *TagVectorCreate) SetVector(v *Vector) *TagVectorCreate {
+	return tvc.SetVectorID(v.ID)
+}
+
+// Mutation returns the TagVectorMutation object of the builder.
+func (tvc *TagVectorCreate) Mutation() *TagVectorMutation {
+	return tvc.mutation
+}
+
+// Save creates the TagVector in the database.
+func (tvc *TagVectorCreate) Save(ctx context.Context) (*TagVector, error) {
+	tvc.defaults()
+	return withHooks(ctx, tvc.sqlSave, tvc.mutation, tvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tvc *TagVectorCreate) SaveX(ctx context.Context) *TagVector {
+	v, err := tvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tvc *TagVectorCreate) Exec(ctx context.Context) error {
+	_, err := tvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvc *TagVectorCreate) ExecX(ctx context.Context) {
+	if err := tvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvc *TagVectorCreate) defaults() {
+	if _, ok := tvc.mutation.UpdatedAt(); !ok {
+		v := tagvector.DefaultUpdatedAt()
+		tvc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvc *TagVectorCreate) check() error {
+	if _, ok := tvc.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "TagVector.tag_id"`)}
+	}
+	if _, ok := tvc.mutation.VectorID(); !ok {
+		return &ValidationError{Name: "vector_id", err: errors.New(`ent: missing required field "TagVector.vector_id"`)}
+	}
+	if _, ok := tvc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "TagVector.value"`)}
+	}
+	if _, ok := tvc.mutation.MediaCount(); !ok {
+		return &ValidationError{Name: "media_count", err: errors.New(`ent: missing required field "TagVector.media_count"`)}
+	}
+	if _, ok := tvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TagVector.updated_at"`)}
+	}
+	if len(tvc.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TagVector.tag"`)}
+	}
+	if len(tvc.mutation.VectorIDs()) == 0 {
+		return &ValidationError{Name: "vector", err: errors.New(`ent: missing required edge "TagVector.vector"`)}
+	}
+	return nil
+}
+
+func (tvc *TagVectorCreate) sqlSave(ctx context.Context) (*TagVector, error) {
+	if err := tvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tvc.mutation.id = &_node.ID
+	tvc.mutation.done = true
+	return _node, nil
+}
+
+func (tvc *TagVectorCreate) createSpec() (*TagVector, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagVector{config: tvc.config}
+		_spec = sqlgraph.NewCreateSpec(tagvector.Table, sqlgraph.NewFieldSpec(tagvector.FieldID, field.TypeInt))
+	)
+	if value, ok := tvc.mutation.Value(); ok {
+		_spec.SetField(tagvector.FieldValue, field.TypeOther, value)
+		_node.Value = value
+	}
+	if value, ok := tvc.mutation.MediaCount(); ok {
+		_spec.SetField(tagvector.FieldMediaCount, field.TypeInt, value)
+		_node.MediaCount = value
+	}
+	if value, ok := tvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagvector.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := tvc.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.TagTable,
+			Columns: []string{tagvector.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tvc.mutation.VectorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.VectorTable,
+			Columns: []string{tagvector.VectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VectorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TagVectorCreateBulk is the builder for creating many TagVector entities in bulk.
+type TagVectorCreateBulk struct {
+	config
+	err      error
+	builders []*TagVectorCreate
+}
+
+// Save creates the TagVector entities in the database.
+func (tvcb *TagVectorCreateBulk) Save(ctx context.Context) ([]*TagVector, error) {
+	if tvcb.err != nil {
+		return nil, tvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tvcb.builders))
+	nodes := make([]*TagVector, len(tvcb.builders))
+	mutators := make([]Mutator, len(tvcb.builders))
+	for i := range tvcb.builders {
+		func(i int, root context.Context) {
+			builder := tvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagVectorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvcb *TagVectorCreateBulk) SaveX(ctx context.Context) []*TagVector {
+	v, err := tvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tvcb *TagVectorCreateBulk) Exec(ctx context.Context) error {
+	_, err := tvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvcb *TagVectorCreateBulk) ExecX(ctx context.Context) {
+	if err := tvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
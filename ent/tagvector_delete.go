@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/predicate"
+	"era/booru/ent/tagvector"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagVectorDelete is the builder for deleting a TagVector entity.
+type TagVectorDelete struct {
+	config
+	hooks    []Hook
+	mutation *TagVectorMutation
+}
+
+// Where appends a list predicates to the TagVectorDelete builder.
+func (tvd *TagVectorDelete) Where(ps ...predicate.TagVector) *TagVectorDelete {
+	tvd.mutation.Where(ps...)
+	return tvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tvd *TagVectorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tvd.sqlExec, tvd.mutation, tvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvd *TagVectorDelete) ExecX(ctx context.Context) int {
+	n, err := tvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tvd *TagVectorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tagvector.Table, sqlgraph.NewFieldSpec(tagvector.FieldID, field.TypeInt))
+	if ps := tvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tvd.mutation.done = true
+	return affected, err
+}
+
+// TagVectorDeleteOne is the builder for deleting a single TagVector entity.
+type TagVectorDeleteOne struct {
+	tvd *TagVectorDelete
+}
+
+// Where appends a list predicates to the TagVectorDelete builder.
+func (tvdo *TagVectorDeleteOne) Where(ps ...predicate.TagVector) *TagVectorDeleteOne {
+	tvdo.tvd.mutation.Where(ps...)
+	return tvdo
+}
+
+// Exec executes the deletion query.
+func (tvdo *TagVectorDeleteOne) Exec(ctx context.Context) error {
+	n, err := tvdo.tvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tagvector.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvdo *TagVectorDeleteOne) ExecX(ctx context.Context) {
+	if err := tvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/predicate"
+	"era/booru/ent/tag"
+	"era/booru/ent/tagvector"
+	"era/booru/ent/vector"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagVectorQuery is the builder for querying TagVector entities.
+type TagVectorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tagvector.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TagVector
+	withTag    *TagQuery
+	withVector *VectorQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TagVectorQuery builder.
+func (tvq *TagVectorQuery) Where(ps ...predicate.TagVector) *TagVectorQuery {
+	tvq.predicates = append(tvq.predicates, ps...)
+	return tvq
+}
+
+// Limit the number of records to be returned by this query.
+func (tvq *TagVectorQuery) Limit(limit int) *TagVectorQuery {
+	tvq.ctx.Limit = &limit
+	return tvq
+}
+
+// Offset to start from.
+func (tvq *TagVectorQuery) Offset(offset int) *TagVectorQuery {
+	tvq.ctx.Offset = &offset
+	return tvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tvq *TagVectorQuery) Unique(unique bool) *TagVectorQuery {
+	tvq.ctx.Unique = &unique
+	return tvq
+}
+
+// Order specifies how the records should be ordered.
+func (tvq *TagVectorQuery) Order(o ...tagvector.OrderOption) *TagVectorQuery {
+	tvq.order = append(tvq.order, o...)
+	return tvq
+}
+
+// QueryTag chains the current query on the "tag" edge.
+func (tvq *TagVectorQuery) QueryTag() *TagQuery {
+	query := (&TagClient{config: tvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagvector.Table, tagvector.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tagvector.TagTable, tagvector.TagColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVector chains the current query on the "vector" edge.
+func (tvq *TagVectorQuery) QueryVector() *VectorQuery {
+	query := (&VectorClient{config: tvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagvector.Table, tagvector.FieldID, selector),
+			sqlgraph.To(vector.Table, vector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tagvector.VectorTable, tagvector.VectorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TagVector entity from the query.
+// Returns a *NotFoundError when no TagVector was found.
+func (tvq *TagVectorQuery) First(ctx context.Context) (*TagVector, error) {
+	nodes, err := tvq.Limit(1).All(setContextOp(ctx, tvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tagvector.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tvq *TagVectorQuery) FirstX(ctx context.Context) *TagVector {
+	node, err := tvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TagVector ID from the query.
+// Returns a *NotFoundError when no TagVector ID was found.
+func (tvq *TagVectorQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tvq.Limit(1).IDs(setContextOp(ctx, tvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tagvector.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tvq *TagVectorQuery) FirstIDX(ctx context.Context) int {
+	id, err := tvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TagVector entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TagVector entity is found.
+// Returns a *NotFoundError when no TagVector entities are found.
+func (tvq *TagVectorQuery) Only(ctx context.Context) (*TagVector, error) {
+	nodes, err := tvq.Limit(2).All(setContextOp(ctx, tvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tagvector.Label}
+	default:
+		return nil, &NotSingularError{tagvector.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tvq *TagVectorQuery) OnlyX(ctx context.Context) *TagVector {
+	node, err := tvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TagVector ID in the query.
+// Returns a *NotSingularError when more than one TagVector ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tvq *TagVectorQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tvq.Limit(2).IDs(setContextOp(ctx, tvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tagvector.Label}
+	default:
+		err = &NotSingularError{tagvector.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tvq *TagVectorQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TagVectors.
+func (tvq *TagVectorQuery) All(ctx context.Context) ([]*TagVector, error) {
+	ctx = setContextOp(ctx, tvq.ctx, ent.OpQueryAll)
+	if err := tvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TagVector, *TagVectorQuery]()
+	return withInterceptors[[]*TagVector](ctx, tvq, qr, tvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tvq *TagVectorQuery) AllX(ctx context.Context) []*TagVector {
+	nodes, err := tvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TagVector IDs.
+func (tvq *TagVectorQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tvq.ctx.Unique == nil && tvq.path != nil {
+		tvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tvq.ctx, ent.OpQueryIDs)
+	if err = tvq.Select(tagvector.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tvq *TagVectorQuery) IDsX(ctx context.Context) []int {
+	ids, err := tvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tvq *TagVectorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tvq.ctx, ent.OpQueryCount)
+	if err := tvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tvq, querierCount[*TagVectorQuery](), tvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tvq *TagVectorQuery) CountX(ctx context.Context) int {
+	count, err := tvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tvq *TagVectorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tvq.ctx, ent.OpQueryExist)
+	switch _, err := tvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tvq *TagVectorQuery) ExistX(ctx context.Context) bool {
+	exist, err := tvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TagVectorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tvq *TagVectorQuery) Clone() *TagVectorQuery {
+	if tvq == nil {
+		return nil
+	}
+	return &TagVectorQuery{
+		config:     tvq.config,
+		ctx:        tvq.ctx.Clone(),
+		order:      append([]tagvector.OrderOption{}, tvq.order...),
+		inters:     append([]Interceptor{}, tvq.inters...),
+		predicates: append([]predicate.TagVector{}, tvq.predicates...),
+		withTag:    tvq.withTag.Clone(),
+		withVector: tvq.withVector.Clone(),
+		// clone intermediate query.
+		sql:  tvq.sql.Clone(),
+		path: tvq.path,
+	}
+}
+
+// WithTag tells the query-builder to eager-load the nodes that are connected to
+// the "tag" edge. The optional arguments are used to configure the query builder of the edge.
+func (tvq *TagVectorQuery) WithTag(opts ...func(*TagQuery)) *TagVectorQuery {
+	query := (&TagClient{config: tvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tvq.withTag = query
+	return tvq
+}
+
+// WithVector tells the query-builder to eager-load the nodes that are connected to
+// the "vector" edge. The optional arguments are used to configure the query builder of the edge.
+func (tvq *TagVectorQuery) WithVector(opts ...func(*VectorQuery)) *TagVectorQuery {
+	query := (&VectorClient{config: tvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tvq.withVector = query
+	return tvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TagID int `json:"tag_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TagVector.Query().
+//		GroupBy(tagvector.FieldTagID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tvq *TagVectorQuery) GroupBy(field string, fields ...string) *TagVectorGroupBy {
+	tvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TagVectorGroupBy{build: tvq}
+	grbuild.flds = &tvq.ctx.Fields
+	grbuild.label = tagvector.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TagID int `json:"tag_id,omitempty"`
+//	}
+//
+//	client.TagVector.Query().
+//		Select(tagvector.FieldTagID).
+//		Scan(ctx, &v)
+func (tvq *TagVectorQuery) Select(fields ...string) *TagVectorSelect {
+	tvq.ctx.Fields = append(tvq.ctx.Fields, fields...)
+	sbuild := &TagVectorSelect{TagVectorQuery: tvq}
+	sbuild.label = tagvector.Label
+	sbuild.flds, sbuild.scan = &tvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TagVectorSelect configured with the given aggregations.
+func (tvq *TagVectorQuery) Aggregate(fns ...AggregateFunc) *TagVectorSelect {
+	return tvq.Select().Aggregate(fns...)
+}
+
+func (tvq *TagVectorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tvq.ctx.Fields {
+		if !tagvector.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tvq.path != nil {
+		prev, err := tvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tvq.sql = prev
+	}
+	return nil
+}
+
+func (tvq *TagVectorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TagVector, error) {
+	var (
+		nodes       = []*TagVector{}
+		_spec       = tvq.querySpec()
+		loadedTypes = [2]bool{
+			tvq.withTag != nil,
+			tvq.withVector != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TagVector).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TagVector{config: tvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tvq.withTag; query != nil {
+		if err := tvq.loadTag(ctx, query, nodes, nil,
+			func(n *TagVector, e *Tag) { n.Edges.Tag = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tvq.withVector; query != nil {
+		if err := tvq.loadVector(ctx, query, nodes, nil,
+			func(n *TagVector, e *Vector) { n.Edges.Vector = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tvq *TagVectorQuery) loadTag(ctx context.Context, query *TagQuery, nodes []*TagVector, init func(*TagVector), assign func(*TagVector, *Tag)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TagVector)
+	for i := range nodes {
+		fk := nodes[i].TagID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tag.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tag_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tvq *TagVectorQuery) loadVector(ctx context.Context, query *VectorQuery, nodes []*TagVector, init func(*TagVector), assign func(*TagVector, *Vector)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TagVector)
+	for i := range nodes {
+		fk := nodes[i].VectorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vector.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vector_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tvq *TagVectorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tvq.querySpec()
+	_spec.Node.Columns = tvq.ctx.Fields
+	if len(tvq.ctx.Fields) > 0 {
+		_spec.Unique = tvq.ctx.Unique != nil && *tvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tvq.driver, _spec)
+}
+
+func (tvq *TagVectorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tagvector.Table, tagvector.Columns, sqlgraph.NewFieldSpec(tagvector.FieldID, field.TypeInt))
+	_spec.From = tvq.sql
+	if unique := tvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagvector.FieldID)
+		for i := range fields {
+			if fields[i] != tagvector.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tvq.withTag != nil {
+			_spec.Node.AddColumnOnce(tagvector.FieldTagID)
+		}
+		if tvq.withVector != nil {
+			_spec.Node.AddColumnOnce(tagvector.FieldVectorID)
+		}
+	}
+	if ps := tvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tvq *TagVectorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tvq.driver.Dialect())
+	t1 := builder.Table(tagvector.Table)
+	columns := tvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tagvector.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tvq.sql != nil {
+		selector = tvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tvq.ctx.Unique != nil && *tvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tvq.predicates {
+		p(selector)
+	}
+	for _, p := range tvq.order {
+		p(selector)
+	}
+	if offset := tvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TagVectorGroupBy is the group-by builder for TagVector entities.
+type TagVectorGroupBy struct {
+	selector
+	build *TagVectorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tvgb *TagVectorGroupBy) Aggregate(fns ...AggregateFunc) *TagVectorGroupBy {
+	tvgb.fns = append(tvgb.fns, fns...)
+	return tvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tvgb *TagVectorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TagVectorQuery, *TagVectorGroupBy](ctx, tvgb.build, tvgb, tvgb.build.inters, v)
+}
+
+func (tvgb *TagVectorGroupBy) sqlScan(ctx context.Context, root *TagVectorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tvgb.fns))
+	for _, fn := range tvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tvgb.flds)+len(tvgb.fns))
+		for _, f := range *tvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TagVectorSelect is the builder for selecting fields of TagVector entities.
+type TagVectorSelect struct {
+	*TagVectorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tvs *TagVectorSelect) Aggregate(fns ...AggregateFunc) *TagVectorSelect {
+	tvs.fns = append(tvs.fns, fns...)
+	return tvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tvs *TagVectorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tvs.ctx, ent.OpQuerySelect)
+	if err := tvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TagVectorQuery, *TagVectorSelect](ctx, tvs.TagVectorQuery, tvs, tvs.inters, v)
+}
+
+func (tvs *TagVectorSelect) sqlScan(ctx context.Context, root *TagVectorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tvs.fns))
+	for _, fn := range tvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
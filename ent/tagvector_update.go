@@ -0,0 +1,547 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"era/booru/ent/predicate"
+	"era/booru/ent/tag"
+	"era/booru/ent/tagvector"
+	"era/booru/ent/vector"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// TagVectorUpdate is the builder for updating TagVector entities.
+type TagVectorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TagVectorMutation
+}
+
+// Where appends a list predicates to the TagVectorUpdate builder.
+func (tvu *TagVectorUpdate) Where(ps ...predicate.TagVector) *TagVectorUpdate {
+	tvu.mutation.Where(ps...)
+	return tvu
+}
+
+// SetTagID sets the "tag_id" field.
+func (tvu *TagVectorUpdate) SetTagID(i int) *TagVectorUpdate {
+	tvu.mutation.SetTagID(i)
+	return tvu
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (tvu *TagVectorUpdate) SetNillableTagID(i *int) *TagVectorUpdate {
+	if i != nil {
+		tvu.SetTagID(*i)
+	}
+	return tvu
+}
+
+// SetVectorID sets the "vector_id" field.
+func (tvu *TagVectorUpdate) SetVectorID(i int) *TagVectorUpdate {
+	tvu.mutation.SetVectorID(i)
+	return tvu
+}
+
+// SetNillableVectorID sets the "vector_id" field if the given value is not nil.
+func (tvu *TagVectorUpdate) SetNillableVectorID(i *int) *TagVectorUpdate {
+	if i != nil {
+		tvu.SetVectorID(*i)
+	}
+	return tvu
+}
+
+// SetValue sets the "value" field.
+func (tvu *TagVectorUpdate) SetValue(pg pgvector.Vector) *TagVectorUpdate {
+	tvu.mutation.SetValue(pg)
+	return tvu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (tvu *TagVectorUpdate) SetNillableValue(pg *pgvector.Vector) *TagVectorUpdate {
+	if pg != nil {
+		tvu.SetValue(*pg)
+	}
+	return tvu
+}
+
+// SetMediaCount sets the "media_count" field.
+func (tvu *TagVectorUpdate) SetMediaCount(i int) *TagVectorUpdate {
+	tvu.mutation.ResetMediaCount()
+	tvu.mutation.SetMediaCount(i)
+	return tvu
+}
+
+// SetNillableMediaCount sets the "media_count" field if the given value is not nil.
+func (tvu *TagVectorUpdate) SetNillableMediaCount(i *int) *TagVectorUpdate {
+	if i != nil {
+		tvu.SetMediaCount(*i)
+	}
+	return tvu
+}
+
+// AddMediaCount adds i to the "media_count" field.
+func (tvu *TagVectorUpdate) AddMediaCount(i int) *TagVectorUpdate {
+	tvu.mutation.AddMediaCount(i)
+	return tvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvu *TagVectorUpdate) SetUpdatedAt(t time.Time) *TagVectorUpdate {
+	tvu.mutation.SetUpdatedAt(t)
+	return tvu
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tvu *TagVectorUpdate) SetTag(t *Tag) *TagVectorUpdate {
+	return tvu.SetTagID(t.ID)
+}
+
+// SetVector sets the "vector" edge to the Vector entity.
+func (tvu *TagVectorUpdate) SetVector(v *Vector) *TagVectorUpdate {
+	return tvu.SetVectorID(v.ID)
+}
+
+// Mutation returns the TagVectorMutation object of the builder.
+func (tvu *TagVectorUpdate) Mutation() *TagVectorMutation {
+	return tvu.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (tvu *TagVectorUpdate) ClearTag() *TagVectorUpdate {
+	tvu.mutation.ClearTag()
+	return tvu
+}
+
+// ClearVector clears the "vector" edge to the Vector entity.
+func (tvu *TagVectorUpdate) ClearVector() *TagVectorUpdate {
+	tvu.mutation.ClearVector()
+	return tvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tvu *TagVectorUpdate) Save(ctx context.Context) (int, error) {
+	tvu.defaults()
+	return withHooks(ctx, tvu.sqlSave, tvu.mutation, tvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvu *TagVectorUpdate) SaveX(ctx context.Context) int {
+	affected, err := tvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tvu *TagVectorUpdate) Exec(ctx context.Context) error {
+	_, err := tvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvu *TagVectorUpdate) ExecX(ctx context.Context) {
+	if err := tvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvu *TagVectorUpdate) defaults() {
+	if _, ok := tvu.mutation.UpdatedAt(); !ok {
+		v := tagvector.UpdateDefaultUpdatedAt()
+		tvu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvu *TagVectorUpdate) check() error {
+	if tvu.mutation.TagCleared() && len(tvu.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagVector.tag"`)
+	}
+	if tvu.mutation.VectorCleared() && len(tvu.mutation.VectorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagVector.vector"`)
+	}
+	return nil
+}
+
+func (tvu *TagVectorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagvector.Table, tagvector.Columns, sqlgraph.NewFieldSpec(tagvector.FieldID, field.TypeInt))
+	if ps := tvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tvu.mutation.Value(); ok {
+		_spec.SetField(tagvector.FieldValue, field.TypeOther, value)
+	}
+	if value, ok := tvu.mutation.MediaCount(); ok {
+		_spec.SetField(tagvector.FieldMediaCount, field.TypeInt, value)
+	}
+	if value, ok := tvu.mutation.AddedMediaCount(); ok {
+		_spec.AddField(tagvector.FieldMediaCount, field.TypeInt, value)
+	}
+	if value, ok := tvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagvector.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tvu.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.TagTable,
+			Columns: []string{tagvector.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvu.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.TagTable,
+			Columns: []string{tagvector.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tvu.mutation.VectorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.VectorTable,
+			Columns: []string{tagvector.VectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvu.mutation.VectorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.VectorTable,
+			Columns: []string{tagvector.VectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagvector.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tvu.mutation.done = true
+	return n, nil
+}
+
+// TagVectorUpdateOne is the builder for updating a single TagVector entity.
+type TagVectorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TagVectorMutation
+}
+
+// SetTagID sets the "tag_id" field.
+func (tvuo *TagVectorUpdateOne) SetTagID(i int) *TagVectorUpdateOne {
+	tvuo.mutation.SetTagID(i)
+	return tvuo
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (tvuo *TagVectorUpdateOne) SetNillableTagID(i *int) *TagVectorUpdateOne {
+	if i != nil {
+		tvuo.SetTagID(*i)
+	}
+	return tvuo
+}
+
+// SetVectorID sets the "vector_id" field.
+func (tvuo *TagVectorUpdateOne) SetVectorID(i int) *TagVectorUpdateOne {
+	tvuo.mutation.SetVectorID(i)
+	return tvuo
+}
+
+// SetNillableVectorID sets the "vector_id" field if the given value is not nil.
+func (tvuo *TagVectorUpdateOne) SetNillableVectorID(i *int) *TagVectorUpdateOne {
+	if i != nil {
+		tvuo.SetVectorID(*i)
+	}
+	return tvuo
+}
+
+// SetValue sets the "value" field.
+func (tvuo *TagVectorUpdateOne) SetValue(pg pgvector.Vector) *TagVectorUpdateOne {
+	tvuo.mutation.SetValue(pg)
+	return tvuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (tvuo *TagVectorUpdateOne) SetNillableValue(pg *pgvector.Vector) *TagVectorUpdateOne {
+	if pg != nil {
+		tvuo.SetValue(*pg)
+	}
+	return tvuo
+}
+
+// SetMediaCount sets the "media_count" field.
+func (tvuo *TagVectorUpdateOne) SetMediaCount(i int) *TagVectorUpdateOne {
+	tvuo.mutation.ResetMediaCount()
+	tvuo.mutation.SetMediaCount(i)
+	return tvuo
+}
+
+// SetNillableMediaCount sets the "media_count" field if the given value is not nil.
+func (tvuo *TagVectorUpdateOne) SetNillableMediaCount(i *int) *TagVectorUpdateOne {
+	if i != nil {
+		tvuo.SetMediaCount(*i)
+	}
+	return tvuo
+}
+
+// AddMediaCount adds i to the "media_count" field.
+func (tvuo *TagVectorUpdateOne) AddMediaCount(i int) *TagVectorUpdateOne {
+	tvuo.mutation.AddMediaCount(i)
+	return tvuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvuo *TagVectorUpdateOne) SetUpdatedAt(t time.Time) *TagVectorUpdateOne {
+	tvuo.mutation.SetUpdatedAt(t)
+	return tvuo
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tvuo *TagVectorUpdateOne) SetTag(t *Tag) *TagVectorUpdateOne {
+	return tvuo.SetTagID(t.ID)
+}
+
+// SetVector sets the "vector" edge to the Vector entity.
+func (tvuo *TagVectorUpdateOne) SetVector(v *Vector) *TagVectorUpdateOne {
+	return tvuo.SetVectorID(v.ID)
+}
+
+// Mutation returns the TagVectorMutation object of the builder.
+func (tvuo *TagVectorUpdateOne) Mutation() *TagVectorMutation {
+	return tvuo.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (tvuo *TagVectorUpdateOne) ClearTag() *TagVectorUpdateOne {
+	tvuo.mutation.ClearTag()
+	return tvuo
+}
+
+// ClearVector clears the "vector" edge to the Vector entity.
+func (tvuo *TagVectorUpdateOne) ClearVector() *TagVectorUpdateOne {
+	tvuo.mutation.ClearVector()
+	return tvuo
+}
+
+// Where appends a list predicates to the TagVectorUpdate builder.
+func (tvuo *TagVectorUpdateOne) Where(ps ...predicate.TagVector) *TagVectorUpdateOne {
+	tvuo.mutation.Where(ps...)
+	return tvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tvuo *TagVectorUpdateOne) Select(field string, fields ...string) *TagVectorUpdateOne {
+	tvuo.fields = append([]string{field}, fields...)
+	return tvuo
+}
+
+// Save executes the query and returns the updated TagVector entity.
+func (tvuo *TagVectorUpdateOne) Save(ctx context.Context) (*TagVector, error) {
+	tvuo.defaults()
+	return withHooks(ctx, tvuo.sqlSave, tvuo.mutation, tvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvuo *TagVectorUpdateOne) SaveX(ctx context.Context) *TagVector {
+	node, err := tvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tvuo *TagVectorUpdateOne) Exec(ctx context.Context) error {
+	_, err := tvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvuo *TagVectorUpdateOne) ExecX(ctx context.Context) {
+	if err := tvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvuo *TagVectorUpdateOne) defaults() {
+	if _, ok := tvuo.mutation.UpdatedAt(); !ok {
+		v := tagvector.UpdateDefaultUpdatedAt()
+		tvuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvuo *TagVectorUpdateOne) check() error {
+	if tvuo.mutation.TagCleared() && len(tvuo.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagVector.tag"`)
+	}
+	if tvuo.mutation.VectorCleared() && len(tvuo.mutation.VectorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagVector.vector"`)
+	}
+	return nil
+}
+
+func (tvuo *TagVectorUpdateOne) sqlSave(ctx context.Context) (_node *TagVector, err error) {
+	if err := tvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagvector.Table, tagvector.Columns, sqlgraph.NewFieldSpec(tagvector.FieldID, field.TypeInt))
+	id, ok := tvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagVector.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagvector.FieldID)
+		for _, f := range fields {
+			if !tagvector.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagvector.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tvuo.mutation.Value(); ok {
+		_spec.SetField(tagvector.FieldValue, field.TypeOther, value)
+	}
+	if value, ok := tvuo.mutation.MediaCount(); ok {
+		_spec.SetField(tagvector.FieldMediaCount, field.TypeInt, value)
+	}
+	if value, ok := tvuo.mutation.AddedMediaCount(); ok {
+		_spec.AddField(tagvector.FieldMediaCount, field.TypeInt, value)
+	}
+	if value, ok := tvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagvector.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tvuo.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.TagTable,
+			Columns: []string{tagvector.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvuo.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.TagTable,
+			Columns: []string{tagvector.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tvuo.mutation.VectorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.VectorTable,
+			Columns: []string{tagvector.VectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvuo.mutation.VectorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tagvector.VectorTable,
+			Columns: []string{tagvector.VectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vector.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TagVector{config: tvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagvector.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tvuo.mutation.done = true
+	return _node, nil
+}
@@ -12,20 +12,50 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// AutoTag is the client for interacting with the AutoTag builders.
+	AutoTag *AutoTagClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
 	// Date is the client for interacting with the Date builders.
 	Date *DateClient
+	// Favorite is the client for interacting with the Favorite builders.
+	Favorite *FavoriteClient
 	// HiddenTagFilter is the client for interacting with the HiddenTagFilter builders.
 	HiddenTagFilter *HiddenTagFilterClient
 	// Media is the client for interacting with the Media builders.
 	Media *MediaClient
 	// MediaDate is the client for interacting with the MediaDate builders.
 	MediaDate *MediaDateClient
+	// MediaField is the client for interacting with the MediaField builders.
+	MediaField *MediaFieldClient
+	// MediaRevision is the client for interacting with the MediaRevision builders.
+	MediaRevision *MediaRevisionClient
 	// MediaVector is the client for interacting with the MediaVector builders.
 	MediaVector *MediaVectorClient
+	// MediaVote is the client for interacting with the MediaVote builders.
+	MediaVote *MediaVoteClient
+	// MetaField is the client for interacting with the MetaField builders.
+	MetaField *MetaFieldClient
+	// Note is the client for interacting with the Note builders.
+	Note *NoteClient
+	// Pool is the client for interacting with the Pool builders.
+	Pool *PoolClient
+	// PoolMedia is the client for interacting with the PoolMedia builders.
+	PoolMedia *PoolMediaClient
+	// Rendition is the client for interacting with the Rendition builders.
+	Rendition *RenditionClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// Source is the client for interacting with the Source builders.
+	Source *SourceClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// TagSuggestion is the client for interacting with the TagSuggestion builders.
+	TagSuggestion *TagSuggestionClient
+	// TagVector is the client for interacting with the TagVector builders.
+	TagVector *TagVectorClient
 	// Vector is the client for interacting with the Vector builders.
 	Vector *VectorClient
 
@@ -159,13 +189,28 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.AutoTag = NewAutoTagClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
 	tx.Date = NewDateClient(tx.config)
+	tx.Favorite = NewFavoriteClient(tx.config)
 	tx.HiddenTagFilter = NewHiddenTagFilterClient(tx.config)
 	tx.Media = NewMediaClient(tx.config)
 	tx.MediaDate = NewMediaDateClient(tx.config)
+	tx.MediaField = NewMediaFieldClient(tx.config)
+	tx.MediaRevision = NewMediaRevisionClient(tx.config)
 	tx.MediaVector = NewMediaVectorClient(tx.config)
+	tx.MediaVote = NewMediaVoteClient(tx.config)
+	tx.MetaField = NewMetaFieldClient(tx.config)
+	tx.Note = NewNoteClient(tx.config)
+	tx.Pool = NewPoolClient(tx.config)
+	tx.PoolMedia = NewPoolMediaClient(tx.config)
+	tx.Rendition = NewRenditionClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
+	tx.Source = NewSourceClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
+	tx.TagSuggestion = NewTagSuggestionClient(tx.config)
+	tx.TagVector = NewTagVectorClient(tx.config)
 	tx.Vector = NewVectorClient(tx.config)
 }
 
@@ -176,7 +221,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Date.QueryXXX(), the query will be executed
+// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -19,6 +19,10 @@ type Vector struct {
 	ID int `json:"id,omitempty"`
 	// Name of the vector entry
 	Name string `json:"name,omitempty"`
+	// Identifier of the model that produces the vectors; empty if unknown
+	Model string `json:"model,omitempty"`
+	// Number of dimensions of the vectors; 0 if unknown
+	Dimension int `json:"dimension,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VectorQuery when eager-loading is set.
 	Edges        VectorEdges `json:"edges"`
@@ -59,9 +63,9 @@ func (*Vector) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case vector.FieldID:
+		case vector.FieldID, vector.FieldDimension:
 			values[i] = new(sql.NullInt64)
-		case vector.FieldName:
+		case vector.FieldName, vector.FieldModel:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -90,6 +94,18 @@ func (v *Vector) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				v.Name = value.String
 			}
+		case vector.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				v.Model = value.String
+			}
+		case vector.FieldDimension:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dimension", values[i])
+			} else if value.Valid {
+				v.Dimension = int(value.Int64)
+			}
 		default:
 			v.selectValues.Set(columns[i], values[i])
 		}
@@ -138,6 +154,12 @@ func (v *Vector) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", v.ID))
 	builder.WriteString("name=")
 	builder.WriteString(v.Name)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(v.Model)
+	builder.WriteString(", ")
+	builder.WriteString("dimension=")
+	builder.WriteString(fmt.Sprintf("%v", v.Dimension))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -14,6 +14,10 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldDimension holds the string denoting the dimension field in the database.
+	FieldDimension = "dimension"
 	// EdgeMedia holds the string denoting the media edge name in mutations.
 	EdgeMedia = "media"
 	// EdgeMediaVectors holds the string denoting the media_vectors edge name in mutations.
@@ -38,6 +42,8 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldModel,
+	FieldDimension,
 }
 
 var (
@@ -56,6 +62,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultModel holds the default value on creation for the "model" field.
+	DefaultModel string
+	// DefaultDimension holds the default value on creation for the "dimension" field.
+	DefaultDimension int
+)
+
 // OrderOption defines the ordering options for the Vector queries.
 type OrderOption func(*sql.Selector)
 
@@ -69,6 +82,16 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByDimension orders the results by the dimension field.
+func ByDimension(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDimension, opts...).ToFunc()
+}
+
 // ByMediaCount orders the results by media count.
 func ByMediaCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
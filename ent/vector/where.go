@@ -59,6 +59,16 @@ func Name(v string) predicate.Vector {
 	return predicate.Vector(sql.FieldEQ(FieldName, v))
 }
 
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldEQ(FieldModel, v))
+}
+
+// Dimension applies equality check predicate on the "dimension" field. It's identical to DimensionEQ.
+func Dimension(v int) predicate.Vector {
+	return predicate.Vector(sql.FieldEQ(FieldDimension, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Vector {
 	return predicate.Vector(sql.FieldEQ(FieldName, v))
@@ -124,6 +134,111 @@ func NameContainsFold(v string) predicate.Vector {
 	return predicate.Vector(sql.FieldContainsFold(FieldName, v))
 }
 
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.Vector {
+	return predicate.Vector(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.Vector {
+	return predicate.Vector(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.Vector {
+	return predicate.Vector(sql.FieldContainsFold(FieldModel, v))
+}
+
+// DimensionEQ applies the EQ predicate on the "dimension" field.
+func DimensionEQ(v int) predicate.Vector {
+	return predicate.Vector(sql.FieldEQ(FieldDimension, v))
+}
+
+// DimensionNEQ applies the NEQ predicate on the "dimension" field.
+func DimensionNEQ(v int) predicate.Vector {
+	return predicate.Vector(sql.FieldNEQ(FieldDimension, v))
+}
+
+// DimensionIn applies the In predicate on the "dimension" field.
+func DimensionIn(vs ...int) predicate.Vector {
+	return predicate.Vector(sql.FieldIn(FieldDimension, vs...))
+}
+
+// DimensionNotIn applies the NotIn predicate on the "dimension" field.
+func DimensionNotIn(vs ...int) predicate.Vector {
+	return predicate.Vector(sql.FieldNotIn(FieldDimension, vs...))
+}
+
+// DimensionGT applies the GT predicate on the "dimension" field.
+func DimensionGT(v int) predicate.Vector {
+	return predicate.Vector(sql.FieldGT(FieldDimension, v))
+}
+
+// DimensionGTE applies the GTE predicate on the "dimension" field.
+func DimensionGTE(v int) predicate.Vector {
+	return predicate.Vector(sql.FieldGTE(FieldDimension, v))
+}
+
+// DimensionLT applies the LT predicate on the "dimension" field.
+func DimensionLT(v int) predicate.Vector {
+	return predicate.Vector(sql.FieldLT(FieldDimension, v))
+}
+
+// DimensionLTE applies the LTE predicate on the "dimension" field.
+func DimensionLTE(v int) predicate.Vector {
+	return predicate.Vector(sql.FieldLTE(FieldDimension, v))
+}
+
 // HasMedia applies the HasEdge predicate on the "media" edge.
 func HasMedia() predicate.Vector {
 	return predicate.Vector(func(s *sql.Selector) {
@@ -27,6 +27,34 @@ func (vc *VectorCreate) SetName(s string) *VectorCreate {
 	return vc
 }
 
+// SetModel sets the "model" field.
+func (vc *VectorCreate) SetModel(s string) *VectorCreate {
+	vc.mutation.SetModel(s)
+	return vc
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (vc *VectorCreate) SetNillableModel(s *string) *VectorCreate {
+	if s != nil {
+		vc.SetModel(*s)
+	}
+	return vc
+}
+
+// SetDimension sets the "dimension" field.
+func (vc *VectorCreate) SetDimension(i int) *VectorCreate {
+	vc.mutation.SetDimension(i)
+	return vc
+}
+
+// SetNillableDimension sets the "dimension" field if the given value is not nil.
+func (vc *VectorCreate) SetNillableDimension(i *int) *VectorCreate {
+	if i != nil {
+		vc.SetDimension(*i)
+	}
+	return vc
+}
+
 // SetID sets the "id" field.
 func (vc *VectorCreate) SetID(i int) *VectorCreate {
 	vc.mutation.SetID(i)
@@ -70,6 +98,7 @@ func (vc *VectorCreate) Mutation() *VectorMutation {
 
 // Save creates the Vector in the database.
 func (vc *VectorCreate) Save(ctx context.Context) (*Vector, error) {
+	vc.defaults()
 	return withHooks(ctx, vc.sqlSave, vc.mutation, vc.hooks)
 }
 
@@ -95,11 +124,29 @@ func (vc *VectorCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (vc *VectorCreate) defaults() {
+	if _, ok := vc.mutation.Model(); !ok {
+		v := vector.DefaultModel
+		vc.mutation.SetModel(v)
+	}
+	if _, ok := vc.mutation.Dimension(); !ok {
+		v := vector.DefaultDimension
+		vc.mutation.SetDimension(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (vc *VectorCreate) check() error {
 	if _, ok := vc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Vector.name"`)}
 	}
+	if _, ok := vc.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "Vector.model"`)}
+	}
+	if _, ok := vc.mutation.Dimension(); !ok {
+		return &ValidationError{Name: "dimension", err: errors.New(`ent: missing required field "Vector.dimension"`)}
+	}
 	return nil
 }
 
@@ -136,6 +183,14 @@ func (vc *VectorCreate) createSpec() (*Vector, *sqlgraph.CreateSpec) {
 		_spec.SetField(vector.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := vc.mutation.Model(); ok {
+		_spec.SetField(vector.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := vc.mutation.Dimension(); ok {
+		_spec.SetField(vector.FieldDimension, field.TypeInt, value)
+		_node.Dimension = value
+	}
 	if nodes := vc.mutation.MediaIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -189,6 +244,7 @@ func (vcb *VectorCreateBulk) Save(ctx context.Context) ([]*Vector, error) {
 	for i := range vcb.builders {
 		func(i int, root context.Context) {
 			builder := vcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*VectorMutation)
 				if !ok {
@@ -4,41 +4,177 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"era/booru/ent"
 	"era/booru/ent/date"
 	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
+	"era/booru/ent/metafield"
+	"era/booru/ent/note"
+	"era/booru/ent/source"
 	"era/booru/ent/tag"
 	"era/booru/internal/config"
 	db2 "era/booru/internal/db"
-	minio "era/booru/internal/minio"
 	"era/booru/internal/queue"
 	"era/booru/internal/search"
+	"era/booru/internal/storage"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	mc "github.com/minio/minio-go/v7"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/riverqueue/river/rivermigrate"
+	"github.com/riverqueue/river/rivertype"
 )
 
 // RegisterAdminRoutes registers admin-only endpoints.
-func RegisterAdminRoutes(r *gin.Engine, db *ent.Client, m *minio.Client, cfg *config.Config, riverClient *river.Client[pgx.Tx]) {
-	r.POST("/api/admin/regenerate", regenerateHandler(db, m, cfg, riverClient))
+func RegisterAdminRoutes(r *gin.Engine, db *ent.Client, store storage.Backend, cfg *config.Config, riverClient *river.Client[pgx.Tx]) {
+	r.POST("/api/admin/regenerate", audit(db, "regenerate"), regenerateHandler(db, store, cfg, riverClient))
 	r.GET("/api/admin/export-tags", exportTagsHandler(db))
-	r.POST("/api/admin/import-tags", importTagsHandler(db))
+	r.POST("/api/admin/import-tags", audit(db, "import_tags"), importTagsHandler(db))
+	r.GET("/api/admin/jobs/:id", jobStatusHandler(riverClient))
+	r.GET("/api/admin/fsck", fsckReportHandler(riverClient))
+	r.POST("/api/admin/fsck", audit(db, "fsck"), fsckRunHandler(riverClient))
+	r.POST("/api/admin/tag-centroids", audit(db, "tag_centroids"), tagCentroidsRunHandler(riverClient))
+	r.GET("/api/admin/vectors", listVectorsHandler(db))
+	r.PUT("/api/admin/vectors/active", audit(db, "set_vision_vector"), setActiveVectorHandler(db, riverClient))
+	r.POST("/api/admin/reembed", audit(db, "reembed"), reembedHandler(db, store, riverClient))
+	r.GET("/api/admin/audit", auditLogHandler(db))
 }
 
-func regenerateHandler(db *ent.Client, m *minio.Client, cfg *config.Config, riverClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+// jobStatusHandler reports the state, progress, errors and output of a queued
+// job, such as a deletion or bulk edit.
+func jobStatusHandler(riverClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		jobID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		job, err := riverClient.JobGet(c.Request.Context(), jobID)
+		if errors.Is(err, river.ErrNotFound) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("get job %d: %v", jobID, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := gin.H{
+			"id":           job.ID,
+			"kind":         job.Kind,
+			"state":        job.State,
+			"attempt":      job.Attempt,
+			"max_attempts": job.MaxAttempts,
+			"created_at":   job.CreatedAt,
+			"finalized_at": job.FinalizedAt,
+			"errors":       job.Errors,
+			"progress":     nil,
+			"output":       nil,
+		}
+		var metadata struct {
+			Progress json.RawMessage `json:"progress"`
+		}
+		if err := json.Unmarshal(job.Metadata, &metadata); err == nil && len(metadata.Progress) > 0 {
+			out["progress"] = metadata.Progress
+		}
+		if output := job.Output(); len(output) > 0 {
+			out["output"] = json.RawMessage(output)
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+// fsckRunHandler queues a consistency check. Pass {"repair": true} to also
+// fix the drift it finds.
+func fsckRunHandler(riverClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Repair bool `json:"repair"`
+		}
+		if c.Request.ContentLength > 0 && !bindJSONOrAbort(c, &body) {
+			return
+		}
+		if err := queue.Enqueue(c.Request.Context(), riverClient, queue.FsckArgs{Repair: body.Repair}); err != nil {
+			log.Printf("enqueue fsck: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"repair": body.Repair})
+	}
+}
+
+// tagCentroidsRunHandler queues a recomputation of the tag centroids.
+func tagCentroidsRunHandler(riverClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		job, err := queue.EnqueueJob(c.Request.Context(), riverClient, queue.TagCentroidsArgs{})
+		if err != nil {
+			log.Printf("enqueue tag centroids: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"job_id": job.ID})
+	}
+}
+
+// fsckReportHandler returns the state of the latest consistency check and the
+// report of the latest completed one.
+func fsckReportHandler(riverClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		params := river.NewJobListParams().
+			Kinds(queue.FsckArgs{}.Kind()).
+			OrderBy(river.JobListOrderByID, river.SortOrderDesc).
+			First(1)
+		latest, err := riverClient.JobList(ctx, params)
+		if err != nil {
+			log.Printf("list fsck jobs: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if len(latest.Jobs) == 0 {
+			c.JSON(http.StatusOK, gin.H{"job": nil, "report": nil})
+			return
+		}
+		job := latest.Jobs[0]
+		out := gin.H{
+			"job": gin.H{
+				"id":         job.ID,
+				"state":      job.State,
+				"created_at": job.CreatedAt,
+				"errors":     job.Errors,
+			},
+			"report": nil,
+		}
+
+		completed, err := riverClient.JobList(ctx, params.States(rivertype.JobStateCompleted))
+		if err != nil {
+			log.Printf("list completed fsck jobs: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if len(completed.Jobs) > 0 {
+			if output := completed.Jobs[0].Output(); len(output) > 0 {
+				out["report"] = json.RawMessage(output)
+			}
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+func regenerateHandler(db *ent.Client, store storage.Backend, cfg *config.Config, riverClient *river.Client[pgx.Tx]) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 
@@ -62,7 +198,7 @@ func regenerateHandler(db *ent.Client, m *minio.Client, cfg *config.Config, rive
 
 		// Iterate over all objects in the bucket and enqueue processing jobs
 		enqueuedCount := 0
-		for obj := range m.ListObjects(ctx, m.Bucket, mc.ListObjectsOptions{Recursive: true}) {
+		for obj := range store.List(ctx, store.Bucket()) {
 			if obj.Err != nil {
 				log.Printf("list object %s: %v", obj.Key, obj.Err)
 				continue
@@ -77,19 +213,17 @@ func regenerateHandler(db *ent.Client, m *minio.Client, cfg *config.Config, rive
 				continue
 			}
 
-			info, err := m.StatObject(ctx, m.Bucket, obj.Key, mc.StatObjectOptions{})
+			info, err := store.Stat(ctx, store.Bucket(), obj.Key)
 			if err != nil {
 				log.Printf("stat object %s: %v", obj.Key, err)
 				continue
 			}
 
 			// Enqueue processing job instead of processing directly
-			_, err = riverClient.Insert(ctx, queue.ProcessArgs{
-				Bucket:      m.Bucket,
+			err = queue.Enqueue(ctx, riverClient, queue.ProcessArgs{
+				Bucket:      store.Bucket(),
 				Key:         obj.Key,
 				ContentType: info.ContentType,
-			}, &river.InsertOpts{
-				Queue: "process", // Send to process queue for media worker
 			})
 			if err != nil {
 				log.Printf("enqueue process job for %s: %v", obj.Key, err)
@@ -108,6 +242,30 @@ func regenerateHandler(db *ent.Client, m *minio.Client, cfg *config.Config, rive
 	}
 }
 
+// exportNote is a region note in the tag export.
+type exportNote struct {
+	Kind   string  `json:"kind"`
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Text   string  `json:"text"`
+	Tag    string  `json:"tag,omitempty"`
+}
+
+func (n exportNote) matches(o *ent.Note) bool {
+	return n.Kind == string(o.Kind) && n.X == o.X && n.Y == o.Y &&
+		n.Width == o.Width && n.Height == o.Height && n.Text == o.Text
+}
+
+// exportField is a field definition in the tag export.
+type exportField struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Values      []string `json:"values,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
 func exportTagsHandler(db *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
@@ -115,12 +273,25 @@ func exportTagsHandler(db *ent.Client) gin.HandlerFunc {
 		items, err := db.Media.Query().
 			WithTags().
 			WithDates(func(q *ent.DateQuery) { q.WithMediaDates() }).
+			WithNotes(func(q *ent.NoteQuery) { q.WithTag().Order(ent.Asc(note.FieldID)) }).
+			WithSources(func(q *ent.SourceQuery) { q.Order(ent.Asc(source.FieldID)) }).
+			WithMediaFields(func(q *ent.MediaFieldQuery) { q.WithMetaField() }).
 			All(ctx)
 		if err != nil {
 			log.Printf("export tags: %v", err)
 			c.AbortWithStatus(http.StatusInternalServerError)
 			return
 		}
+		defs, err := db.MetaField.Query().Order(ent.Asc(metafield.FieldName)).All(ctx)
+		if err != nil {
+			log.Printf("export fields: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		fields := make([]exportField, len(defs))
+		for i, f := range defs {
+			fields[i] = exportField{Name: f.Name, Type: string(f.Type), Values: f.Values, Description: f.Description}
+		}
 
 		c.Header("Content-Type", "application/gzip")
 		c.Header("Content-Disposition", "attachment; filename=\"tags_export.ndjson.gz\"")
@@ -130,9 +301,10 @@ func exportTagsHandler(db *ent.Client) gin.HandlerFunc {
 
 		enc := json.NewEncoder(gz)
 		meta := struct {
-			Version   int       `json:"version"`
-			CreatedAt time.Time `json:"createdAt"`
-		}{Version: 2, CreatedAt: time.Now().UTC()}
+			Version   int           `json:"version"`
+			CreatedAt time.Time     `json:"createdAt"`
+			Fields    []exportField `json:"fields,omitempty"`
+		}{Version: 6, CreatedAt: time.Now().UTC(), Fields: fields}
 		if err := enc.Encode(meta); err != nil {
 			log.Printf("encode meta: %v", err)
 			return
@@ -157,14 +329,43 @@ func exportTagsHandler(db *ent.Client) gin.HandlerFunc {
 				}
 			}
 
+			notes := make([]exportNote, len(m.Edges.Notes))
+			for i, n := range m.Edges.Notes {
+				notes[i] = exportNote{
+					Kind: string(n.Kind), X: n.X, Y: n.Y,
+					Width: n.Width, Height: n.Height, Text: n.Text,
+				}
+				if n.Edges.Tag != nil {
+					notes[i].Tag = n.Edges.Tag.Name
+				}
+			}
+
+			sources := make([]string, len(m.Edges.Sources))
+			for i, s := range m.Edges.Sources {
+				sources[i] = s.URL
+			}
+
+			values := make(map[string]any, len(m.Edges.MediaFields))
+			for _, mf := range m.Edges.MediaFields {
+				values[mf.Edges.MetaField.Name] = db2.DecodeFieldValue(mf.Value)
+			}
+
 			if err := enc.Encode(struct {
-				ID    string   `json:"id"`
-				Tags  []string `json:"tags"`
-				Dates []struct {
+				ID          string   `json:"id"`
+				Title       string   `json:"title,omitempty"`
+				Description string   `json:"description,omitempty"`
+				Tags        []string `json:"tags"`
+				Dates       []struct {
 					Name  string `json:"name"`
 					Value string `json:"value"`
 				} `json:"dates"`
-			}{ID: m.ID, Tags: tags, Dates: dates}); err != nil {
+				Notes   []exportNote   `json:"notes,omitempty"`
+				Sources []string       `json:"sources,omitempty"`
+				Fields  map[string]any `json:"fields,omitempty"`
+			}{
+				ID: m.ID, Title: m.Title, Description: m.Description,
+				Tags: tags, Dates: dates, Notes: notes, Sources: sources, Fields: values,
+			}); err != nil {
 				log.Printf("encode record %s: %v", m.ID, err)
 				return
 			}
@@ -174,7 +375,7 @@ func exportTagsHandler(db *ent.Client) gin.HandlerFunc {
 
 func importTagsHandler(db *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		ctx := c.Request.Context()
+		ctx := editContext(c)
 
 		gz, err := gzip.NewReader(c.Request.Body)
 		if err != nil {
@@ -187,23 +388,34 @@ func importTagsHandler(db *ent.Client) gin.HandlerFunc {
 		dec := json.NewDecoder(gz)
 
 		var meta struct {
-			Version   int       `json:"version"`
-			CreatedAt time.Time `json:"createdAt"`
+			Version   int           `json:"version"`
+			CreatedAt time.Time     `json:"createdAt"`
+			Fields    []exportField `json:"fields"`
 		}
 		if err := dec.Decode(&meta); err != nil {
 			log.Printf("decode meta: %v", err)
 			c.AbortWithStatus(http.StatusBadRequest)
 			return
 		}
+		if err := importFieldDefs(ctx, db, meta.Fields); err != nil {
+			log.Printf("import fields: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
 
 		for {
 			var item struct {
-				ID    string   `json:"id"`
-				Tags  []string `json:"tags"`
-				Dates []struct {
+				ID          string   `json:"id"`
+				Title       string   `json:"title"`
+				Description string   `json:"description"`
+				Tags        []string `json:"tags"`
+				Dates       []struct {
 					Name  string `json:"name"`
 					Value string `json:"value"`
 				} `json:"dates"`
+				Notes   []exportNote   `json:"notes"`
+				Sources []string       `json:"sources"`
+				Fields  map[string]any `json:"fields"`
 			}
 			if err := dec.Decode(&item); err != nil {
 				if err == io.EOF {
@@ -218,6 +430,8 @@ func importTagsHandler(db *ent.Client) gin.HandlerFunc {
 			mobj, err := db.Media.Query().Where(media.IDEQ(item.ID)).
 				WithTags().
 				WithDates(func(q *ent.DateQuery) { q.WithMediaDates() }).
+				WithNotes().
+				WithSources().
 				Only(ctx)
 			if ent.IsNotFound(err) {
 				continue // Skip if media doesn't exist
@@ -237,82 +451,141 @@ func importTagsHandler(db *ent.Client) gin.HandlerFunc {
 				}
 			}
 
-			// 2) Handle incoming dates
-			for _, d := range item.Dates {
-				t, err := time.Parse("2006-01-02", d.Value)
-				if err != nil {
-					continue
-				}
-				dt, err := db2.FindOrCreateDate(ctx, db, d.Name)
-				if err != nil {
-					log.Printf("date lookup %s: %v", d.Name, err)
-					continue
-				}
-				md, err := db.MediaDate.Query().Where(mediadate.HasMediaWith(media.IDEQ(item.ID))).
-					Where(mediadate.HasDateWith(date.IDEQ(dt.ID))).Only(ctx)
-				if ent.IsNotFound(err) {
-					_, err = db.MediaDate.Create().SetMediaID(item.ID).SetDateID(dt.ID).SetValue(t).Save(ctx)
-				} else if err == nil {
-					_, err = md.Update().SetValue(t).Save(ctx)
-				}
-				if err != nil {
-					log.Printf("update date %s for %s: %v", d.Name, item.ID, err)
-				}
-			}
-
-			// 3) Parse existing tags and merge with incoming tags
-			var toAdd []int
-			if len(item.Tags) > 0 {
-				existing := make(map[string]struct{}, len(mobj.Edges.Tags))
-				for _, t := range mobj.Edges.Tags {
-					existing[t.Name] = struct{}{}
-				}
-
-				// Find new tags to add
-				for _, name := range normalizeTags(item.Tags) {
-					if _, ok := existing[name]; ok {
-						continue // Tag already exists
+			// Dates and tags are merged in one transaction that also records
+			// the revision.
+			var changes []string
+			_, err = db2.EditMedia(ctx, db, item.ID, "import", func(tx *ent.Client) error {
+				// 2) Handle incoming dates
+				datesSet := 0
+				for _, d := range item.Dates {
+					t, err := time.Parse("2006-01-02", d.Value)
+					if err != nil {
+						continue
+					}
+					dt, err := db2.FindOrCreateDate(ctx, tx, d.Name)
+					if err != nil {
+						return fmt.Errorf("date lookup %s: %w", d.Name, err)
 					}
-					tg, err := db.Tag.Query().Where(tag.NameEQ(name)).Only(ctx)
+					md, err := tx.MediaDate.Query().Where(mediadate.HasMediaWith(media.IDEQ(item.ID))).
+						Where(mediadate.HasDateWith(date.IDEQ(dt.ID))).Only(ctx)
 					if ent.IsNotFound(err) {
-						tg, err = db.Tag.Create().SetName(name).SetType(tag.TypeUserTag).Save(ctx)
+						_, err = tx.MediaDate.Create().SetMediaID(item.ID).SetDateID(dt.ID).SetValue(t).Save(ctx)
+					} else if err == nil {
+						if md.Value.Format("2006-01-02") == d.Value {
+							continue
+						}
+						_, err = md.Update().SetValue(t).Save(ctx)
 					}
 					if err != nil {
-						log.Printf("lookup tag %s: %v", name, err)
-						c.AbortWithStatus(http.StatusInternalServerError)
-						return
+						return fmt.Errorf("update date %s: %w", d.Name, err)
 					}
-					toAdd = append(toAdd, tg.ID)
+					datesSet++
+				}
+				if datesSet > 0 {
+					changes = append(changes, fmt.Sprintf("set %d dates", datesSet))
 				}
-			}
 
-			// Update media with changes
-			upd := db.Media.UpdateOneID(item.ID)
-			var changes []string
+				// 3) Parse existing tags and merge with incoming tags
+				var toAdd []int
+				if len(item.Tags) > 0 {
+					existing := make(map[string]struct{}, len(mobj.Edges.Tags))
+					for _, t := range mobj.Edges.Tags {
+						existing[t.Name] = struct{}{}
+					}
 
-			// Always remove "tagme" tag if it exists
-			if tagmeID != nil {
-				upd = upd.RemoveTagIDs(*tagmeID)
-				changes = append(changes, "removed 'tagme' tag")
-			}
+					// Find new tags to add
+					for _, name := range normalizeTags(item.Tags) {
+						if _, ok := existing[name]; ok {
+							continue // Tag already exists
+						}
+						tg, err := tx.Tag.Query().Where(tag.NameEQ(name)).Only(ctx)
+						if ent.IsNotFound(err) {
+							tg, err = tx.Tag.Create().SetName(name).SetType(tag.TypeUserTag).Save(ctx)
+						}
+						if err != nil {
+							return fmt.Errorf("lookup tag %s: %w", name, err)
+						}
+						toAdd = append(toAdd, tg.ID)
+					}
+				}
 
-			// Add new tags
-			if len(toAdd) > 0 {
-				upd = upd.AddTagIDs(toAdd...)
-				changes = append(changes, fmt.Sprintf("added %d tags", len(toAdd)))
-			}
+				// Update media with changes
+				upd := tx.Media.UpdateOneID(item.ID)
+
+				// Always remove "tagme" tag if it exists
+				if tagmeID != nil {
+					upd = upd.RemoveTagIDs(*tagmeID)
+					changes = append(changes, "removed 'tagme' tag")
+				}
 
-			// Update date if we should (import date is earlier)
-			// Dates handled separately above
+				// Add new tags
+				if len(toAdd) > 0 {
+					upd = upd.AddTagIDs(toAdd...)
+					changes = append(changes, fmt.Sprintf("added %d tags", len(toAdd)))
+				}
 
-			// Only save if we have something to update
-			if len(changes) > 0 {
-				if _, err := upd.Save(ctx); err != nil {
-					log.Printf("update media %s: %v", item.ID, err)
+				// Exported text replaces the current text; records without
+				// it leave the media unchanged.
+				// Text over the API limits is skipped rather than failing
+				// the whole import.
+				if utf8.RuneCountInString(item.Title) > maxTitleLength {
+					log.Printf("skip title of %s: longer than %d characters", item.ID, maxTitleLength)
+				} else if item.Title != "" && item.Title != mobj.Title {
+					upd = upd.SetTitle(item.Title)
+					changes = append(changes, "set title")
+				}
+				if utf8.RuneCountInString(item.Description) > maxDescriptionLength {
+					log.Printf("skip description of %s: longer than %d characters", item.ID, maxDescriptionLength)
+				} else if item.Description != "" && item.Description != mobj.Description {
+					upd = upd.SetDescription(item.Description)
+					changes = append(changes, "set description")
+				}
+
+				// Unchanged media keeps its version, so clients editing it
+				// see no conflict, and is not reindexed.
+				if len(changes) == 0 {
+					return nil
+				}
+				return upd.AddVersion(1).Exec(ctx)
+			})
+			if err != nil {
+				log.Printf("import media %s: %v", item.ID, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			added, err := importNotes(ctx, db, mobj, item.Notes)
+			if err != nil {
+				log.Printf("import notes %s: %v", item.ID, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			if added > 0 {
+				changes = append(changes, fmt.Sprintf("added %d notes", added))
+			}
+			added, err = importSources(ctx, db, mobj, item.Sources)
+			if err != nil {
+				log.Printf("import sources %s: %v", item.ID, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			if added > 0 {
+				changes = append(changes, fmt.Sprintf("added %d sources", added))
+			}
+			if len(item.Fields) > 0 {
+				_, err := db2.SetMediaFields(ctx, db, item.ID, item.Fields)
+				switch {
+				case errors.Is(err, db2.ErrInvalidField):
+					log.Printf("skip fields of %s: %v", item.ID, err)
+				case ent.IsNotFound(err):
+				case err != nil:
+					log.Printf("import fields %s: %v", item.ID, err)
 					c.AbortWithStatus(http.StatusInternalServerError)
 					return
+				default:
+					changes = append(changes, fmt.Sprintf("set %d fields", len(item.Fields)))
 				}
-
+			}
+			if len(changes) > 0 {
 				log.Printf("updated media %s: %s", item.ID, strings.Join(changes, ", "))
 			}
 		}
@@ -321,6 +594,94 @@ func importTagsHandler(db *ent.Client) gin.HandlerFunc {
 	}
 }
 
+// importNotes creates the exported notes a media item does not have yet. A
+// note is already present when its kind, region and text match. Invalid
+// notes are skipped.
+func importNotes(ctx context.Context, db *ent.Client, m *ent.Media, notes []exportNote) (int, error) {
+	added := 0
+	for _, n := range notes {
+		if note.KindValidator(note.Kind(n.Kind)) != nil || !validRegion(n.X, n.Y, n.Width, n.Height) {
+			continue
+		}
+		if slices.ContainsFunc(m.Edges.Notes, n.matches) {
+			continue
+		}
+		create := db.Note.Create().
+			SetMediaID(m.ID).
+			SetKind(note.Kind(n.Kind)).
+			SetX(n.X).SetY(n.Y).
+			SetWidth(n.Width).SetHeight(n.Height).
+			SetText(n.Text).
+			SetAuthor("import")
+		tagID, err := noteTagID(ctx, db, n.Tag)
+		if err != nil {
+			return added, err
+		}
+		created, err := create.SetNillableTagID(tagID).Save(ctx)
+		if err != nil {
+			return added, err
+		}
+		m.Edges.Notes = append(m.Edges.Notes, created)
+		added++
+	}
+	return added, nil
+}
+
+// importFieldDefs creates the exported field definitions that do not exist
+// yet. Invalid definitions are skipped; their values then fail to import.
+func importFieldDefs(ctx context.Context, db *ent.Client, fields []exportField) error {
+	for _, f := range fields {
+		typ := metafield.Type(f.Type)
+		if err := db2.ValidateFieldDef(f.Name, typ, f.Values); err != nil {
+			log.Printf("skip field %s: %v", f.Name, err)
+			continue
+		}
+		exists, err := db.MetaField.Query().Where(metafield.NameEQ(f.Name)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if err := db.MetaField.Create().
+			SetName(f.Name).
+			SetType(typ).
+			SetValues(f.Values).
+			SetDescription(f.Description).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importSources adds the exported sources a media item does not have yet.
+// Invalid URLs and media in the trash are skipped.
+func importSources(ctx context.Context, db *ent.Client, m *ent.Media, urls []string) (int, error) {
+	have := make(map[string]bool)
+	for _, s := range m.Edges.Sources {
+		have[s.URL] = true
+	}
+	var missing []string
+	for _, raw := range urls {
+		if u, _, err := db2.NormalizeSourceURL(raw); err == nil && !have[u] {
+			have[u] = true
+			missing = append(missing, u)
+		}
+	}
+	if len(missing) == 0 {
+		return 0, nil
+	}
+	_, err := db2.SetMediaSources(ctx, db, m.ID, missing, true)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(missing), nil
+}
+
 func recreateRiverTables(ctx context.Context, dsn string) error {
 	pool, err := pgxpool.New(ctx, dsn)
 	if err != nil {
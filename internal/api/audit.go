@@ -0,0 +1,176 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/ent/auditlog"
+	"era/booru/ent/predicate"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Request and response bodies larger than this are not stored in the log.
+const maxAuditBody = 64 << 10
+
+// auditWriter keeps a copy of the response body for the audit entry.
+type auditWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *auditWriter) Write(b []byte) (int, error) {
+	if w.body.Len() <= maxAuditBody {
+		w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// audit is a middleware that records the request it wraps in the audit log
+// under action: who made it, its parameters, the response and how long it
+// took.
+func audit(dbClient *ent.Client, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		params := auditParams(c)
+		w := &auditWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+
+		c.Next()
+
+		status := w.Status()
+		create := dbClient.AuditLog.Create().
+			SetActor(requestActor(c)).
+			SetAction(action).
+			SetMethod(c.Request.Method).
+			SetPath(c.Request.URL.Path).
+			SetParams(params).
+			SetStatus(status).
+			SetSuccess(status < http.StatusBadRequest).
+			SetDurationMs(time.Since(start).Milliseconds())
+		if body := w.body.Bytes(); len(body) <= maxAuditBody && json.Valid(body) {
+			create.SetResult(json.RawMessage(body))
+		}
+		// Record the outcome even if the client went away.
+		if err := create.Exec(context.WithoutCancel(c.Request.Context())); err != nil {
+			log.Printf("write audit log for %s: %v", action, err)
+		}
+	}
+}
+
+// auditParams collects the path and query parameters and a JSON body. The
+// body is restored for the handler.
+func auditParams(c *gin.Context) map[string]any {
+	params := map[string]any{}
+	for _, p := range c.Params {
+		params[p.Key] = p.Value
+	}
+	for key, values := range c.Request.URL.Query() {
+		params[key] = strings.Join(values, ",")
+	}
+	if c.Request.ContentLength > 0 {
+		params["content_length"] = c.Request.ContentLength
+	}
+	if c.ContentType() != "application/json" || c.Request.ContentLength > maxAuditBody {
+		return params
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxAuditBody+1))
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+	if err != nil || len(body) > maxAuditBody {
+		return params
+	}
+	var decoded any
+	if json.Unmarshal(body, &decoded) == nil {
+		params["body"] = decoded
+	}
+	return params
+}
+
+// auditLogHandler lists audit entries, newest first. It filters by actor,
+// action, success ("true"/"false") and an RFC 3339 since/until range.
+func auditLogHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		query := dbClient.AuditLog.Query()
+		if actor := c.Query("actor"); actor != "" {
+			query = query.Where(auditlog.ActorEQ(actor))
+		}
+		if action := c.Query("action"); action != "" {
+			query = query.Where(auditlog.ActionEQ(action))
+		}
+		if raw := c.Query("success"); raw != "" {
+			success, err := strconv.ParseBool(raw)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "success must be true or false"})
+				return
+			}
+			query = query.Where(auditlog.SuccessEQ(success))
+		}
+		for key, where := range map[string]func(time.Time) predicate.AuditLog{
+			"since": auditlog.CreatedAtGTE,
+			"until": auditlog.CreatedAtLT,
+		} {
+			raw := c.Query(key)
+			if raw == "" {
+				continue
+			}
+			t, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": key + " must be an RFC 3339 time"})
+				return
+			}
+			query = query.Where(where(t))
+		}
+
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "100"))
+		if err != nil || pageSize < 1 || pageSize > 500 {
+			pageSize = 100
+		}
+
+		ctx := c.Request.Context()
+		total, err := query.Clone().Count(ctx)
+		if err != nil {
+			log.Printf("count audit log: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		entries, err := query.
+			Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID)).
+			Offset((page - 1) * pageSize).
+			Limit(pageSize).
+			All(ctx)
+		if err != nil {
+			log.Printf("list audit log: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := make([]gin.H, len(entries))
+		for i, e := range entries {
+			out[i] = gin.H{
+				"id":          e.ID,
+				"created_at":  e.CreatedAt,
+				"actor":       e.Actor,
+				"action":      e.Action,
+				"method":      e.Method,
+				"path":        e.Path,
+				"params":      e.Params,
+				"status":      e.Status,
+				"success":     e.Success,
+				"result":      e.Result,
+				"duration_ms": e.DurationMs,
+			}
+		}
+		c.JSON(http.StatusOK, gin.H{"entries": out, "total": total})
+	}
+}
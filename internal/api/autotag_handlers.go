@@ -0,0 +1,386 @@
+package api
+
+import (
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"era/booru/ent"
+	"era/booru/ent/autotag"
+	"era/booru/ent/media"
+	"era/booru/ent/mediavector"
+	"era/booru/ent/tagsuggestion"
+	"era/booru/internal/db"
+	"era/booru/internal/queue"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// defaultAutoTagThreshold is the cosine similarity a new candidate needs by
+// default. Image-text similarities of SigLIP are small even for good
+// matches, so thresholds are best tuned per tag.
+const defaultAutoTagThreshold = 0.1
+
+func RegisterAutoTagRoutes(r *gin.Engine, dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) {
+	r.GET("/api/autotags", listAutoTagsHandler(dbClient))
+	r.POST("/api/autotags", audit(dbClient, "create_autotag"), createAutoTagHandler(dbClient))
+	r.PATCH("/api/autotags/:id", audit(dbClient, "update_autotag"), updateAutoTagHandler(dbClient))
+	r.DELETE("/api/autotags/:id", audit(dbClient, "delete_autotag"), deleteAutoTagHandler(dbClient))
+	r.POST("/api/autotags/run", audit(dbClient, "run_autotag"), runAutoTagHandler(dbClient, queueClient))
+
+	r.GET("/api/suggestions", listSuggestionsHandler(dbClient))
+	r.GET("/api/media/:id/suggestions", mediaSuggestionsHandler(dbClient))
+	r.POST("/api/suggestions/:id/accept", decideSuggestionHandler(dbClient, true))
+	r.POST("/api/suggestions/:id/reject", decideSuggestionHandler(dbClient, false))
+}
+
+// autoTagJSON describes a vocabulary entry; its tag must be loaded.
+func autoTagJSON(a *ent.AutoTag) gin.H {
+	return gin.H{
+		"id":        a.ID,
+		"tag":       a.Edges.Tag.Name,
+		"prompt":    a.Prompt,
+		"threshold": a.Threshold,
+		"embedded":  a.Embedding != nil,
+	}
+}
+
+// suggestionJSON describes a suggestion; its tag must be loaded.
+func suggestionJSON(s *ent.TagSuggestion) gin.H {
+	return gin.H{
+		"id":       s.ID,
+		"media_id": s.MediaID,
+		"tag":      s.Edges.Tag.Name,
+		"score":    s.Score,
+		"source":   s.Source,
+		"status":   s.Status,
+	}
+}
+
+func validThreshold(t float64) bool {
+	return !math.IsNaN(t) && t >= -1 && t <= 1
+}
+
+// listAutoTagsHandler returns the auto-tagger vocabulary in the order it was
+// added.
+func listAutoTagsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		autoTags, err := dbClient.AutoTag.Query().
+			WithTag().
+			Order(ent.Asc(autotag.FieldID)).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list auto tags: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := make([]gin.H, len(autoTags))
+		for i, a := range autoTags {
+			out[i] = autoTagJSON(a)
+		}
+		c.JSON(http.StatusOK, gin.H{"autotags": out})
+	}
+}
+
+// createAutoTagHandler adds a tag to the vocabulary. The prompt defaults to
+// "a photo of <tag>" with underscores read as spaces.
+func createAutoTagHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			Tag       string   `json:"tag"`
+			Prompt    string   `json:"prompt"`
+			Threshold *float64 `json:"threshold"`
+		}
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+		name := strings.TrimSpace(req.Tag)
+		if name == "" {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "tag is required"})
+			return
+		}
+		prompt := strings.TrimSpace(req.Prompt)
+		if prompt == "" {
+			prompt = "a photo of " + strings.ReplaceAll(name, "_", " ")
+		}
+		threshold := defaultAutoTagThreshold
+		if req.Threshold != nil {
+			threshold = *req.Threshold
+		}
+		if !validThreshold(threshold) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "threshold must be between -1 and 1"})
+			return
+		}
+		ctx := c.Request.Context()
+
+		t, err := db.FindOrCreateTag(ctx, dbClient, name)
+		if err != nil {
+			log.Printf("auto tag %q: %v", name, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		exists, err := dbClient.AutoTag.Query().Where(autotag.TagIDEQ(t.ID)).Exist(ctx)
+		if err != nil {
+			log.Printf("check auto tag %q: %v", name, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if exists {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "tag is already in the vocabulary"})
+			return
+		}
+		a, err := dbClient.AutoTag.Create().
+			SetTag(t).
+			SetPrompt(prompt).
+			SetThreshold(threshold).
+			Save(ctx)
+		if err != nil {
+			log.Printf("create auto tag %q: %v", name, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		a.Edges.Tag = t
+		c.JSON(http.StatusCreated, autoTagJSON(a))
+	}
+}
+
+// updateAutoTagHandler changes the prompt or the threshold of a vocabulary
+// entry. A new prompt is embedded again by the next auto-tag job.
+func updateAutoTagHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		var req struct {
+			Prompt    *string  `json:"prompt"`
+			Threshold *float64 `json:"threshold"`
+		}
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+		ctx := c.Request.Context()
+
+		a, err := dbClient.AutoTag.Get(ctx, id)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("get auto tag %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		update := a.Update()
+		if req.Prompt != nil {
+			prompt := strings.TrimSpace(*req.Prompt)
+			if prompt == "" {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "prompt must not be empty"})
+				return
+			}
+			if prompt != a.Prompt {
+				update.SetPrompt(prompt).ClearEmbedding()
+			}
+		}
+		if req.Threshold != nil {
+			if !validThreshold(*req.Threshold) {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "threshold must be between -1 and 1"})
+				return
+			}
+			update.SetThreshold(*req.Threshold)
+		}
+		if _, err := update.Save(ctx); err != nil {
+			log.Printf("update auto tag %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		a, err = dbClient.AutoTag.Query().Where(autotag.IDEQ(id)).WithTag().Only(ctx)
+		if err != nil {
+			log.Printf("load auto tag %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, autoTagJSON(a))
+	}
+}
+
+// deleteAutoTagHandler removes a tag from the vocabulary together with its
+// pending zero-shot suggestions.
+func deleteAutoTagHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		ctx := c.Request.Context()
+
+		a, err := dbClient.AutoTag.Get(ctx, id)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("get auto tag %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if err := dbClient.AutoTag.DeleteOne(a).Exec(ctx); err != nil {
+			log.Printf("delete auto tag %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		n, err := dbClient.TagSuggestion.Delete().
+			Where(
+				tagsuggestion.TagIDEQ(a.TagID),
+				tagsuggestion.SourceEQ(tagsuggestion.SourceZeroShot),
+				tagsuggestion.StatusEQ(tagsuggestion.StatusPending),
+			).
+			Exec(ctx)
+		if err != nil {
+			log.Printf("delete suggestions of auto tag %d: %v", id, err)
+		}
+		c.JSON(http.StatusOK, gin.H{"id": id, "suggestions": n})
+	}
+}
+
+// runAutoTagHandler queues auto-tagging of every live media item with an
+// embedding in the active vision vector, e.g. after the vocabulary changed.
+func runAutoTagHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		vt, err := db.ActiveVisionVector(ctx, dbClient)
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusAccepted, gin.H{"queued": 0})
+			return
+		}
+		if err != nil {
+			log.Printf("load vision vector: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		ids, err := dbClient.MediaVector.Query().
+			Where(
+				mediavector.VectorIDEQ(vt.ID),
+				mediavector.HasMediaWith(media.DeletedAtIsNil()),
+			).
+			Select(mediavector.FieldMediaID).
+			Strings(ctx)
+		if err != nil {
+			log.Printf("list embedded media: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		queued := 0
+		for _, id := range ids {
+			if err := queue.Enqueue(ctx, queueClient, queue.AutoTagArgs{ID: id, Model: vt.Model}); err != nil {
+				log.Printf("enqueue auto tag %s: %v", id, err)
+				continue
+			}
+			queued++
+		}
+		c.JSON(http.StatusAccepted, gin.H{"queued": queued})
+	}
+}
+
+// listSuggestionsHandler pages through the pending suggestions of live
+// media, most confident first, for triage.
+func listSuggestionsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "50"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 50
+		}
+
+		query := dbClient.TagSuggestion.Query().
+			Where(
+				tagsuggestion.StatusEQ(tagsuggestion.StatusPending),
+				tagsuggestion.HasMediaWith(media.DeletedAtIsNil()),
+			)
+		total, err := query.Clone().Count(c.Request.Context())
+		if err != nil {
+			log.Printf("count suggestions: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		suggestions, err := query.
+			WithTag().
+			Order(ent.Desc(tagsuggestion.FieldScore), ent.Asc(tagsuggestion.FieldID)).
+			Offset((page - 1) * pageSize).
+			Limit(pageSize).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list suggestions: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := make([]gin.H, len(suggestions))
+		for i, s := range suggestions {
+			out[i] = suggestionJSON(s)
+		}
+		c.JSON(http.StatusOK, gin.H{"suggestions": out, "total": total})
+	}
+}
+
+// mediaSuggestionsHandler returns the pending suggestions of a media item,
+// most confident first.
+func mediaSuggestionsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+		suggestions, err := dbClient.TagSuggestion.Query().
+			Where(
+				tagsuggestion.MediaIDEQ(id),
+				tagsuggestion.StatusEQ(tagsuggestion.StatusPending),
+			).
+			WithTag().
+			Order(ent.Desc(tagsuggestion.FieldScore)).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list suggestions %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := make([]gin.H, len(suggestions))
+		for i, s := range suggestions {
+			out[i] = suggestionJSON(s)
+		}
+		c.JSON(http.StatusOK, gin.H{"suggestions": out})
+	}
+}
+
+// decideSuggestionHandler accepts or rejects a pending suggestion for the
+// requesting user.
+func decideSuggestionHandler(dbClient *ent.Client, accept bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		s, err := db.DecideSuggestion(editContext(c), dbClient, id, accept)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, db.ErrSuggestionDecided) {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			log.Printf("decide suggestion %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, suggestionJSON(s))
+	}
+}
@@ -0,0 +1,212 @@
+package api
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"era/booru/ent"
+	"era/booru/ent/comment"
+	"era/booru/ent/media"
+	"era/booru/internal/markdown"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// maxCommentLength bounds the Markdown source of a comment, in characters.
+const maxCommentLength = 10000
+
+func RegisterCommentRoutes(r *gin.Engine, dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) {
+	r.GET("/api/media/:id/comments", listCommentsHandler(dbClient))
+	r.POST("/api/media/:id/comments", createCommentHandler(dbClient, queueClient))
+	r.PATCH("/api/comments/:id", updateCommentHandler(dbClient, queueClient))
+	r.DELETE("/api/comments/:id", deleteCommentHandler(dbClient, queueClient))
+}
+
+// commentJSON describes a comment with its rendered Markdown.
+func commentJSON(cm *ent.Comment) gin.H {
+	return gin.H{
+		"id":         cm.ID,
+		"media_id":   cm.MediaID,
+		"author":     cm.Author,
+		"body":       cm.Body,
+		"html":       markdown.Render(cm.Body),
+		"created_at": cm.CreatedAt,
+		"updated_at": cm.UpdatedAt,
+		"edited":     cm.UpdatedAt.After(cm.CreatedAt),
+	}
+}
+
+// commentBody validates and trims the body of a comment or aborts with 400.
+func commentBody(c *gin.Context, body string) (string, bool) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > maxCommentLength {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "comment must have 1 to 10000 characters"})
+		return "", false
+	}
+	return body, true
+}
+
+// loadOwnComment loads the :id comment for an edit by its author. Anyone
+// else gets 403. Authors are compared by requestActor, which only believes a
+// user name from a trusted proxy, so a client cannot claim another author.
+func loadOwnComment(c *gin.Context, dbClient *ent.Client) (*ent.Comment, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return nil, false
+	}
+	cm, err := dbClient.Comment.Get(c.Request.Context(), id)
+	if ent.IsNotFound(err) {
+		c.AbortWithStatus(http.StatusNotFound)
+		return nil, false
+	}
+	if err != nil {
+		log.Printf("get comment %d: %v", id, err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return nil, false
+	}
+	if cm.Author != requestActor(c) {
+		c.AbortWithStatus(http.StatusForbidden)
+		return nil, false
+	}
+	return cm, true
+}
+
+// listCommentsHandler pages through the comments of a media item, oldest
+// first.
+func listCommentsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "50"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 50
+		}
+
+		query := dbClient.Comment.Query().Where(comment.MediaIDEQ(id))
+		total, err := query.Clone().Count(c.Request.Context())
+		if err != nil {
+			log.Printf("count comments %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		comments, err := query.
+			Order(ent.Asc(comment.FieldCreatedAt), ent.Asc(comment.FieldID)).
+			Offset((page - 1) * pageSize).
+			Limit(pageSize).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list comments %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		out := make([]gin.H, len(comments))
+		for i, cm := range comments {
+			out[i] = commentJSON(cm)
+		}
+		c.JSON(http.StatusOK, gin.H{"comments": out, "total": total})
+	}
+}
+
+// createCommentHandler adds a comment by the requesting user. Trashed media
+// cannot be commented on.
+func createCommentHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			Body string `json:"body"`
+		}
+		id, ok := bindIDAndJSON(c, &req)
+		if !ok {
+			return
+		}
+		body, ok := commentBody(c, req.Body)
+		if !ok {
+			return
+		}
+
+		exists, err := dbClient.Media.Query().
+			Where(media.IDEQ(id), media.DeletedAtIsNil()).
+			Exist(c.Request.Context())
+		if err != nil {
+			log.Printf("check media %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if !exists {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		cm, err := dbClient.Comment.Create().
+			SetMediaID(id).
+			SetAuthor(requestActor(c)).
+			SetBody(body).
+			Save(c.Request.Context())
+		if err != nil {
+			log.Printf("create comment %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		reindexMedia(c.Request.Context(), queueClient, []string{id})
+		c.JSON(http.StatusCreated, commentJSON(cm))
+	}
+}
+
+// updateCommentHandler replaces the body of the requesting user's comment.
+func updateCommentHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			Body string `json:"body"`
+		}
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+		body, ok := commentBody(c, req.Body)
+		if !ok {
+			return
+		}
+		cm, ok := loadOwnComment(c, dbClient)
+		if !ok {
+			return
+		}
+
+		updated, err := cm.Update().SetBody(body).Save(c.Request.Context())
+		if err != nil {
+			log.Printf("update comment %d: %v", cm.ID, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		reindexMedia(c.Request.Context(), queueClient, []string{cm.MediaID})
+		c.JSON(http.StatusOK, commentJSON(updated))
+	}
+}
+
+// deleteCommentHandler deletes the requesting user's comment.
+func deleteCommentHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cm, ok := loadOwnComment(c, dbClient)
+		if !ok {
+			return
+		}
+
+		if err := dbClient.Comment.DeleteOne(cm).Exec(c.Request.Context()); err != nil && !ent.IsNotFound(err) {
+			log.Printf("delete comment %d: %v", cm.ID, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		reindexMedia(c.Request.Context(), queueClient, []string{cm.MediaID})
+		c.JSON(http.StatusOK, gin.H{"id": cm.ID})
+	}
+}
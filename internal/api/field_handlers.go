@@ -0,0 +1,236 @@
+package api
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"slices"
+
+	"era/booru/ent"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+	"era/booru/internal/db"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+func RegisterFieldRoutes(r *gin.Engine, dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) {
+	r.GET("/api/fields", listFieldsHandler(dbClient))
+	r.POST("/api/fields", audit(dbClient, "create_field"), createFieldHandler(dbClient))
+	r.PATCH("/api/fields/:id", audit(dbClient, "update_field"), updateFieldHandler(dbClient))
+	r.DELETE("/api/fields/:id", audit(dbClient, "delete_field"), deleteFieldHandler(dbClient, queueClient))
+	r.PUT("/api/media/:id/fields", setMediaFieldsHandler(dbClient))
+}
+
+// fieldJSON describes a field definition.
+func fieldJSON(f *ent.MetaField) gin.H {
+	out := gin.H{
+		"id":          f.ID,
+		"name":        f.Name,
+		"type":        f.Type,
+		"description": f.Description,
+	}
+	if f.Type == metafield.TypeEnum {
+		out["values"] = f.Values
+	}
+	return out
+}
+
+// abortInvalidField answers 400 for db.ErrInvalidField and reports whether
+// it did.
+func abortInvalidField(c *gin.Context, err error) bool {
+	if errors.Is(err, db.ErrInvalidField) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return true
+	}
+	return false
+}
+
+// listFieldsHandler returns all field definitions by name.
+func listFieldsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fields, err := dbClient.MetaField.Query().
+			Order(ent.Asc(metafield.FieldName)).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list fields: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := make([]gin.H, len(fields))
+		for i, f := range fields {
+			out[i] = fieldJSON(f)
+		}
+		c.JSON(http.StatusOK, gin.H{"fields": out})
+	}
+}
+
+// createFieldHandler defines a new field. Names and types cannot change
+// later.
+func createFieldHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			Name        string   `json:"name"`
+			Type        string   `json:"type"`
+			Values      []string `json:"values"`
+			Description string   `json:"description"`
+		}
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+		typ := metafield.Type(req.Type)
+		if abortInvalidField(c, db.ValidateFieldDef(req.Name, typ, req.Values)) {
+			return
+		}
+		ctx := c.Request.Context()
+
+		exists, err := dbClient.MetaField.Query().Where(metafield.NameEQ(req.Name)).Exist(ctx)
+		if err != nil {
+			log.Printf("check field %s: %v", req.Name, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if exists {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "field already exists"})
+			return
+		}
+
+		f, err := dbClient.MetaField.Create().
+			SetName(req.Name).
+			SetType(typ).
+			SetValues(req.Values).
+			SetDescription(req.Description).
+			Save(ctx)
+		if err != nil {
+			log.Printf("create field %s: %v", req.Name, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusCreated, fieldJSON(f))
+	}
+}
+
+// updateFieldHandler changes the description or the values of an enum
+// field. Values still used by media cannot be removed.
+func updateFieldHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		var req struct {
+			Values      []string `json:"values"`
+			Description *string  `json:"description"`
+		}
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+		ctx := c.Request.Context()
+
+		f, err := dbClient.MetaField.Get(ctx, id)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("get field %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		update := f.Update()
+		if req.Description != nil {
+			update.SetDescription(*req.Description)
+		}
+		if req.Values != nil {
+			if abortInvalidField(c, db.ValidateEnumValues(f.Type, req.Values)) {
+				return
+			}
+			used, err := dbClient.MediaField.Query().Where(mediafield.MetaFieldIDEQ(id)).All(ctx)
+			if err != nil {
+				log.Printf("list values of field %d: %v", id, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			for _, mf := range used {
+				if v, _ := db.DecodeFieldValue(mf.Value).(string); !slices.Contains(req.Values, v) {
+					c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "value " + v + " is still in use"})
+					return
+				}
+			}
+			update.SetValues(req.Values)
+		}
+		f, err = update.Save(ctx)
+		if err != nil {
+			log.Printf("update field %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, fieldJSON(f))
+	}
+}
+
+// deleteFieldHandler deletes a field with all its values and reindexes the
+// media that had one.
+func deleteFieldHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		ctx := c.Request.Context()
+
+		mediaIDs, err := dbClient.MediaField.Query().
+			Where(mediafield.MetaFieldIDEQ(id)).
+			Select(mediafield.FieldMediaID).
+			Strings(ctx)
+		if err != nil {
+			log.Printf("list media of field %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		err = dbClient.MetaField.DeleteOneID(id).Exec(ctx)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("delete field %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		reindexMedia(ctx, queueClient, mediaIDs)
+		c.JSON(http.StatusOK, gin.H{"id": id, "media": len(mediaIDs)})
+	}
+}
+
+// setMediaFieldsHandler sets the named field values of a media item; null
+// removes a value and unnamed fields are left alone.
+func setMediaFieldsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Fields map[string]any `json:"fields"`
+		}
+		id, ok := bindIDAndJSON(c, &body)
+		if !ok {
+			return
+		}
+
+		values, err := db.SetMediaFields(c.Request.Context(), dbClient, id, body.Fields)
+		if abortInvalidField(c, err) {
+			return
+		}
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("set fields %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": id, "fields": values})
+	}
+}
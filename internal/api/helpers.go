@@ -1,12 +1,31 @@
 package api
 
 import (
+	"context"
+	"log"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"era/booru/internal/db"
+	"era/booru/internal/queue"
+
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
 )
 
+// intIDParam extracts a numeric :id parameter, as used by pools, notes and
+// fields, or aborts with 400.
+func intIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
 // idParam extracts the :id parameter and validates it or aborts the request with a 400 Bad Request status.
 func idParam(c *gin.Context) (string, bool) {
 	id := c.Param("id")
@@ -45,6 +64,63 @@ func bindIDAndJSON[T any](c *gin.Context, dst *T) (string, bool) {
 	return id, true
 }
 
+// requestActor names who made a request, as determined by ActorMiddleware:
+// the user authenticated by a trusted reverse proxy if any, else the client
+// address.
+func requestActor(c *gin.Context) string {
+	if actor := c.GetString(actorKey); actor != "" {
+		return actor
+	}
+	return c.ClientIP()
+}
+
+// editContext returns the request context with its actor attached, for
+// calls that record media revisions.
+func editContext(c *gin.Context) context.Context {
+	return db.WithActor(c.Request.Context(), requestActor(c))
+}
+
+// expandQuery resolves "fav:me" in a search expression to the favorites of
+// the requesting user. The name is quoted, so whitespace or query syntax in
+// it stays part of the name; quotes in it are dropped, which does not change
+// the words favorites are matched by.
+func expandQuery(c *gin.Context, expr string) string {
+	if !strings.Contains(expr, "fav:me") {
+		return expr
+	}
+	actor := `"` + strings.ReplaceAll(requestActor(c), `"`, "") + `"`
+	tokens := strings.Fields(expr)
+	for i, t := range tokens {
+		if neg, ok := strings.CutSuffix(t, "fav:me"); ok && (neg == "" || neg == "-") {
+			tokens[i] = neg + "fav:" + actor
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// mediaETag returns the entity tag of a media version.
+func mediaETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// ifMatchVersion parses the media version from an If-Match header set to a
+// previously returned ETag.
+func ifMatchVersion(c *gin.Context) (int, bool) {
+	v, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(c.GetHeader("If-Match"), "W/"), `"`))
+	return v, err == nil
+}
+
+// reindexMedia queues index jobs for media whose indexed relations, such as
+// pools or comments, changed. Failures are logged; the next edit or fsck run
+// repairs the document.
+func reindexMedia(ctx context.Context, queueClient *river.Client[pgx.Tx], ids []string) {
+	for _, id := range ids {
+		if err := queue.Enqueue(ctx, queueClient, queue.IndexArgs{ID: id}); err != nil {
+			log.Printf("enqueue index %s: %v", id, err)
+		}
+	}
+}
+
 // normalizeTags trims, deduplicates and returns clean tag values.
 func normalizeTags(tags []string) []string {
 	seen := map[string]struct{}{}
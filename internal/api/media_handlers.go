@@ -9,59 +9,87 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"era/booru/ent"
+	"era/booru/ent/comment"
 	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
 	"era/booru/ent/mediavector"
+	"era/booru/ent/poolmedia"
+	"era/booru/ent/rendition"
+	"era/booru/ent/source"
 	"era/booru/ent/tag"
 	"era/booru/internal/config"
 	"era/booru/internal/db"
-	"era/booru/internal/minio"
+	"era/booru/internal/embedrpc"
 	"era/booru/internal/queue"
 	"era/booru/internal/search"
+	"era/booru/internal/storage"
 
 	pgvector "github.com/pgvector/pgvector-go"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5"
-	mc "github.com/minio/minio-go/v7"
 	"github.com/riverqueue/river"
 )
 
-func RegisterMediaRoutes(r *gin.Engine, db *ent.Client, m *minio.Client, cfg *config.Config, queueClient *river.Client[pgx.Tx]) {
-	r.GET("/api/media", listMediaHandler(cfg, db, queueClient))
-	r.GET("/api/media/previews", listPreviewsHandler(cfg, db, queueClient))
-	r.GET("/api/media/:id", getMediaHandler(db, m, cfg))
+func RegisterMediaRoutes(r *gin.Engine, db *ent.Client, store storage.Backend, cfg *config.Config, queueClient *river.Client[pgx.Tx], embedder *embedrpc.TextEmbedder) {
+	r.GET("/api/media", listMediaHandler(cfg, db, embedder))
+	r.GET("/api/media/previews", listPreviewsHandler(cfg, db, embedder))
+	r.GET("/api/media/by-source", findBySourceHandler(db))
+	r.GET("/api/media/:id", getMediaHandler(db, store, cfg))
+	r.PATCH("/api/media/:id", updateMediaTextHandler(db))
 	r.POST("/api/media/similar", similarMediaHandler(db, cfg))
-	r.POST("/api/media/upload-url", uploadURLHandler(m, cfg))
+	r.POST("/api/media/bulk", audit(db, "bulk_edit"), bulkEditHandler(queueClient))
+	r.POST("/api/media/upload-url", uploadURLHandler(store))
 	r.POST("/api/media/:id/tags", updateMediaTagsHandler(db))
+	r.PATCH("/api/media/:id/tags", patchMediaTagsHandler(db))
+	r.GET("/api/media/:id/revisions", listRevisionsHandler(db))
+	r.POST("/api/media/:id/revisions/:rev/revert", revertRevisionHandler(db))
 	r.POST("/api/media/:id/dates", updateMediaDatesHandler(db))
+	r.PUT("/api/media/:id/rating", updateRatingHandler(db))
+	r.PUT("/api/media/:id/sources", updateMediaSourcesHandler(db))
+	r.PUT("/api/media/:id/favorite", favoriteHandler(db, true))
+	r.DELETE("/api/media/:id/favorite", favoriteHandler(db, false))
+	r.PUT("/api/media/:id/vote", voteHandler(db))
 	r.POST("/api/media/:id/vectors", updateMediaVectorsHandler(db))
-	r.DELETE("/api/media/:id", deleteMediaHandler(db, m))
+	r.GET("/api/media/:id/suggested-tags", suggestedTagsHandler(db))
+	r.POST("/api/media/:id/transcode", transcodeMediaHandler(db, cfg, queueClient))
+	r.DELETE("/api/media/:id", audit(db, "trash_media"), trashMediaHandler(db, cfg))
 }
 
+// bucketForFormat picks the bucket a listing URL should point at. Videos and
+// audio only have a JPEG in the preview bucket, so they are routed to
+// videoBucket; images are served from pictureBucket.
 func bucketForFormat(format, videoBucket, pictureBucket string) string {
-	switch format {
-	case "mp4", "webm", "avi", "mkv":
+	if config.SupportedVideoFormats[format] || config.SupportedAudioFormats[format] {
 		return videoBucket
-	default:
-		return pictureBucket
 	}
+	return pictureBucket
 }
 
-func listMediaHandler(cfg *config.Config, db *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
-	return listCommon(cfg.MinioPublicPrefix, cfg.MinioBucket, cfg.MinioBucket, db, queueClient)
+func listMediaHandler(cfg *config.Config, db *ent.Client, embedder *embedrpc.TextEmbedder) gin.HandlerFunc {
+	return listCommon(cfg.MinioPublicPrefix, cfg.MinioBucket, cfg.MinioBucket, db, embedder)
 }
 
-func listPreviewsHandler(cfg *config.Config, db *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+func listPreviewsHandler(cfg *config.Config, db *ent.Client, embedder *embedrpc.TextEmbedder) gin.HandlerFunc {
 	//for now, image previews are just original full-size images
-	return listCommon(cfg.MinioPublicPrefix, cfg.PreviewBucket, cfg.MinioBucket, db, queueClient)
+	return listCommon(cfg.MinioPublicPrefix, cfg.PreviewBucket, cfg.MinioBucket, db, embedder)
 }
 
-func listCommon(minioPrefix string, videoBucket string, pictureBucket string, dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+// mediaSortFields maps the sort parameter of media listings to Bleve sort
+// fields. Upload order is served from the database. Similarity searches
+// ignore the parameter.
+var mediaSortFields = map[string][]string{
+	"upload":    nil,
+	"score":     {"-score", "-fav_count", "_id"},
+	"favorites": {"-fav_count", "-score", "_id"},
+}
+
+func listCommon(minioPrefix string, videoBucket string, pictureBucket string, dbClient *ent.Client, embedder *embedrpc.TextEmbedder) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		rawQuery := strings.TrimSpace(c.Query("q"))
+		rawQuery := expandQuery(c, strings.TrimSpace(c.Query("q")))
 		hasTextQuery := rawQuery != ""
 		vectorQueryParamRaw, hasVectorQueryParam := c.GetQuery("vector_q")
 		vectorQueryParam := strings.TrimSpace(vectorQueryParamRaw)
@@ -83,6 +111,7 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 			c.AbortWithStatus(http.StatusInternalServerError)
 			return
 		} else if filterExpr != "" {
+			filterExpr = expandQuery(c, filterExpr)
 			if tagQuery != "" {
 				tagQuery = strings.TrimSpace(tagQuery + " " + filterExpr)
 			} else {
@@ -110,6 +139,11 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 			pageSize = 60
 		}
 		offset := (page - 1) * pageSize
+		sortBy, ok := mediaSortFields[c.DefaultQuery("sort", "upload")]
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "unknown sort"})
+			return
+		}
 
 		var (
 			items []*ent.Media
@@ -134,18 +168,28 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 				// No candidates left after tag filtering; skip vector ordering.
 			} else {
 				requestCtx := c.Request.Context()
-				vectorName := "vision"
+				active, activeErr := db.ActiveVisionVector(requestCtx, dbClient)
+				if activeErr != nil && !ent.IsNotFound(activeErr) {
+					log.Printf("load vision vector: %v", activeErr)
+					c.AbortWithStatus(http.StatusInternalServerError)
+					return
+				}
+				vectorName, model := "", ""
+				if active != nil {
+					vectorName, model = active.Name, active.Model
+				}
 				excludeID := ""
 				var vec []float32
 
 				if strings.HasPrefix(vectorQuery, "media:") {
 					raw := strings.TrimSpace(strings.TrimPrefix(vectorQuery, "media:"))
-					desiredName := "vision"
-					if parts := strings.SplitN(raw, ":", 2); len(parts) == 2 {
-						if parts[0] != "" {
-							desiredName = parts[0]
+					desiredName := vectorName
+					// Vector names contain colons themselves, media IDs do not.
+					if i := strings.LastIndex(raw, ":"); i >= 0 {
+						if i > 0 {
+							desiredName = raw[:i]
 						}
-						raw = parts[1]
+						raw = raw[i+1:]
 					}
 					excludeID = strings.TrimSpace(raw)
 					if desiredName != "" {
@@ -164,7 +208,7 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 						vec = loaded
 					}
 				} else {
-					if queueClient == nil {
+					if embedder == nil {
 						c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "text embedding unavailable"})
 						return
 					}
@@ -172,7 +216,7 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 					defer cancel()
 
 					var embedErr error
-					vec, embedErr = queue.RequestTextEmbedding(ctx, queueClient, vectorQuery)
+					vec, embedErr = embedder.Embed(ctx, vectorQuery, model)
 					if embedErr != nil {
 						log.Printf("text embedding %q failed: %v", vectorQuery, embedErr)
 						status := http.StatusServiceUnavailable
@@ -189,6 +233,10 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 					total = 0
 				} else {
 					items, total, err = search.SimilarMediaByVector(requestCtx, dbClient, vectorName, vec, pageSize, offset, excludeID, includeIDs)
+					if errors.Is(err, search.ErrDimensionMismatch) {
+						c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+						return
+					}
 					if err != nil {
 						log.Printf("vector media search: %v", err)
 						c.AbortWithStatus(http.StatusInternalServerError)
@@ -196,7 +244,7 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 					}
 				}
 			}
-		} else if !hasTextQuery {
+		} else if !hasTextQuery && sortBy == nil {
 			if tagQuery == "" {
 				items, total, err = db.ListMediaByDate(c.Request.Context(), dbClient, "upload", pageSize, offset, nil)
 			} else {
@@ -218,7 +266,7 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 				return
 			}
 		} else {
-			items, total, err = search.SearchMedia(tagQuery, pageSize, offset)
+			items, total, err = search.SearchMedia(tagQuery, pageSize, offset, sortBy...)
 			if err != nil {
 				log.Printf("search media: %v", err)
 				c.AbortWithStatus(http.StatusInternalServerError)
@@ -241,6 +289,9 @@ func listCommon(minioPrefix string, videoBucket string, pictureBucket string, db
 				"height": mitem.Height,
 				"format": mitem.Format,
 			}
+			if mitem.Title != "" {
+				out[i]["title"] = mitem.Title
+			}
 		}
 		c.JSON(http.StatusOK, gin.H{"media": out, "total": total})
 	}
@@ -268,9 +319,6 @@ func loadMediaVectorForSearch(
 	}
 
 	targetName := desiredName
-	if targetName == "" {
-		targetName = "vision"
-	}
 
 	var fallback []float32
 	fallbackName := ""
@@ -312,7 +360,7 @@ func loadMediaVectorForSearch(
 	return nil, "", nil
 }
 
-func getMediaHandler(db *ent.Client, m *minio.Client, cfg *config.Config) gin.HandlerFunc {
+func getMediaHandler(dbClient *ent.Client, store storage.Backend, cfg *config.Config) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		id, ok := idParam(c)
 		if !ok {
@@ -320,7 +368,7 @@ func getMediaHandler(db *ent.Client, m *minio.Client, cfg *config.Config) gin.Ha
 			return
 		}
 
-		item, err := db.Media.Query().Where(media.IDEQ(id)).
+		item, err := dbClient.Media.Query().Where(media.IDEQ(id)).
 			WithTags().
 			WithDates(func(q *ent.DateQuery) {
 				q.WithMediaDates(func(mdq *ent.MediaDateQuery) {
@@ -332,6 +380,7 @@ func getMediaHandler(db *ent.Client, m *minio.Client, cfg *config.Config) gin.Ha
 					mvq.Where(mediavector.MediaIDEQ(id))
 				})
 			}).
+			WithSources(func(q *ent.SourceQuery) { q.Order(ent.Asc(source.FieldID)) }).
 			Only(c.Request.Context())
 		if err != nil {
 			log.Printf("get media %s: %v", id, err)
@@ -339,7 +388,7 @@ func getMediaHandler(db *ent.Client, m *minio.Client, cfg *config.Config) gin.Ha
 			return
 		}
 
-		stat, err := m.StatObject(c.Request.Context(), m.Bucket, string(id), mc.StatObjectOptions{})
+		stat, err := store.Stat(c.Request.Context(), store.Bucket(), string(id))
 		if err != nil {
 			log.Printf("stat object %s: %v", string(id), err)
 			c.AbortWithStatus(http.StatusInternalServerError)
@@ -347,9 +396,41 @@ func getMediaHandler(db *ent.Client, m *minio.Client, cfg *config.Config) gin.Ha
 		}
 
 		url := fmt.Sprintf("%s/%s/%s", cfg.MinioPublicPrefix, cfg.MinioBucket, string(id))
+		previewURL := fmt.Sprintf("%s/%s/%s", cfg.MinioPublicPrefix, bucketForFormat(item.Format, cfg.PreviewBucket, cfg.MinioBucket), string(id))
+		renditions, err := dbClient.Rendition.Query().
+			Where(rendition.MediaIDEQ(id)).
+			Order(ent.Asc(rendition.FieldFormat)).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list renditions %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		// Fall back to the original when no rendition has finished.
+		playableURL := url
+		renditionsOut := make([]gin.H, len(renditions))
+		for i, r := range renditions {
+			out := gin.H{
+				"format":   r.Format,
+				"status":   r.Status,
+				"progress": r.Progress,
+				"size":     r.Size,
+			}
+			if r.Status == rendition.StatusReady {
+				renditionURL := fmt.Sprintf("%s/%s/%s", cfg.MinioPublicPrefix, cfg.PreviewBucket, storage.RenditionKey(id, string(r.Format)))
+				out["url"] = renditionURL
+				if playableURL == url {
+					playableURL = renditionURL
+				}
+			}
+			if r.Error != "" {
+				out["error"] = r.Error
+			}
+			renditionsOut[i] = out
+		}
 		tags := make([]gin.H, len(item.Edges.Tags))
 		for i, t := range item.Edges.Tags {
-			count, err := db.Tag.Query().Where(tag.IDEQ(t.ID)).QueryMedia().Count(c.Request.Context())
+			count, err := dbClient.Tag.Query().Where(tag.IDEQ(t.ID)).QueryMedia().Count(c.Request.Context())
 			if err != nil {
 				log.Printf("count tag %s: %v", t.Name, err)
 				count = 0
@@ -390,17 +471,79 @@ func getMediaHandler(db *ent.Client, m *minio.Client, cfg *config.Config) gin.Ha
 			}
 		}
 
+		memberships, err := dbClient.PoolMedia.Query().
+			Where(poolmedia.MediaIDEQ(id)).
+			WithPool().
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list pools of %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		pools := make([]gin.H, len(memberships))
+		for i, m := range memberships {
+			pools[i] = gin.H{"id": m.PoolID, "name": m.Edges.Pool.Name, "position": m.Position}
+		}
+
+		sources := make([]string, len(item.Edges.Sources))
+		for i, s := range item.Edges.Sources {
+			sources[i] = s.URL
+		}
+
+		commentCount, err := dbClient.Comment.Query().Where(comment.MediaIDEQ(id)).Count(c.Request.Context())
+		if err != nil {
+			log.Printf("count comments %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		fields, err := db.MediaFieldValues(c.Request.Context(), dbClient, id)
+		if err != nil {
+			log.Printf("load fields %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		favorited, vote, err := db.MediaReactions(c.Request.Context(), dbClient, requestActor(c), id)
+		if err != nil {
+			log.Printf("load reactions %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		c.Header("ETag", mediaETag(item.Version))
 		c.JSON(http.StatusOK, gin.H{
-			"id":       item.ID,
-			"url":      url,
-			"width":    item.Width,
-			"height":   item.Height,
-			"format":   item.Format,
-			"duration": item.Duration,
-			"size":     stat.Size,
-			"tags":     tags,
-			"dates":    dates,
-			"vectors":  vectors,
+			"id":            item.ID,
+			"version":       item.Version,
+			"url":           url,
+			"preview_url":   previewURL,
+			"playable_url":  playableURL,
+			"renditions":    renditionsOut,
+			"width":         item.Width,
+			"height":        item.Height,
+			"format":        item.Format,
+			"duration":      item.Duration,
+			"frames":        item.Frames,
+			"bitrate":       item.Bitrate,
+			"video_codec":   item.VideoCodec,
+			"audio_codec":   item.AudioCodec,
+			"fps":           item.Fps,
+			"has_audio":     item.HasAudio,
+			"rotation":      item.Rotation,
+			"deleted_at":    item.DeletedAt,
+			"title":         item.Title,
+			"description":   item.Description,
+			"rating":        item.Rating,
+			"score":         item.Score,
+			"fav_count":     item.FavCount,
+			"favorited":     favorited,
+			"vote":          vote,
+			"pools":         pools,
+			"comment_count": commentCount,
+			"sources":       sources,
+			"fields":        fields,
+			"size":          stat.Size,
+			"tags":          tags,
+			"dates":         dates,
+			"vectors":       vectors,
 		})
 	}
 }
@@ -423,7 +566,17 @@ func similarMediaHandler(dbClient *ent.Client, cfg *config.Config) gin.HandlerFu
 		}
 
 		if body.Name == "" {
-			body.Name = "vision"
+			vt, err := db.ActiveVisionVector(c.Request.Context(), dbClient)
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusOK, gin.H{"media": []gin.H{}})
+				return
+			}
+			if err != nil {
+				log.Printf("load vision vector: %v", err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			body.Name = vt.Name
 		}
 		if body.Limit <= 0 {
 			body.Limit = 5
@@ -452,6 +605,10 @@ func similarMediaHandler(dbClient *ent.Client, cfg *config.Config) gin.HandlerFu
 		}
 
 		results, _, err := search.SimilarMediaByVector(c.Request.Context(), dbClient, body.Name, body.Vector, body.Limit, 0, body.Exclude, includeIDs)
+		if errors.Is(err, search.ErrDimensionMismatch) {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		if err != nil {
 			log.Printf("similar media search: %v", err)
 			c.AbortWithStatus(http.StatusInternalServerError)
@@ -475,7 +632,88 @@ func similarMediaHandler(dbClient *ent.Client, cfg *config.Config) gin.HandlerFu
 	}
 }
 
-func uploadURLHandler(m *minio.Client, cfg *config.Config) gin.HandlerFunc {
+// suggestedTagsHandler ranks the tags of the nearest neighbours of a media
+// item by the vision embedding. "k" sets the number of neighbours (default
+// 20, at most 100) and "limit" the number of suggestions (default 20).
+func suggestedTagsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+		k, err := strconv.Atoi(c.DefaultQuery("k", "20"))
+		if err != nil || k < 1 || k > 100 {
+			k = 20
+		}
+		limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+		if err != nil || limit < 1 {
+			limit = 20
+		}
+
+		suggestions, err := search.SuggestTagsFromNeighbors(c.Request.Context(), dbClient, id, k)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, search.ErrNotEmbedded) {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			log.Printf("suggest tags %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if len(suggestions) > limit {
+			suggestions = suggestions[:limit]
+		}
+		c.JSON(http.StatusOK, gin.H{"id": id, "neighbors": k, "suggestions": suggestions})
+	}
+}
+
+// transcodeMediaHandler queues a browser-playable rendition of a video. The
+// optional "format" defaults to the configured TRANSCODE_FORMAT.
+func transcodeMediaHandler(dbClient *ent.Client, cfg *config.Config, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Format string `json:"format"`
+		}
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+		if c.Request.ContentLength > 0 && !bindJSONOrAbort(c, &body) {
+			return
+		}
+		if body.Format == "" {
+			body.Format = cfg.TranscodeFormat
+		}
+		if err := rendition.FormatValidator(rendition.Format(body.Format)); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		item, err := dbClient.Media.Get(c.Request.Context(), id)
+		if err != nil {
+			log.Printf("get media %s: %v", id, err)
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if !config.SupportedVideoFormats[item.Format] {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "only videos can be transcoded"})
+			return
+		}
+
+		if err := queue.Enqueue(c.Request.Context(), queueClient, queue.TranscodeArgs{Key: id, Format: body.Format}); err != nil {
+			log.Printf("enqueue transcode %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"id": id, "format": body.Format})
+	}
+}
+
+func uploadURLHandler(store storage.Backend) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		type req struct {
 			Filename string `json:"filename"`
@@ -486,7 +724,7 @@ func uploadURLHandler(m *minio.Client, cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		url, err := m.PresignedPut(c.Request.Context(), cfg, body.Filename, time.Minute*15)
+		url, err := store.PresignedPut(c.Request.Context(), body.Filename, time.Minute*15)
 		if err != nil {
 			log.Printf("presign: %v", err)
 			c.AbortWithStatus(http.StatusInternalServerError)
@@ -496,24 +734,183 @@ func uploadURLHandler(m *minio.Client, cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
+// updateMediaTagsHandler replaces all tags of a media item. The edit must be
+// based on the current version, given as If-Match ETag or "version"; a stale
+// version is rejected with 409 so concurrent edits are not silently lost.
 func updateMediaTagsHandler(dbClient *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var body struct {
-			Tags []string `json:"tags"`
+			Tags    []string `json:"tags"`
+			Version *int     `json:"version"`
 		}
 		id, ok := bindIDAndJSON(c, &body)
 		if !ok {
 			return
 		}
+		version, ok := ifMatchVersion(c)
+		if body.Version != nil {
+			version, ok = *body.Version, true
+		}
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusPreconditionRequired, gin.H{"error": "If-Match or version required"})
+			return
+		}
 
 		clean := normalizeTags(body.Tags)
 
-		if err := db.SetMediaTags(c.Request.Context(), dbClient, id, clean); err != nil {
+		item, err := db.SetMediaTags(editContext(c), dbClient, id, clean, version)
+		if errors.Is(err, db.ErrVersionConflict) {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "media was modified, reload and retry"})
+			return
+		}
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
 			log.Printf("update media tags %s: %v", id, err)
 			c.AbortWithStatus(http.StatusInternalServerError)
 			return
 		}
-		c.Status(http.StatusOK)
+		c.Header("ETag", mediaETag(item.Version))
+		c.JSON(http.StatusOK, gin.H{"id": id, "version": item.Version})
+	}
+}
+
+// Limits of the free-form text fields, in characters.
+const (
+	maxTitleLength       = 300
+	maxDescriptionLength = 20000
+)
+
+// updateMediaTextHandler sets the title and/or description of a media item.
+// Omitted fields are left unchanged; empty strings clear them.
+func updateMediaTextHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Title       *string `json:"title"`
+			Description *string `json:"description"`
+		}
+		id, ok := bindIDAndJSON(c, &body)
+		if !ok {
+			return
+		}
+
+		update := dbClient.Media.UpdateOneID(id).Where(media.DeletedAtIsNil())
+		if body.Title != nil {
+			title := strings.TrimSpace(*body.Title)
+			if utf8.RuneCountInString(title) > maxTitleLength {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "title is limited to 300 characters"})
+				return
+			}
+			update.SetTitle(title)
+		}
+		if body.Description != nil {
+			description := strings.TrimSpace(*body.Description)
+			if utf8.RuneCountInString(description) > maxDescriptionLength {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "description is limited to 20000 characters"})
+				return
+			}
+			update.SetDescription(description)
+		}
+		m, err := update.Save(c.Request.Context())
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if ent.IsValidationError(err) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			log.Printf("update media text %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": m.ID, "title": m.Title, "description": m.Description})
+	}
+}
+
+// patchMediaTagsHandler adds and removes individual tags. It needs no
+// version, as it only touches the tags it names.
+func patchMediaTagsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Add    []string `json:"add"`
+			Remove []string `json:"remove"`
+		}
+		id, ok := bindIDAndJSON(c, &body)
+		if !ok {
+			return
+		}
+
+		item, err := db.EditMediaTags(editContext(c), dbClient, id, normalizeTags(body.Add), normalizeTags(body.Remove))
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("patch media tags %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.Header("ETag", mediaETag(item.Version))
+		c.JSON(http.StatusOK, gin.H{"id": id, "version": item.Version})
+	}
+}
+
+// bulkEditHandler queues the same edits for a list of IDs and/or every media
+// matching a search expression. Progress and the summary are reported by
+// GET /api/admin/jobs/:id.
+func bulkEditHandler(queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			IDs        []string `json:"ids"`
+			Query      string   `json:"query"`
+			AddTags    []string `json:"add_tags"`
+			RemoveTags []string `json:"remove_tags"`
+			SetDate    *struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"set_date"`
+			Delete bool `json:"delete"`
+		}
+		if !bindJSONOrAbort(c, &body) {
+			return
+		}
+
+		args := queue.BulkEditArgs{
+			IDs:        body.IDs,
+			Query:      expandQuery(c, strings.TrimSpace(body.Query)),
+			AddTags:    normalizeTags(body.AddTags),
+			RemoveTags: normalizeTags(body.RemoveTags),
+			Delete:     body.Delete,
+			Actor:      requestActor(c),
+		}
+		if len(args.IDs) == 0 && args.Query == "" {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "ids or query required"})
+			return
+		}
+		if body.SetDate != nil {
+			t, err := time.Parse("2006-01-02", body.SetDate.Value)
+			if err != nil || body.SetDate.Name == "" {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "set_date needs a name and a YYYY-MM-DD value"})
+				return
+			}
+			args.SetDate = &queue.BulkDate{Name: body.SetDate.Name, Value: t}
+		}
+		if len(args.AddTags) == 0 && len(args.RemoveTags) == 0 && args.SetDate == nil && !args.Delete {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "no operation given"})
+			return
+		}
+
+		job, err := queue.EnqueueJob(c.Request.Context(), queueClient, args)
+		if err != nil {
+			log.Printf("enqueue bulk edit: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"job_id": job.ID})
 	}
 }
 
@@ -541,7 +938,7 @@ func updateMediaDatesHandler(dbClient *ent.Client) gin.HandlerFunc {
 			vals = append(vals, db.DateValue{Name: d.Name, Value: t})
 		}
 
-		if err := db.SetMediaDates(c.Request.Context(), dbClient, id, vals); err != nil {
+		if err := db.SetMediaDates(editContext(c), dbClient, id, vals); err != nil {
 			log.Printf("update media dates %s: %v", id, err)
 			c.AbortWithStatus(http.StatusInternalServerError)
 			return
@@ -577,25 +974,30 @@ func updateMediaVectorsHandler(dbClient *ent.Client) gin.HandlerFunc {
 	}
 }
 
-func deleteMediaHandler(db *ent.Client, m *minio.Client) gin.HandlerFunc {
+// trashMediaHandler moves a media item to the trash. It disappears from
+// listings, search and similarity until restored or purged.
+func trashMediaHandler(dbClient *ent.Client, cfg *config.Config) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		id, ok := idParam(c)
 		if !ok {
 			return
 		}
 
-		if err := m.RemoveObject(c.Request.Context(), m.Bucket, string(id), mc.RemoveObjectOptions{}); err != nil {
-			log.Printf("remove object %s: %v", string(id), err)
-			c.AbortWithStatus(http.StatusInternalServerError)
+		item, err := dbClient.Media.Get(c.Request.Context(), id)
+		if err != nil {
+			log.Printf("get media %s: %v", id, err)
+			c.AbortWithStatus(http.StatusNotFound)
 			return
 		}
-
-		if err := db.Media.DeleteOneID(id).Exec(c.Request.Context()); err != nil {
-			log.Printf("delete media %s: %v", id, err)
-			c.AbortWithStatus(http.StatusInternalServerError)
-			return
+		if item.DeletedAt == nil {
+			item, err = dbClient.Media.UpdateOne(item).SetDeletedAt(time.Now()).Save(c.Request.Context())
+			if err != nil {
+				log.Printf("trash media %s: %v", id, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
 		}
 
-		c.Status(http.StatusOK)
+		c.JSON(http.StatusOK, trashEntry(item, cfg))
 	}
 }
@@ -2,6 +2,9 @@ package api
 
 import (
 	"net/http"
+	"net/netip"
+
+	"era/booru/internal/config"
 
 	"github.com/gin-gonic/gin"
 )
@@ -9,8 +12,9 @@ import (
 func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, If-Match")
+		c.Header("Access-Control-Expose-Headers", "ETag")
 		c.Header("Access-Control-Allow-Credentials", "true")
 
 		if c.Request.Method == http.MethodOptions {
@@ -31,3 +35,50 @@ func GinLogger() gin.HandlerFunc {
 		SkipPaths: skipPaths,
 	})
 }
+
+// actorKey holds the actor of a request in the gin context.
+const actorKey = "actor"
+
+// ActorMiddleware records who made each request: the user named in
+// cfg.ProxyUserHeader when the request comes straight from one of
+// cfg.TrustedProxies, else the client address. Anyone can send the header,
+// so it means nothing from other peers.
+func ActorMiddleware(cfg *config.Config) gin.HandlerFunc {
+	var trusted []netip.Prefix
+	for _, p := range cfg.TrustedProxies {
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			addr, err := netip.ParseAddr(p)
+			if err != nil {
+				continue // rejected by config.Load
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		trusted = append(trusted, prefix)
+	}
+	fromProxy := func(c *gin.Context) bool {
+		addr, err := netip.ParseAddr(c.RemoteIP())
+		if err != nil {
+			return false
+		}
+		addr = addr.Unmap()
+		for _, p := range trusted {
+			if p.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(c *gin.Context) {
+		actor := ""
+		if cfg.ProxyUserHeader != "" && fromProxy(c) {
+			actor = c.GetHeader(cfg.ProxyUserHeader)
+		}
+		if actor == "" {
+			actor = c.ClientIP()
+		}
+		c.Set(actorKey, actor)
+		c.Next()
+	}
+}
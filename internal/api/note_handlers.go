@@ -0,0 +1,407 @@
+package api
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/note"
+	"era/booru/internal/db"
+	"era/booru/internal/queue"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+func RegisterNoteRoutes(r *gin.Engine, dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) {
+	r.GET("/api/media/:id/notes", listNotesHandler(dbClient))
+	r.POST("/api/media/:id/notes", createNoteHandler(dbClient, queueClient))
+	r.PATCH("/api/notes/:id", updateNoteHandler(dbClient, queueClient))
+	r.DELETE("/api/notes/:id", deleteNoteHandler(dbClient))
+	r.POST("/api/notes/:id/embed", embedNoteHandler(dbClient, queueClient))
+	r.GET("/api/notes/:id/similar", similarNotesHandler(dbClient))
+}
+
+// noteRequest is the body of note creation and updates. Omitted fields are
+// left unchanged on update.
+type noteRequest struct {
+	Kind   *string  `json:"kind"`
+	X      *float64 `json:"x"`
+	Y      *float64 `json:"y"`
+	Width  *float64 `json:"width"`
+	Height *float64 `json:"height"`
+	Text   *string  `json:"text"`
+	// Tag links the region to a tag by name; an empty name removes the link.
+	Tag *string `json:"tag"`
+	// Embed queues a vision embedding of the region.
+	Embed bool `json:"embed"`
+}
+
+// noteJSON describes a note. The embedding itself is not returned.
+func noteJSON(n *ent.Note) gin.H {
+	out := gin.H{
+		"id":         n.ID,
+		"media_id":   n.MediaID,
+		"kind":       n.Kind,
+		"x":          n.X,
+		"y":          n.Y,
+		"width":      n.Width,
+		"height":     n.Height,
+		"text":       n.Text,
+		"author":     n.Author,
+		"embedded":   n.Embedding != nil,
+		"created_at": n.CreatedAt,
+		"updated_at": n.UpdatedAt,
+	}
+	if n.Edges.Tag != nil {
+		out["tag"] = n.Edges.Tag.Name
+	}
+	return out
+}
+
+// validRegion reports whether a rectangle lies inside the image.
+func validRegion(x, y, w, h float64) bool {
+	return x >= 0 && y >= 0 && w > 0 && h > 0 && x+w <= 1 && y+h <= 1
+}
+
+// noteTagID resolves the tag link of a note request, creating the tag if
+// needed. An empty name yields nil, which clears the link.
+func noteTagID(ctx context.Context, dbClient *ent.Client, name string) (*int, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil
+	}
+	tg, err := db.FindOrCreateTag(ctx, dbClient, name)
+	if err != nil {
+		return nil, err
+	}
+	return &tg.ID, nil
+}
+
+// loadNote returns a note with its tag.
+func loadNote(ctx context.Context, dbClient *ent.Client, id int) (*ent.Note, error) {
+	return dbClient.Note.Query().Where(note.IDEQ(id)).WithTag().Only(ctx)
+}
+
+// listNotesHandler returns the notes of a media item.
+func listNotesHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+
+		notes, err := dbClient.Note.Query().
+			Where(note.MediaIDEQ(id)).
+			WithTag().
+			Order(ent.Asc(note.FieldID)).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list notes %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := make([]gin.H, len(notes))
+		for i, n := range notes {
+			out[i] = noteJSON(n)
+		}
+		c.JSON(http.StatusOK, gin.H{"notes": out})
+	}
+}
+
+// createNoteHandler adds a note to a media item outside the trash.
+func createNoteHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req noteRequest
+		id, ok := bindIDAndJSON(c, &req)
+		if !ok {
+			return
+		}
+		if req.X == nil || req.Y == nil || req.Width == nil || req.Height == nil ||
+			!validRegion(*req.X, *req.Y, *req.Width, *req.Height) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "region must lie within the image (0..1)"})
+			return
+		}
+		ctx := c.Request.Context()
+
+		exists, err := dbClient.Media.Query().Where(media.IDEQ(id), media.DeletedAtIsNil()).Exist(ctx)
+		if err != nil {
+			log.Printf("check media %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if !exists {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		create := dbClient.Note.Create().
+			SetMediaID(id).
+			SetX(*req.X).SetY(*req.Y).
+			SetWidth(*req.Width).SetHeight(*req.Height).
+			SetAuthor(requestActor(c))
+		if req.Kind != nil {
+			kind := note.Kind(*req.Kind)
+			if err := note.KindValidator(kind); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			create.SetKind(kind)
+		}
+		if req.Text != nil {
+			create.SetText(*req.Text)
+		}
+		if req.Tag != nil {
+			tagID, err := noteTagID(ctx, dbClient, *req.Tag)
+			if err != nil {
+				log.Printf("note tag %q: %v", *req.Tag, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			create.SetNillableTagID(tagID)
+		}
+		n, err := create.Save(ctx)
+		if err != nil {
+			log.Printf("create note %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if req.Embed {
+			if _, err := enqueueRegionEmbedding(ctx, dbClient, queueClient, n.ID); err != nil {
+				log.Printf("enqueue region embedding %d: %v", n.ID, err)
+			}
+		}
+
+		n, err = loadNote(ctx, dbClient, n.ID)
+		if err != nil {
+			log.Printf("load note: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusCreated, noteJSON(n))
+	}
+}
+
+// updateNoteHandler changes a note. Moving or resizing the region drops its
+// embedding, which is recomputed if it existed or embed is set.
+func updateNoteHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		var req noteRequest
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+		ctx := c.Request.Context()
+
+		n, err := dbClient.Note.Get(ctx, id)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("get note %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		x, y, w, h := n.X, n.Y, n.Width, n.Height
+		for _, f := range []struct {
+			dst *float64
+			src *float64
+		}{{&x, req.X}, {&y, req.Y}, {&w, req.Width}, {&h, req.Height}} {
+			if f.src != nil {
+				*f.dst = *f.src
+			}
+		}
+		if !validRegion(x, y, w, h) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "region must lie within the image (0..1)"})
+			return
+		}
+		moved := x != n.X || y != n.Y || w != n.Width || h != n.Height
+
+		update := n.Update().SetX(x).SetY(y).SetWidth(w).SetHeight(h)
+		if moved {
+			update.ClearEmbedding().ClearEmbeddingModel()
+		}
+		if req.Kind != nil {
+			kind := note.Kind(*req.Kind)
+			if err := note.KindValidator(kind); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			update.SetKind(kind)
+		}
+		if req.Text != nil {
+			update.SetText(*req.Text)
+		}
+		if req.Tag != nil {
+			tagID, err := noteTagID(ctx, dbClient, *req.Tag)
+			if err != nil {
+				log.Printf("note tag %q: %v", *req.Tag, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			if tagID == nil {
+				update.ClearTagID()
+			} else {
+				update.SetTagID(*tagID)
+			}
+		}
+		if err := update.Exec(ctx); err != nil {
+			log.Printf("update note %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if req.Embed || (moved && n.Embedding != nil) {
+			if _, err := enqueueRegionEmbedding(ctx, dbClient, queueClient, id); err != nil {
+				log.Printf("enqueue region embedding %d: %v", id, err)
+			}
+		}
+
+		n, err = loadNote(ctx, dbClient, id)
+		if err != nil {
+			log.Printf("load note %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, noteJSON(n))
+	}
+}
+
+// deleteNoteHandler deletes a note.
+func deleteNoteHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		err := dbClient.Note.DeleteOneID(id).Exec(c.Request.Context())
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("delete note %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": id})
+	}
+}
+
+// embedNoteHandler queues a vision embedding of a note's region.
+func embedNoteHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		exists, err := dbClient.Note.Query().Where(note.IDEQ(id)).Exist(c.Request.Context())
+		if err != nil {
+			log.Printf("check note %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if !exists {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		job, err := enqueueRegionEmbedding(c.Request.Context(), dbClient, queueClient, id)
+		if err != nil {
+			log.Printf("enqueue region embedding %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"id": id, "job_id": job.ID})
+	}
+}
+
+// enqueueRegionEmbedding queues a vision embedding of a note's region with
+// the active vision model, which similar image search uses too. Any worker
+// takes the job while no model has registered its vector.
+func enqueueRegionEmbedding(ctx context.Context, dbClient *ent.Client, queueClient *river.Client[pgx.Tx], noteID int) (*rivertype.JobRow, error) {
+	args := queue.EmbedRegionArgs{NoteID: noteID}
+	vt, err := db.ActiveVisionVector(ctx, dbClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if vt != nil {
+		args.Model = vt.Model
+	}
+	return queue.EnqueueJob(ctx, queueClient, args)
+}
+
+// similarNotesHandler returns the embedded regions closest to a note's
+// region, on media outside the trash. Only regions embedded by the same
+// model are comparable.
+func similarNotesHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+		if err != nil || limit < 1 || limit > 100 {
+			limit = 20
+		}
+		ctx := c.Request.Context()
+
+		n, err := dbClient.Note.Get(ctx, id)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("get note %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if n.Embedding == nil {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "note region is not embedded"})
+			return
+		}
+
+		sameModel := note.EmbeddingModelIsNil()
+		if n.EmbeddingModel != nil {
+			sameModel = note.EmbeddingModelEQ(*n.EmbeddingModel)
+		}
+		notes, err := dbClient.Note.Query().
+			Where(
+				note.IDNEQ(id),
+				note.EmbeddingNotNil(),
+				sameModel,
+				note.HasMediaWith(media.DeletedAtIsNil()),
+			).
+			Order(func(s *sql.Selector) {
+				s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString(s.C(note.FieldEmbedding))
+					b.WriteString(" <#> ")
+					b.Arg(*n.Embedding)
+				}))
+			}).
+			WithTag().
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			log.Printf("similar notes %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := make([]gin.H, len(notes))
+		for i, sim := range notes {
+			out[i] = noteJSON(sim)
+		}
+		c.JSON(http.StatusOK, gin.H{"notes": out})
+	}
+}
@@ -0,0 +1,449 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/pool"
+	"era/booru/ent/poolmedia"
+	"era/booru/internal/config"
+	"era/booru/internal/db"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// errNotPermutation is returned when a new pool order does not list every
+// member exactly once.
+var errNotPermutation = errors.New("order must list every member exactly once")
+
+func RegisterPoolRoutes(r *gin.Engine, dbClient *ent.Client, cfg *config.Config, queueClient *river.Client[pgx.Tx]) {
+	r.GET("/api/pools", listPoolsHandler(dbClient, cfg))
+	r.POST("/api/pools", createPoolHandler(dbClient))
+	r.GET("/api/pools/:id", getPoolHandler(dbClient, cfg))
+	r.PATCH("/api/pools/:id", updatePoolHandler(dbClient))
+	r.DELETE("/api/pools/:id", audit(dbClient, "delete_pool"), deletePoolHandler(dbClient, queueClient))
+	r.POST("/api/pools/:id/media", addPoolMediaHandler(dbClient, queueClient))
+	r.DELETE("/api/pools/:id/media/:media_id", removePoolMediaHandler(dbClient, queueClient))
+	r.PUT("/api/pools/:id/order", reorderPoolHandler(dbClient))
+}
+
+// poolSummary describes a pool with its member count and thumbnail. Trashed
+// members are not counted and never used as the thumbnail.
+func poolSummary(ctx context.Context, dbClient *ent.Client, cfg *config.Config, p *ent.Pool) (gin.H, error) {
+	members := dbClient.PoolMedia.Query().
+		Where(poolmedia.PoolIDEQ(p.ID), poolmedia.HasMediaWith(media.DeletedAtIsNil()))
+	count, err := members.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cover *ent.Media
+	if p.CoverID != nil {
+		cover, err = dbClient.Media.Query().
+			Where(media.IDEQ(*p.CoverID), media.DeletedAtIsNil()).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	if cover == nil {
+		first, err := members.Order(poolmedia.ByPosition()).WithMedia().First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if first != nil {
+			cover = first.Edges.Media
+		}
+	}
+
+	out := gin.H{
+		"id":          p.ID,
+		"name":        p.Name,
+		"description": p.Description,
+		"cover_id":    p.CoverID,
+		"count":       count,
+		"created_at":  p.CreatedAt,
+		"updated_at":  p.UpdatedAt,
+	}
+	if cover != nil {
+		bucket := bucketForFormat(cover.Format, cfg.PreviewBucket, cfg.MinioBucket)
+		out["cover_url"] = fmt.Sprintf("%s/%s/%s", cfg.MinioPublicPrefix, bucket, cover.ID)
+	}
+	return out, nil
+}
+
+// listPoolsHandler lists pools, most recently changed first.
+func listPoolsHandler(dbClient *ent.Client, cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "60"))
+		if err != nil || pageSize < 1 || pageSize > 60 {
+			pageSize = 60
+		}
+
+		query := dbClient.Pool.Query()
+		if name := strings.TrimSpace(c.Query("q")); name != "" {
+			query = query.Where(pool.NameContainsFold(name))
+		}
+		total, err := query.Clone().Count(c.Request.Context())
+		if err != nil {
+			log.Printf("count pools: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		pools, err := query.
+			Order(ent.Desc(pool.FieldUpdatedAt), ent.Desc(pool.FieldID)).
+			Offset((page - 1) * pageSize).
+			Limit(pageSize).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list pools: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		out := make([]gin.H, len(pools))
+		for i, p := range pools {
+			out[i], err = poolSummary(c.Request.Context(), dbClient, cfg, p)
+			if err != nil {
+				log.Printf("summarize pool %d: %v", p.ID, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+		}
+		c.JSON(http.StatusOK, gin.H{"pools": out, "total": total})
+	}
+}
+
+type poolRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	CoverID     *string `json:"cover_id"`
+}
+
+// createPoolHandler creates an empty pool.
+func createPoolHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req poolRequest
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+		if req.Name == nil || strings.TrimSpace(*req.Name) == "" {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		create := dbClient.Pool.Create().SetName(strings.TrimSpace(*req.Name))
+		if req.Description != nil {
+			create.SetDescription(*req.Description)
+		}
+		p, err := create.Save(c.Request.Context())
+		if err != nil {
+			log.Printf("create pool: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"id": p.ID, "name": p.Name, "description": p.Description})
+	}
+}
+
+// getPoolHandler returns a pool and its members in order. Trashed members
+// keep their place but are not listed.
+func getPoolHandler(dbClient *ent.Client, cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+
+		p, err := dbClient.Pool.Get(c.Request.Context(), id)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("get pool %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out, err := poolSummary(c.Request.Context(), dbClient, cfg, p)
+		if err != nil {
+			log.Printf("summarize pool %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		members, err := dbClient.PoolMedia.Query().
+			Where(poolmedia.PoolIDEQ(id), poolmedia.HasMediaWith(media.DeletedAtIsNil())).
+			Order(poolmedia.ByPosition()).
+			WithMedia().
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list pool %d members: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		items := make([]gin.H, len(members))
+		for i, m := range members {
+			item := m.Edges.Media
+			bucket := bucketForFormat(item.Format, cfg.PreviewBucket, cfg.MinioBucket)
+			items[i] = gin.H{
+				"id":       item.ID,
+				"url":      fmt.Sprintf("%s/%s/%s", cfg.MinioPublicPrefix, bucket, item.ID),
+				"width":    item.Width,
+				"height":   item.Height,
+				"format":   item.Format,
+				"position": m.Position,
+			}
+		}
+		out["media"] = items
+		c.JSON(http.StatusOK, out)
+	}
+}
+
+// updatePoolHandler changes the name, description or cover of a pool. An
+// empty cover_id falls back to the first member.
+func updatePoolHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		var req poolRequest
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+
+		update := dbClient.Pool.UpdateOneID(id)
+		if req.Name != nil {
+			name := strings.TrimSpace(*req.Name)
+			if name == "" {
+				c.AbortWithStatus(http.StatusBadRequest)
+				return
+			}
+			update.SetName(name)
+		}
+		if req.Description != nil {
+			update.SetDescription(*req.Description)
+		}
+		if req.CoverID != nil {
+			if *req.CoverID == "" {
+				update.ClearCoverID()
+			} else {
+				member, err := dbClient.PoolMedia.Query().
+					Where(poolmedia.PoolIDEQ(id), poolmedia.MediaIDEQ(*req.CoverID)).
+					Exist(c.Request.Context())
+				if err != nil {
+					log.Printf("check pool %d member: %v", id, err)
+					c.AbortWithStatus(http.StatusInternalServerError)
+					return
+				}
+				if !member {
+					c.JSON(http.StatusBadRequest, gin.H{"error": "cover must be a member of the pool"})
+					return
+				}
+				update.SetCoverID(*req.CoverID)
+			}
+		}
+
+		p, err := update.Save(c.Request.Context())
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("update pool %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": p.ID, "name": p.Name, "description": p.Description, "cover_id": p.CoverID})
+	}
+}
+
+// deletePoolHandler deletes a pool. Its media are kept and reindexed
+// without it.
+func deletePoolHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+
+		ids, err := db.PoolMediaIDs(c.Request.Context(), dbClient, id)
+		if err != nil {
+			log.Printf("list pool %d members: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		err = dbClient.Pool.DeleteOneID(id).Exec(c.Request.Context())
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("delete pool %d: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		reindexMedia(c.Request.Context(), queueClient, ids)
+		c.JSON(http.StatusOK, gin.H{"id": id})
+	}
+}
+
+// updatePoolMembers applies change to the members of a pool and writes the
+// response, or aborts with the matching status.
+func updatePoolMembers(c *gin.Context, dbClient *ent.Client, id int, change func(ids []string) ([]string, error)) (before, after []string, ok bool) {
+	before, after, err := db.UpdatePoolMembers(c.Request.Context(), dbClient, id, change)
+	switch {
+	case ent.IsNotFound(err):
+		c.AbortWithStatus(http.StatusNotFound)
+		return nil, nil, false
+	case errors.Is(err, db.ErrUnknownMedia), errors.Is(err, errNotPermutation):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, nil, false
+	case err != nil:
+		log.Printf("update pool %d members: %v", id, err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return nil, nil, false
+	}
+	c.JSON(http.StatusOK, gin.H{"id": id, "media": after})
+	return before, after, true
+}
+
+type addPoolMediaRequest struct {
+	IDs []string `json:"ids"`
+	// Position is where the media are inserted; they are appended if unset.
+	Position *int `json:"position"`
+}
+
+// addPoolMediaHandler inserts media into a pool. Media already in the pool
+// are moved to the new position.
+func addPoolMediaHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		var req addPoolMediaRequest
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+		if len(req.IDs) == 0 {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		before, _, ok := updatePoolMembers(c, dbClient, id, func(ids []string) ([]string, error) {
+			ids = slices.DeleteFunc(ids, func(m string) bool { return slices.Contains(req.IDs, m) })
+			pos := len(ids)
+			if req.Position != nil {
+				pos = max(0, min(*req.Position, len(ids)))
+			}
+			return slices.Insert(ids, pos, req.IDs...), nil
+		})
+		if !ok {
+			return
+		}
+		added := slices.DeleteFunc(slices.Clone(req.IDs), func(m string) bool { return slices.Contains(before, m) })
+		reindexMedia(c.Request.Context(), queueClient, added)
+	}
+}
+
+// removePoolMediaHandler removes a media item from a pool.
+func removePoolMediaHandler(dbClient *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		mediaID := c.Param("media_id")
+
+		exists, err := dbClient.PoolMedia.Query().
+			Where(poolmedia.PoolIDEQ(id), poolmedia.MediaIDEQ(mediaID)).
+			Exist(c.Request.Context())
+		if err != nil {
+			log.Printf("check pool %d member: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if !exists {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		if _, _, ok := updatePoolMembers(c, dbClient, id, func(ids []string) ([]string, error) {
+			return slices.DeleteFunc(ids, func(m string) bool { return m == mediaID }), nil
+		}); !ok {
+			return
+		}
+		if err := dbClient.Pool.Update().
+			Where(pool.IDEQ(id), pool.CoverIDEQ(mediaID)).
+			ClearCoverID().
+			Exec(c.Request.Context()); err != nil {
+			log.Printf("clear pool %d cover: %v", id, err)
+		}
+		reindexMedia(c.Request.Context(), queueClient, []string{mediaID})
+	}
+}
+
+type reorderPoolRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// reorderPoolHandler sets the order of a pool's members. The request lists
+// the members returned by getPoolHandler; trashed members, which are not
+// listed, move to the end.
+func reorderPoolHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := intIDParam(c)
+		if !ok {
+			return
+		}
+		var req reorderPoolRequest
+		if !bindJSONOrAbort(c, &req) {
+			return
+		}
+
+		trashed, err := dbClient.PoolMedia.Query().
+			Where(poolmedia.PoolIDEQ(id), poolmedia.HasMediaWith(media.DeletedAtNotNil())).
+			Order(poolmedia.ByPosition()).
+			Select(poolmedia.FieldMediaID).
+			Strings(c.Request.Context())
+		if err != nil {
+			log.Printf("list pool %d trashed members: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		updatePoolMembers(c, dbClient, id, func(ids []string) ([]string, error) {
+			order := append(slices.Clone(req.IDs), trashed...)
+			if len(order) != len(ids) {
+				return nil, errNotPermutation
+			}
+			current := make(map[string]bool, len(ids))
+			for _, m := range ids {
+				current[m] = true
+			}
+			for _, m := range order {
+				if !current[m] {
+					return nil, errNotPermutation
+				}
+				delete(current, m)
+			}
+			return order, nil
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"log"
+	"net/http"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/internal/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+// reactionResponse reports the favorite count and score of a media item
+// along with the reactions of the requesting user.
+func reactionResponse(c *gin.Context, dbClient *ent.Client, m *ent.Media) {
+	fav, vote, err := db.MediaReactions(c.Request.Context(), dbClient, requestActor(c), m.ID)
+	if err != nil {
+		log.Printf("load reactions %s: %v", m.ID, err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"id":        m.ID,
+		"fav_count": m.FavCount,
+		"score":     m.Score,
+		"favorited": fav,
+		"vote":      vote,
+	})
+}
+
+// favoriteHandler adds a media item to the requesting user's favorites, or
+// removes it when fav is false.
+func favoriteHandler(dbClient *ent.Client, fav bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+
+		m, err := db.SetFavorite(c.Request.Context(), dbClient, requestActor(c), id, fav)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("set favorite %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		reactionResponse(c, dbClient, m)
+	}
+}
+
+// voteHandler records the requesting user's vote: 1 up, -1 down, 0 none.
+func voteHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Value *int `json:"value"`
+		}
+		id, ok := bindIDAndJSON(c, &body)
+		if !ok {
+			return
+		}
+		if body.Value == nil || *body.Value < -1 || *body.Value > 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "value must be -1, 0 or 1"})
+			return
+		}
+
+		m, err := db.SetVote(c.Request.Context(), dbClient, requestActor(c), id, *body.Value)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("set vote %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		reactionResponse(c, dbClient, m)
+	}
+}
+
+// updateRatingHandler sets the content rating of a media item. An empty
+// rating marks it as unrated.
+func updateRatingHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Rating string `json:"rating"`
+		}
+		id, ok := bindIDAndJSON(c, &body)
+		if !ok {
+			return
+		}
+
+		update := dbClient.Media.UpdateOneID(id).Where(media.DeletedAtIsNil())
+		if body.Rating == "" {
+			update.ClearRating()
+		} else {
+			rating := media.Rating(body.Rating)
+			if err := media.RatingValidator(rating); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			update.SetRating(rating)
+		}
+		m, err := update.Save(c.Request.Context())
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("set rating %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": m.ID, "rating": m.Rating})
+	}
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"era/booru/ent"
+	"era/booru/ent/mediarevision"
+	"era/booru/internal/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+// listRevisionsHandler returns the edit history of a media item, newest first.
+func listRevisionsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+
+		revisions, err := dbClient.MediaRevision.Query().
+			Where(mediarevision.MediaIDEQ(id)).
+			Order(ent.Desc(mediarevision.FieldCreatedAt), ent.Desc(mediarevision.FieldID)).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list revisions %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		out := make([]gin.H, len(revisions))
+		for i, r := range revisions {
+			out[i] = gin.H{
+				"id":           r.ID,
+				"action":       r.Action,
+				"actor":        r.Actor,
+				"version":      r.Version,
+				"created_at":   r.CreatedAt,
+				"tags_before":  r.TagsBefore,
+				"tags_after":   r.TagsAfter,
+				"dates_before": r.DatesBefore,
+				"dates_after":  r.DatesAfter,
+			}
+		}
+		c.JSON(http.StatusOK, gin.H{"revisions": out})
+	}
+}
+
+// revertRevisionHandler restores the tags and dates a media item had right
+// after the given revision.
+func revertRevisionHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+		revisionID, err := strconv.Atoi(c.Param("rev"))
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		item, err := db.RevertMedia(editContext(c), dbClient, id, revisionID)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("revert media %s to revision %d: %v", id, revisionID, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.Header("ETag", mediaETag(item.Version))
+		c.JSON(http.StatusOK, gin.H{"id": id, "version": item.Version})
+	}
+}
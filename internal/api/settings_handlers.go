@@ -15,9 +15,9 @@ import (
 func RegisterSettingsRoutes(r *gin.Engine, dbClient *ent.Client) {
 	group := r.Group("/api/settings")
 	group.GET("/hidden-tags", listHiddenTagFiltersHandler(dbClient))
-	group.POST("/hidden-tags", createHiddenTagFilterHandler(dbClient))
-	group.POST("/hidden-tags/:id/select", selectHiddenTagFilterHandler(dbClient))
-	group.DELETE("/hidden-tags/:id", deleteHiddenTagFilterHandler(dbClient))
+	group.POST("/hidden-tags", audit(dbClient, "create_hidden_filter"), createHiddenTagFilterHandler(dbClient))
+	group.POST("/hidden-tags/:id/select", audit(dbClient, "select_hidden_filter"), selectHiddenTagFilterHandler(dbClient))
+	group.DELETE("/hidden-tags/:id", audit(dbClient, "delete_hidden_filter"), deleteHiddenTagFilterHandler(dbClient))
 }
 
 func listHiddenTagFiltersHandler(dbClient *ent.Client) gin.HandlerFunc {
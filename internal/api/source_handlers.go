@@ -0,0 +1,84 @@
+package api
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"slices"
+
+	"era/booru/ent"
+	"era/booru/internal/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxSourceLookups bounds the URLs checked by one by-source request.
+const maxSourceLookups = 50
+
+// updateMediaSourcesHandler replaces the source URLs of a media item.
+func updateMediaSourcesHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Sources []string `json:"sources"`
+		}
+		id, ok := bindIDAndJSON(c, &body)
+		if !ok {
+			return
+		}
+
+		sources, err := db.SetMediaSources(c.Request.Context(), dbClient, id, body.Sources, false)
+		if errors.Is(err, db.ErrInvalidSource) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("set sources %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		urls := make([]string, len(sources))
+		for i, s := range sources {
+			urls[i] = s.URL
+		}
+		c.JSON(http.StatusOK, gin.H{"id": id, "sources": urls})
+	}
+}
+
+// findBySourceHandler reports which media were obtained from the given
+// "url" parameters, so clients can check whether a page is archived already.
+func findBySourceHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		urls := c.QueryArray("url")
+		if len(urls) == 0 || len(urls) > maxSourceLookups {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "1 to 50 url parameters required"})
+			return
+		}
+
+		results := make([]gin.H, len(urls))
+		for i, raw := range urls {
+			normalized, sources, err := db.FindMediaBySource(c.Request.Context(), dbClient, raw)
+			if errors.Is(err, db.ErrInvalidSource) {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			if err != nil {
+				log.Printf("find by source %q: %v", raw, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			ids := make([]string, 0, len(sources))
+			for _, s := range sources {
+				ids = append(ids, s.MediaID)
+			}
+			// Sources are ordered by media, and a media item may have both
+			// the http and https form of a URL.
+			ids = slices.Compact(ids)
+			results[i] = gin.H{"url": normalized, "archived": len(ids) > 0, "media": ids}
+		}
+		c.JSON(http.StatusOK, gin.H{"results": results})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"era/booru/internal/assets"
+	"era/booru/internal/config"
+	"era/booru/internal/storage"
 
 	"github.com/gin-gonic/gin"
 )
@@ -19,6 +21,17 @@ func RegisterStaticRoutes(r *gin.Engine) {
 	r.NoRoute(serveIndex(assets.UI))
 }
 
+// RegisterStorageRoutes serves objects under the public prefix for storage
+// backends that have no server of their own, such as the filesystem backend.
+func RegisterStorageRoutes(r *gin.Engine, store storage.Backend, cfg *config.Config) {
+	h, ok := store.(http.Handler)
+	if !ok {
+		return
+	}
+	prefix := strings.TrimRight(cfg.MinioPublicPrefix, "/")
+	r.Any(prefix+"/*object", gin.WrapH(http.StripPrefix(prefix, h)))
+}
+
 func serveStatic(c *gin.Context) {
 	path := "build" + c.Request.URL.Path
 
@@ -1,14 +1,20 @@
 package api
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 
 	"era/booru/ent"
 	"era/booru/ent/media"
 	"era/booru/ent/tag"
+	"era/booru/internal/config"
+	"era/booru/internal/db"
+	"era/booru/internal/search"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
@@ -19,9 +25,11 @@ type tagSummary struct {
 	Count int    `json:"count"`
 }
 
-func RegisterTagRoutes(r *gin.Engine, db *ent.Client) {
+func RegisterTagRoutes(r *gin.Engine, db *ent.Client, cfg *config.Config) {
 	r.GET("/api/tags", listTagsHandler(db))
 	r.GET("/api/tags/suggest", suggestTagsHandler(db))
+	r.GET("/api/tags/similar", similarTagsHandler(db))
+	r.GET("/api/tags/missing", missingTagHandler(db, cfg))
 }
 
 func listTagsHandler(db *ent.Client) gin.HandlerFunc {
@@ -84,3 +92,92 @@ func makeTagSummaries(tags []*ent.Tag) []tagSummary {
 	}
 	return summaries
 }
+
+// abortCentroidError answers errors of a centroid lookup and reports whether
+// there was one.
+func abortCentroidError(c *gin.Context, name string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case ent.IsNotFound(err):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, search.ErrNoCentroid):
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		log.Printf("tag centroid %q: %v", name, err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+	return true
+}
+
+// similarTagsHandler returns the tags whose media look most like the media
+// of the tag given by "tag", by their vision centroids.
+func similarTagsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := strings.TrimSpace(c.Query("tag"))
+		limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+		if err != nil || limit < 1 || limit > 100 {
+			limit = 20
+		}
+		tags, err := search.SimilarTags(c.Request.Context(), dbClient, name, limit)
+		if abortCentroidError(c, name, err) {
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"tag": name, "tags": tags})
+	}
+}
+
+// missingTagHandler pages through media without the tag given by "tag" that
+// are closest to its centroid, for finding media that likely deserve it.
+// Media hidden by the active tag filter are left out.
+func missingTagHandler(dbClient *ent.Client, cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := strings.TrimSpace(c.Query("tag"))
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "50"))
+		if err != nil || pageSize < 1 || pageSize > 100 {
+			pageSize = 50
+		}
+		ctx := c.Request.Context()
+
+		var includeIDs []string
+		filterExpr, err := db.ActiveHiddenTagFilterValue(ctx, dbClient)
+		if err != nil {
+			log.Printf("load hidden tag filter: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if filterExpr != "" {
+			includeIDs, err = search.SearchMediaIDs(filterExpr)
+			if err != nil {
+				log.Printf("filter media ids: %v", err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			if len(includeIDs) == 0 {
+				c.JSON(http.StatusOK, gin.H{"tag": name, "media": []gin.H{}})
+				return
+			}
+		}
+
+		items, err := search.MediaMissingTag(ctx, dbClient, name, pageSize, (page-1)*pageSize, includeIDs)
+		if abortCentroidError(c, name, err) {
+			return
+		}
+		out := make([]gin.H, 0, len(items))
+		for _, item := range items {
+			bucket := bucketForFormat(item.Format, cfg.PreviewBucket, cfg.MinioBucket)
+			out = append(out, gin.H{
+				"id":     item.ID,
+				"url":    fmt.Sprintf("%s/%s/%s", cfg.MinioPublicPrefix, bucket, item.ID),
+				"width":  item.Width,
+				"height": item.Height,
+				"format": item.Format,
+			})
+		}
+		c.JSON(http.StatusOK, gin.H{"tag": name, "media": out})
+	}
+}
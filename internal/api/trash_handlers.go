@@ -0,0 +1,139 @@
+package api
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/internal/config"
+	"era/booru/internal/queue"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+func RegisterTrashRoutes(r *gin.Engine, db *ent.Client, cfg *config.Config, queueClient *river.Client[pgx.Tx]) {
+	r.GET("/api/trash", listTrashHandler(db, cfg))
+	r.POST("/api/trash/:id/restore", audit(db, "restore_media"), restoreMediaHandler(db))
+	r.DELETE("/api/trash/:id", audit(db, "purge_media"), purgeMediaHandler(db, queueClient))
+}
+
+// trashEntry describes a trashed media item. purge_at is omitted when the
+// trash is kept forever.
+func trashEntry(item *ent.Media, cfg *config.Config) gin.H {
+	bucket := bucketForFormat(item.Format, cfg.PreviewBucket, cfg.MinioBucket)
+	out := gin.H{
+		"id":         item.ID,
+		"url":        fmt.Sprintf("%s/%s/%s", cfg.MinioPublicPrefix, bucket, item.ID),
+		"width":      item.Width,
+		"height":     item.Height,
+		"format":     item.Format,
+		"deleted_at": item.DeletedAt,
+	}
+	if item.DeletedAt != nil && cfg.TrashRetention > 0 {
+		out["purge_at"] = item.DeletedAt.Add(cfg.TrashRetention)
+	}
+	return out
+}
+
+// listTrashHandler lists trashed media, most recently deleted first.
+func listTrashHandler(db *ent.Client, cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "60"))
+		if err != nil || pageSize < 1 || pageSize > 60 {
+			pageSize = 60
+		}
+
+		query := db.Media.Query().Where(media.DeletedAtNotNil())
+		total, err := query.Clone().Count(c.Request.Context())
+		if err != nil {
+			log.Printf("count trash: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		items, err := query.
+			Order(ent.Desc(media.FieldDeletedAt), ent.Asc(media.FieldID)).
+			Offset((page - 1) * pageSize).
+			Limit(pageSize).
+			All(c.Request.Context())
+		if err != nil {
+			log.Printf("list trash: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		out := make([]gin.H, len(items))
+		for i, item := range items {
+			out[i] = trashEntry(item, cfg)
+		}
+		c.JSON(http.StatusOK, gin.H{"media": out, "total": total})
+	}
+}
+
+// restoreMediaHandler takes a media item out of the trash. Updating the row
+// reindexes it.
+func restoreMediaHandler(db *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+
+		err := db.Media.UpdateOneID(id).
+			Where(media.DeletedAtNotNil()).
+			ClearDeletedAt().
+			Exec(c.Request.Context())
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("restore media %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": id})
+	}
+}
+
+// purgeMediaHandler permanently deletes a trashed media item without waiting
+// for the retention period. The job removes the row, index document and
+// objects and retries until all of them are gone.
+func purgeMediaHandler(db *ent.Client, queueClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := idParam(c)
+		if !ok {
+			return
+		}
+
+		exists, err := db.Media.Query().
+			Where(media.IDEQ(id), media.DeletedAtNotNil()).
+			Exist(c.Request.Context())
+		if err != nil {
+			log.Printf("check media %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if !exists {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		job, err := queue.EnqueueJob(c.Request.Context(), queueClient, queue.DeleteArgs{ID: id})
+		if err != nil {
+			log.Printf("enqueue delete %s: %v", id, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		c.JSON(http.StatusAccepted, gin.H{"id": id, "job_id": job.ID})
+	}
+}
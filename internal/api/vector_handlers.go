@@ -0,0 +1,162 @@
+package api
+
+import (
+	"log"
+	"net/http"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/mediavector"
+	"era/booru/ent/vector"
+	"era/booru/internal/config"
+	"era/booru/internal/db"
+	"era/booru/internal/queue"
+	"era/booru/internal/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// listVectorsHandler lists the vision vectors with the model and dimension
+// of their embeddings, how many live media have one and which one search
+// uses.
+func listVectorsHandler(dbClient *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		vectors, err := dbClient.Vector.Query().Order(ent.Asc(vector.FieldID)).All(ctx)
+		if err != nil {
+			log.Printf("list vectors: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		active, err := db.ActiveVisionVector(ctx, dbClient)
+		if err != nil && !ent.IsNotFound(err) {
+			log.Printf("load vision vector: %v", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		out := make([]gin.H, 0, len(vectors))
+		for _, vt := range vectors {
+			if !db.IsVisionVector(vt.Name) {
+				continue
+			}
+			n, err := dbClient.MediaVector.Query().
+				Where(mediavector.VectorIDEQ(vt.ID), mediavector.HasMediaWith(media.DeletedAtIsNil())).
+				Count(ctx)
+			if err != nil {
+				log.Printf("count media of vector %s: %v", vt.Name, err)
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+			out = append(out, gin.H{
+				"name":        vt.Name,
+				"model":       vt.Model,
+				"dimension":   vt.Dimension,
+				"media_count": n,
+				"active":      active != nil && active.ID == vt.ID,
+			})
+		}
+		c.JSON(http.StatusOK, gin.H{"vectors": out})
+	}
+}
+
+// setActiveVectorHandler switches search to another vision vector and queues
+// the tag centroids of the new one.
+func setActiveVectorHandler(dbClient *ent.Client, riverClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Name string `json:"name"`
+		}
+		if !bindJSONOrAbort(c, &body) {
+			return
+		}
+		if !db.IsVisionVector(body.Name) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "not a vision vector"})
+			return
+		}
+		ctx := c.Request.Context()
+		exists, err := dbClient.Vector.Query().Where(vector.NameEQ(body.Name)).Exist(ctx)
+		if err != nil {
+			log.Printf("load vector %s: %v", body.Name, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if !exists {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err := db.SetActiveVisionVector(ctx, dbClient, body.Name); err != nil {
+			log.Printf("set vision vector %s: %v", body.Name, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if err := queue.Enqueue(ctx, riverClient, queue.TagCentroidsArgs{}); err != nil {
+			log.Printf("enqueue tag centroids: %v", err)
+		}
+		c.JSON(http.StatusOK, gin.H{"name": body.Name})
+	}
+}
+
+// reembedHandler queues embedding of every live media item that has no
+// vector of the given model yet, for the workers running it. The model must
+// have registered its vector, i.e. a worker running it has started.
+func reembedHandler(dbClient *ent.Client, store storage.Backend, riverClient *river.Client[pgx.Tx]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Model string `json:"model"`
+		}
+		if !bindJSONOrAbort(c, &body) {
+			return
+		}
+		if body.Model == "" {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		ctx := c.Request.Context()
+		vt, err := dbClient.Vector.Query().
+			Where(vector.ModelEQ(body.Model)).
+			Order(ent.Desc(vector.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("load vector of model %s: %v", body.Model, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		// Audio is only embedded when it carries cover art, as in fsck.
+		audio := make([]string, 0, len(config.SupportedAudioFormats))
+		for f := range config.SupportedAudioFormats {
+			audio = append(audio, f)
+		}
+		ids, err := dbClient.Media.Query().
+			Where(
+				media.DeletedAtIsNil(),
+				media.FormatNotIn(audio...),
+				media.Not(media.HasVectorsWith(vector.IDEQ(vt.ID))),
+			).
+			Order(ent.Asc(media.FieldID)).
+			IDs(ctx)
+		if err != nil {
+			log.Printf("list media without %s: %v", vt.Name, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		jobs := make([]river.JobArgs, len(ids))
+		for i, id := range ids {
+			jobs[i] = queue.EmbedArgs{Bucket: store.Bucket(), Key: id, Model: vt.Model}
+		}
+		// Media still queued from an earlier request is skipped.
+		queued, err := queue.EnqueueMany(ctx, riverClient, jobs)
+		if err != nil {
+			log.Printf("enqueue embed with %s: %v", vt.Model, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"vector": vt.Name, "queued": queued})
+	}
+}
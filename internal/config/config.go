@@ -2,13 +2,20 @@ package config
 
 import (
 	"fmt"
+	"net/netip"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
 	PostgresDSN           string
+	StorageBackend        string // "minio" (default) or "fs"
+	StoragePath           string // root directory of the fs backend
+	StorageSecret         string // signs fs upload URLs; random per process if empty
 	MinioUser             string
 	MinioPassword         string
 	MinioBucket           string
@@ -18,29 +25,100 @@ type Config struct {
 	MinioPublicPrefix     string // e.g., "/minio"
 	BlevePath             string // path to Bleve index, e.g., "/data/bleve"
 	MinioSSL              bool
-	DevMode               bool // enable development features like auto migration
+	DevMode               bool          // enable development features like auto migration
+	TranscodeVideos       bool          // transcode videos browsers cannot play into TranscodeFormat
+	TranscodeFormat       string        // "mp4" (H.264/AAC) or "webm" (VP9/Opus)
+	FsckInterval          time.Duration // how often to check storage, Postgres and Bleve; 0 disables
+	FsckRepair            bool          // let scheduled checks repair the drift they find
+	IngestConcurrency     int           // objects enqueued for processing at the same time
+	IngestScanInterval    time.Duration // time between catch-up scans of the bucket; 0 scans only on start
+	TrashRetention        time.Duration // how long trashed media is kept before it is purged; 0 keeps it
+	CentroidInterval      time.Duration // how often tag centroids are recomputed; 0 disables
+	EmbedGRPCAddr         string        // embed worker gRPC address for search queries; empty uses the queue only
+	EmbedGRPCListen       string        // address the embed worker serves gRPC on; empty disables
+	TrustedProxies        []string      // addresses or CIDRs of reverse proxies whose forwarding headers are believed
+	ProxyUserHeader       string        // header a trusted proxy names the authenticated user in; empty disables
 }
 
 func Load() (*Config, error) {
 	// Load .env file if present; ignore error if not found
 	_ = godotenv.Load()
 
+	backend := getEnvOrDefault("STORAGE_BACKEND", "minio")
+	// The filesystem backend needs no MinIO connection; bucket names become
+	// directory names and the public prefix is served by the API server.
+	minioEnv := getEnv
+	if backend == "fs" {
+		minioEnv = func(key string) string {
+			return getEnvOrDefault(key, fsDefaults[key])
+		}
+	}
+
 	cfg := &Config{
 		PostgresDSN:           getEnv("POSTGRES_DSN"),
-		MinioUser:             getEnv("MINIO_ROOT_USER"),
-		MinioPassword:         getEnv("MINIO_ROOT_PASSWORD"),
-		MinioBucket:           getEnv("MINIO_BUCKET"),
-		PreviewBucket:         getEnv("MINIO_PREVIEW_BUCKET"),
-		MinioInternalEndpoint: getEnv("MINIO_INTERNAL_ENDPOINT"),        // for SDK connection
+		StorageBackend:        backend,
+		StoragePath:           getEnvOrDefault("STORAGE_PATH", ""),
+		StorageSecret:         getEnvOrDefault("STORAGE_SECRET", ""),
+		MinioUser:             minioEnv("MINIO_ROOT_USER"),
+		MinioPassword:         minioEnv("MINIO_ROOT_PASSWORD"),
+		MinioBucket:           minioEnv("MINIO_BUCKET"),
+		PreviewBucket:         minioEnv("MINIO_PREVIEW_BUCKET"),
+		MinioInternalEndpoint: minioEnv("MINIO_INTERNAL_ENDPOINT"),      // for SDK connection
 		MinioPublicHost:       getEnvOrDefault("MINIO_PUBLIC_HOST", ""), // for browser-facing host
-		MinioPublicPrefix:     getEnv("MINIO_PUBLIC_PREFIX"),            // e.g., "/minio" for Caddy reverse proxy
+		MinioPublicPrefix:     minioEnv("MINIO_PUBLIC_PREFIX"),          // e.g., "/minio" for Caddy reverse proxy
 		BlevePath:             getEnv("BLEVE_PATH"),
-		MinioSSL:              getEnv("MINIO_SSL") == "true",
+		MinioSSL:              minioEnv("MINIO_SSL") == "true",
 		DevMode:               getEnv("DEV_MODE") == "true",
+		TranscodeVideos:       getEnvOrDefault("TRANSCODE_VIDEOS", "false") == "true",
+		TranscodeFormat:       getEnvOrDefault("TRANSCODE_FORMAT", "mp4"),
+		FsckRepair:            getEnvOrDefault("FSCK_REPAIR", "false") == "true",
+		EmbedGRPCAddr:         getEnvOrDefault("EMBED_GRPC_ADDR", ""),
+		EmbedGRPCListen:       getEnvOrDefault("EMBED_GRPC_LISTEN", ""),
+		ProxyUserHeader:       getEnvOrDefault("PROXY_USER_HEADER", ""),
+	}
+	interval, err := time.ParseDuration(getEnvOrDefault("FSCK_INTERVAL", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("FSCK_INTERVAL: %w", err)
+	}
+	cfg.FsckInterval = interval
+	cfg.IngestScanInterval, err = time.ParseDuration(getEnvOrDefault("INGEST_SCAN_INTERVAL", "10m"))
+	if err != nil {
+		return nil, fmt.Errorf("INGEST_SCAN_INTERVAL: %w", err)
+	}
+	cfg.TrashRetention, err = time.ParseDuration(getEnvOrDefault("TRASH_RETENTION", "720h"))
+	if err != nil {
+		return nil, fmt.Errorf("TRASH_RETENTION: %w", err)
+	}
+	cfg.CentroidInterval, err = time.ParseDuration(getEnvOrDefault("CENTROID_INTERVAL", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("CENTROID_INTERVAL: %w", err)
+	}
+	for _, p := range strings.Split(getEnvOrDefault("TRUSTED_PROXIES", ""), ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if _, err := netip.ParsePrefix(p); err != nil {
+			if _, err := netip.ParseAddr(p); err != nil {
+				return nil, fmt.Errorf("TRUSTED_PROXIES: %q is neither an address nor a CIDR", p)
+			}
+		}
+		cfg.TrustedProxies = append(cfg.TrustedProxies, p)
+	}
+	cfg.IngestConcurrency, err = strconv.Atoi(getEnvOrDefault("INGEST_CONCURRENCY", "4"))
+	if err != nil || cfg.IngestConcurrency < 1 {
+		return nil, fmt.Errorf("INGEST_CONCURRENCY must be a positive integer")
 	}
 	return cfg, nil
 }
 
+// fsDefaults fills in MinIO settings that double as fs backend options.
+var fsDefaults = map[string]string{
+	"MINIO_BUCKET":         "media",
+	"MINIO_PREVIEW_BUCKET": "previews",
+	"MINIO_PUBLIC_PREFIX":  "/files",
+}
+
 func getEnv(key string) string {
 	v := os.Getenv(key)
 	if v == "" {
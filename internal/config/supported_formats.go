@@ -15,6 +15,14 @@ var SupportedVideoFormats = map[string]bool{
 	"mkv":  true,
 }
 
+var SupportedAudioFormats = map[string]bool{
+	"mp3":  true,
+	"flac": true,
+	"ogg":  true,
+	"opus": true,
+	"wav":  true,
+}
+
 var SupportedFormats = map[string]bool{}
 
 func init() {
@@ -24,4 +32,7 @@ func init() {
 	for k := range SupportedVideoFormats {
 		SupportedFormats[k] = true
 	}
+	for k := range SupportedAudioFormats {
+		SupportedFormats[k] = true
+	}
 }
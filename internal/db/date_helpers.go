@@ -22,9 +22,17 @@ type DateValue struct {
 	Value time.Time
 }
 
-// SetMediaDates replaces all dates on the given media item with the provided list.
+// SetMediaDates replaces all dates on the given media item with the provided
+// list and records the change as a revision.
 func SetMediaDates(ctx context.Context, db *ent.Client, mediaID string, dates []DateValue) error {
-	if _, err := db.Media.UpdateOneID(mediaID).ClearDates().Save(ctx); err != nil {
+	_, err := EditMedia(ctx, db, mediaID, "set_dates", func(tx *ent.Client) error {
+		return setMediaDates(ctx, tx, mediaID, dates)
+	})
+	return err
+}
+
+func setMediaDates(ctx context.Context, db *ent.Client, mediaID string, dates []DateValue) error {
+	if _, err := db.Media.UpdateOneID(mediaID).ClearDates().AddVersion(1).Save(ctx); err != nil {
 		return err
 	}
 	for _, d := range dates {
@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+
+	"era/booru/ent"
+	"era/booru/ent/favorite"
+	"era/booru/ent/mediavote"
+)
+
+// SetFavorite adds or removes a media item from the favorites of actor and
+// returns the media with its updated favorite count.
+func SetFavorite(ctx context.Context, client *ent.Client, actor, id string, fav bool) (*ent.Media, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockLiveMedia(ctx, tx, id); err != nil {
+		return nil, err
+	}
+	delta := 0
+	if fav {
+		exists, err := tx.Favorite.Query().
+			Where(favorite.ActorEQ(actor), favorite.MediaIDEQ(id)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			if err := tx.Favorite.Create().SetActor(actor).SetMediaID(id).Exec(ctx); err != nil {
+				return nil, err
+			}
+			delta = 1
+		}
+	} else {
+		n, err := tx.Favorite.Delete().
+			Where(favorite.ActorEQ(actor), favorite.MediaIDEQ(id)).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		delta = -n
+	}
+
+	m, err := tx.Media.UpdateOneID(id).AddFavCount(delta).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m, tx.Commit()
+}
+
+// SetVote records the vote of actor on a media item: 1 for up, -1 for down
+// and 0 to withdraw it. It returns the media with its updated score.
+func SetVote(ctx context.Context, client *ent.Client, actor, id string, value int) (*ent.Media, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockLiveMedia(ctx, tx, id); err != nil {
+		return nil, err
+	}
+	prev, err := tx.MediaVote.Query().
+		Where(mediavote.ActorEQ(actor), mediavote.MediaIDEQ(id)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	delta := value
+	switch {
+	case prev != nil && value == 0:
+		delta = -prev.Value
+		err = tx.MediaVote.DeleteOne(prev).Exec(ctx)
+	case prev != nil:
+		delta = value - prev.Value
+		err = tx.MediaVote.UpdateOne(prev).SetValue(value).Exec(ctx)
+	case value != 0:
+		err = tx.MediaVote.Create().SetActor(actor).SetMediaID(id).SetValue(value).Exec(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := tx.Media.UpdateOneID(id).AddScore(delta).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m, tx.Commit()
+}
+
+// MediaReactions returns whether actor favorited a media item and how they
+// voted on it.
+func MediaReactions(ctx context.Context, client *ent.Client, actor, id string) (fav bool, vote int, err error) {
+	fav, err = client.Favorite.Query().
+		Where(favorite.ActorEQ(actor), favorite.MediaIDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	v, err := client.MediaVote.Query().
+		Where(mediavote.ActorEQ(actor), mediavote.MediaIDEQ(id)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return fav, 0, nil
+	}
+	if err != nil {
+		return false, 0, err
+	}
+	return fav, v.Value, nil
+}
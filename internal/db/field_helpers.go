@@ -0,0 +1,227 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/mediafield"
+	"era/booru/ent/metafield"
+)
+
+// ErrInvalidField is returned for invalid field definitions and for values
+// that do not match their field.
+var ErrInvalidField = errors.New("invalid field")
+
+const maxFieldStringLength = 1000
+
+var (
+	fieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+	enumValuePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]{0,63}$`)
+)
+
+// searchDocumentFields are the fields of the search document besides the
+// media columns. Custom fields are indexed next to them and must not clash.
+var searchDocumentFields = []string{
+	"edges", "tags", "pools", "favorites", "comments", "sources", "dates", "vectors", "fields",
+}
+
+// ValidateFieldDef checks the name and enum values of a new field. Names are
+// lower-case identifiers that do not shadow built-in search fields; enum
+// values are single lower-case words so "field=value" matches them exactly.
+func ValidateFieldDef(name string, typ metafield.Type, values []string) error {
+	if !fieldNamePattern.MatchString(name) {
+		return fmt.Errorf("%w: name must be a lower-case identifier", ErrInvalidField)
+	}
+	if slices.Contains(media.Columns, name) || slices.Contains(searchDocumentFields, name) {
+		return fmt.Errorf("%w: %q is a built-in field", ErrInvalidField, name)
+	}
+	if err := metafield.TypeValidator(typ); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidField, err)
+	}
+	return ValidateEnumValues(typ, values)
+}
+
+// ValidateEnumValues checks the allowed values of a field of type typ.
+func ValidateEnumValues(typ metafield.Type, values []string) error {
+	if typ != metafield.TypeEnum {
+		if len(values) > 0 {
+			return fmt.Errorf("%w: only enum fields have values", ErrInvalidField)
+		}
+		return nil
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("%w: enum fields need values", ErrInvalidField)
+	}
+	for i, v := range values {
+		if !enumValuePattern.MatchString(v) || slices.Contains(values[:i], v) {
+			return fmt.Errorf("%w: bad or duplicate enum value %q", ErrInvalidField, v)
+		}
+	}
+	return nil
+}
+
+// FieldValue converts a decoded JSON value to the JSON stored for def.
+// Numbers and booleans may also be given as strings.
+func FieldValue(def *ent.MetaField, v any) (json.RawMessage, error) {
+	bad := fmt.Errorf("%w: %v is not a valid %s value for %s", ErrInvalidField, v, def.Type, def.Name)
+	var out any
+	switch def.Type {
+	case metafield.TypeInt:
+		n, ok := number(v)
+		if !ok || n != math.Trunc(n) || math.Abs(n) > 1<<53 {
+			return nil, bad
+		}
+		out = int64(n)
+	case metafield.TypeFloat:
+		n, ok := number(v)
+		if !ok || math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, bad
+		}
+		out = n
+	case metafield.TypeBool:
+		switch b := v.(type) {
+		case bool:
+			out = b
+		case string:
+			parsed, err := strconv.ParseBool(b)
+			if err != nil {
+				return nil, bad
+			}
+			out = parsed
+		default:
+			return nil, bad
+		}
+	case metafield.TypeString:
+		s, ok := v.(string)
+		s = strings.TrimSpace(s)
+		if !ok || s == "" || utf8.RuneCountInString(s) > maxFieldStringLength {
+			return nil, bad
+		}
+		out = s
+	case metafield.TypeEnum:
+		s, ok := v.(string)
+		if !ok || !slices.Contains(def.Values, s) {
+			return nil, bad
+		}
+		out = s
+	default:
+		return nil, bad
+	}
+	return json.Marshal(out)
+}
+
+func number(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// DecodeFieldValue returns a stored value as a Go value: float64 for numbers,
+// bool or string.
+func DecodeFieldValue(raw json.RawMessage) any {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+	return v
+}
+
+// MediaFieldValues returns the custom field values of a media item by name.
+func MediaFieldValues(ctx context.Context, client *ent.Client, mediaID string) (map[string]any, error) {
+	rows, err := client.MediaField.Query().
+		Where(mediafield.MediaIDEQ(mediaID)).
+		WithMetaField().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]any, len(rows))
+	for _, r := range rows {
+		out[r.Edges.MetaField.Name] = DecodeFieldValue(r.Value)
+	}
+	return out, nil
+}
+
+// SetMediaFields sets custom field values of a media item outside the trash
+// by field name; a nil value removes the field. Other fields are left alone.
+// It returns all values of the item afterwards.
+func SetMediaFields(ctx context.Context, client *ent.Client, mediaID string, values map[string]any) (map[string]any, error) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	defs, err := tx.MetaField.Query().Where(metafield.NameIn(names...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*ent.MetaField, len(defs))
+	for _, d := range defs {
+		byName[d.Name] = d
+	}
+
+	if err := lockLiveMedia(ctx, tx, mediaID); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		def, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidField, name)
+		}
+		if values[name] == nil {
+			if _, err := tx.MediaField.Delete().
+				Where(mediafield.MediaIDEQ(mediaID), mediafield.MetaFieldIDEQ(def.ID)).
+				Exec(ctx); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		raw, err := FieldValue(def, values[name])
+		if err != nil {
+			return nil, err
+		}
+		n, err := tx.MediaField.Update().
+			Where(mediafield.MediaIDEQ(mediaID), mediafield.MetaFieldIDEQ(def.ID)).
+			SetValue(raw).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			if err := tx.MediaField.Create().
+				SetMediaID(mediaID).
+				SetMetaFieldID(def.ID).
+				SetValue(raw).
+				Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	out, err := MediaFieldValues(ctx, tx.Client(), mediaID)
+	if err != nil {
+		return nil, err
+	}
+	return out, tx.Commit()
+}
@@ -0,0 +1,17 @@
+package db
+
+import (
+	"context"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+)
+
+// lockLiveMedia locks the row of media that is not in the trash until tx
+// ends, so concurrent edits of its tags, fields or reactions run one after
+// the other instead of racing on unique indexes. The update also reindexes
+// the media after the commit. ent sends no statement for an update without
+// changes, hence the zero increment.
+func lockLiveMedia(ctx context.Context, tx *ent.Tx, id string) error {
+	return tx.Media.UpdateOneID(id).Where(media.DeletedAtIsNil()).AddVersion(0).Exec(ctx)
+}
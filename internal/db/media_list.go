@@ -5,12 +5,14 @@ import (
 
 	"era/booru/ent"
 	"era/booru/ent/date"
+	"era/booru/ent/media"
 	"era/booru/ent/mediadate"
 
 	"entgo.io/ent/dialect/sql"
 )
 
-// ListMediaByDate returns media records ordered by the specified named date.
+// ListMediaByDate returns media records outside the trash ordered by the
+// specified named date.
 // If includeIDs is non-nil, only media matching one of the provided IDs are returned.
 func ListMediaByDate(ctx context.Context, client *ent.Client, dateName string, limit, offset int, includeIDs []string) ([]*ent.Media, int, error) {
 	dt, err := client.Date.Query().Where(date.NameEQ(dateName)).Only(ctx)
@@ -25,7 +27,10 @@ func ListMediaByDate(ctx context.Context, client *ent.Client, dateName string, l
 		return []*ent.Media{}, 0, nil
 	}
 
-	baseQuery := client.MediaDate.Query().Where(mediadate.DateIDEQ(dt.ID))
+	baseQuery := client.MediaDate.Query().Where(
+		mediadate.DateIDEQ(dt.ID),
+		mediadate.HasMediaWith(media.DeletedAtIsNil()),
+	)
 	if len(includeIDs) > 0 {
 		baseQuery = baseQuery.Where(mediadate.MediaIDIn(includeIDs...))
 	}
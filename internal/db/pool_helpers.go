@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/poolmedia"
+)
+
+// ErrUnknownMedia is returned when a pool edit names media that do not exist.
+var ErrUnknownMedia = errors.New("unknown media")
+
+// PoolMediaIDs returns the members of a pool in order.
+func PoolMediaIDs(ctx context.Context, client *ent.Client, poolID int) ([]string, error) {
+	return client.PoolMedia.Query().
+		Where(poolmedia.PoolIDEQ(poolID)).
+		Order(poolmedia.ByPosition()).
+		Select(poolmedia.FieldMediaID).
+		Strings(ctx)
+}
+
+// UpdatePoolMembers replaces the members of a pool with the order returned by
+// change, which receives the current members. Concurrent updates of the same
+// pool are serialized. It returns the members before and after the update.
+func UpdatePoolMembers(ctx context.Context, client *ent.Client, poolID int, change func(ids []string) ([]string, error)) (before, after []string, err error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	// Touching the pool locks its row until commit.
+	if err := tx.Pool.UpdateOneID(poolID).Exec(ctx); err != nil {
+		return nil, nil, err
+	}
+	before, err = PoolMediaIDs(ctx, tx.Client(), poolID)
+	if err != nil {
+		return nil, nil, err
+	}
+	after, err = change(slices.Clone(before))
+	if err != nil {
+		return nil, nil, err
+	}
+	after = uniqueStable(after)
+
+	known, err := tx.Media.Query().Where(media.IDIn(after...)).Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if known != len(after) {
+		return nil, nil, ErrUnknownMedia
+	}
+
+	if _, err := tx.PoolMedia.Delete().Where(poolmedia.PoolIDEQ(poolID)).Exec(ctx); err != nil {
+		return nil, nil, err
+	}
+	creates := make([]*ent.PoolMediaCreate, len(after))
+	for i, id := range after {
+		creates[i] = tx.PoolMedia.Create().SetPoolID(poolID).SetMediaID(id).SetPosition(i)
+	}
+	if len(creates) > 0 {
+		if err := tx.PoolMedia.CreateBulk(creates...).Exec(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	return before, after, tx.Commit()
+}
+
+// uniqueStable drops repeated IDs, keeping the first occurrence.
+func uniqueStable(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := ids[:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,164 @@
+package db
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/mediadate"
+	"era/booru/ent/mediarevision"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context whose media edits are attributed to actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func actorFrom(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return "system"
+}
+
+// MediaState is the user-editable metadata captured by a revision.
+type MediaState struct {
+	Tags  []string
+	Dates map[string]string
+}
+
+func (s MediaState) equal(o MediaState) bool {
+	return slices.Equal(s.Tags, o.Tags) && maps.Equal(s.Dates, o.Dates)
+}
+
+// mediaStates loads the sorted tags and dates of the given media.
+func mediaStates(ctx context.Context, client *ent.Client, ids []string) (map[string]MediaState, error) {
+	items, err := client.Media.Query().
+		Where(media.IDIn(ids...)).
+		WithTags().
+		WithDates(func(q *ent.DateQuery) {
+			q.WithMediaDates(func(mdq *ent.MediaDateQuery) { mdq.Where(mediadate.MediaIDIn(ids...)) })
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]MediaState, len(items))
+	for _, m := range items {
+		state := MediaState{Tags: make([]string, 0, len(m.Edges.Tags)), Dates: map[string]string{}}
+		for _, t := range m.Edges.Tags {
+			state.Tags = append(state.Tags, t.Name)
+		}
+		slices.Sort(state.Tags)
+		for _, d := range m.Edges.Dates {
+			// Date nodes are shared between the loaded media.
+			for _, md := range d.Edges.MediaDates {
+				if md.MediaID == m.ID {
+					state.Dates[d.Name] = md.Value.Format("2006-01-02")
+				}
+			}
+		}
+		out[m.ID] = state
+	}
+	return out, nil
+}
+
+// EditMediaBatch runs edit in a transaction and, in the same transaction,
+// records a MediaRevision for every media in ids whose tags or dates changed.
+// The edit must use the client it is given.
+func EditMediaBatch(ctx context.Context, client *ent.Client, ids []string, action string, edit func(tx *ent.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := mediaStates(ctx, tx.Client(), ids)
+	if err != nil {
+		return err
+	}
+	if err := edit(tx.Client()); err != nil {
+		return err
+	}
+	after, err := mediaStates(ctx, tx.Client(), ids)
+	if err != nil {
+		return err
+	}
+	rows, err := tx.Media.Query().
+		Where(media.IDIn(ids...)).
+		Select(media.FieldID, media.FieldVersion).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	actor := actorFrom(ctx)
+	creates := make([]*ent.MediaRevisionCreate, 0, len(rows))
+	for _, m := range rows {
+		b, a := before[m.ID], after[m.ID]
+		if b.equal(a) {
+			continue
+		}
+		creates = append(creates, tx.MediaRevision.Create().
+			SetMediaID(m.ID).
+			SetAction(action).
+			SetActor(actor).
+			SetVersion(m.Version).
+			SetTagsBefore(b.Tags).
+			SetTagsAfter(a.Tags).
+			SetDatesBefore(b.Dates).
+			SetDatesAfter(a.Dates))
+	}
+	if len(creates) > 0 {
+		if err := tx.MediaRevision.CreateBulk(creates...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// EditMedia is EditMediaBatch for one item and returns it after the edit.
+func EditMedia(ctx context.Context, client *ent.Client, mediaID, action string, edit func(tx *ent.Client) error) (*ent.Media, error) {
+	if err := EditMediaBatch(ctx, client, []string{mediaID}, action, edit); err != nil {
+		return nil, err
+	}
+	return client.Media.Get(ctx, mediaID)
+}
+
+// RevertMedia restores the tags and dates a media item had right after the
+// given revision. The revert is recorded as a revision itself.
+func RevertMedia(ctx context.Context, client *ent.Client, mediaID string, revisionID int) (*ent.Media, error) {
+	rev, err := client.MediaRevision.Query().
+		Where(mediarevision.IDEQ(revisionID), mediarevision.MediaIDEQ(mediaID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]DateValue, 0, len(rev.DatesAfter))
+	for name, value := range rev.DatesAfter {
+		t, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, DateValue{Name: name, Value: t})
+	}
+
+	return EditMedia(ctx, client, mediaID, "revert", func(tx *ent.Client) error {
+		tagIDs, err := FindOrCreateTags(ctx, tx, rev.TagsAfter)
+		if err != nil {
+			return err
+		}
+		if err := tx.Media.UpdateOneID(mediaID).
+			ClearTags().
+			AddTagIDs(tagIDs...).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return setMediaDates(ctx, tx, mediaID, dates)
+	})
+}
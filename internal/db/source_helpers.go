@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/source"
+)
+
+// ErrInvalidSource is returned for source URLs that are not absolute http(s)
+// URLs.
+var ErrInvalidSource = errors.New("invalid source URL")
+
+// NormalizeSourceURL canonicalises a source URL so the same page is stored
+// and looked up the same way: the scheme and host are lower-cased, default
+// ports, credentials and the fragment are dropped. It also returns the domain
+// used for search, the host without a leading "www.".
+func NormalizeSourceURL(raw string) (string, string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %v", ErrInvalidSource, err)
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidSource, raw)
+	}
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	u.Host = host
+	if port != "" {
+		u.Host = host + ":" + port
+	}
+	u.User = nil
+	u.Fragment = ""
+	u.RawFragment = ""
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return u.String(), strings.TrimPrefix(host, "www."), nil
+}
+
+// normalizeSources normalises and deduplicates urls, keeping their order.
+func normalizeSources(urls []string) ([]string, map[string]string, error) {
+	clean := make([]string, 0, len(urls))
+	domains := make(map[string]string, len(urls))
+	for _, raw := range urls {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		u, domain, err := NormalizeSourceURL(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := domains[u]; ok {
+			continue
+		}
+		clean = append(clean, u)
+		domains[u] = domain
+	}
+	return clean, domains, nil
+}
+
+// SetMediaSources replaces the sources of a media item outside the trash and
+// returns them in the order given. With merge set, existing sources are kept
+// and only missing ones are added.
+func SetMediaSources(ctx context.Context, client *ent.Client, id string, urls []string, merge bool) ([]*ent.Source, error) {
+	clean, domains, err := normalizeSources(urls)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockLiveMedia(ctx, tx, id); err != nil {
+		return nil, err
+	}
+	existing, err := tx.Source.Query().Where(source.MediaIDEQ(id)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	have := make(map[string]bool, len(existing))
+	var stale []int
+	for _, s := range existing {
+		have[s.URL] = true
+		if _, keep := domains[s.URL]; !keep && !merge {
+			stale = append(stale, s.ID)
+		}
+	}
+	if len(stale) > 0 {
+		if _, err := tx.Source.Delete().Where(source.IDIn(stale...)).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	var creates []*ent.SourceCreate
+	for _, u := range clean {
+		if !have[u] {
+			creates = append(creates, tx.Source.Create().SetMediaID(id).SetURL(u).SetDomain(domains[u]))
+		}
+	}
+	if len(creates) > 0 {
+		if err := tx.Source.CreateBulk(creates...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	sources, err := tx.Source.Query().
+		Where(source.MediaIDEQ(id)).
+		Order(ent.Asc(source.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sources, tx.Commit()
+}
+
+// FindMediaBySource returns the sources equal to raw on media outside the
+// trash. The http and https forms of a URL are treated as the same page.
+func FindMediaBySource(ctx context.Context, client *ent.Client, raw string) (string, []*ent.Source, error) {
+	u, _, err := NormalizeSourceURL(raw)
+	if err != nil {
+		return "", nil, err
+	}
+	rest := u[strings.Index(u, "://"):]
+	sources, err := client.Source.Query().
+		Where(
+			source.URLIn("http"+rest, "https"+rest),
+			source.HasMediaWith(media.DeletedAtIsNil()),
+		).
+		Order(ent.Asc(source.FieldMediaID)).
+		All(ctx)
+	return u, sources, err
+}
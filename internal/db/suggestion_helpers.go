@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/tag"
+	"era/booru/ent/tagsuggestion"
+)
+
+// ErrSuggestionDecided is returned when a suggestion was already accepted or
+// rejected.
+var ErrSuggestionDecided = errors.New("suggestion already decided")
+
+// SuggestTags replaces the pending suggestions from source for a media item
+// outside the trash with scores, a score per tag ID. Tags the item already
+// has and suggestions users decided on are left out. It returns the number
+// of pending suggestions from source afterwards.
+func SuggestTags(ctx context.Context, client *ent.Client, mediaID string, source tagsuggestion.Source, scores map[int]float64) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	scores = maps.Clone(scores)
+	m, err := tx.Media.Query().
+		Where(media.IDEQ(mediaID), media.DeletedAtIsNil()).
+		WithTags(func(q *ent.TagQuery) { q.Select(tag.FieldID) }).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, t := range m.Edges.Tags {
+		delete(scores, t.ID)
+	}
+
+	existing, err := tx.TagSuggestion.Query().
+		Where(tagsuggestion.MediaIDEQ(mediaID)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var stale []int
+	for _, s := range existing {
+		score, ok := scores[s.TagID]
+		delete(scores, s.TagID)
+		switch {
+		case s.Status != tagsuggestion.StatusPending:
+		case !ok && s.Source == source:
+			stale = append(stale, s.ID)
+		case ok && s.Score != score:
+			if err := tx.TagSuggestion.UpdateOne(s).SetScore(score).SetSource(source).Exec(ctx); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(stale) > 0 {
+		if _, err := tx.TagSuggestion.Delete().Where(tagsuggestion.IDIn(stale...)).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	creates := make([]*ent.TagSuggestionCreate, 0, len(scores))
+	for tagID, score := range scores {
+		creates = append(creates, tx.TagSuggestion.Create().
+			SetMediaID(mediaID).
+			SetTagID(tagID).
+			SetScore(score).
+			SetSource(source))
+	}
+	if len(creates) > 0 {
+		if err := tx.TagSuggestion.CreateBulk(creates...).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := tx.TagSuggestion.Query().
+		Where(
+			tagsuggestion.MediaIDEQ(mediaID),
+			tagsuggestion.SourceEQ(source),
+			tagsuggestion.StatusEQ(tagsuggestion.StatusPending),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return n, tx.Commit()
+}
+
+// DecideSuggestion accepts or rejects a pending suggestion. Accepting adds
+// the tag to the media item. Once the last pending suggestion of an item is
+// decided and at least one of them was accepted, the item counts as triaged
+// and loses its "tagme" tag. Tag changes are recorded as a revision.
+func DecideSuggestion(ctx context.Context, client *ent.Client, id int, accept bool) (*ent.TagSuggestion, error) {
+	s, err := client.TagSuggestion.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.Status != tagsuggestion.StatusPending {
+		return nil, ErrSuggestionDecided
+	}
+	status, action := tagsuggestion.StatusRejected, "reject_suggestion"
+	if accept {
+		status, action = tagsuggestion.StatusAccepted, "accept_suggestion"
+	}
+
+	_, err = EditMedia(ctx, client, s.MediaID, action, func(tx *ent.Client) error {
+		n, err := tx.TagSuggestion.Update().
+			Where(tagsuggestion.IDEQ(id), tagsuggestion.StatusEQ(tagsuggestion.StatusPending)).
+			SetStatus(status).
+			SetDecidedBy(actorFrom(ctx)).
+			SetDecidedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrSuggestionDecided
+		}
+
+		update := tx.Media.UpdateOneID(s.MediaID).Where(media.DeletedAtIsNil())
+		changed := false
+		if accept {
+			update.AddTagIDs(s.TagID)
+			changed = true
+		}
+		pending, err := tx.TagSuggestion.Query().
+			Where(tagsuggestion.MediaIDEQ(s.MediaID), tagsuggestion.StatusEQ(tagsuggestion.StatusPending)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !pending {
+			accepted, err := tx.TagSuggestion.Query().
+				Where(tagsuggestion.MediaIDEQ(s.MediaID), tagsuggestion.StatusEQ(tagsuggestion.StatusAccepted)).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if accepted {
+				tagmeIDs, err := tx.Tag.Query().Where(tag.NameEQ("tagme")).IDs(ctx)
+				if err != nil {
+					return err
+				}
+				update.RemoveTagIDs(tagmeIDs...)
+				changed = changed || len(tagmeIDs) > 0
+			}
+		}
+		if !changed {
+			return nil
+		}
+		return update.AddVersion(1).Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client.TagSuggestion.Query().Where(tagsuggestion.IDEQ(id)).WithTag().Only(ctx)
+}
@@ -3,14 +3,30 @@ package db
 import (
 	"context"
 	"era/booru/ent"
+	"era/booru/ent/media"
 	"era/booru/ent/tag"
+	"errors"
 	"fmt"
 )
 
+// ErrVersionConflict is returned when a media item changed since the version
+// the caller based its edit on.
+var ErrVersionConflict = errors.New("media version conflict")
+
 func FindOrCreateTag(ctx context.Context, db *ent.Client, name string) (*ent.Tag, error) {
+	return findOrCreateTagOfType(ctx, db, name, tag.TypeUserTag)
+}
+
+// FindOrCreateMetaTag returns the meta tag with the given name, creating it if needed.
+// Meta tags are assigned automatically during processing (e.g. "animated").
+func FindOrCreateMetaTag(ctx context.Context, db *ent.Client, name string) (*ent.Tag, error) {
+	return findOrCreateTagOfType(ctx, db, name, tag.TypeMetaTag)
+}
+
+func findOrCreateTagOfType(ctx context.Context, db *ent.Client, name string, typ tag.Type) (*ent.Tag, error) {
 	tg, err := db.Tag.Query().Where(tag.NameEQ(name)).Only(ctx)
 	if ent.IsNotFound(err) {
-		tg, err = db.Tag.Create().SetName(name).SetType(tag.TypeUserTag).Save(ctx)
+		tg, err = db.Tag.Create().SetName(name).SetType(typ).Save(ctx)
 	}
 	return tg, err
 }
@@ -28,13 +44,51 @@ func FindOrCreateTags(ctx context.Context, db *ent.Client, tagNames []string) ([
 	return tagIDs, nil
 }
 
-// SetMediaTags replaces all tags on the given media item with the provided list.
-// The tags slice should already be normalized (trimmed and deduplicated).
-func SetMediaTags(ctx context.Context, db *ent.Client, mediaID string, tags []string) error {
-	tagIDs, err := FindOrCreateTags(ctx, db, tags)
-	if err != nil {
+// SetMediaTags replaces all tags on the given media item with the provided list
+// if the item is still at version. The tags slice should already be
+// normalized (trimmed and deduplicated).
+func SetMediaTags(ctx context.Context, db *ent.Client, mediaID string, tags []string, version int) (*ent.Media, error) {
+	return EditMedia(ctx, db, mediaID, "set_tags", func(tx *ent.Client) error {
+		tagIDs, err := FindOrCreateTags(ctx, tx, tags)
+		if err != nil {
+			return err
+		}
+		err = tx.Media.UpdateOneID(mediaID).
+			Where(media.VersionEQ(version)).
+			ClearTags().
+			AddTagIDs(tagIDs...).
+			AddVersion(1).
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			exists, existErr := tx.Media.Query().Where(media.IDEQ(mediaID)).Exist(ctx)
+			if existErr != nil {
+				return existErr
+			}
+			if exists {
+				return ErrVersionConflict
+			}
+		}
 		return err
-	}
-	_, err = db.Media.UpdateOneID(mediaID).ClearTags().AddTagIDs(tagIDs...).Save(ctx)
-	return err
+	})
+}
+
+// EditMediaTags adds and removes tags on the given media item. Unlike
+// SetMediaTags it leaves other tags alone, so concurrent edits do not
+// conflict. Unknown tags in remove are ignored.
+func EditMediaTags(ctx context.Context, db *ent.Client, mediaID string, add, remove []string) (*ent.Media, error) {
+	return EditMedia(ctx, db, mediaID, "edit_tags", func(tx *ent.Client) error {
+		addIDs, err := FindOrCreateTags(ctx, tx, add)
+		if err != nil {
+			return err
+		}
+		removeIDs, err := tx.Tag.Query().Where(tag.NameIn(remove...)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.Media.UpdateOneID(mediaID).
+			RemoveTagIDs(removeIDs...).
+			AddTagIDs(addIDs...).
+			AddVersion(1).
+			Exec(ctx)
+	})
 }
@@ -2,8 +2,13 @@ package db
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"era/booru/ent"
+	"era/booru/ent/mediavector"
+	"era/booru/ent/setting"
+	"era/booru/ent/tagvector"
 	"era/booru/ent/vector"
 
 	pgvector "github.com/pgvector/pgvector-go"
@@ -39,3 +44,126 @@ func SetMediaVectors(ctx context.Context, db *ent.Client, mediaID string, vector
 	}
 	return nil
 }
+
+// legacyVisionVector is the vector vision embeddings were stored under
+// before vectors recorded their model.
+const legacyVisionVector = "vision"
+
+const settingKeyVisionVector = "vision_vector"
+
+// VisionVectorName names the vision vector of a model, so embeddings of
+// different models or sizes are never mixed.
+func VisionVectorName(model string, dim int) string {
+	return fmt.Sprintf("%s:%s:%d", legacyVisionVector, model, dim)
+}
+
+// IsVisionVector reports whether name is a vision vector.
+func IsVisionVector(name string) bool {
+	return name == legacyVisionVector || strings.HasPrefix(name, legacyVisionVector+":")
+}
+
+// RegisterVisionVector returns the vision vector of a model, creating it if
+// needed. The first model registered adopts the embeddings stored before
+// vectors recorded their model if they have its dimension, since it almost
+// certainly produced them.
+func RegisterVisionVector(ctx context.Context, db *ent.Client, model string, dim int) (*ent.Vector, error) {
+	name := VisionVectorName(model, dim)
+	vt, err := db.Vector.Query().Where(vector.NameEQ(name)).Only(ctx)
+	if !ent.IsNotFound(err) {
+		return vt, err
+	}
+
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	vt, err = tx.Vector.Create().SetName(name).SetModel(model).SetDimension(dim).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := adoptLegacyVision(ctx, tx.Client(), vt); err != nil {
+		return nil, err
+	}
+	return vt, tx.Commit()
+}
+
+// adoptLegacyVision moves the legacy vision embeddings to vt if vt is the
+// only other vision vector and the dimensions match.
+func adoptLegacyVision(ctx context.Context, db *ent.Client, vt *ent.Vector) error {
+	legacy, err := db.Vector.Query().Where(vector.NameEQ(legacyVisionVector)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	others, err := db.Vector.Query().
+		Where(vector.NameHasPrefix(legacyVisionVector+":"), vector.IDNEQ(vt.ID)).
+		Exist(ctx)
+	if err != nil || others {
+		return err
+	}
+	sample, err := db.MediaVector.Query().Where(mediavector.VectorIDEQ(legacy.ID)).First(ctx)
+	if err == nil && len(sample.Value.Slice()) != vt.Dimension {
+		return nil
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if _, err := db.MediaVector.Update().
+		Where(mediavector.VectorIDEQ(legacy.ID)).
+		SetVectorID(vt.ID).
+		Save(ctx); err != nil {
+		return err
+	}
+	if _, err := db.TagVector.Delete().Where(tagvector.VectorIDEQ(legacy.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	return db.Vector.DeleteOne(legacy).Exec(ctx)
+}
+
+// ActiveVisionVector returns the vision vector search uses: the one chosen
+// with SetActiveVisionVector, or else the oldest. Newer models therefore
+// only take over once an admin switches to them, after re-embedding.
+func ActiveVisionVector(ctx context.Context, db *ent.Client) (*ent.Vector, error) {
+	s, err := db.Setting.Query().Where(setting.KeyEQ(settingKeyVisionVector)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if s != nil && s.Value != "" {
+		vt, err := db.Vector.Query().Where(vector.NameEQ(s.Value)).Only(ctx)
+		if !ent.IsNotFound(err) {
+			return vt, err
+		}
+	}
+	return db.Vector.Query().
+		Where(vector.Or(vector.NameEQ(legacyVisionVector), vector.NameHasPrefix(legacyVisionVector+":"))).
+		Order(ent.Asc(vector.FieldID)).
+		First(ctx)
+}
+
+// SetActiveVisionVector makes search use the named vision vector.
+func SetActiveVisionVector(ctx context.Context, db *ent.Client, name string) error {
+	n, err := db.Setting.Update().
+		Where(setting.KeyEQ(settingKeyVisionVector)).
+		SetValue(name).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+	return db.Setting.Create().SetKey(settingKeyVisionVector).SetValue(name).Exec(ctx)
+}
+
+// SetMediaVector stores the value of one vector of a media item and leaves
+// its other vectors alone.
+func SetMediaVector(ctx context.Context, db *ent.Client, mediaID string, vectorID int, value pgvector.Vector) error {
+	n, err := db.MediaVector.Update().
+		Where(mediavector.MediaIDEQ(mediaID), mediavector.VectorIDEQ(vectorID)).
+		SetValue(value).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+	return db.MediaVector.Create().SetMediaID(mediaID).SetVectorID(vectorID).SetValue(value).Exec(ctx)
+}
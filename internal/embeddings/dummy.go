@@ -12,18 +12,36 @@ func VisionEmbedding([]byte) ([]float32, error) {
 	return nil, nil
 }
 
+func VisionEmbeddingPage([]byte, int) ([]float32, error) {
+	return nil, nil
+}
+
+func VisionEmbeddingRegion([]byte, float64, float64, float64, float64) ([]float32, error) {
+	return nil, nil
+}
+
 func VisionEmbeddingRGB24([]byte) ([]float32, error) {
 	return nil, nil
 }
 
+func VisionDimension() (int, error) {
+	return 0, nil
+}
+
 func TextEmbedding(any interface{}) ([]float32, error) {
 	return nil, nil
 }
 
+func TextEmbeddings(texts []string) ([][]float32, error) {
+	return make([][]float32, len(texts)), nil
+}
+
 func DefaultModelOptionsFromEnv() ModelOptions {
 	return ModelOptions{}
 }
 
+func ModelID() string { return "" }
+
 func EnsureModel(ctx context.Context, opts ModelOptions) (string, error) {
 	return "", nil
 }
@@ -27,6 +27,7 @@ var (
 	textOutputName     string
 	textInputNames     []string
 	textSequenceLength int
+	textMaxBatch       int // fixed batch dimension of the text model; 0 if dynamic
 	textTokenizer      *tokenizer.Tokenizer
 )
 
@@ -149,8 +150,12 @@ func Load(dir string) error {
 
 		textInputNames = make([]string, len(textInputs))
 		seqLen := 0
+		textMaxBatch = 0
 		for i, in := range textInputs {
 			textInputNames[i] = in.Name
+			if len(in.Dimensions) > 1 && in.Dimensions[0] > 0 {
+				textMaxBatch = int(in.Dimensions[0])
+			}
 			if len(in.Dimensions) > 1 {
 				if v := int(in.Dimensions[len(in.Dimensions)-1]); v > seqLen {
 					seqLen = v
@@ -82,6 +82,19 @@ func DefaultModelOptionsFromEnv() ModelOptions {
 	return opts
 }
 
+// ModelID identifies the loaded model in the names of stored vectors:
+// MODEL_ID if set, else the repository and revision it is downloaded from.
+func ModelID() string {
+	if id := strings.TrimSpace(os.Getenv("MODEL_ID")); id != "" {
+		return id
+	}
+	repo := getEnvOrDefault("MODEL_REPOSITORY", defaultRepo)
+	if rev := getEnvOrDefault("MODEL_REVISION", defaultRevision); rev != defaultRevision {
+		return repo + "@" + rev
+	}
+	return repo
+}
+
 func EnsureModel(ctx context.Context, opts ModelOptions) (string, error) {
 	if opts.CacheDir == "" {
 		return "", errors.New("model cache directory is empty")
@@ -65,3 +65,20 @@ func TestDefaultModelOptionsFromEnv(t *testing.T) {
 		t.Fatalf("expected client to be initialised")
 	}
 }
+
+func TestModelID(t *testing.T) {
+	t.Setenv("MODEL_ID", "")
+	t.Setenv("MODEL_REPOSITORY", "")
+	t.Setenv("MODEL_REVISION", "")
+	if got := ModelID(); got != defaultRepo {
+		t.Fatalf("expected %s, got %s", defaultRepo, got)
+	}
+	t.Setenv("MODEL_REVISION", "v2")
+	if got := ModelID(); got != defaultRepo+"@v2" {
+		t.Fatalf("expected revision in id, got %s", got)
+	}
+	t.Setenv("MODEL_ID", "custom")
+	if got := ModelID(); got != "custom" {
+		t.Fatalf("expected MODEL_ID to win, got %s", got)
+	}
+}
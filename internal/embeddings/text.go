@@ -11,54 +11,85 @@ import (
 
 // TextEmbedding converts a text query into an L2-normalised embedding vector.
 func TextEmbedding(text string) ([]float32, error) {
+	vecs, err := TextEmbeddings([]string{text})
+	if err != nil {
+		return nil, err
+	}
+	return vecs[0], nil
+}
+
+// TextEmbeddings embeds several texts and returns their L2-normalised
+// vectors in order. The texts share a run of the text model, or as few runs
+// as its batch dimension allows.
+func TextEmbeddings(texts []string) ([][]float32, error) {
 	if textSess == nil || textTokenizer == nil {
 		return nil, fmt.Errorf("text embedding model not loaded")
 	}
-
-	trimmed := strings.TrimSpace(text)
-	if trimmed == "" {
-		return nil, fmt.Errorf("text query is empty")
+	size := len(texts)
+	if textMaxBatch > 0 && textMaxBatch < size {
+		size = textMaxBatch
 	}
-
-	encoding, err := textTokenizer.EncodeSingle(trimmed)
-	if err != nil {
-		return nil, fmt.Errorf("tokenize text: %w", err)
+	vecs := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += size {
+		batch, err := textEmbeddingBatch(texts[start:min(start+size, len(texts))])
+		if err != nil {
+			return nil, err
+		}
+		vecs = append(vecs, batch...)
 	}
+	return vecs, nil
+}
 
-	ids := encoding.Ids
-	mask := encoding.AttentionMask
-	if len(ids) == 0 {
-		return nil, fmt.Errorf("tokenizer produced no tokens")
-	}
-	if len(mask) == 0 {
-		mask = make([]int, len(ids))
-		for i := range mask {
-			mask[i] = 1
+// textEmbeddingBatch embeds texts in a single run of the text model.
+func textEmbeddingBatch(texts []string) ([][]float32, error) {
+	type tokens struct{ ids, mask []int }
+	encoded := make([]tokens, len(texts))
+	targetLen := textSequenceLength
+	for i, text := range texts {
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" {
+			return nil, fmt.Errorf("text query is empty")
+		}
+
+		encoding, err := textTokenizer.EncodeSingle(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("tokenize text: %w", err)
 		}
-	}
 
-	targetLen := len(ids)
-	if textSequenceLength > 0 {
-		targetLen = textSequenceLength
+		ids := encoding.Ids
+		mask := encoding.AttentionMask
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("tokenizer produced no tokens")
+		}
+		if len(mask) == 0 {
+			mask = make([]int, len(ids))
+			for j := range mask {
+				mask[j] = 1
+			}
+		}
+		encoded[i] = tokens{ids, mask}
+		if textSequenceLength <= 0 && len(ids) > targetLen {
+			targetLen = len(ids)
+		}
 	}
 
-	ids64 := make([]int64, targetLen)
-	mask64 := make([]int64, targetLen)
-	for i := 0; i < targetLen; i++ {
-		if i < len(ids) {
-			ids64[i] = int64(ids[i])
-			if i < len(mask) {
-				mask64[i] = int64(mask[i])
+	n := len(texts)
+	shape := ort.NewShape(int64(n), int64(targetLen))
+	ids64 := make([]int64, n*targetLen)
+	mask64 := make([]int64, n*targetLen)
+	for row, enc := range encoded {
+		base := row * targetLen
+		for i := 0; i < targetLen && i < len(enc.ids); i++ {
+			ids64[base+i] = int64(enc.ids[i])
+			if i < len(enc.mask) {
+				mask64[base+i] = int64(enc.mask[i])
 			} else {
-				mask64[i] = 1
+				mask64[base+i] = 1
 			}
-		} else {
-			ids64[i] = 0
-			mask64[i] = 0
 		}
 	}
 
-	idsTensor, err := ort.NewTensor[int64](ort.NewShape(1, int64(targetLen)), ids64)
+	idsTensor, err := ort.NewTensor[int64](shape, ids64)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +106,7 @@ func TextEmbedding(text string) ([]float32, error) {
 			inputs[i] = idsTensor
 		case "attention_mask":
 			if attentionTensor == nil {
-				attentionTensor, err = ort.NewTensor[int64](ort.NewShape(1, int64(targetLen)), mask64)
+				attentionTensor, err = ort.NewTensor[int64](shape, mask64)
 				if err != nil {
 					return nil, err
 				}
@@ -84,11 +115,11 @@ func TextEmbedding(text string) ([]float32, error) {
 			inputs[i] = attentionTensor
 		case "position_ids":
 			if positionTensor == nil {
-				positionData := make([]int64, targetLen)
+				positionData := make([]int64, n*targetLen)
 				for j := range positionData {
-					positionData[j] = int64(j)
+					positionData[j] = int64(j % targetLen)
 				}
-				positionTensor, err = ort.NewTensor[int64](ort.NewShape(1, int64(targetLen)), positionData)
+				positionTensor, err = ort.NewTensor[int64](shape, positionData)
 				if err != nil {
 					return nil, err
 				}
@@ -97,8 +128,8 @@ func TextEmbedding(text string) ([]float32, error) {
 			inputs[i] = positionTensor
 		case "token_type_ids":
 			if tokenTypeTensor == nil {
-				tokenTypeData := make([]int64, targetLen)
-				tokenTypeTensor, err = ort.NewTensor[int64](ort.NewShape(1, int64(targetLen)), tokenTypeData)
+				tokenTypeData := make([]int64, n*targetLen)
+				tokenTypeTensor, err = ort.NewTensor[int64](shape, tokenTypeData)
 				if err != nil {
 					return nil, err
 				}
@@ -122,21 +153,24 @@ func TextEmbedding(text string) ([]float32, error) {
 	defer out.Destroy()
 
 	data := out.GetData()
-	shape := out.GetShape()
-
-	var vec []float32
-	switch len(shape) {
-	case 2:
-		dim := int(shape[1])
-		if len(data) != dim {
-			return nil, fmt.Errorf("embedding data length mismatch: got %d, expected %d", len(data), dim)
-		}
-		vec = make([]float32, dim)
-		copy(vec, data)
-	default:
-		return nil, fmt.Errorf("unsupported embedding rank %d", len(shape))
+	outShape := out.GetShape()
+	if len(outShape) != 2 {
+		return nil, fmt.Errorf("unsupported embedding rank %d", len(outShape))
+	}
+	if int(outShape[0]) != n {
+		return nil, fmt.Errorf("embedding batch mismatch: got %d, expected %d", outShape[0], n)
+	}
+	dim := int(outShape[1])
+	if len(data) != n*dim {
+		return nil, fmt.Errorf("embedding data length mismatch: got %d, expected %d", len(data), n*dim)
 	}
 
-	l2(vec)
-	return vec, nil
+	vecs := make([][]float32, n)
+	for i := range vecs {
+		vec := make([]float32, dim)
+		copy(vec, data[i*dim:(i+1)*dim])
+		l2(vec)
+		vecs[i] = vec
+	}
+	return vecs, nil
 }
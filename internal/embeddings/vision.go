@@ -24,9 +24,18 @@ func ensureVips() {
 
 // VisionEmbedding converts an image buffer to an L2-normalised embedding vector.
 func VisionEmbedding(buf []byte) ([]float32, error) {
+	return VisionEmbeddingPage(buf, 0)
+}
+
+// VisionEmbeddingPage embeds a single frame of a multi-page image such as an
+// animated GIF or WebP. Page 0 is the first frame.
+func VisionEmbeddingPage(buf []byte, page int) ([]float32, error) {
 	if len(buf) == 0 {
 		return nil, fmt.Errorf("vision embedding: empty image buffer")
 	}
+	if page < 0 {
+		return nil, fmt.Errorf("vision embedding: invalid page %d", page)
+	}
 
 	ensureVips()
 
@@ -35,7 +44,7 @@ func VisionEmbedding(buf []byte) ([]float32, error) {
 	var lastErr error
 	for attempt := 0; attempt < maxAttempts; attempt++ {
 		S := InputSpatialSize()
-		vec, err := visionEmbeddingWithSize(buf, S)
+		vec, err := visionEmbeddingWithSize(buf, S, page)
 		if err == nil {
 			return vec, nil
 		}
@@ -52,6 +61,38 @@ func VisionEmbedding(buf []byte) ([]float32, error) {
 	return nil, lastErr
 }
 
+// VisionEmbeddingRegion embeds the part of an image inside a rectangle whose
+// position and size are fractions of the image dimensions.
+func VisionEmbeddingRegion(buf []byte, x, y, w, h float64) ([]float32, error) {
+	if len(buf) == 0 {
+		return nil, fmt.Errorf("vision embedding: empty image buffer")
+	}
+
+	ensureVips()
+
+	img, err := vips.NewImageFromBuffer(buf, vips.DefaultLoadOptions())
+	if err != nil {
+		return nil, fmt.Errorf("vips load: %w", err)
+	}
+	defer img.Close()
+	// Regions are drawn on the image as browsers display it.
+	_ = img.Autorot()
+
+	width, height := img.Width(), img.Height()
+	left := min(int(x*float64(width)), width-1)
+	top := min(int(y*float64(height)), height-1)
+	cropW := max(1, min(int(math.Round(w*float64(width))), width-left))
+	cropH := max(1, min(int(math.Round(h*float64(height))), height-top))
+	if err := img.ExtractArea(left, top, cropW, cropH); err != nil {
+		return nil, fmt.Errorf("vips extract area: %w", err)
+	}
+	crop, err := img.PngsaveBuffer(nil)
+	if err != nil {
+		return nil, fmt.Errorf("vips pngsave: %w", err)
+	}
+	return VisionEmbedding(crop)
+}
+
 func l2(v []float32) {
 	var sum float64
 	for _, x := range v {
@@ -93,13 +134,39 @@ func VisionEmbeddingRGB24(buf []byte) ([]float32, error) {
 	return nil, lastErr
 }
 
-func visionEmbeddingWithSize(buf []byte, S int) ([]float32, error) {
+// VisionDimension returns the length of the vectors the vision model
+// produces, found by embedding a blank image.
+func VisionDimension() (int, error) {
+	const maxAttempts = 3
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		S := InputSpatialSize()
+		vec, err := visionEmbeddingRGB24WithSize(make([]byte, 3*S*S), S)
+		if err == nil {
+			return len(vec), nil
+		}
+		lastErr = err
+
+		if newSize, ok := retargetVisionInputSize(err, S); ok {
+			setInputSpatialSize(newSize)
+			continue
+		}
+
+		return 0, err
+	}
+
+	return 0, lastErr
+}
+
+func visionEmbeddingWithSize(buf []byte, S, page int) ([]float32, error) {
 	if S <= 0 {
 		return nil, fmt.Errorf("invalid vision input size %d", S)
 	}
 
 	// 1) Do NOT set loader autorotate — PNG/WebP PNG path will reject it.
 	loadOptions := vips.DefaultLoadOptions()
+	loadOptions.Page = page
 	// loadOptions.Autorotate = true // <-- remove
 
 	// (Optional) If you want autorotation for JPEGs only:
@@ -0,0 +1,91 @@
+package embedrpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type textResult struct {
+	vec []float32
+	err error
+}
+
+type textRequest struct {
+	text string
+	// result is buffered so the batcher never waits for callers that gave up.
+	result chan textResult
+}
+
+// textBatcher collects texts embedded at about the same time and embeds
+// them together, which costs little more than embedding one.
+type textBatcher struct {
+	embedAll func([]string) ([][]float32, error)
+	max      int
+	wait     time.Duration
+	requests chan textRequest
+}
+
+func newTextBatcher(embedAll func([]string) ([][]float32, error), max int, wait time.Duration) *textBatcher {
+	return &textBatcher{embedAll: embedAll, max: max, wait: wait, requests: make(chan textRequest)}
+}
+
+// embed queues text for the next batch and waits for its vector.
+func (b *textBatcher) embed(ctx context.Context, text string) ([]float32, error) {
+	req := textRequest{text: text, result: make(chan textResult, 1)}
+	select {
+	case b.requests <- req:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case res := <-req.result:
+		return res.vec, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// run embeds batches until ctx is done. A batch is complete when it is full
+// or wait has passed since its first text arrived.
+func (b *textBatcher) run(ctx context.Context) {
+	for {
+		var batch []textRequest
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-b.requests:
+			batch = append(batch, req)
+		}
+		timer := time.NewTimer(b.wait)
+	collect:
+		for len(batch) < b.max {
+			select {
+			case req := <-b.requests:
+				batch = append(batch, req)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+		b.flush(batch)
+	}
+}
+
+func (b *textBatcher) flush(batch []textRequest) {
+	texts := make([]string, len(batch))
+	for i, req := range batch {
+		texts[i] = req.text
+	}
+	vecs, err := b.embedAll(texts)
+	if err == nil && len(vecs) != len(batch) {
+		err = fmt.Errorf("embedded %d of %d texts", len(vecs), len(batch))
+	}
+	for i, req := range batch {
+		if err != nil {
+			req.result <- textResult{err: err}
+			continue
+		}
+		req.result <- textResult{vec: vecs[i]}
+	}
+}
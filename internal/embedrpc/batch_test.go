@@ -0,0 +1,89 @@
+package embedrpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"era/booru/proto/embedpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTextBatcher(t *testing.T) {
+	var mu sync.Mutex
+	var sizes []int
+	embedAll := func(texts []string) ([][]float32, error) {
+		mu.Lock()
+		sizes = append(sizes, len(texts))
+		mu.Unlock()
+		vecs := make([][]float32, len(texts))
+		for i, text := range texts {
+			vecs[i] = []float32{float32(len(text))}
+		}
+		return vecs, nil
+	}
+	b := newTextBatcher(embedAll, 3, 50*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go b.run(ctx)
+
+	texts := []string{"a", "bb", "ccc", "dddd"}
+	var wg sync.WaitGroup
+	for _, text := range texts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vec, err := b.embed(ctx, text)
+			if err != nil {
+				t.Errorf("embed %q: %v", text, err)
+				return
+			}
+			if len(vec) != 1 || int(vec[0]) != len(text) {
+				t.Errorf("embed %q: got %v", text, vec)
+			}
+		}()
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	total := 0
+	for _, n := range sizes {
+		if n > 3 {
+			t.Fatalf("batch of %d exceeds the maximum of 3", n)
+		}
+		total += n
+	}
+	if total != len(texts) || len(sizes) >= len(texts) {
+		t.Fatalf("expected %d texts in fewer batches, got batches %v", len(texts), sizes)
+	}
+}
+
+func TestTextBatcherError(t *testing.T) {
+	b := newTextBatcher(func([]string) ([][]float32, error) {
+		return nil, errors.New("model failed")
+	}, 4, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go b.run(ctx)
+
+	if _, err := b.embed(ctx, "a"); err == nil || err.Error() != "model failed" {
+		t.Fatalf("expected the model error, got %v", err)
+	}
+}
+
+func TestServerRejectsOtherModel(t *testing.T) {
+	s := &Server{Model: "m1"}
+	_, err := s.Text(context.Background(), &embedpb.TextRequest{Text: "a", Model: "m2"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	_, err = s.Text(context.Background(), &embedpb.TextRequest{Text: " ", Model: "m1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package embedrpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"era/booru/internal/queue"
+	"era/booru/proto/embedpb"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// rpcTimeout bounds a gRPC call so a stuck worker still leaves time for the
+// queue.
+const rpcTimeout = 5 * time.Second
+
+// TextEmbedder embeds search queries. It asks the embed worker over gRPC
+// when an address is configured and falls back to a queued job, which any
+// worker running the model picks up, when the call fails.
+type TextEmbedder struct {
+	conn   *grpc.ClientConn
+	client embedpb.EmbedderClient
+	queue  *river.Client[pgx.Tx]
+}
+
+// NewTextEmbedder returns an embedder that only uses the queue when addr is
+// empty. Connections are established lazily.
+func NewTextEmbedder(addr string, queueClient *river.Client[pgx.Tx]) (*TextEmbedder, error) {
+	t := &TextEmbedder{queue: queueClient}
+	if addr == "" {
+		return t, nil
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("embed worker %s: %w", addr, err)
+	}
+	t.conn = conn
+	t.client = embedpb.NewEmbedderClient(conn)
+	return t, nil
+}
+
+// Close closes the gRPC connection.
+func (t *TextEmbedder) Close() error {
+	if t.conn == nil {
+		return nil
+	}
+	return t.conn.Close()
+}
+
+// Embed returns the embedding of text by model, or by any model when model
+// is empty.
+func (t *TextEmbedder) Embed(ctx context.Context, text, model string) ([]float32, error) {
+	if t.client != nil {
+		rpcCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+		vec, err := t.client.Text(rpcCtx, &embedpb.TextRequest{Text: text, Model: model})
+		cancel()
+		if err == nil && len(vec.GetValues()) > 0 {
+			return vec.GetValues(), nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// Another model is expected while models are switched.
+		if status.Code(err) != codes.FailedPrecondition {
+			log.Printf("embed text over gRPC: %v; falling back to the queue", err)
+		}
+	}
+	if t.queue == nil {
+		return nil, fmt.Errorf("queue client is not configured")
+	}
+	return queue.RequestTextEmbedding(ctx, t.queue, text, model)
+}
@@ -0,0 +1,99 @@
+// Package embedrpc serves the Embedder service of proto/embed.proto from the
+// embed worker and calls it from the API server.
+package embedrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	embed "era/booru/internal/embeddings"
+	"era/booru/proto/embedpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxBatch is the most texts embedded in one run of the text model.
+	maxBatch = 32
+	// batchWait is how long the first text of a batch waits for others.
+	batchWait = 5 * time.Millisecond
+)
+
+// Server implements the Embedder service with the loaded model. Concurrent
+// text requests share runs of the text model.
+type Server struct {
+	embedpb.UnimplementedEmbedderServer
+	// Model identifies the loaded model, as recorded in stored vectors.
+	Model string
+	texts *textBatcher
+}
+
+// NewServer returns a server for the loaded model.
+func NewServer(model string) *Server {
+	return &Server{Model: model, texts: newTextBatcher(embed.TextEmbeddings, maxBatch, batchWait)}
+}
+
+// Serve listens on addr and serves s until ctx is done.
+func Serve(ctx context.Context, addr string, s *Server) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	gs := grpc.NewServer()
+	embedpb.RegisterEmbedderServer(gs, s)
+	go s.texts.run(ctx)
+	go func() {
+		<-ctx.Done()
+		gs.GracefulStop()
+	}()
+	return gs.Serve(lis)
+}
+
+func (s *Server) Text(ctx context.Context, req *embedpb.TextRequest) (*embedpb.Vector, error) {
+	if err := s.checkModel(req.GetModel()); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetText()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is empty")
+	}
+	vec, err := s.texts.embed(ctx, req.GetText())
+	return vector(ctx, vec, err)
+}
+
+func (s *Server) Image(ctx context.Context, req *embedpb.ImageRequest) (*embedpb.Vector, error) {
+	if err := s.checkModel(req.GetModel()); err != nil {
+		return nil, err
+	}
+	if len(req.GetJpeg()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "image is empty")
+	}
+	vec, err := embed.VisionEmbedding(req.GetJpeg())
+	return vector(ctx, vec, err)
+}
+
+// checkModel rejects requests for another model than the loaded one, so the
+// caller can route them elsewhere.
+func (s *Server) checkModel(model string) error {
+	if model != "" && model != s.Model {
+		return status.Errorf(codes.FailedPrecondition, "server runs model %q, not %q", s.Model, model)
+	}
+	return nil
+}
+
+func vector(ctx context.Context, vec []float32, err error) (*embedpb.Vector, error) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(vec) == 0 {
+		return nil, status.Error(codes.Unavailable, "no model loaded")
+	}
+	return &embedpb.Vector{Values: vec}, nil
+}
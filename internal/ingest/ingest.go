@@ -0,0 +1,158 @@
+// Package ingest turns newly uploaded originals into processing jobs.
+package ingest
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/internal/queue"
+	"era/booru/internal/storage"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// object is an original waiting to be enqueued. An empty content type is
+// looked up from storage.
+type object struct {
+	key         string
+	contentType string
+}
+
+// Ingester enqueues process jobs for originals reported by the storage
+// watcher and for those found by periodic catch-up scans, which cover
+// uploads made while notifications were unavailable. Enqueueing is
+// idempotent, so an object reported by both paths is processed once.
+type Ingester struct {
+	DB      *ent.Client
+	Storage storage.Backend
+	Queue   *river.Client[pgx.Tx]
+	// Concurrency bounds how many objects are enqueued at the same time.
+	Concurrency int
+	// ScanInterval is the time between catch-up scans; 0 scans only on start.
+	ScanInterval time.Duration
+
+	objects chan object
+}
+
+// Run watches storage and scans the bucket until ctx is done.
+func (i *Ingester) Run(ctx context.Context) {
+	workers := max(i.Concurrency, 1)
+	i.objects = make(chan object, workers)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for obj := range i.objects {
+				if err := i.enqueue(ctx, obj); err != nil {
+					log.Printf("enqueue process %s: %v", obj.key, err)
+				}
+			}
+		}()
+	}
+
+	var producers sync.WaitGroup
+	producers.Add(2)
+	go func() {
+		defer producers.Done()
+		i.Storage.Watch(ctx, func(ctx context.Context, key, contentType string) {
+			log.Printf("object %s uploaded with content type %s", key, contentType)
+			i.submit(ctx, object{key: key, contentType: contentType})
+		})
+	}()
+	go func() {
+		defer producers.Done()
+		i.scanLoop(ctx)
+	}()
+
+	producers.Wait()
+	close(i.objects)
+	wg.Wait()
+}
+
+func (i *Ingester) submit(ctx context.Context, obj object) {
+	select {
+	case i.objects <- obj:
+	case <-ctx.Done():
+	}
+}
+
+func (i *Ingester) scanLoop(ctx context.Context) {
+	var tick <-chan time.Time
+	if i.ScanInterval > 0 {
+		ticker := time.NewTicker(i.ScanInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		if n, err := i.scan(ctx); err != nil {
+			log.Printf("ingest scan: %v", err)
+		} else if n > 0 {
+			log.Printf("ingest scan found %d unprocessed objects", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+		}
+	}
+}
+
+// scan submits every original without a media row and returns their count.
+// Originals that failed processing for good are left out.
+func (i *Ingester) scan(ctx context.Context) (int, error) {
+	ids, err := i.DB.Media.Query().IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	known := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		known[id] = true
+	}
+
+	deleting, err := queue.PendingDeletes(ctx, i.Queue)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range deleting {
+		known[id] = true
+	}
+	failed, err := queue.FailedProcessKeys(ctx, i.Queue)
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range failed {
+		known[key] = true
+	}
+
+	found := 0
+	for obj := range i.Storage.List(ctx, i.Storage.Bucket()) {
+		if obj.Err != nil {
+			return found, obj.Err
+		}
+		if known[obj.Key] {
+			continue
+		}
+		found++
+		i.submit(ctx, object{key: obj.Key, contentType: obj.ContentType})
+	}
+	return found, ctx.Err()
+}
+
+func (i *Ingester) enqueue(ctx context.Context, obj object) error {
+	bucket := i.Storage.Bucket()
+	if obj.contentType == "" {
+		// Listings carry no content type; the worker routes on it.
+		info, err := i.Storage.Stat(ctx, bucket, obj.key)
+		if err != nil {
+			return err
+		}
+		obj.contentType = info.ContentType
+	}
+	return queue.Enqueue(ctx, i.Queue, queue.ProcessArgs{Bucket: bucket, Key: obj.key, ContentType: obj.contentType})
+}
@@ -7,29 +7,28 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
-	mc "github.com/minio/minio-go/v7"
-
-	"era/booru/internal/minio"
 	"era/booru/internal/processing"
+	"era/booru/internal/storage"
 )
 
-// ErabooruClient provides helpers for interacting with the test server and MiniO.
+// ErabooruClient provides helpers for interacting with the test server and storage.
 // All fatal errors are reported via the testing.TB instance.
 type ErabooruClient struct {
 	t       testing.TB
-	Minio   *minio.Client
+	Store   storage.Backend
 	client  *http.Client
 	baseURL string
 }
 
 // NewClient constructs a new helper client.
-func NewClient(t testing.TB, m *minio.Client, httpClient *http.Client, baseURL string) *ErabooruClient {
+func NewClient(t testing.TB, store storage.Backend, httpClient *http.Client, baseURL string) *ErabooruClient {
 	t.Helper()
-	return &ErabooruClient{t: t, Minio: m, client: httpClient, baseURL: strings.TrimRight(baseURL, "/")}
+	return &ErabooruClient{t: t, Store: store, client: httpClient, baseURL: strings.TrimRight(baseURL, "/")}
 }
 
 // WaitForMedia polls until the media item becomes available.
@@ -51,7 +50,7 @@ func (c *ErabooruClient) WaitForMedia(id string, timeout time.Duration) {
 	c.t.Fatalf("media %s not ingested", id)
 }
 
-// UploadAndWait uploads the given file to MinIO and waits until it is processed.
+// UploadAndWait uploads the given file to storage and waits until it is processed.
 func (c *ErabooruClient) UploadAndWait(ctx context.Context, path string) string {
 	c.t.Helper()
 	f, err := os.Open(path)
@@ -67,29 +66,153 @@ func (c *ErabooruClient) UploadAndWait(ctx context.Context, path string) string
 	if _, err := f.Seek(0, 0); err != nil {
 		c.t.Fatalf("seek %s: %v", path, err)
 	}
-	if _, err := c.Minio.PutObject(ctx, c.Minio.Bucket, hash, f, -1, mc.PutObjectOptions{ContentType: "image/png"}); err != nil {
+	if _, err := c.Store.Put(ctx, c.Store.Bucket(), hash, f, "image/png"); err != nil {
 		c.t.Fatalf("put %s: %v", hash, err)
 	}
 	c.WaitForMedia(hash, 10*time.Second)
 	return hash
 }
 
-// AddTags adds tags to the given media item via the API.
+// AddTags replaces the tags of the given media item via the API, based on
+// its current version.
 func (c *ErabooruClient) AddTags(id string, tags []string) {
+	c.t.Helper()
+	if code := c.SetTags(id, tags, c.Version(id)); code != http.StatusOK {
+		c.t.Fatalf("tag response %d", code)
+	}
+}
+
+// SetTags replaces the tags of the given media item if it is still at
+// version and returns the response status.
+func (c *ErabooruClient) SetTags(id string, tags []string, version int) int {
 	c.t.Helper()
 	b, _ := json.Marshal(struct {
-		Tags []string `json:"tags"`
-	}{Tags: tags})
+		Tags    []string `json:"tags"`
+		Version int      `json:"version"`
+	}{Tags: tags, Version: version})
 	resp, err := c.client.Post(fmt.Sprintf("%s/api/media/%s/tags", c.baseURL, id), "application/json", bytes.NewReader(b))
 	if err != nil {
 		c.t.Fatalf("post tags %s: %v", id, err)
 	}
 	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// PatchTags adds and removes tags of the given media item via the API.
+func (c *ErabooruClient) PatchTags(id string, add, remove []string) {
+	c.t.Helper()
+	b, _ := json.Marshal(struct {
+		Add    []string `json:"add"`
+		Remove []string `json:"remove"`
+	}{Add: add, Remove: remove})
+	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/api/media/%s/tags", c.baseURL, id), bytes.NewReader(b))
+	if err != nil {
+		c.t.Fatalf("patch tags %s: %v", id, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		c.t.Fatalf("patch tags %s: %v", id, err)
+	}
+	resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		c.t.Fatalf("tag response %d", resp.StatusCode)
+		c.t.Fatalf("patch tags response %d", resp.StatusCode)
 	}
 }
 
+// Media returns the given media item as served by the API.
+func (c *ErabooruClient) Media(id string) MediaItem {
+	c.t.Helper()
+	var item MediaItem
+	c.getJSON(fmt.Sprintf("%s/api/media/%s", c.baseURL, id), &item)
+	return item
+}
+
+// Version returns the current version of the given media item.
+func (c *ErabooruClient) Version(id string) int {
+	c.t.Helper()
+	return c.Media(id).Version
+}
+
+// Revisions lists the revisions of the given media item, newest first.
+func (c *ErabooruClient) Revisions(id string) []Revision {
+	c.t.Helper()
+	var out struct {
+		Revisions []Revision `json:"revisions"`
+	}
+	c.getJSON(fmt.Sprintf("%s/api/media/%s/revisions", c.baseURL, id), &out)
+	return out.Revisions
+}
+
+// Revert restores the tags and dates of the given media item from a
+// revision via the API.
+func (c *ErabooruClient) Revert(id string, revision int) {
+	c.t.Helper()
+	resp, err := c.client.Post(fmt.Sprintf("%s/api/media/%s/revisions/%d/revert", c.baseURL, id, revision), "application/json", nil)
+	if err != nil {
+		c.t.Fatalf("revert %s: %v", id, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		c.t.Fatalf("revert response %d", resp.StatusCode)
+	}
+}
+
+func (c *ErabooruClient) getJSON(url string, v any) {
+	c.t.Helper()
+	resp, err := c.client.Get(url)
+	if err != nil {
+		c.t.Fatalf("get %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		c.t.Fatalf("get %s: response %d", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		c.t.Fatalf("decode %s: %v", url, err)
+	}
+}
+
+// MediaItem holds the fields of a media item the tests look at.
+type MediaItem struct {
+	Version int `json:"version"`
+	Tags    []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+	Dates []struct {
+		Name  string    `json:"name"`
+		Value time.Time `json:"value"`
+	} `json:"dates"`
+}
+
+// TagNames returns the sorted tag names of the item.
+func (m MediaItem) TagNames() []string {
+	names := make([]string, len(m.Tags))
+	for i, t := range m.Tags {
+		names[i] = t.Name
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Date returns the value of the named date as YYYY-MM-DD, or "" when unset.
+func (m MediaItem) Date(name string) string {
+	for _, d := range m.Dates {
+		if d.Name == name {
+			return d.Value.Format("2006-01-02")
+		}
+	}
+	return ""
+}
+
+// Revision is an entry of the revision history of a media item.
+type Revision struct {
+	ID         int               `json:"id"`
+	Action     string            `json:"action"`
+	TagsAfter  []string          `json:"tags_after"`
+	DatesAfter map[string]string `json:"dates_after"`
+}
+
 // SetUploadDate sets the upload date for the given media item via the API.
 func (c *ErabooruClient) SetUploadDate(id, val string) {
 	c.t.Helper()
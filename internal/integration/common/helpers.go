@@ -16,8 +16,8 @@ import (
 
 	"era/booru/internal/config"
 	"era/booru/internal/db"
-	"era/booru/internal/minio"
 	"era/booru/internal/queue"
+	"era/booru/internal/storage"
 	indexworker "era/booru/internal/workers/indexworker"
 	mediaworker "era/booru/internal/workers/mediaworker"
 )
@@ -85,15 +85,20 @@ func StartMediaWorker(t testing.TB, ctx context.Context, cfg *config.Config) *Me
 		t.Fatalf("db: %v", err)
 	}
 
-	m, err := minio.New(cfg)
+	store, err := storage.New(cfg)
 	if err != nil {
-		t.Fatalf("minio: %v", err)
+		t.Fatalf("storage: %v", err)
 	}
 
 	river.AddWorker(workers, &mediaworker.ProcessWorker{
-		Minio: m,
-		DB:    database,
-		Cfg:   cfg,
+		Storage: store,
+		DB:      database,
+		Cfg:     cfg,
+	})
+	river.AddWorker(workers, &mediaworker.TranscodeWorker{
+		Storage: store,
+		DB:      database,
+		Cfg:     cfg,
 	})
 	river.AddWorker(workers, &indexworker.IndexWorker{DB: database})
 
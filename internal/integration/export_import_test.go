@@ -89,7 +89,7 @@ func TestExportImportCycle(t *testing.T) {
 	ts := httptest.NewServer(srv.Router)
 	defer ts.Close()
 	client := ts.Client()
-	ec := common.NewClient(t, srv.Minio, client, ts.URL)
+	ec := common.NewClient(t, srv.Store, client, ts.URL)
 
 	img1Hash := ec.UploadAndWait(ctx, filepath.Join("testdata", "img1.png"))
 	img2Hash := ec.UploadAndWait(ctx, filepath.Join("testdata", "img2.png"))
@@ -141,7 +141,7 @@ func TestExportImportCycle(t *testing.T) {
 	ts = httptest.NewServer(srv.Router)
 	defer ts.Close()
 	client = ts.Client()
-	ec = common.NewClient(t, srv.Minio, client, ts.URL)
+	ec = common.NewClient(t, srv.Store, client, ts.URL)
 
 	// Now regenerate should work without River errors
 	resp, err = client.Post(ts.URL+"/api/admin/regenerate", "application/json", nil)
@@ -0,0 +1,139 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	tcminio "github.com/testcontainers/testcontainers-go/modules/minio"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	common "era/booru/internal/integration/common"
+	"era/booru/internal/server"
+)
+
+func TestTagEditsAndRevert(t *testing.T) {
+	if os.Getenv("RUN_INTEGRATION_TESTS") == "" {
+		t.Skip("integration test; set RUN_INTEGRATION_TESTS=1 to run")
+	}
+
+	ctx := context.Background()
+
+	pgC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:16-alpine",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_DB":       "booru",
+				"POSTGRES_USER":     "booru",
+				"POSTGRES_PASSWORD": "booru",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatalf("start postgres: %v", err)
+	}
+	defer pgC.Terminate(ctx)
+
+	host, err := pgC.Host(ctx)
+	if err != nil {
+		t.Fatalf("get host: %v", err)
+	}
+	port, err := pgC.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		t.Fatalf("get port: %v", err)
+	}
+	dsn := fmt.Sprintf("postgres://booru:booru@%s:%s/booru?sslmode=disable", host, port.Port())
+
+	mC, err := tcminio.Run(ctx, "minio/minio:RELEASE.2024-01-16T16-07-38Z",
+		tcminio.WithUsername("minioadmin"), tcminio.WithPassword("minio123"))
+	if err != nil {
+		t.Fatalf("start minio: %v", err)
+	}
+	defer mC.Terminate(ctx)
+
+	common.WaitForPostgres(t, dsn, 30*time.Second)
+
+	minioAddr, err := mC.ConnectionString(ctx)
+	if err != nil {
+		t.Fatalf("minio addr: %v", err)
+	}
+
+	cfg := common.SetupEnv(t, dsn, minioAddr)
+
+	srv, err := server.New(ctx, cfg)
+	if err != nil {
+		t.Fatalf("server: %v", err)
+	}
+	defer srv.Close()
+
+	mediaWorker := common.StartMediaWorker(t, ctx, cfg)
+	defer mediaWorker.Stop()
+
+	ts := httptest.NewServer(srv.Router)
+	defer ts.Close()
+	ec := common.NewClient(t, srv.Store, ts.Client(), ts.URL)
+
+	id := ec.UploadAndWait(ctx, filepath.Join("testdata", "img1.png"))
+	ec.SetUploadDate(id, "2021-01-02")
+
+	// Replacing the tags needs the current version; a stale one conflicts
+	// and leaves the tags alone.
+	stale := ec.Version(id)
+	if code := ec.SetTags(id, []string{"alpha", "beta"}, stale); code != http.StatusOK {
+		t.Fatalf("set tags: response %d", code)
+	}
+	if code := ec.SetTags(id, []string{"gamma"}, stale); code != http.StatusConflict {
+		t.Fatalf("set tags with stale version: got %d, want %d", code, http.StatusConflict)
+	}
+	if got := ec.Media(id).TagNames(); !slices.Equal(got, []string{"alpha", "beta"}) {
+		t.Fatalf("tags after conflict: got %v", got)
+	}
+
+	// Adding and removing tags needs no version and keeps the others.
+	ec.PatchTags(id, []string{"gamma"}, []string{"alpha"})
+	if got := ec.Media(id).TagNames(); !slices.Equal(got, []string{"beta", "gamma"}) {
+		t.Fatalf("tags after patch: got %v", got)
+	}
+
+	ec.SetUploadDate(id, "2022-02-03")
+
+	// Reverting to the first tag edit restores its tags and dates.
+	var setTags *common.Revision
+	for _, rev := range ec.Revisions(id) {
+		if rev.Action == "set_tags" {
+			setTags = &rev
+		}
+	}
+	if setTags == nil {
+		t.Fatalf("no set_tags revision recorded")
+	}
+	if got := setTags.DatesAfter["upload"]; got != "2021-01-02" {
+		t.Fatalf("set_tags revision: upload date %q, want 2021-01-02", got)
+	}
+	before := ec.Version(id)
+	ec.Revert(id, setTags.ID)
+
+	item := ec.Media(id)
+	if got := item.TagNames(); !slices.Equal(got, []string{"alpha", "beta"}) {
+		t.Fatalf("tags after revert: got %v", got)
+	}
+	if got := item.Date("upload"); got != "2021-01-02" {
+		t.Fatalf("upload date after revert: got %q", got)
+	}
+	if item.Version <= before {
+		t.Fatalf("revert kept version %d", item.Version)
+	}
+	if revs := ec.Revisions(id); len(revs) == 0 || revs[0].Action != "revert" {
+		t.Fatalf("revert not recorded: %+v", revs)
+	}
+}
@@ -0,0 +1,148 @@
+// Package markdown renders the Markdown subset used in comments to HTML.
+//
+// Raw HTML is always escaped, so the output is safe to embed in a page.
+// Supported are paragraphs, line breaks, fenced code blocks, block quotes,
+// bullet lists, code spans, **strong**, *emphasis*, [links](https://…) and
+// bare http(s) URLs. Underscores are left alone since tag names use them.
+package markdown
+
+import (
+	"html"
+	"strings"
+)
+
+// Render converts Markdown source to HTML.
+func Render(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	var b strings.Builder
+	renderBlocks(&b, strings.Split(src, "\n"))
+	return b.String()
+}
+
+func renderBlocks(b *strings.Builder, lines []string) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			i++
+		case strings.HasPrefix(trimmed, "```"):
+			i++
+			start := i
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+				i++
+			}
+			b.WriteString("<pre><code>")
+			b.WriteString(html.EscapeString(strings.Join(lines[start:i], "\n")))
+			b.WriteString("</code></pre>\n")
+			i++ // closing fence
+		case isQuote(trimmed):
+			var inner []string
+			for ; i < len(lines) && isQuote(strings.TrimSpace(lines[i])); i++ {
+				q := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				inner = append(inner, strings.TrimPrefix(q, " "))
+			}
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, inner)
+			b.WriteString("</blockquote>\n")
+		case isListItem(trimmed):
+			b.WriteString("<ul>\n")
+			for ; i < len(lines) && isListItem(strings.TrimSpace(lines[i])); i++ {
+				b.WriteString("<li>")
+				b.WriteString(renderInline(strings.TrimSpace(lines[i])[2:]))
+				b.WriteString("</li>\n")
+			}
+			b.WriteString("</ul>\n")
+		default:
+			var para []string
+			for ; i < len(lines); i++ {
+				t := strings.TrimSpace(lines[i])
+				if t == "" || strings.HasPrefix(t, "```") || isQuote(t) || isListItem(t) {
+					break
+				}
+				para = append(para, renderInline(t))
+			}
+			b.WriteString("<p>")
+			b.WriteString(strings.Join(para, "<br>\n"))
+			b.WriteString("</p>\n")
+		}
+	}
+}
+
+func isQuote(line string) bool { return strings.HasPrefix(line, ">") }
+
+func isListItem(line string) bool {
+	return strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ")
+}
+
+func renderInline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		switch {
+		case rest[0] == '`':
+			if end := strings.IndexByte(rest[1:], '`'); end >= 0 {
+				b.WriteString("<code>" + html.EscapeString(rest[1:1+end]) + "</code>")
+				i += end + 2
+				continue
+			}
+		case strings.HasPrefix(rest, "**"):
+			if end := strings.Index(rest[2:], "**"); end > 0 {
+				b.WriteString("<strong>" + renderInline(rest[2:2+end]) + "</strong>")
+				i += end + 4
+				continue
+			}
+		case rest[0] == '*':
+			if end := strings.IndexByte(rest[1:], '*'); end > 0 {
+				b.WriteString("<em>" + renderInline(rest[1:1+end]) + "</em>")
+				i += end + 2
+				continue
+			}
+		case rest[0] == '[':
+			if text, url, n, ok := parseLink(rest); ok {
+				b.WriteString(link(url, renderInline(text)))
+				i += n
+				continue
+			}
+		case isURLStart(rest) && (i == 0 || s[i-1] == ' ' || s[i-1] == '('):
+			n := strings.IndexAny(rest, " \t")
+			if n < 0 {
+				n = len(rest)
+			}
+			url := strings.TrimRight(rest[:n], ".,;:!?)")
+			b.WriteString(link(url, html.EscapeString(url)))
+			i += len(url)
+			continue
+		}
+		b.WriteString(html.EscapeString(rest[:1]))
+		i++
+	}
+	return b.String()
+}
+
+// parseLink parses "[text](url)" at the start of s. Only http and https
+// URLs are accepted.
+func parseLink(s string) (text, url string, n int, ok bool) {
+	closeText := strings.Index(s, "](")
+	if closeText < 0 {
+		return "", "", 0, false
+	}
+	closeURL := strings.IndexByte(s[closeText+2:], ')')
+	if closeURL < 0 {
+		return "", "", 0, false
+	}
+	text = s[1:closeText]
+	url = s[closeText+2 : closeText+2+closeURL]
+	if text == "" || !isURLStart(url) || strings.ContainsAny(url, " \t") {
+		return "", "", 0, false
+	}
+	return text, url, closeText + 3 + closeURL, true
+}
+
+func isURLStart(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+func link(url, inner string) string {
+	return `<a href="` + html.EscapeString(url) + `" rel="nofollow noopener noreferrer">` + inner + "</a>"
+}
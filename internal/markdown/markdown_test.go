@@ -0,0 +1,36 @@
+package markdown
+
+import "testing"
+
+func TestRender(t *testing.T) {
+	cases := map[string]string{
+		"hello":                          "<p>hello</p>\n",
+		"a\nb\n\nc":                      "<p>a<br>\nb</p>\n<p>c</p>\n",
+		"**bold** and *it* `x*y`":        "<p><strong>bold</strong> and <em>it</em> <code>x*y</code></p>\n",
+		"cat_girl_tail stays":            "<p>cat_girl_tail stays</p>\n",
+		"- one\n- two":                   "<ul>\n<li>one</li>\n<li>two</li>\n</ul>\n",
+		"> quoted\n> more":               "<blockquote>\n<p>quoted<br>\nmore</p>\n</blockquote>\n",
+		"```\n<b>\n```":                  "<pre><code>&lt;b&gt;</code></pre>\n",
+		"see https://example.com/a.":     "<p>see <a href=\"https://example.com/a\" rel=\"nofollow noopener noreferrer\">https://example.com/a</a>.</p>\n",
+		"[src](https://example.com?a=1)": "<p><a href=\"https://example.com?a=1\" rel=\"nofollow noopener noreferrer\">src</a></p>\n",
+	}
+	for in, want := range cases {
+		if got := Render(in); got != want {
+			t.Errorf("Render(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestRenderEscapesHTML(t *testing.T) {
+	cases := map[string]string{
+		`<script>alert(1)</script>`:          "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n",
+		`[x](javascript:alert(1))`:           "<p>[x](javascript:alert(1))</p>\n",
+		`[x](https://a.b/"onmouseover="f())`: "<p><a href=\"https://a.b/&#34;onmouseover=&#34;f(\" rel=\"nofollow noopener noreferrer\">x</a>)</p>\n",
+		"**<img src=x onerror=alert(1)>**":   "<p><strong>&lt;img src=x onerror=alert(1)&gt;</strong></p>\n",
+	}
+	for in, want := range cases {
+		if got := Render(in); got != want {
+			t.Errorf("Render(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
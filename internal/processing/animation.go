@@ -0,0 +1,213 @@
+package processing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image/gif"
+	"time"
+)
+
+// AnimationInfo describes the playback properties of an animated image.
+type AnimationInfo struct {
+	Frames   int
+	Duration time.Duration
+}
+
+// Animated reports whether the image has more than one frame.
+func (a AnimationInfo) Animated() bool {
+	return a.Frames > 1
+}
+
+// Browsers play frames with a delay under 20ms at 100ms; mirror that so the
+// stored duration matches what users actually see.
+const (
+	minFrameDelay     = 20 * time.Millisecond
+	defaultFrameDelay = 100 * time.Millisecond
+)
+
+var errTruncated = errors.New("truncated image data")
+
+// DetectAnimation inspects GIF, APNG and WebP data and returns its frame count
+// and total playback duration. Still images report a single frame.
+func DetectAnimation(data []byte, format string) (AnimationInfo, error) {
+	switch format {
+	case "gif":
+		return gifAnimation(data)
+	case "png":
+		return pngAnimation(data)
+	case "webp":
+		return webpAnimation(data)
+	default:
+		return AnimationInfo{Frames: 1}, nil
+	}
+}
+
+func clampFrameDelay(d time.Duration) time.Duration {
+	if d < minFrameDelay {
+		return defaultFrameDelay
+	}
+	return d
+}
+
+func gifAnimation(data []byte) (AnimationInfo, error) {
+	g, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return AnimationInfo{}, err
+	}
+	info := AnimationInfo{Frames: len(g.Image)}
+	if info.Frames <= 1 {
+		return info, nil
+	}
+	for _, delay := range g.Delay {
+		// GIF delays are expressed in hundredths of a second.
+		info.Duration += clampFrameDelay(time.Duration(delay) * 10 * time.Millisecond)
+	}
+	return info, nil
+}
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+func pngAnimation(data []byte) (AnimationInfo, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return AnimationInfo{}, errors.New("not a png image")
+	}
+	info := AnimationInfo{Frames: 1}
+	animated := false
+	var total time.Duration
+	for pos := len(pngSignature); pos+8 <= len(data); {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		kind := string(data[pos+4 : pos+8])
+		start := pos + 8
+		end := start + length
+		if length < 0 || end+4 > len(data) {
+			return AnimationInfo{}, errTruncated
+		}
+		chunk := data[start:end]
+		switch kind {
+		case "acTL":
+			// acTL must appear before the first IDAT for the image to be an APNG.
+			if len(chunk) < 8 {
+				return AnimationInfo{}, errTruncated
+			}
+			animated = true
+			info.Frames = int(binary.BigEndian.Uint32(chunk[0:4]))
+		case "fcTL":
+			if !animated {
+				break
+			}
+			if len(chunk) < 26 {
+				return AnimationInfo{}, errTruncated
+			}
+			num := time.Duration(binary.BigEndian.Uint16(chunk[20:22]))
+			den := time.Duration(binary.BigEndian.Uint16(chunk[22:24]))
+			if den == 0 {
+				den = 100
+			}
+			total += clampFrameDelay(num * time.Second / den)
+		case "IDAT":
+			if !animated {
+				return info, nil
+			}
+		case "IEND":
+			pos = len(data)
+			continue
+		}
+		pos = end + 4
+	}
+	if info.Frames > 1 {
+		info.Duration = total
+	}
+	return info, nil
+}
+
+// webpChunks walks the RIFF container and calls fn for every chunk.
+func webpChunks(data []byte, fn func(kind string, payload []byte) error) error {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return errors.New("not a webp image")
+	}
+	for pos := 12; pos+8 <= len(data); {
+		kind := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		start := pos + 8
+		end := start + size
+		if size < 0 || end > len(data) {
+			return errTruncated
+		}
+		if err := fn(kind, data[start:end]); err != nil {
+			return err
+		}
+		// Chunks are padded to an even size.
+		pos = end + size%2
+	}
+	return nil
+}
+
+func uint24(b []byte) int {
+	return int(b[0]) | int(b[1])<<8 | int(b[2])<<16
+}
+
+func webpAnimation(data []byte) (AnimationInfo, error) {
+	info := AnimationInfo{}
+	err := webpChunks(data, func(kind string, payload []byte) error {
+		if kind != "ANMF" {
+			return nil
+		}
+		if len(payload) < 16 {
+			return errTruncated
+		}
+		info.Frames++
+		info.Duration += clampFrameDelay(time.Duration(uint24(payload[12:15])) * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		return AnimationInfo{}, err
+	}
+	if info.Frames <= 1 {
+		return AnimationInfo{Frames: 1}, nil
+	}
+	return info, nil
+}
+
+// webpConfig reads the canvas size from a WebP header. The standard library
+// has no WebP decoder, so GetMetadata falls back to this for "webp" files.
+func webpConfig(data []byte) (width, height int, err error) {
+	found := false
+	err = webpChunks(data, func(kind string, payload []byte) error {
+		if found {
+			return nil
+		}
+		switch kind {
+		case "VP8X":
+			if len(payload) < 10 {
+				return errTruncated
+			}
+			width = uint24(payload[4:7]) + 1
+			height = uint24(payload[7:10]) + 1
+			found = true
+		case "VP8 ":
+			if len(payload) < 10 || payload[3] != 0x9d || payload[4] != 0x01 || payload[5] != 0x2a {
+				return errors.New("invalid VP8 header")
+			}
+			width = int(binary.LittleEndian.Uint16(payload[6:8]) & 0x3fff)
+			height = int(binary.LittleEndian.Uint16(payload[8:10]) & 0x3fff)
+			found = true
+		case "VP8L":
+			if len(payload) < 5 || payload[0] != 0x2f {
+				return errors.New("invalid VP8L header")
+			}
+			bits := binary.LittleEndian.Uint32(payload[1:5])
+			width = int(bits&0x3fff) + 1
+			height = int((bits>>14)&0x3fff) + 1
+			found = true
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if !found {
+		return 0, 0, errors.New("webp image has no frame header")
+	}
+	return width, height, nil
+}
@@ -0,0 +1,158 @@
+package processing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"testing"
+	"time"
+)
+
+func encodeGIF(t *testing.T, delays []int) []byte {
+	t.Helper()
+	palette := color.Palette{color.Black, color.White}
+	anim := &gif.GIF{}
+	for _, d := range delays {
+		anim.Image = append(anim.Image, image.NewPaletted(image.Rect(0, 0, 4, 4), palette))
+		anim.Delay = append(anim.Delay, d)
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, anim); err != nil {
+		t.Fatalf("encode gif: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func pngChunk(kind string, payload []byte) []byte {
+	out := make([]byte, 8, 12+len(payload))
+	binary.BigEndian.PutUint32(out[0:4], uint32(len(payload)))
+	copy(out[4:8], kind)
+	out = append(out, payload...)
+	crc := crc32.ChecksumIEEE(append([]byte(kind), payload...))
+	return binary.BigEndian.AppendUint32(out, crc)
+}
+
+func fcTL(num, den uint16) []byte {
+	payload := make([]byte, 26)
+	binary.BigEndian.PutUint16(payload[20:22], num)
+	binary.BigEndian.PutUint16(payload[22:24], den)
+	return pngChunk("fcTL", payload)
+}
+
+func webpChunk(kind string, payload []byte) []byte {
+	out := make([]byte, 8, 8+len(payload)+1)
+	copy(out[0:4], kind)
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(payload)))
+	out = append(out, payload...)
+	if len(payload)%2 == 1 {
+		out = append(out, 0)
+	}
+	return out
+}
+
+func riff(chunks ...[]byte) []byte {
+	body := []byte("WEBP")
+	for _, c := range chunks {
+		body = append(body, c...)
+	}
+	out := []byte("RIFF")
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(body)))
+	return append(out, body...)
+}
+
+func anmf(durationMs int) []byte {
+	payload := make([]byte, 16)
+	payload[12] = byte(durationMs)
+	payload[13] = byte(durationMs >> 8)
+	payload[14] = byte(durationMs >> 16)
+	return webpChunk("ANMF", payload)
+}
+
+func TestDetectAnimationGIF(t *testing.T) {
+	info, err := DetectAnimation(encodeGIF(t, []int{50, 50, 0}), "gif")
+	if err != nil {
+		t.Fatalf("detect: %v", err)
+	}
+	if info.Frames != 3 {
+		t.Fatalf("expected 3 frames, got %d", info.Frames)
+	}
+	// A zero delay plays back at 100ms in browsers.
+	if info.Duration != 1100*time.Millisecond {
+		t.Fatalf("unexpected duration %s", info.Duration)
+	}
+}
+
+func TestDetectAnimationStillGIF(t *testing.T) {
+	info, err := DetectAnimation(encodeGIF(t, []int{0}), "gif")
+	if err != nil {
+		t.Fatalf("detect: %v", err)
+	}
+	if info.Animated() {
+		t.Fatalf("single-frame gif reported as animated: %+v", info)
+	}
+}
+
+func TestDetectAnimationAPNG(t *testing.T) {
+	actl := make([]byte, 8)
+	binary.BigEndian.PutUint32(actl[0:4], 2)
+	var data []byte
+	data = append(data, pngSignature...)
+	data = append(data, pngChunk("IHDR", make([]byte, 13))...)
+	data = append(data, pngChunk("acTL", actl)...)
+	data = append(data, fcTL(1, 4)...)
+	data = append(data, pngChunk("IDAT", nil)...)
+	data = append(data, fcTL(500, 1000)...)
+	data = append(data, pngChunk("fdAT", make([]byte, 4))...)
+	data = append(data, pngChunk("IEND", nil)...)
+
+	info, err := DetectAnimation(data, "png")
+	if err != nil {
+		t.Fatalf("detect: %v", err)
+	}
+	if info.Frames != 2 || info.Duration != 750*time.Millisecond {
+		t.Fatalf("unexpected animation info %+v", info)
+	}
+}
+
+func TestDetectAnimationPlainPNG(t *testing.T) {
+	var data []byte
+	data = append(data, pngSignature...)
+	data = append(data, pngChunk("IHDR", make([]byte, 13))...)
+	data = append(data, pngChunk("IDAT", nil)...)
+	data = append(data, pngChunk("IEND", nil)...)
+
+	info, err := DetectAnimation(data, "png")
+	if err != nil {
+		t.Fatalf("detect: %v", err)
+	}
+	if info.Animated() {
+		t.Fatalf("plain png reported as animated: %+v", info)
+	}
+}
+
+func TestDetectAnimationWebP(t *testing.T) {
+	vp8x := make([]byte, 10)
+	vp8x[0] = 0x02
+	vp8x[4] = 99 // canvas width - 1
+	vp8x[7] = 49 // canvas height - 1
+	data := riff(webpChunk("VP8X", vp8x), webpChunk("ANIM", make([]byte, 6)), anmf(40), anmf(40), anmf(120))
+
+	info, err := DetectAnimation(data, "webp")
+	if err != nil {
+		t.Fatalf("detect: %v", err)
+	}
+	if info.Frames != 3 || info.Duration != 200*time.Millisecond {
+		t.Fatalf("unexpected animation info %+v", info)
+	}
+
+	meta, err := GetMetadata(data)
+	if err != nil {
+		t.Fatalf("metadata: %v", err)
+	}
+	if meta.Format != "webp" || meta.Width != 100 || meta.Height != 50 {
+		t.Fatalf("unexpected metadata %+v", meta)
+	}
+}
@@ -2,7 +2,11 @@ package processing
 
 import (
 	"bytes"
+	"errors"
 	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
 )
 
 type ImageMetadata struct {
@@ -14,6 +18,11 @@ type ImageMetadata struct {
 
 func GetMetadata(data []byte) (ImageMetadata, error) {
 	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		if w, h, webpErr := webpConfig(data); webpErr == nil {
+			cfg, format, err = image.Config{Width: w, Height: h}, "webp", nil
+		}
+	}
 	if err != nil {
 		return ImageMetadata{}, err
 	}
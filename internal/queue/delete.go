@@ -0,0 +1,58 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// PendingDeletes returns the IDs of media with an unfinished delete job. Their
+// objects outlive the database row for a moment, so scans that enqueue
+// processing for unknown objects must skip them.
+func PendingDeletes(ctx context.Context, c *river.Client[pgx.Tx]) ([]string, error) {
+	return listJobArgs(ctx, c, DeleteArgs{}.Kind(), activeJobStates, func(args DeleteArgs) string {
+		return args.ID
+	})
+}
+
+// FailedProcessKeys returns the keys of originals whose process job was
+// discarded or cancelled. Enqueueing them again is skipped until River prunes
+// the job, so scans leave them out instead of reporting them every time.
+func FailedProcessKeys(ctx context.Context, c *river.Client[pgx.Tx]) ([]string, error) {
+	states := []rivertype.JobState{rivertype.JobStateCancelled, rivertype.JobStateDiscarded}
+	return listJobArgs(ctx, c, ProcessArgs{}.Kind(), states, func(args ProcessArgs) string {
+		return args.Key
+	})
+}
+
+// listJobArgs returns the value of key for the args of every job of kind in
+// one of states.
+func listJobArgs[A any](ctx context.Context, c *river.Client[pgx.Tx], kind string, states []rivertype.JobState, key func(A) string) ([]string, error) {
+	const pageSize = 1000
+
+	var out []string
+	params := river.NewJobListParams().
+		Kinds(kind).
+		States(states...).
+		First(pageSize)
+	for {
+		res, err := c.JobList(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range res.Jobs {
+			var args A
+			if err := json.Unmarshal(job.EncodedArgs, &args); err != nil {
+				return nil, err
+			}
+			out = append(out, key(args))
+		}
+		if len(res.Jobs) < pageSize {
+			return out, nil
+		}
+		params = params.After(res.LastCursor)
+	}
+}
@@ -13,12 +13,13 @@ import (
 )
 
 // RequestTextEmbedding enqueues a text embedding job and waits for its result.
-func RequestTextEmbedding(ctx context.Context, client *river.Client[pgx.Tx], text string) ([]float32, error) {
+// Only workers running model take the job unless it is empty.
+func RequestTextEmbedding(ctx context.Context, client *river.Client[pgx.Tx], text, model string) ([]float32, error) {
 	if client == nil {
 		return nil, fmt.Errorf("queue client is not configured")
 	}
 
-	insertRes, err := client.Insert(ctx, EmbedTextArgs{Text: text}, &river.InsertOpts{Queue: "embed", Priority: 1})
+	insertRes, err := client.Insert(ctx, EmbedTextArgs{Text: text, Model: model}, &river.InsertOpts{Queue: "embed", Priority: 1})
 	if err != nil {
 		return nil, fmt.Errorf("enqueue text embedding: %w", err)
 	}
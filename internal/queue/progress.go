@@ -0,0 +1,26 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// RecordProgress stores progress under the "progress" metadata key of a
+// running job so it can be polled before the job finishes. River merges its
+// own metadata on completion, which keeps the last value.
+func RecordProgress(ctx context.Context, jobID int64, progress any) error {
+	client, err := river.ClientFromContextSafely[pgx.Tx](ctx)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	return client.Driver().GetExecutor().Exec(ctx,
+		`UPDATE river_job SET metadata = jsonb_set(metadata, '{progress}', $2::jsonb) WHERE id = $1`,
+		jobID, string(b))
+}
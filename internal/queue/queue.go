@@ -2,12 +2,14 @@ package queue
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/riverqueue/river/rivermigrate"
+	"github.com/riverqueue/river/rivertype"
 )
 
 type ClientType string
@@ -42,7 +44,10 @@ func getConfigForClientType(clientType ClientType, workers *river.Workers) *rive
 	case ClientTypeServer:
 		return (&river.Config{
 			Queues: map[string]river.QueueConfig{
-				"index": {MaxWorkers: 3},
+				"index":       {MaxWorkers: 3},
+				"delete":      {MaxWorkers: 2},
+				"maintenance": {MaxWorkers: 1},
+				"bulk":        {MaxWorkers: 1},
 			},
 			Workers: workers,
 		}).WithDefaults()
@@ -50,6 +55,8 @@ func getConfigForClientType(clientType ClientType, workers *river.Workers) *rive
 		return (&river.Config{
 			Queues: map[string]river.QueueConfig{
 				"process": {MaxWorkers: 3},
+				// Transcodes are slow and CPU bound; keep them from starving uploads.
+				"transcode": {MaxWorkers: 1},
 			},
 			Workers: workers,
 		}).WithDefaults()
@@ -67,8 +74,67 @@ func getConfigForClientType(clientType ClientType, workers *river.Workers) *rive
 	}
 }
 
-// Enqueue inserts a job into the default queue.
+// activeJobStates are the states in which a job has not finished yet.
+var activeJobStates = []rivertype.JobState{
+	rivertype.JobStateAvailable,
+	rivertype.JobStatePending,
+	rivertype.JobStateRetryable,
+	rivertype.JobStateRunning,
+	rivertype.JobStateScheduled,
+}
+
+// ingestJobStates also covers failed jobs so catch-up scans do not retry an
+// object that cannot be processed until River prunes the old job. Completed
+// jobs are left out: a deleted item can be uploaded again.
+var ingestJobStates = append([]rivertype.JobState{
+	rivertype.JobStateCancelled,
+	rivertype.JobStateDiscarded,
+}, activeJobStates...)
+
+// Enqueue inserts a job into the queue that handles its kind.
 func Enqueue(ctx context.Context, c *river.Client[pgx.Tx], args river.JobArgs) error {
+	_, err := EnqueueJob(ctx, c, args)
+	return err
+}
+
+// EnqueueJob is Enqueue for callers that report the job back. When a unique
+// job already exists, that job is returned.
+func EnqueueJob(ctx context.Context, c *river.Client[pgx.Tx], args river.JobArgs) (*rivertype.JobRow, error) {
+	res, err := c.Insert(ctx, args, insertOpts(args))
+	if err != nil {
+		return nil, err
+	}
+	return res.Job, nil
+}
+
+// enqueueBatch is the most jobs EnqueueMany inserts in one statement.
+const enqueueBatch = 1000
+
+// EnqueueMany is Enqueue for many jobs, inserted in batches. It returns the
+// number of jobs inserted; unique jobs that already exist are not counted.
+func EnqueueMany(ctx context.Context, c *river.Client[pgx.Tx], args []river.JobArgs) (int, error) {
+	inserted := 0
+	for start := 0; start < len(args); start += enqueueBatch {
+		batch := args[start:min(start+enqueueBatch, len(args))]
+		params := make([]river.InsertManyParams, len(batch))
+		for i, a := range batch {
+			params[i] = river.InsertManyParams{Args: a, InsertOpts: insertOpts(a)}
+		}
+		res, err := c.InsertMany(ctx, params)
+		if err != nil {
+			return inserted, err
+		}
+		for _, r := range res {
+			if !r.UniqueSkippedAsDuplicate {
+				inserted++
+			}
+		}
+	}
+	return inserted, nil
+}
+
+// insertOpts routes args to the queue that handles its kind.
+func insertOpts(args river.JobArgs) *river.InsertOpts {
 	opts := &river.InsertOpts{}
 
 	var (
@@ -80,17 +146,53 @@ func Enqueue(ctx context.Context, c *river.Client[pgx.Tx], args river.JobArgs) e
 	switch args.(type) {
 	case ProcessArgs:
 		queueName = "process" // Goes to media worker
+		// Notifications, catch-up scans and regenerate may all report the
+		// same object; only one job per object may be queued.
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: ingestJobStates}
+	case TranscodeArgs:
+		queueName = "transcode" // Goes to media worker
+		// Completed jobs must not block a later transcode of the same media.
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: activeJobStates}
 	case IndexArgs:
 		queueName = "index" // Goes to server
-		opts.UniqueOpts = river.UniqueOpts{ByArgs: true}
+		// Not unique: River counts running jobs as duplicates, so an edit
+		// committed after a running job read the row would lose its reindex.
+		// Indexing is idempotent, so an extra job only costs a little work.
+	case DeleteArgs:
+		queueName = "delete" // Goes to server, which owns the Bleve index
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: activeJobStates}
+	case FsckArgs:
+		queueName = "maintenance" // Goes to server, which owns the Bleve index
+		// Skip the run if one is already queued, but allow a new one once it finished.
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: activeJobStates}
+	case BulkEditArgs:
+		queueName = "bulk" // Goes to server, which owns the Bleve index
+	case PurgeTrashArgs:
+		queueName = "maintenance"
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: activeJobStates}
+	case TagCentroidsArgs:
+		queueName = "maintenance"
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: activeJobStates}
 	case EmbedArgs:
 		queueName = "embed" // Goes to image embed worker
 		priority = 2        // lower priority than search embeddings
 		setPriority = true
+		// Re-embedding may be requested again before the backlog is done.
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: activeJobStates}
+	case EmbedRegionArgs:
+		queueName = "embed"
+		priority = 2
+		setPriority = true
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: activeJobStates}
 	case EmbedTextArgs:
 		queueName = "embed"
 		priority = 1
 		setPriority = true
+	case AutoTagArgs:
+		queueName = "embed" // Needs the text model for new candidate prompts
+		priority = 3        // suggestions can wait for embeddings
+		setPriority = true
+		opts.UniqueOpts = river.UniqueOpts{ByArgs: true, ByState: activeJobStates}
 	default:
 		queueName = "" // Default queue
 	}
@@ -101,9 +203,7 @@ func Enqueue(ctx context.Context, c *river.Client[pgx.Tx], args river.JobArgs) e
 	if setPriority {
 		opts.Priority = priority
 	}
-
-	_, err := c.Insert(ctx, args, opts)
-	return err
+	return opts
 }
 
 func WorkerEnqueue(ctx context.Context, args river.JobArgs) error {
@@ -115,8 +215,8 @@ func WorkerEnqueue(ctx context.Context, args river.JobArgs) error {
 }
 
 type ProcessArgs struct {
-	Bucket      string `json:"bucket"`
-	Key         string `json:"key"`
+	Bucket      string `json:"bucket" river:"unique"`
+	Key         string `json:"key" river:"unique"`
 	ContentType string `json:"content_type,omitempty"`
 }
 
@@ -126,6 +226,76 @@ func (ProcessArgs) InsertOpts() river.InsertOpts {
 	return river.InsertOpts{MaxAttempts: 3}
 }
 
+// TranscodeArgs requests a browser-playable rendition of a video.
+type TranscodeArgs struct {
+	Key    string `json:"key"`
+	Format string `json:"format"`
+}
+
+func (TranscodeArgs) Kind() string { return "transcode_media" }
+
+func (TranscodeArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{MaxAttempts: 3}
+}
+
+// DeleteArgs permanently removes a trashed media item with everything derived
+// from it.
+type DeleteArgs struct {
+	ID string `json:"id"`
+}
+
+func (DeleteArgs) Kind() string { return "delete_media" }
+
+func (DeleteArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{MaxAttempts: 10}
+}
+
+// FsckArgs requests a consistency check between storage, Postgres and Bleve.
+// With Repair set the detected drift is also fixed.
+type FsckArgs struct {
+	Repair bool `json:"repair"`
+}
+
+func (FsckArgs) Kind() string { return "fsck" }
+
+// BulkEditArgs applies the same edits to many media. The targets are IDs plus
+// every live media matching Query.
+type BulkEditArgs struct {
+	IDs        []string  `json:"ids,omitempty"`
+	Query      string    `json:"query,omitempty"`
+	AddTags    []string  `json:"add_tags,omitempty"`
+	RemoveTags []string  `json:"remove_tags,omitempty"`
+	SetDate    *BulkDate `json:"set_date,omitempty"`
+	Delete     bool      `json:"delete,omitempty"`
+	// Actor is recorded in the revisions of the edited media.
+	Actor string `json:"actor,omitempty"`
+}
+
+// BulkDate sets the named date of every target.
+type BulkDate struct {
+	Name  string    `json:"name"`
+	Value time.Time `json:"value"`
+}
+
+func (BulkEditArgs) Kind() string { return "bulk_edit" }
+
+func (BulkEditArgs) InsertOpts() river.InsertOpts {
+	// Every edit is idempotent, so a retry reapplies the whole job.
+	return river.InsertOpts{MaxAttempts: 3}
+}
+
+// PurgeTrashArgs queues the deletion of media that stayed in the trash longer
+// than the configured retention.
+type PurgeTrashArgs struct{}
+
+func (PurgeTrashArgs) Kind() string { return "purge_trash" }
+
+// TagCentroidsArgs recomputes the centroid of the vision vectors of every
+// tag.
+type TagCentroidsArgs struct{}
+
+func (TagCentroidsArgs) Kind() string { return "tag_centroids" }
+
 type IndexArgs struct {
 	ID string `json:"id"`
 }
@@ -135,6 +305,9 @@ func (IndexArgs) Kind() string { return "index_media" }
 type EmbedArgs struct {
 	Bucket string `json:"bucket"`
 	Key    string `json:"key"`
+	// Model restricts the job to workers running that model, for
+	// re-embedding with a new model. Any worker takes it when empty.
+	Model string `json:"model,omitempty"`
 }
 
 func (EmbedArgs) Kind() string { return "embed_media" }
@@ -143,8 +316,39 @@ func (EmbedArgs) InsertOpts() river.InsertOpts {
 	return river.InsertOpts{MaxAttempts: 3}
 }
 
+// EmbedRegionArgs embeds the region of a note with the vision model.
+type EmbedRegionArgs struct {
+	NoteID int `json:"note_id"`
+	// Model restricts the job to workers running that model, like
+	// EmbedArgs.Model.
+	Model string `json:"model,omitempty"`
+}
+
+func (EmbedRegionArgs) Kind() string { return "embed_region" }
+
+func (EmbedRegionArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{MaxAttempts: 3}
+}
+
 type EmbedTextArgs struct {
 	Text string `json:"text"`
+	// Model restricts the job to workers running that model, like
+	// EmbedArgs.Model.
+	Model string `json:"model,omitempty"`
 }
 
 func (EmbedTextArgs) Kind() string { return "embed_text" }
+
+// AutoTagArgs scores the vision embedding of a media item against the
+// auto-tagger vocabulary and updates its tag suggestions.
+type AutoTagArgs struct {
+	ID string `json:"id"`
+	// Model is the model whose embeddings are compared, like EmbedArgs.Model.
+	Model string `json:"model,omitempty"`
+}
+
+func (AutoTagArgs) Kind() string { return "auto_tag" }
+
+func (AutoTagArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{MaxAttempts: 3}
+}
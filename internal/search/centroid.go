@@ -0,0 +1,135 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/mediavector"
+	"era/booru/ent/tag"
+	"era/booru/ent/tagvector"
+	dbhelpers "era/booru/internal/db"
+
+	"entgo.io/ent/dialect/sql"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ErrNoCentroid is returned for tags without a computed centroid, either
+// because too few of their media are embedded or the job has not run yet.
+var ErrNoCentroid = errors.New("tag has no centroid")
+
+// TagSimilarity is a tag with the cosine similarity of its centroid to the
+// centroid of another tag.
+type TagSimilarity struct {
+	Tag        string  `json:"tag"`
+	Similarity float64 `json:"similarity"`
+	MediaCount int     `json:"media_count"`
+}
+
+// tagCentroid loads the centroid of the named tag in the active vision
+// vector.
+func tagCentroid(ctx context.Context, db *ent.Client, name string) (*ent.TagVector, error) {
+	t, err := db.Tag.Query().Where(tag.NameEQ(name)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vt, err := dbhelpers.ActiveVisionVector(ctx, db)
+	if ent.IsNotFound(err) {
+		return nil, ErrNoCentroid
+	}
+	if err != nil {
+		return nil, err
+	}
+	tv, err := db.TagVector.Query().
+		Where(tagvector.TagIDEQ(t.ID), tagvector.VectorIDEQ(vt.ID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNoCentroid
+	}
+	return tv, err
+}
+
+// byDistance orders rows of table by the negative inner product of their
+// column with vec, i.e. most similar first for normalised vectors.
+func byDistance(table, column string, vec pgvector.Vector) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString(table)
+			b.WriteByte('.')
+			b.WriteString(column)
+			b.WriteString(" <#> ")
+			b.Arg(vec)
+		}))
+	}
+}
+
+// SimilarTags returns the tags whose centroids are closest to the centroid
+// of the named tag, most similar first.
+func SimilarTags(ctx context.Context, db *ent.Client, name string, limit int) ([]TagSimilarity, error) {
+	tv, err := tagCentroid(ctx, db, name)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.TagVector.Query().
+		Where(tagvector.VectorIDEQ(tv.VectorID), tagvector.TagIDNEQ(tv.TagID)).
+		WithTag().
+		Order(byDistance(tagvector.Table, tagvector.FieldValue, tv.Value)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := tv.Value.Slice()
+	out := make([]TagSimilarity, len(rows))
+	for i, r := range rows {
+		out[i] = TagSimilarity{
+			Tag:        r.Edges.Tag.Name,
+			Similarity: dot(query, r.Value.Slice()),
+			MediaCount: r.MediaCount,
+		}
+	}
+	return out, nil
+}
+
+// MediaMissingTag returns live media without the named tag whose vision
+// vectors are closest to its centroid, i.e. the media most likely missing
+// the tag. includeIDs restricts the candidates when non-empty.
+func MediaMissingTag(ctx context.Context, db *ent.Client, name string, limit, offset int, includeIDs []string) ([]*ent.Media, error) {
+	tv, err := tagCentroid(ctx, db, name)
+	if err != nil {
+		return nil, err
+	}
+	query := db.MediaVector.Query().
+		Where(
+			mediavector.VectorIDEQ(tv.VectorID),
+			mediavector.HasMediaWith(
+				media.DeletedAtIsNil(),
+				media.Not(media.HasTagsWith(tag.IDEQ(tv.TagID))),
+			),
+		)
+	if len(includeIDs) > 0 {
+		query = query.Where(mediavector.MediaIDIn(includeIDs...))
+	}
+	ids, err := query.
+		Order(byDistance(mediavector.Table, mediavector.FieldValue, tv.Value)).
+		Offset(offset).
+		Limit(limit).
+		Select(mediavector.FieldMediaID).
+		Strings(ctx)
+	if err != nil || len(ids) == 0 {
+		return []*ent.Media{}, err
+	}
+
+	items, err := db.Media.Query().Where(media.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order := make(map[string]int, len(ids))
+	for i, id := range ids {
+		order[id] = i
+	}
+	sort.Slice(items, func(i, j int) bool { return order[items[i].ID] < order[items[j].ID] })
+	return items, nil
+}
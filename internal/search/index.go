@@ -6,17 +6,25 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/mediadate"
+	"era/booru/ent/mediavector"
 
 	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/mapping"
 )
 
 // SearchMedia executes a query against the Bleve index and returns the matching
-// Media documents. It does not touch the Postgres database.
-func SearchMedia(expr string, limit, offset int) ([]*ent.Media, int, error) {
+// Media documents, ordered by relevance or by the Bleve sort fields in sortBy
+// (e.g. "-score"). It does not touch the Postgres database.
+func SearchMedia(expr string, limit, offset int, sortBy ...string) ([]*ent.Media, int, error) {
 	if IDX == nil {
 		return nil, 0, fmt.Errorf("index not open")
 	}
@@ -24,6 +32,9 @@ func SearchMedia(expr string, limit, offset int) ([]*ent.Media, int, error) {
 	log.Printf("search query: %s", expr)
 	req := bleve.NewSearchRequestOptions(query, limit, offset, false)
 	req.Fields = []string{"*"}
+	if len(sortBy) > 0 {
+		req.SortBy(sortBy)
+	}
 	res, err := IDX.Search(req)
 	if err != nil {
 		return nil, 0, err
@@ -95,22 +106,68 @@ func SearchMediaIDs(expr string) ([]string, error) {
 
 var IDX bleve.Index // global handle
 
+// proseFields are analyzed as English prose rather than like tags. Only the
+// title is stored, so search hits can show it.
+var proseFields = map[string]bool{"title": true, "description": false, "comments": false}
+
+// customStringFields holds the values of custom string and enum fields,
+// lowercased and kept whole so "field=value" matches the entire value in any
+// case.
+const customStringFields = "fields"
+
+// newIndexMapping returns the mapping of new indexes. Fields are mapped
+// dynamically except the prose fields, and the source domains and custom
+// string fields, which are kept whole.
+func newIndexMapping() *mapping.IndexMappingImpl {
+	m := bleve.NewIndexMapping()
+	for field, store := range proseFields {
+		prose := bleve.NewTextFieldMapping()
+		prose.Analyzer = en.AnalyzerName
+		prose.Store = store
+		m.DefaultMapping.AddFieldMappingsAt(field, prose)
+	}
+	sources := bleve.NewTextFieldMapping()
+	sources.Analyzer = keyword.Name
+	sources.Store = false
+	m.DefaultMapping.AddFieldMappingsAt("sources", sources)
+	custom := bleve.NewDocumentMapping()
+	custom.DefaultAnalyzer = keyword.Name
+	m.DefaultMapping.AddSubDocumentMapping(customStringFields, custom)
+	configureVectorMapping(m)
+	return m
+}
+
+// mappingOutdated reports whether idx was created before newIndexMapping
+// gained its current fields. Bleve cannot change the mapping of an index, so
+// Rebuild recreates such indexes.
+func mappingOutdated(idx bleve.Index) bool {
+	m, ok := idx.Mapping().(*mapping.IndexMappingImpl)
+	if !ok || m.DefaultMapping == nil {
+		return false
+	}
+	for _, field := range []string{"title", "description", "comments", "sources", customStringFields} {
+		if _, ok := m.DefaultMapping.Properties[field]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
 // OpenOrCreate initialises the index at start-up.
 func OpenOrCreate(path string) error {
 	var err error
 	// Check if path exists and is a valid Bleve index
 	if _, err = os.Stat(path); os.IsNotExist(err) {
-		mapping := bleve.NewIndexMapping()
-		configureVectorMapping(mapping)
-		IDX, err = bleve.New(path, mapping)
+		IDX, err = bleve.New(path, newIndexMapping())
 		return err
 	}
 	// Try to open; if fails due to metadata, recreate
 	IDX, err = bleve.Open(path)
 	if err != nil && strings.Contains(err.Error(), "metadata missing") {
-		mapping := bleve.NewIndexMapping()
-		configureVectorMapping(mapping)
-		IDX, err = bleve.New(path, mapping)
+		IDX, err = bleve.New(path, newIndexMapping())
+	}
+	if err == nil && mappingOutdated(IDX) {
+		log.Printf("search index at %s predates the current mapping; regenerate it to enable text, comment, source and custom field search", path)
 	}
 	return err
 }
@@ -121,31 +178,149 @@ func IndexMedia(m *ent.Media) error {
 		return fmt.Errorf("index not open")
 	}
 	log.Printf("indexing media %s", m.ID)
+	return IDX.Index(string(m.ID), mediaDocument(m))
+}
+
+// IndexMediaBatch reindexes the given media in a single Bleve batch. IDs that
+// no longer exist or are trashed are removed from the index.
+func IndexMediaBatch(ctx context.Context, db *ent.Client, ids []string) error {
+	if IDX == nil {
+		return fmt.Errorf("index not open")
+	}
+	items, err := db.Media.Query().
+		Where(media.IDIn(ids...), media.DeletedAtIsNil()).
+		WithTags().
+		WithPools().
+		WithFavorites().
+		WithComments().
+		WithSources().
+		WithMediaFields(func(q *ent.MediaFieldQuery) { q.WithMetaField() }).
+		WithDates(func(q *ent.DateQuery) {
+			q.WithMediaDates(func(mdq *ent.MediaDateQuery) { mdq.Where(mediadate.MediaIDIn(ids...)) })
+		}).
+		WithVectors(func(q *ent.VectorQuery) {
+			q.WithMediaVectors(func(mvq *ent.MediaVectorQuery) { mvq.Where(mediavector.MediaIDIn(ids...)) })
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	batch := IDX.NewBatch()
+	live := make(map[string]bool, len(items))
+	for _, m := range items {
+		live[m.ID] = true
+		if err := batch.Index(m.ID, mediaDocument(m)); err != nil {
+			return err
+		}
+	}
+	for _, id := range ids {
+		if !live[id] {
+			batch.Delete(id)
+		}
+	}
+	return IDX.Batch(batch)
+}
+
+// mediaDocument builds the Bleve document of a media item loaded with its
+// tags, dates and vectors.
+func mediaDocument(m *ent.Media) any {
 	doc := struct {
 		ent.Media
-		Tags    []string             `json:"tags"`
-		Dates   map[string]string    `json:"dates"`
-		Vectors map[string][]float32 `json:"vectors,omitempty"`
-	}{Media: *m}
+		Tags      []string `json:"tags"`
+		Pools     []string `json:"pools,omitempty"`
+		Favorites []string `json:"favorites,omitempty"`
+		Comments  []string `json:"comments,omitempty"`
+		Sources   []string `json:"sources,omitempty"`
+		// Zero scores and counts are indexed too so "score<1" matches them.
+		Score    int                  `json:"score"`
+		FavCount int                  `json:"fav_count"`
+		Dates    map[string]string    `json:"dates"`
+		Vectors  map[string][]float32 `json:"vectors,omitempty"`
+	}{Media: *m, Score: m.Score, FavCount: m.FavCount}
 	if m.Edges.Tags != nil {
 		doc.Tags = make([]string, len(m.Edges.Tags))
 		for i, t := range m.Edges.Tags {
 			doc.Tags[i] = t.Name
 		}
 	}
+	// Pool IDs are indexed as text so "pool:<id>" is a plain term match.
+	for _, p := range m.Edges.Pools {
+		doc.Pools = append(doc.Pools, strconv.Itoa(p.ID))
+	}
+	for _, f := range m.Edges.Favorites {
+		doc.Favorites = append(doc.Favorites, f.Actor)
+	}
+	for _, c := range m.Edges.Comments {
+		doc.Comments = append(doc.Comments, c.Body)
+	}
+	doc.Sources = sourceDomains(m.Edges.Sources)
 	if m.Edges.Dates != nil {
 		doc.Dates = make(map[string]string, len(m.Edges.Dates))
 		for _, d := range m.Edges.Dates {
-			if len(d.Edges.MediaDates) > 0 {
-				v := d.Edges.MediaDates[0].Value.Format("2006-01-02")
-				doc.Dates[d.Name] = v
+			// Date nodes are shared when several media are loaded at once.
+			for _, md := range d.Edges.MediaDates {
+				if md.MediaID == m.ID {
+					doc.Dates[d.Name] = md.Value.Format("2006-01-02")
+					break
+				}
 			}
 		}
 	}
 	if vecs := extractVectors(m); len(vecs) > 0 {
 		doc.Vectors = vecs
 	}
-	return IDX.Index(string(m.ID), doc)
+	if len(m.Edges.MediaFields) == 0 {
+		return doc
+	}
+
+	// Custom fields are indexed at the top level under their own names, and
+	// string values below customStringFields, so the document becomes a map.
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return doc
+	}
+	var out map[string]any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return doc
+	}
+	if doc.Vectors != nil {
+		out["vectors"] = doc.Vectors
+	}
+	strs := map[string]string{}
+	for _, mf := range m.Edges.MediaFields {
+		if mf.Edges.MetaField == nil {
+			continue
+		}
+		var v any
+		if err := json.Unmarshal(mf.Value, &v); err != nil {
+			continue
+		}
+		if str, ok := v.(string); ok {
+			strs[mf.Edges.MetaField.Name] = strings.ToLower(str)
+			continue
+		}
+		out[mf.Edges.MetaField.Name] = v
+	}
+	if len(strs) > 0 {
+		out[customStringFields] = strs
+	}
+	return out
+}
+
+// sourceDomains lists the domains of sources together with their parent
+// domains, so "source:pixiv.net" also matches "i.pixiv.net".
+func sourceDomains(sources []*ent.Source) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, s := range sources {
+		for d := s.Domain; strings.Contains(d, "."); d = d[strings.IndexByte(d, '.')+1:] {
+			if !seen[d] {
+				seen[d] = true
+				out = append(out, d)
+			}
+		}
+	}
+	return out
 }
 
 // DeleteMedia removes the document from the Bleve index.
@@ -156,6 +331,34 @@ func DeleteMedia(id string) error {
 	return IDX.Delete(string(id))
 }
 
+// DocumentIDs returns the IDs of every document in the index.
+func DocumentIDs() ([]string, error) {
+	if IDX == nil {
+		return nil, fmt.Errorf("index not open")
+	}
+	const batchSize = 1000
+
+	var ids []string
+	for {
+		req := bleve.NewSearchRequestOptions(bleve.NewMatchAllQuery(), batchSize, 0, false)
+		req.Fields = []string{}
+		req.SortBy([]string{"_id"})
+		if len(ids) > 0 {
+			req.SearchAfter = []string{ids[len(ids)-1]}
+		}
+		result, err := IDX.Search(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range result.Hits {
+			ids = append(ids, hit.ID)
+		}
+		if len(result.Hits) < batchSize {
+			return ids, nil
+		}
+	}
+}
+
 // Close closes the Bleve index handle if open.
 func Close() error {
 	if IDX != nil {
@@ -166,10 +369,16 @@ func Close() error {
 	return nil
 }
 
-// IndexAllMedia indexes all media records from the database.
+// IndexAllMedia indexes all media records outside the trash.
 func IndexAllMedia(ctx context.Context, db *ent.Client) error {
 	items, err := db.Media.Query().
+		Where(media.DeletedAtIsNil()).
 		WithTags().
+		WithPools().
+		WithFavorites().
+		WithComments().
+		WithSources().
+		WithMediaFields(func(q *ent.MediaFieldQuery) { q.WithMetaField() }).
 		WithDates(func(q *ent.DateQuery) { q.WithMediaDates() }).
 		WithVectors(func(q *ent.VectorQuery) { q.WithMediaVectors() }).
 		All(ctx)
@@ -189,6 +398,17 @@ func IndexAllMedia(ctx context.Context, db *ent.Client) error {
 func Rebuild(ctx context.Context, db *ent.Client, path string) error {
 	log.Printf("Starting intelligent index rebuild at path: %s", path)
 
+	// An outdated mapping can only be replaced by a new index.
+	if IDX != nil && mappingOutdated(IDX) {
+		log.Printf("Index mapping is outdated, recreating index...")
+		if err := Close(); err != nil {
+			return fmt.Errorf("failed to close index: %v", err)
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("failed to remove index: %v", err)
+		}
+	}
+
 	// If index is not open, try to open/create it
 	if IDX == nil {
 		log.Printf("Index not open, attempting to open/create...")
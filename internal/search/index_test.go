@@ -0,0 +1,35 @@
+package search
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"era/booru/ent"
+)
+
+func TestMediaDocumentSharedDates(t *testing.T) {
+	// Loading several media at once shares Date nodes between them.
+	upload := &ent.Date{
+		Name: "upload",
+		Edges: ent.DateEdges{MediaDates: []*ent.MediaDate{
+			{MediaID: "a", Value: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+			{MediaID: "b", Value: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)},
+		}},
+	}
+	m := &ent.Media{ID: "b", Edges: ent.MediaEdges{Dates: []*ent.Date{upload}}}
+
+	b, err := json.Marshal(mediaDocument(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Dates map[string]string `json:"dates"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if got := doc.Dates["upload"]; got != "2025-03-04" {
+		t.Fatalf("upload date = %q, want 2025-03-04", got)
+	}
+}
@@ -3,17 +3,26 @@ package search
 import (
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/blevesearch/bleve/v2"
 	q "github.com/blevesearch/bleve/v2/search/query"
 )
 
 // parseQuery turns a string like "width>300 type=image" into a Bleve query.
-// Numeric fields support range comparisons (> < >= <= =) while string fields
-// only allow equality checks. Tokens prefixed with a hyphen (e.g. "-cat") are
-// treated as exclusions.
+// Numeric fields support range comparisons (> < >= <= =) while string and
+// boolean fields (e.g. "has_audio=true") only allow equality checks. Tokens
+// prefixed with a hyphen (e.g. "-cat") are treated as exclusions.
+// "pool:<id>" matches the members of a pool, "fav:<user>" the favorites of a
+// user (quoted if the name has spaces), "rating:s,q" media rated safe or
+// questionable (s, q and e abbreviate the ratings), "comment:<word>" media
+// whose comments mention the word and "source:<domain>" media obtained from
+// the domain or one of its subdomains. "title:<word>" and "desc:<word>" search
+// the title and description; a double-quoted value such as desc:"red car" or
+// a bare "red car" (either field) matches the phrase. Custom string fields
+// match their whole value in any case, e.g. artist="daft punk".
 func parseQuery(expr string) q.Query {
-	tokens := strings.Fields(expr)
+	tokens := splitTokens(expr)
 	must := make([]q.Query, 0, len(tokens))
 	mustNot := make([]q.Query, 0)
 	for _, token := range tokens {
@@ -29,7 +38,27 @@ func parseQuery(expr string) q.Query {
 
 		field, op, val := splitToken(t)
 		var part q.Query
-		if field == "" {
+		if id, ok := strings.CutPrefix(t, "pool:"); ok {
+			part = newPoolQuery(id)
+		} else if actor, ok := strings.CutPrefix(t, "fav:"); ok {
+			part = newFavoriteQuery(actor)
+		} else if text, ok := strings.CutPrefix(t, "comment:"); ok {
+			part = newCommentQuery(text)
+		} else if text, ok := strings.CutPrefix(t, "title:"); ok {
+			// Audio files carry their title as a meta tag like
+			// "title:night_drive" as well, which must stay searchable.
+			if part = newTextQuery(text, "title"); part != nil {
+				part = bleve.NewDisjunctionQuery(part, newTagQuery(t))
+			}
+		} else if text, ok := strings.CutPrefix(t, "desc:"); ok {
+			part = newTextQuery(text, "description")
+		} else if strings.HasPrefix(t, `"`) {
+			part = newTextQuery(t, "title", "description")
+		} else if domain, ok := strings.CutPrefix(t, "source:"); ok {
+			part = newSourceQuery(domain)
+		} else if ratings, ok := strings.CutPrefix(t, "rating:"); ok {
+			part = newRatingQuery(ratings)
+		} else if field == "" {
 			part = newTagQuery(t)
 		} else {
 			part = buildFieldQuery(field, op, val)
@@ -46,12 +75,138 @@ func parseQuery(expr string) q.Query {
 	return combineClauses(must, mustNot)
 }
 
+// splitTokens splits expr at whitespace outside double quotes. Quotes are
+// kept, so `desc:"red car"` stays one token.
+func splitTokens(expr string) []string {
+	var tokens []string
+	var cur strings.Builder
+	quoted := false
+	for _, r := range expr {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			cur.WriteRune(r)
+		case !quoted && unicode.IsSpace(r):
+			if cur.Len() > 0 {
+				tokens = append(tokens, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if cur.Len() > 0 {
+		tokens = append(tokens, cur.String())
+	}
+	return tokens
+}
+
+// newTextQuery matches text in any of the prose fields. Quoted text is
+// matched as a phrase, otherwise any of its words may match.
+func newTextQuery(text string, fields ...string) q.Query {
+	phrase := strings.HasPrefix(text, `"`)
+	text = strings.Trim(text, `"`)
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	clauses := make([]q.Query, len(fields))
+	for i, field := range fields {
+		if phrase {
+			mq := bleve.NewMatchPhraseQuery(text)
+			mq.SetField(field)
+			clauses[i] = mq
+		} else {
+			mq := bleve.NewMatchQuery(text)
+			mq.SetField(field)
+			clauses[i] = mq
+		}
+	}
+	if len(clauses) == 1 {
+		return clauses[0]
+	}
+	return bleve.NewDisjunctionQuery(clauses...)
+}
+
 func newTagQuery(term string) q.Query {
 	tq := bleve.NewTermQuery(term)
 	tq.SetField("tags")
 	return tq
 }
 
+func newPoolQuery(id string) q.Query {
+	if id == "" {
+		return nil
+	}
+	tq := bleve.NewTermQuery(id)
+	tq.SetField("pools")
+	return tq
+}
+
+// newFavoriteQuery matches the favorites of actor, which may be quoted to
+// include spaces. Actors are analyzed like any text field, so the query is
+// analyzed the same way.
+func newFavoriteQuery(actor string) q.Query {
+	actor = strings.Trim(actor, `"`)
+	if strings.TrimSpace(actor) == "" {
+		return nil
+	}
+	mq := bleve.NewMatchPhraseQuery(actor)
+	mq.SetField("favorites")
+	return mq
+}
+
+// newCommentQuery matches comments containing text, analyzed like the
+// comments themselves so that "comment:cats" finds "cat".
+func newCommentQuery(text string) q.Query {
+	if text == "" {
+		return nil
+	}
+	mq := bleve.NewMatchQuery(text)
+	mq.SetField("comments")
+	return mq
+}
+
+// newSourceQuery matches media with a source on domain. A leading "www." is
+// ignored, as it is when sources are stored.
+func newSourceQuery(domain string) q.Query {
+	domain = strings.TrimPrefix(strings.ToLower(domain), "www.")
+	if domain == "" {
+		return nil
+	}
+	tq := bleve.NewTermQuery(domain)
+	tq.SetField("sources")
+	return tq
+}
+
+var ratingNames = map[string]string{
+	"s": "safe", "safe": "safe",
+	"q": "questionable", "questionable": "questionable",
+	"e": "explicit", "explicit": "explicit",
+}
+
+// newRatingQuery matches any of the comma-separated ratings. Unknown ratings
+// match nothing.
+func newRatingQuery(list string) q.Query {
+	var clauses []q.Query
+	for _, r := range strings.Split(list, ",") {
+		name, ok := ratingNames[strings.ToLower(r)]
+		if !ok {
+			continue
+		}
+		tq := bleve.NewTermQuery(name)
+		tq.SetField("rating")
+		clauses = append(clauses, tq)
+	}
+	switch len(clauses) {
+	case 0:
+		return bleve.NewMatchNoneQuery()
+	case 1:
+		return clauses[0]
+	default:
+		return bleve.NewDisjunctionQuery(clauses...)
+	}
+}
+
 func splitToken(token string) (field, op, val string) {
 	for _, candidate := range []string{"<=", ">=", "<", ">", "="} {
 		if idx := strings.Index(token, candidate); idx > 0 {
@@ -88,13 +243,32 @@ func buildFieldQuery(field, op, val string) q.Query {
 		}
 	}
 	if op == "=" {
+		if val == "true" || val == "false" {
+			return newBoolQuery(field, val == "true")
+		}
 		tq := bleve.NewTermQuery(val)
 		tq.SetField(field)
-		return tq
+		// Custom string fields never share a name with built-in fields.
+		// Their values may be quoted to include spaces.
+		custom := bleve.NewTermQuery(strings.ToLower(strings.Trim(val, `"`)))
+		custom.SetField(customStringFields + "." + field)
+		return bleve.NewDisjunctionQuery(tq, custom)
 	}
 	return nil
 }
 
+// newBoolQuery matches boolean fields such as has_audio. Media that never had
+// the field set (e.g. images) count as false, so "field=false" matches
+// everything that is not explicitly true.
+func newBoolQuery(field string, want bool) q.Query {
+	bq := bleve.NewBoolFieldQuery(true)
+	bq.SetField(field)
+	if want {
+		return bq
+	}
+	return combineClauses(nil, []q.Query{bq})
+}
+
 func combineClauses(must, mustNot []q.Query) q.Query {
 	if len(mustNot) == 0 {
 		switch len(must) {
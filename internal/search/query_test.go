@@ -1,9 +1,14 @@
 package search
 
 import (
+	"slices"
 	"sort"
 	"testing"
 
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/tag"
+
 	"github.com/blevesearch/bleve/v2"
 )
 
@@ -76,3 +81,271 @@ func TestParseQueryOnlyNegative(t *testing.T) {
 		}
 	}
 }
+
+type videoDoc struct {
+	Tags     []string `json:"tags"`
+	FPS      float64  `json:"fps,omitempty"`
+	HasAudio *bool    `json:"has_audio,omitempty"`
+}
+
+func newVideoIndex(t *testing.T) bleve.Index {
+	t.Helper()
+	mapping := bleve.NewIndexMapping()
+	mapping.DefaultAnalyzer = "keyword"
+	idx, err := bleve.NewMemOnly(mapping)
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+	t.Cleanup(func() { _ = idx.Close() })
+	yes, no := true, false
+	docs := map[string]videoDoc{
+		"loud":   {Tags: []string{"clip"}, FPS: 60, HasAudio: &yes},
+		"silent": {Tags: []string{"clip"}, FPS: 30, HasAudio: &no},
+		"still":  {Tags: []string{"photo"}},
+	}
+	for id, doc := range docs {
+		if err := idx.Index(id, doc); err != nil {
+			t.Fatalf("failed to index %s: %v", id, err)
+		}
+	}
+	return idx
+}
+
+func TestParseQueryBoolField(t *testing.T) {
+	idx := newVideoIndex(t)
+	if ids := searchIDs(t, idx, "has_audio=true"); len(ids) != 1 || ids[0] != "loud" {
+		t.Fatalf("unexpected has_audio=true result %v", ids)
+	}
+	ids := searchIDs(t, idx, "clip has_audio=false")
+	if len(ids) != 1 || ids[0] != "silent" {
+		t.Fatalf("unexpected has_audio=false result %v", ids)
+	}
+}
+
+func TestParseQueryFloatRange(t *testing.T) {
+	idx := newVideoIndex(t)
+	if ids := searchIDs(t, idx, "fps>=60"); len(ids) != 1 || ids[0] != "loud" {
+		t.Fatalf("unexpected fps>=60 result %v", ids)
+	}
+}
+
+func TestParseQueryPool(t *testing.T) {
+	// Use the production mapping, which analyzes text fields.
+	idx, err := bleve.NewMemOnly(bleve.NewIndexMapping())
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+	t.Cleanup(func() { _ = idx.Close() })
+	members := map[string][]int{"page1": {7}, "page2": {7, 12}, "other": {12}, "loose": nil}
+	for id, pools := range members {
+		m := &ent.Media{ID: id}
+		for _, p := range pools {
+			m.Edges.Pools = append(m.Edges.Pools, &ent.Pool{ID: p})
+		}
+		if err := idx.Index(id, mediaDocument(m)); err != nil {
+			t.Fatalf("failed to index %s: %v", id, err)
+		}
+	}
+
+	ids := searchIDs(t, idx, "pool:7")
+	if len(ids) != 2 || ids[0] != "page1" || ids[1] != "page2" {
+		t.Fatalf("unexpected pool:7 result %v", ids)
+	}
+	if ids := searchIDs(t, idx, "pool:12 -pool:7"); len(ids) != 1 || ids[0] != "other" {
+		t.Fatalf("unexpected pool:12 -pool:7 result %v", ids)
+	}
+}
+
+func TestParseQueryReactions(t *testing.T) {
+	idx, err := bleve.NewMemOnly(bleve.NewIndexMapping())
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+	t.Cleanup(func() { _ = idx.Close() })
+	safe, explicit := media.RatingSafe, media.RatingExplicit
+	docs := []*ent.Media{
+		{ID: "liked", Rating: &safe, Score: 7, FavCount: 1, Edges: ent.MediaEdges{
+			Favorites: []*ent.Favorite{{Actor: "Alice"}},
+		}},
+		{ID: "nsfw", Rating: &explicit, Score: -2, Edges: ent.MediaEdges{
+			Favorites: []*ent.Favorite{{Actor: "Mary Ann"}},
+		}},
+		{ID: "unrated"},
+	}
+	for _, m := range docs {
+		if err := idx.Index(m.ID, mediaDocument(m)); err != nil {
+			t.Fatalf("failed to index %s: %v", m.ID, err)
+		}
+	}
+
+	cases := map[string][]string{
+		"fav:Alice":       {"liked"},
+		"fav:bob":         {},
+		`fav:"Mary Ann"`:  {"nsfw"},
+		`-fav:"Mary Ann"`: {"liked", "unrated"},
+		"rating:s":        {"liked"},
+		"rating:s,e":      {"liked", "nsfw"},
+		"-rating:e":       {"liked", "unrated"},
+		"score>5":         {"liked"},
+		"score<1":         {"nsfw", "unrated"},
+		"fav_count=0":     {"nsfw", "unrated"},
+	}
+	for expr, want := range cases {
+		if got := searchIDs(t, idx, expr); !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestParseQueryComment(t *testing.T) {
+	idx, err := bleve.NewMemOnly(newIndexMapping())
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+	t.Cleanup(func() { _ = idx.Close() })
+	docs := []*ent.Media{
+		{ID: "discussed", Edges: ent.MediaEdges{
+			Comments: []*ent.Comment{{Body: "The cats are Sleeping"}},
+		}},
+		{ID: "tagged", Edges: ent.MediaEdges{Tags: []*ent.Tag{{Name: "cats"}}}},
+	}
+	for _, m := range docs {
+		if err := idx.Index(m.ID, mediaDocument(m)); err != nil {
+			t.Fatalf("failed to index %s: %v", m.ID, err)
+		}
+	}
+
+	for expr, want := range map[string][]string{
+		"comment:cat":   {"discussed"},
+		"comment:sleep": {"discussed"},
+		"cats":          {"tagged"},
+	} {
+		if got := searchIDs(t, idx, expr); !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", expr, got, want)
+		}
+	}
+	if mappingOutdated(idx) {
+		t.Fatal("new index reported as outdated")
+	}
+}
+
+func TestParseQuerySource(t *testing.T) {
+	idx, err := bleve.NewMemOnly(newIndexMapping())
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+	t.Cleanup(func() { _ = idx.Close() })
+	docs := []*ent.Media{
+		{ID: "pixiv", Edges: ent.MediaEdges{Sources: []*ent.Source{{Domain: "i.pixiv.net"}}}},
+		{ID: "both", Edges: ent.MediaEdges{Sources: []*ent.Source{
+			{Domain: "pixiv.net"}, {Domain: "x.com"},
+		}}},
+		{ID: "none"},
+	}
+	for _, m := range docs {
+		if err := idx.Index(m.ID, mediaDocument(m)); err != nil {
+			t.Fatalf("failed to index %s: %v", m.ID, err)
+		}
+	}
+
+	for expr, want := range map[string][]string{
+		"source:pixiv.net":               {"both", "pixiv"},
+		"source:www.Pixiv.net":           {"both", "pixiv"},
+		"source:i.pixiv.net":             {"pixiv"},
+		"source:net":                     {},
+		"-source:x.com":                  {"none", "pixiv"},
+		"source:x.com -source:pixiv.net": {},
+	} {
+		if got := searchIDs(t, idx, expr); !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestParseQueryText(t *testing.T) {
+	idx, err := bleve.NewMemOnly(newIndexMapping())
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+	t.Cleanup(func() { _ = idx.Close() })
+	docs := []*ent.Media{
+		{ID: "car", Title: "Red Cars", Description: "A red car parked by the sea"},
+		{ID: "sea", Description: "Boats on the red sea"},
+		{ID: "tagged", Edges: ent.MediaEdges{Tags: []*ent.Tag{{Name: "car"}}}},
+		{ID: "track", Edges: ent.MediaEdges{Tags: []*ent.Tag{{Name: "title:night_drive", Type: tag.TypeMetaTag}}}},
+	}
+	for _, m := range docs {
+		if err := idx.Index(m.ID, mediaDocument(m)); err != nil {
+			t.Fatalf("failed to index %s: %v", m.ID, err)
+		}
+	}
+
+	for expr, want := range map[string][]string{
+		"title:car":                 {"car"},
+		"desc:parking":              {"car"},
+		`desc:"red sea"`:            {"sea"},
+		`desc:"red car"`:            {"car"},
+		`"red cars"`:                {"car"},
+		`desc:red -desc:"red  car"`: {"sea"},
+		"car":                       {"tagged"},
+		"title:night_drive":         {"track"},
+		`title:""`:                  {"car", "sea", "tagged", "track"},
+	} {
+		if got := searchIDs(t, idx, expr); !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", expr, got, want)
+		}
+	}
+	if got := splitTokens(`a  desc:"b c" -"d"`); !slices.Equal(got, []string{"a", `desc:"b c"`, `-"d"`}) {
+		t.Errorf("splitTokens: got %q", got)
+	}
+}
+
+func TestParseQueryCustomFields(t *testing.T) {
+	idx, err := bleve.NewMemOnly(newIndexMapping())
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+	t.Cleanup(func() { _ = idx.Close() })
+	field := func(name, value string) *ent.MediaField {
+		return &ent.MediaField{Value: []byte(value), Edges: ent.MediaFieldEdges{
+			MetaField: &ent.MetaField{Name: name},
+		}}
+	}
+	docs := []*ent.Media{
+		{ID: "night", Width: 10, Edges: ent.MediaEdges{
+			Tags: []*ent.Tag{{Name: "city"}},
+			MediaFields: []*ent.MediaField{
+				field("iso", "3200"), field("mood", `"calm"`),
+				field("flash", "true"), field("lens", `"Sony FE 35mm"`),
+				field("artist", `"Daft Punk"`),
+			},
+		}},
+		{ID: "day", Edges: ent.MediaEdges{MediaFields: []*ent.MediaField{field("iso", "100")}}},
+		{ID: "plain", Width: 20},
+	}
+	for _, m := range docs {
+		if err := idx.Index(m.ID, mediaDocument(m)); err != nil {
+			t.Fatalf("failed to index %s: %v", m.ID, err)
+		}
+	}
+
+	for expr, want := range map[string][]string{
+		"iso>=400":           {"night"},
+		"iso<400":            {"day"},
+		"mood=calm":          {"night"},
+		"flash=true":         {"night"},
+		"flash=false":        {"day", "plain"},
+		"lens=sony":          nil,
+		"artist=daft_punk":   nil,
+		`artist="Daft Punk"`: {"night"},
+		"artist=Daft":        nil,
+		"artist=punk":        nil,
+		"mood=CALM":          {"night"},
+		"city iso=3200":      {"night"},
+		"width>5 -iso>50":    {"plain"},
+	} {
+		if got := searchIDs(t, idx, expr); !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", expr, got, want)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package search
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sort"
 
 	"era/booru/ent"
@@ -13,6 +15,10 @@ import (
 	pgvector "github.com/pgvector/pgvector-go"
 )
 
+// ErrDimensionMismatch is returned when a query vector cannot be compared
+// with the stored vectors, e.g. because it was embedded by another model.
+var ErrDimensionMismatch = errors.New("vector dimension mismatch")
+
 // SimilarMediaByVector returns media ordered by similarity to the provided
 // vector. When Bleve vector search is available the build can provide a
 // specialised implementation via build tags. The default implementation uses
@@ -30,9 +36,23 @@ func SimilarMediaByVector(
 		return []*ent.Media{}, 0, nil
 	}
 
+	vt, err := db.Vector.Query().Where(vector.NameEQ(vectorName)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return []*ent.Media{}, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if vt.Dimension > 0 && vt.Dimension != len(query) {
+		return nil, 0, fmt.Errorf("%w: %s has %d dimensions, query has %d", ErrDimensionMismatch, vt.Name, vt.Dimension, len(query))
+	}
+
 	vec := pgvector.NewVector(query)
 	baseQuery := db.MediaVector.Query().
-		Where(mediavector.HasVectorWith(vector.NameEQ(vectorName)))
+		Where(
+			mediavector.VectorIDEQ(vt.ID),
+			mediavector.HasMediaWith(media.DeletedAtIsNil()),
+		)
 
 	if excludeID != "" {
 		baseQuery = baseQuery.Where(mediavector.MediaIDNEQ(excludeID))
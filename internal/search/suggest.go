@@ -0,0 +1,146 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/mediavector"
+	"era/booru/ent/tag"
+	dbhelpers "era/booru/internal/db"
+)
+
+// ErrNotEmbedded is returned when a media item has no vision embedding.
+var ErrNotEmbedded = errors.New("media has no vision embedding")
+
+// TagSuggestion is a tag proposed for a media item. Confidence is the
+// similarity-weighted share of the neighbours that have the tag.
+type TagSuggestion struct {
+	Tag        string  `json:"tag"`
+	Confidence float64 `json:"confidence"`
+	Votes      int     `json:"votes"`
+}
+
+// neighborTags are the tags of a neighbour and its similarity to the item.
+type neighborTags struct {
+	Similarity float64
+	Tags       []string
+}
+
+// SuggestTagsFromNeighbors proposes tags for a live media item from its k
+// nearest neighbours by the active vision embedding. Every neighbour votes for its
+// user tags with its cosine similarity; tags the item already has are left
+// out. Suggestions are ordered by confidence.
+func SuggestTagsFromNeighbors(ctx context.Context, db *ent.Client, mediaID string, k int) ([]TagSuggestion, error) {
+	m, err := db.Media.Query().
+		Where(media.IDEQ(mediaID), media.DeletedAtIsNil()).
+		WithTags().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vt, err := dbhelpers.ActiveVisionVector(ctx, db)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotEmbedded
+	}
+	if err != nil {
+		return nil, err
+	}
+	mv, err := db.MediaVector.Query().
+		Where(mediavector.MediaIDEQ(mediaID), mediavector.VectorIDEQ(vt.ID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotEmbedded
+	}
+	if err != nil {
+		return nil, err
+	}
+	query := mv.Value.Slice()
+
+	neighbors, _, err := SimilarMediaByVector(ctx, db, vt.Name, query, k, 0, mediaID, nil)
+	if err != nil || len(neighbors) == 0 {
+		return []TagSuggestion{}, err
+	}
+	ids := make([]string, len(neighbors))
+	for i, n := range neighbors {
+		ids[i] = n.ID
+	}
+
+	vectors, err := db.MediaVector.Query().
+		Where(mediavector.MediaIDIn(ids...), mediavector.VectorIDEQ(vt.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	similarity := make(map[string]float64, len(vectors))
+	for _, v := range vectors {
+		similarity[v.MediaID] = dot(query, v.Value.Slice())
+	}
+
+	tagged, err := db.Media.Query().
+		Where(media.IDIn(ids...)).
+		WithTags(func(q *ent.TagQuery) { q.Where(tag.TypeEQ(tag.TypeUserTag)) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	votes := make([]neighborTags, len(tagged))
+	for i, n := range tagged {
+		votes[i].Similarity = similarity[n.ID]
+		for _, t := range n.Edges.Tags {
+			votes[i].Tags = append(votes[i].Tags, t.Name)
+		}
+	}
+
+	exclude := map[string]bool{"tagme": true}
+	for _, t := range m.Edges.Tags {
+		exclude[t.Name] = true
+	}
+	return rankNeighborTags(votes, exclude), nil
+}
+
+// rankNeighborTags weighs the tags of the neighbours by their similarity.
+// Neighbours that are not similar at all do not vote.
+func rankNeighborTags(neighbors []neighborTags, exclude map[string]bool) []TagSuggestion {
+	var total float64
+	weights := map[string]float64{}
+	counts := map[string]int{}
+	for _, n := range neighbors {
+		if n.Similarity <= 0 {
+			continue
+		}
+		total += n.Similarity
+		for _, t := range n.Tags {
+			if exclude[t] {
+				continue
+			}
+			weights[t] += n.Similarity
+			counts[t]++
+		}
+	}
+
+	out := make([]TagSuggestion, 0, len(weights))
+	for t, w := range weights {
+		out = append(out, TagSuggestion{Tag: t, Confidence: w / total, Votes: counts[t]})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Confidence != out[j].Confidence {
+			return out[i].Confidence > out[j].Confidence
+		}
+		return out[i].Tag < out[j].Tag
+	})
+	return out
+}
+
+func dot(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var sum float64
+	for i := range a {
+		sum += float64(a[i]) * float64(b[i])
+	}
+	return sum
+}
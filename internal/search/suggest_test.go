@@ -0,0 +1,30 @@
+package search
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRankNeighborTags(t *testing.T) {
+	neighbors := []neighborTags{
+		{Similarity: 0.6, Tags: []string{"cat", "outdoor"}},
+		{Similarity: 0.3, Tags: []string{"cat", "dog"}},
+		{Similarity: 0.1, Tags: []string{"indoor"}},
+		{Similarity: -0.2, Tags: []string{"car"}},
+	}
+	got := rankNeighborTags(neighbors, map[string]bool{"outdoor": true})
+	want := []TagSuggestion{
+		{Tag: "cat", Confidence: 0.9, Votes: 2},
+		{Tag: "dog", Confidence: 0.3, Votes: 1},
+		{Tag: "indoor", Confidence: 0.1, Votes: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i].Tag != want[i].Tag || got[i].Votes != want[i].Votes ||
+			math.Abs(got[i].Confidence-want[i].Confidence) > 1e-9 {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
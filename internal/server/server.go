@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -13,9 +12,15 @@ import (
 	"era/booru/internal/api"
 	"era/booru/internal/config"
 	"era/booru/internal/db"
-	"era/booru/internal/minio"
+	"era/booru/internal/embedrpc"
+	"era/booru/internal/ingest"
 	"era/booru/internal/queue"
 	"era/booru/internal/search"
+	"era/booru/internal/storage"
+	bulkworker "era/booru/internal/workers/bulkworker"
+	centroidworker "era/booru/internal/workers/centroidworker"
+	deleteworker "era/booru/internal/workers/deleteworker"
+	fsckworker "era/booru/internal/workers/fsckworker"
 	indexworker "era/booru/internal/workers/indexworker"
 	mediaworker "era/booru/internal/workers/mediaworker"
 
@@ -26,21 +31,22 @@ import (
 type Server struct {
 	Router *gin.Engine
 	DB     *ent.Client
-	Minio  *minio.Client
+	Store  storage.Backend
 	Cfg    *config.Config
 	Queue  *river.Client[pgx.Tx] // Changed from *sql.Tx
 	DBPool *pgxpool.Pool         // Changed from *sql.DB
+	Embed  *embedrpc.TextEmbedder
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
-// New constructs a Server and starts the MinIO watcher.
+// New constructs a Server and starts ingesting uploads.
 func New(ctx context.Context, cfg *config.Config) (*Server, error) {
 	if err := search.OpenOrCreate(cfg.BlevePath); err != nil {
 		return nil, err
 	}
 
-	m, err := minio.New(cfg)
+	store, err := storage.New(cfg)
 	if err != nil {
 		search.Close()
 		return nil, err
@@ -64,6 +70,12 @@ func New(ctx context.Context, cfg *config.Config) (*Server, error) {
 		return nil, err
 	}
 
+	embedder, err := embedrpc.NewTextEmbedder(cfg.EmbedGRPCAddr, riverClient)
+	if err != nil {
+		search.Close()
+		return nil, err
+	}
+
 	river.AddWorker(workers, &indexworker.IndexWorker{DB: database})
 
 	// Register the embed_text job kind so the server can enqueue requests for
@@ -73,41 +85,84 @@ func New(ctx context.Context, cfg *config.Config) (*Server, error) {
 	river.AddWorker(workers, river.WorkFunc(func(ctx context.Context, job *river.Job[queue.EmbedTextArgs]) error {
 		return nil
 	}))
+	// Likewise for embed_media, which fsck enqueues in repair mode.
+	river.AddWorker(workers, river.WorkFunc(func(ctx context.Context, job *river.Job[queue.EmbedArgs]) error {
+		return nil
+	}))
+	// And for embed_region, which the notes API enqueues.
+	river.AddWorker(workers, river.WorkFunc(func(ctx context.Context, job *river.Job[queue.EmbedRegionArgs]) error {
+		return nil
+	}))
+	// And for auto_tag, which the auto-tagger API enqueues.
+	river.AddWorker(workers, river.WorkFunc(func(ctx context.Context, job *river.Job[queue.AutoTagArgs]) error {
+		return nil
+	}))
+	river.AddWorker(workers, &fsckworker.FsckWorker{DB: database, Storage: store})
+	river.AddWorker(workers, &deleteworker.DeleteWorker{DB: database, Storage: store})
+	river.AddWorker(workers, &deleteworker.PurgeWorker{DB: database, Retention: cfg.TrashRetention})
+	river.AddWorker(workers, &centroidworker.CentroidWorker{DB: database})
+	river.AddWorker(workers, &bulkworker.BulkWorker{DB: database})
 	if err := riverClient.Start(ctx); err != nil {
 		return nil, err
 	}
 	river.AddWorker(workers, &mediaworker.ProcessWorker{
-		Minio: m,
-		DB:    database,
-		Cfg:   cfg,
+		Storage: store,
+		DB:      database,
+		Cfg:     cfg,
+	})
+	river.AddWorker(workers, &mediaworker.TranscodeWorker{
+		Storage: store,
+		DB:      database,
+		Cfg:     cfg,
 	})
 
 	srvCtx, cancel := context.WithCancel(ctx)
 
-	go m.Watch(srvCtx, func(ctx context.Context, object, contentType string) {
-		log.Printf("object %s uploaded with content type %s", object, contentType)
-		args := queue.ProcessArgs{Bucket: m.Bucket, Key: object, ContentType: contentType}
-		if err := queue.Enqueue(ctx, riverClient, args); err != nil {
-			log.Printf("enqueue process %s: %v", object, err)
-		}
-	})
+	ingester := &ingest.Ingester{
+		DB:           database,
+		Storage:      store,
+		Queue:        riverClient,
+		Concurrency:  cfg.IngestConcurrency,
+		ScanInterval: cfg.IngestScanInterval,
+	}
+	go ingester.Run(srvCtx)
+
+	go fsckworker.Schedule(srvCtx, riverClient, cfg.FsckInterval, cfg.FsckRepair)
+	go deleteworker.SchedulePurge(srvCtx, riverClient, cfg.TrashRetention)
+	go centroidworker.Schedule(srvCtx, riverClient, cfg.CentroidInterval)
 
 	r := gin.New()
-	r.Use(api.GinLogger(), gin.Recovery(), api.CORSMiddleware())
+	// Only trusted proxies may set the client address through forwarding
+	// headers; gin believes everyone by default.
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		cancel()
+		riverClient.Stop(ctx)
+		search.Close()
+		return nil, err
+	}
+	r.Use(api.GinLogger(), gin.Recovery(), api.CORSMiddleware(), api.ActorMiddleware(cfg))
 	r.GET("/health", func(c *gin.Context) { c.Status(http.StatusNoContent) })
-	api.RegisterMediaRoutes(r, database, m, cfg, riverClient)
-	api.RegisterTagRoutes(r, database)
-	api.RegisterAdminRoutes(r, database, m, cfg, riverClient)
+	api.RegisterMediaRoutes(r, database, store, cfg, riverClient, embedder)
+	api.RegisterTrashRoutes(r, database, cfg, riverClient)
+	api.RegisterPoolRoutes(r, database, cfg, riverClient)
+	api.RegisterCommentRoutes(r, database, riverClient)
+	api.RegisterNoteRoutes(r, database, riverClient)
+	api.RegisterFieldRoutes(r, database, riverClient)
+	api.RegisterAutoTagRoutes(r, database, riverClient)
+	api.RegisterTagRoutes(r, database, cfg)
+	api.RegisterAdminRoutes(r, database, store, cfg, riverClient)
 	api.RegisterSettingsRoutes(r, database)
+	api.RegisterStorageRoutes(r, store, cfg)
 	api.RegisterStaticRoutes(r)
 
 	s := &Server{
 		Router: r,
 		DB:     database,
-		Minio:  m,
+		Store:  store,
 		Cfg:    cfg,
 		Queue:  riverClient,
 		DBPool: pool,
+		Embed:  embedder,
 		ctx:    srvCtx,
 		cancel: cancel,
 	}
@@ -125,6 +180,9 @@ func (s *Server) Close() {
 	if s.Queue != nil {
 		s.Queue.Stop(context.Background())
 	}
+	if s.Embed != nil {
+		s.Embed.Close()
+	}
 	if s.DBPool != nil {
 		s.DBPool.Close()
 	}
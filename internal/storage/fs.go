@@ -0,0 +1,391 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"era/booru/internal/config"
+)
+
+// How often the filesystem watcher scans for new originals.
+const fsPollInterval = 2 * time.Second
+
+// FS stores objects as files below a root directory, one subdirectory per
+// bucket. It serves objects and signed uploads over HTTP under the public
+// prefix, so URLs look the same as with MinIO.
+type FS struct {
+	root          string
+	bucket        string
+	previewBucket string
+	secret        []byte
+	cfg           *config.Config
+}
+
+// NewFS creates the bucket directories below cfg.StoragePath.
+func NewFS(cfg *config.Config) (*FS, error) {
+	if cfg.StoragePath == "" {
+		return nil, errors.New("STORAGE_PATH is required for the fs storage backend")
+	}
+	root, err := filepath.Abs(cfg.StoragePath)
+	if err != nil {
+		return nil, err
+	}
+	secret := []byte(cfg.StorageSecret)
+	if len(secret) == 0 {
+		// Only the server signs and verifies upload URLs, so a per-process
+		// key works; it merely invalidates pending uploads on restart.
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+	}
+	f := &FS{root: root, bucket: cfg.MinioBucket, previewBucket: cfg.PreviewBucket, secret: secret, cfg: cfg}
+	for _, bucket := range []string{f.bucket, f.previewBucket} {
+		if err := os.MkdirAll(filepath.Join(root, bucket), 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func (f *FS) Bucket() string        { return f.bucket }
+func (f *FS) PreviewBucket() string { return f.previewBucket }
+
+// path resolves an object to its file, rejecting keys that escape the bucket.
+func (f *FS) path(bucket, key string) (string, error) {
+	if bucket != f.bucket && bucket != f.previewBucket {
+		return "", fmt.Errorf("unknown bucket %q", bucket)
+	}
+	if key == "" || !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(f.root, bucket, filepath.FromSlash(key)), nil
+}
+
+// metaPath holds the content type of an object. Sidecars live in a separate
+// tree so listings only see objects.
+func (f *FS) metaPath(bucket, key string) string {
+	return filepath.Join(f.root, ".meta", bucket, filepath.FromSlash(key))
+}
+
+func (f *FS) Get(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	p, err := f.path(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(p)
+}
+
+func (f *FS) Stat(ctx context.Context, bucket, key string) (ObjectInfo, error) {
+	p, err := f.path(bucket, key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	fi, err := os.Stat(p)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{
+		Key:          key,
+		Size:         fi.Size(),
+		ContentType:  f.contentType(bucket, key, p),
+		LastModified: fi.ModTime(),
+	}, nil
+}
+
+// contentType returns the type recorded at upload or sniffs files that were
+// copied into the directory by hand.
+func (f *FS) contentType(bucket, key, p string) string {
+	if b, err := os.ReadFile(f.metaPath(bucket, key)); err == nil {
+		return string(b)
+	}
+	if t := mime.TypeByExtension(filepath.Ext(p)); t != "" {
+		return t
+	}
+	file, err := os.Open(p)
+	if err != nil {
+		return "application/octet-stream"
+	}
+	defer file.Close()
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(file, head)
+	t := http.DetectContentType(head[:n])
+	if t == "application/ogg" {
+		t = "audio/ogg"
+	}
+	return t
+}
+
+// write stores r under bucket/key through a temporary file. Without
+// overwrite it fails with fs.ErrExist if the object is already present.
+func (f *FS) write(bucket, key string, r io.Reader, contentType string, overwrite bool) (ObjectInfo, error) {
+	p, err := f.path(bucket, key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return ObjectInfo{}, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	defer os.Remove(tmp.Name())
+	size, err := io.Copy(tmp, r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	if overwrite {
+		err = os.Rename(tmp.Name(), p)
+	} else {
+		// Link fails if p exists, which makes the create-only check atomic.
+		err = os.Link(tmp.Name(), p)
+	}
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	meta := f.metaPath(bucket, key)
+	if contentType == "" {
+		os.Remove(meta)
+	} else if err := os.MkdirAll(filepath.Dir(meta), 0o755); err != nil {
+		return ObjectInfo{}, err
+	} else if err := os.WriteFile(meta, []byte(contentType), 0o644); err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{Key: key, Size: size, ContentType: contentType, LastModified: time.Now()}, nil
+}
+
+func (f *FS) Put(ctx context.Context, bucket, key string, r io.Reader, contentType string) (ObjectInfo, error) {
+	return f.write(bucket, key, r, contentType, true)
+}
+
+func (f *FS) PutFile(ctx context.Context, bucket, key, path, contentType string) (ObjectInfo, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	defer src.Close()
+	return f.write(bucket, key, src, contentType, true)
+}
+
+func (f *FS) Remove(ctx context.Context, bucket, key string) error {
+	p, err := f.path(bucket, key)
+	if err != nil {
+		return err
+	}
+	os.Remove(f.metaPath(bucket, key))
+	// Match S3 semantics: removing a missing object succeeds.
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (f *FS) List(ctx context.Context, bucket string) <-chan ObjectInfo {
+	out := make(chan ObjectInfo)
+	go func() {
+		defer close(out)
+		dir := filepath.Join(f.root, bucket)
+		filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			var info ObjectInfo
+			switch {
+			case err != nil:
+				info.Err = err
+			case d.IsDir() || strings.HasPrefix(d.Name(), ".upload-"):
+				return nil
+			default:
+				rel, _ := filepath.Rel(dir, p)
+				key := filepath.ToSlash(rel)
+				fi, err := d.Info()
+				if err != nil {
+					info = ObjectInfo{Key: key, Err: err}
+					break
+				}
+				info = ObjectInfo{Key: key, Size: fi.Size(), LastModified: fi.ModTime()}
+			}
+			select {
+			case out <- info:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return out
+}
+
+// SourceURL returns the object's file path; ffmpeg reads it directly.
+func (f *FS) SourceURL(bucket, key string) string {
+	p, err := f.path(bucket, key)
+	if err != nil {
+		return ""
+	}
+	return p
+}
+
+func (f *FS) sign(method, bucket, key string, expires int64) string {
+	mac := hmac.New(sha256.New, f.secret)
+	fmt.Fprintf(mac, "%s\n%s/%s\n%d", method, bucket, key, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// PresignedPut returns an upload URL served by ServeHTTP.
+func (f *FS) PresignedPut(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if _, err := f.path(f.bucket, key); err != nil {
+		return "", err
+	}
+	expires := time.Now().Add(expiry).Unix()
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires, 10))
+	q.Set("signature", f.sign(http.MethodPut, f.bucket, key, expires))
+	u := url.URL{
+		Path:     path.Join(f.cfg.MinioPublicPrefix, f.bucket, key),
+		RawQuery: q.Encode(),
+	}
+	if f.cfg.MinioPublicHost != "" {
+		u.Scheme = "http"
+		if f.cfg.MinioSSL {
+			u.Scheme = "https"
+		}
+		u.Host = f.cfg.MinioPublicHost
+	}
+	return u.String(), nil
+}
+
+// ServeHTTP serves GET and HEAD for both buckets and signed PUT uploads of
+// new originals. Paths are "/<bucket>/<key>" with the public prefix stripped.
+func (f *FS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	p, err := f.path(bucket, key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		file, err := os.Open(p)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		defer file.Close()
+		fi, err := file.Stat()
+		if err != nil || fi.IsDir() {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", f.contentType(bucket, key, p))
+		http.ServeContent(w, r, "", fi.ModTime(), file)
+	case http.MethodPut:
+		if !f.validUpload(bucket, key, r.URL.Query()) {
+			http.Error(w, "invalid or expired signature", http.StatusForbidden)
+			return
+		}
+		// Uploads only create originals, whether or not the client sends
+		// If-None-Match: the signature does not cover the header.
+		_, err := f.write(bucket, key, r.Body, r.Header.Get("Content-Type"), false)
+		if errors.Is(err, fs.ErrExist) {
+			http.Error(w, "object already exists", http.StatusPreconditionFailed)
+			return
+		}
+		if err != nil {
+			log.Printf("fs upload %s/%s: %v", bucket, key, err)
+			http.Error(w, "upload failed", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *FS) validUpload(bucket, key string, q url.Values) bool {
+	if bucket != f.bucket {
+		return false
+	}
+	expires, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return false
+	}
+	want := f.sign(http.MethodPut, bucket, key, expires)
+	return hmac.Equal([]byte(want), []byte(q.Get("signature")))
+}
+
+// Watch polls the originals directory and reports files that appeared since
+// the previous scan. A file is reported once its size stopped changing, so
+// copies in progress are not picked up early.
+func (f *FS) Watch(ctx context.Context, onObject func(ctx context.Context, key, contentType string)) {
+	seen := map[string]bool{}
+	pending := map[string]int64{}
+	scan := func(initial bool) {
+		present := map[string]bool{}
+		for obj := range f.List(ctx, f.bucket) {
+			if obj.Err != nil {
+				log.Printf("fs watch: %v", obj.Err)
+				continue
+			}
+			present[obj.Key] = true
+			if seen[obj.Key] {
+				continue
+			}
+			if initial {
+				seen[obj.Key] = true
+				continue
+			}
+			if size, ok := pending[obj.Key]; !ok || size != obj.Size {
+				pending[obj.Key] = obj.Size
+				continue
+			}
+			delete(pending, obj.Key)
+			seen[obj.Key] = true
+			p, _ := f.path(f.bucket, obj.Key)
+			onObject(ctx, obj.Key, f.contentType(f.bucket, obj.Key, p))
+		}
+		// Forget removed files so a re-upload is reported again.
+		for key := range seen {
+			if !present[key] {
+				delete(seen, key)
+			}
+		}
+		for key := range pending {
+			if !present[key] {
+				delete(pending, key)
+			}
+		}
+	}
+
+	scan(true)
+	ticker := time.NewTicker(fsPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			scan(false)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"era/booru/internal/config"
+)
+
+func newTestFS(t *testing.T) *FS {
+	t.Helper()
+	f, err := NewFS(&config.Config{
+		StoragePath:       t.TempDir(),
+		MinioBucket:       "media",
+		PreviewBucket:     "previews",
+		MinioPublicPrefix: "/files",
+	})
+	if err != nil {
+		t.Fatalf("NewFS: %v", err)
+	}
+	return f
+}
+
+func TestFSRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	f := newTestFS(t)
+
+	if _, err := f.Put(ctx, "media", "abc", strings.NewReader("hello"), "video/x-matroska"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if _, err := PutPreviewJpeg(ctx, f, "abc", strings.NewReader("jpeg")); err != nil {
+		t.Fatalf("put preview: %v", err)
+	}
+
+	info, err := f.Stat(ctx, "media", "abc")
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+	if info.Size != 5 || info.ContentType != "video/x-matroska" {
+		t.Fatalf("unexpected stat %+v", info)
+	}
+
+	rc, err := f.Get(ctx, "media", "abc")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(data) != "hello" {
+		t.Fatalf("got %q", data)
+	}
+
+	var keys []string
+	for obj := range f.List(ctx, "media") {
+		if obj.Err != nil {
+			t.Fatalf("list: %v", obj.Err)
+		}
+		keys = append(keys, obj.Key)
+	}
+	if len(keys) != 1 || keys[0] != "abc" {
+		t.Fatalf("unexpected listing %v", keys)
+	}
+
+	if err := f.Remove(ctx, "media", "abc"); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if _, err := f.Stat(ctx, "media", "abc"); !errors.Is(err, ErrNotExist) {
+		t.Fatalf("expected ErrNotExist, got %v", err)
+	}
+	if err := f.Remove(ctx, "media", "abc"); err != nil {
+		t.Fatalf("removing a missing object should succeed: %v", err)
+	}
+}
+
+func TestFSRejectsEscapingKeys(t *testing.T) {
+	f := newTestFS(t)
+	for _, key := range []string{"", "../secret", "/etc/passwd", "a/../../b"} {
+		if _, err := f.Put(context.Background(), "media", key, strings.NewReader("x"), ""); err == nil {
+			t.Errorf("expected key %q to be rejected", key)
+		}
+	}
+	if _, err := f.Put(context.Background(), "other", "abc", strings.NewReader("x"), ""); err == nil {
+		t.Errorf("expected unknown bucket to be rejected")
+	}
+}
+
+func TestFSPresignedUpload(t *testing.T) {
+	f := newTestFS(t)
+	srv := httptest.NewServer(http.StripPrefix("/files", f))
+	defer srv.Close()
+
+	u, err := f.PresignedPut(context.Background(), "abc", time.Minute)
+	if err != nil {
+		t.Fatalf("presign: %v", err)
+	}
+	put := func(url string, body string, createOnly bool) int {
+		req, _ := http.NewRequest(http.MethodPut, srv.URL+url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "image/png")
+		if createOnly {
+			req.Header.Set("If-None-Match", "*")
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("put: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if code := put(strings.Replace(u, "signature=", "signature=0", 1), "data", true); code != http.StatusForbidden {
+		t.Fatalf("tampered signature: got %d", code)
+	}
+	if code := put(u, "data", true); code != http.StatusOK {
+		t.Fatalf("upload: got %d", code)
+	}
+	if code := put(u, "data", true); code != http.StatusPreconditionFailed {
+		t.Fatalf("second upload should not overwrite: got %d", code)
+	}
+	// The signature does not cover If-None-Match, so dropping it must not
+	// allow an overwrite either.
+	if code := put(u, "evil", false); code != http.StatusPreconditionFailed {
+		t.Fatalf("upload without If-None-Match should not overwrite: got %d", code)
+	}
+
+	resp, err := http.Get(srv.URL + "/files/media/abc")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != "data" || resp.Header.Get("Content-Type") != "image/png" {
+		t.Fatalf("unexpected download: %d %q %q", resp.StatusCode, body, resp.Header.Get("Content-Type"))
+	}
+}
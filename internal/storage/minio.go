@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"era/booru/internal/config"
+
+	mc "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Minio stores objects in MinIO or any other S3 compatible service.
+type Minio struct {
+	client        *mc.Client
+	bucket        string
+	previewBucket string
+	cfg           *config.Config
+}
+
+// NewMinio connects to MinIO and creates the configured buckets if needed.
+func NewMinio(cfg *config.Config) (*Minio, error) {
+	cli, err := mc.New(cfg.MinioInternalEndpoint, &mc.Options{
+		Creds:  credentials.NewStaticV4(cfg.MinioUser, cfg.MinioPassword, ""),
+		Secure: cfg.MinioSSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	for _, bucket := range []string{cfg.MinioBucket, cfg.PreviewBucket} {
+		exists, err := cli.BucketExists(ctx, bucket)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			if err := cli.MakeBucket(ctx, bucket, mc.MakeBucketOptions{}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &Minio{client: cli, bucket: cfg.MinioBucket, previewBucket: cfg.PreviewBucket, cfg: cfg}, nil
+}
+
+func (m *Minio) Bucket() string        { return m.bucket }
+func (m *Minio) PreviewBucket() string { return m.previewBucket }
+
+// minioErr maps missing objects onto ErrNotExist.
+func minioErr(err error) error {
+	if err != nil && mc.ToErrorResponse(err).Code == "NoSuchKey" {
+		return fmt.Errorf("%w: %v", ErrNotExist, err)
+	}
+	return err
+}
+
+func (m *Minio) Get(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	obj, err := m.client.GetObject(ctx, bucket, key, mc.GetObjectOptions{})
+	if err != nil {
+		return nil, minioErr(err)
+	}
+	// GetObject is lazy; stat it so a missing object fails here and not on
+	// the first read.
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		return nil, minioErr(err)
+	}
+	return obj, nil
+}
+
+func objectInfo(info mc.ObjectInfo) ObjectInfo {
+	return ObjectInfo{
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		LastModified: info.LastModified,
+		Err:          minioErr(info.Err),
+	}
+}
+
+func (m *Minio) Stat(ctx context.Context, bucket, key string) (ObjectInfo, error) {
+	info, err := m.client.StatObject(ctx, bucket, key, mc.StatObjectOptions{})
+	if err != nil {
+		return ObjectInfo{}, minioErr(err)
+	}
+	return objectInfo(info), nil
+}
+
+func (m *Minio) Put(ctx context.Context, bucket, key string, r io.Reader, contentType string) (ObjectInfo, error) {
+	info, err := m.client.PutObject(ctx, bucket, key, r, -1, mc.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{Key: key, Size: info.Size, ContentType: contentType, LastModified: info.LastModified}, nil
+}
+
+func (m *Minio) PutFile(ctx context.Context, bucket, key, path, contentType string) (ObjectInfo, error) {
+	info, err := m.client.FPutObject(ctx, bucket, key, path, mc.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{Key: key, Size: info.Size, ContentType: contentType, LastModified: info.LastModified}, nil
+}
+
+func (m *Minio) Remove(ctx context.Context, bucket, key string) error {
+	return m.client.RemoveObject(ctx, bucket, key, mc.RemoveObjectOptions{})
+}
+
+func (m *Minio) List(ctx context.Context, bucket string) <-chan ObjectInfo {
+	out := make(chan ObjectInfo)
+	go func() {
+		defer close(out)
+		for obj := range m.client.ListObjects(ctx, bucket, mc.ListObjectsOptions{Recursive: true}) {
+			select {
+			case out <- objectInfo(obj):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// SourceURL returns the internal MinIO URL of the object.
+func (m *Minio) SourceURL(bucket, key string) string {
+	scheme := "http://"
+	if m.cfg.MinioSSL {
+		scheme = "https://"
+	}
+	return fmt.Sprintf("%s%s/%s/%s", scheme, m.cfg.MinioInternalEndpoint, strings.TrimPrefix(bucket, "/"), strings.TrimPrefix(key, "/"))
+}
+
+// PresignedPut returns a presigned URL for uploading an object.
+func (m *Minio) PresignedPut(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	extra := http.Header{}
+	extra.Set("If-None-Match", "*")
+	u, err := m.client.PresignHeader(ctx, http.MethodPut, m.bucket, key, expiry, nil, extra)
+	if err != nil {
+		return "", err
+	}
+
+	// If MinioPublicHost is empty, return relative URL
+	if m.cfg.MinioPublicHost == "" {
+		// Clear scheme and host to make it relative
+		u.Scheme = ""
+		u.Host = ""
+		u.Path = path.Join(m.cfg.MinioPublicPrefix, u.Path)
+		return u.String(), nil
+	}
+
+	// Otherwise, use the configured host
+	u.Host = m.cfg.MinioPublicHost
+	u.Path = path.Join(m.cfg.MinioPublicPrefix, u.Path)
+	return u.String(), nil
+}
+
+// How long Watch waits before listening again after the stream ended.
+const minioReconnectDelay = 5 * time.Second
+
+// Watch listens for bucket notifications about newly created objects and
+// reconnects whenever the notification stream ends.
+func (m *Minio) Watch(ctx context.Context, onObject func(ctx context.Context, key, contentType string)) {
+	for {
+		ch := m.client.ListenBucketNotification(ctx, m.bucket, "", "", []string{"s3:ObjectCreated:*"})
+		for notification := range ch {
+			if notification.Err != nil {
+				log.Printf("notification error: %v", notification.Err)
+				continue
+			}
+			for _, rec := range notification.Records {
+				onObject(ctx, rec.S3.Object.Key, rec.S3.Object.ContentType)
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("bucket notifications for %s stopped, reconnecting in %s", m.bucket, minioReconnectDelay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(minioReconnectDelay):
+		}
+	}
+}
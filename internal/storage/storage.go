@@ -0,0 +1,77 @@
+// Package storage abstracts the object store holding originals and previews.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"time"
+
+	"era/booru/internal/config"
+)
+
+// ErrNotExist is returned (possibly wrapped) when an object does not exist.
+var ErrNotExist = fs.ErrNotExist
+
+// ObjectInfo describes a stored object. Err is set on list entries that could
+// not be read.
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+	Err          error
+}
+
+// Backend stores media objects in two buckets: Bucket for uploaded originals
+// and PreviewBucket for generated previews and renditions.
+type Backend interface {
+	Bucket() string
+	PreviewBucket() string
+
+	Get(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+	Stat(ctx context.Context, bucket, key string) (ObjectInfo, error)
+	Put(ctx context.Context, bucket, key string, r io.Reader, contentType string) (ObjectInfo, error)
+	PutFile(ctx context.Context, bucket, key, path, contentType string) (ObjectInfo, error)
+	Remove(ctx context.Context, bucket, key string) error
+	// List streams every object in bucket; the channel is closed when done.
+	List(ctx context.Context, bucket string) <-chan ObjectInfo
+
+	// SourceURL returns a location ffmpeg and ffprobe can read the object from.
+	SourceURL(bucket, key string) string
+	// PresignedPut returns a browser-facing URL that uploads a new original.
+	PresignedPut(ctx context.Context, key string, expiry time.Duration) (string, error)
+	// Watch blocks until ctx is done and calls onObject for every new original.
+	// Calls are sequential, so a slow callback applies backpressure. Events
+	// can be missed (e.g. while the process is down); callers should also
+	// scan the bucket periodically.
+	Watch(ctx context.Context, onObject func(ctx context.Context, key, contentType string))
+}
+
+// New opens the backend selected by cfg.StorageBackend.
+func New(cfg *config.Config) (Backend, error) {
+	switch cfg.StorageBackend {
+	case "", "minio":
+		return NewMinio(cfg)
+	case "fs":
+		return NewFS(cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.StorageBackend)
+	}
+}
+
+// PutPreviewJpeg stores a JPEG preview for the object key.
+func PutPreviewJpeg(ctx context.Context, b Backend, key string, r io.Reader) (ObjectInfo, error) {
+	return b.Put(ctx, b.PreviewBucket(), key, r, "image/jpeg")
+}
+
+// RenditionKey returns the preview bucket key of a transcoded rendition.
+func RenditionKey(id, format string) string {
+	return "renditions/" + id + "." + format
+}
+
+// PutRendition uploads a transcoded rendition from a local file.
+func PutRendition(ctx context.Context, b Backend, id, format, path string) (ObjectInfo, error) {
+	return b.PutFile(ctx, b.PreviewBucket(), RenditionKey(id, format), path, "video/"+format)
+}
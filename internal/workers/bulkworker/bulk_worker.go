@@ -0,0 +1,165 @@
+package bulkworker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/mediadate"
+	"era/booru/ent/tag"
+	"era/booru/internal/db"
+	"era/booru/internal/queue"
+	"era/booru/internal/search"
+
+	"github.com/riverqueue/river"
+)
+
+// batchSize is how many media are edited per transaction and reindexed per
+// Bleve batch.
+const batchSize = 500
+
+// Progress is recorded after every batch.
+type Progress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// Result is recorded as the output of every bulk edit.
+type Result struct {
+	// Targets matched by IDs and Query.
+	Matched int `json:"matched"`
+	// Targets that were edited.
+	Updated int `json:"updated"`
+	// Targets that did not exist or were already trashed.
+	Skipped int `json:"skipped"`
+}
+
+// BulkWorker applies a bulk edit in batches. The edits bypass the per-row
+// Bleve hook, and each batch is reindexed at once instead. It runs in the
+// server, which owns the Bleve index.
+type BulkWorker struct {
+	river.WorkerDefaults[queue.BulkEditArgs]
+	DB *ent.Client
+}
+
+// Timeout allows editing large selections; River's default is one minute.
+func (w *BulkWorker) Timeout(*river.Job[queue.BulkEditArgs]) time.Duration {
+	return time.Hour
+}
+
+func (w *BulkWorker) Work(ctx context.Context, job *river.Job[queue.BulkEditArgs]) error {
+	args := job.Args
+	ctx = db.WithActor(ctx, args.Actor)
+
+	ids, err := targets(args)
+	if err != nil {
+		return err
+	}
+	addTags, err := db.FindOrCreateTags(ctx, w.DB, args.AddTags)
+	if err != nil {
+		return err
+	}
+	removeTags, err := w.DB.Tag.Query().Where(tag.NameIn(args.RemoveTags...)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	var dateID int
+	if args.SetDate != nil {
+		dt, err := db.FindOrCreateDate(ctx, w.DB, args.SetDate.Name)
+		if err != nil {
+			return err
+		}
+		dateID = dt.ID
+	}
+
+	result := Result{Matched: len(ids)}
+	for batch := range slices.Chunk(ids, batchSize) {
+		live, err := w.DB.Media.Query().
+			Where(media.IDIn(batch...), media.DeletedAtIsNil()).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(live) > 0 {
+			err := db.EditMediaBatch(ctx, w.DB, live, "bulk_edit", func(tx *ent.Client) error {
+				return edit(ctx, tx, args, live, addTags, removeTags, dateID)
+			})
+			if err != nil {
+				return err
+			}
+			if err := search.IndexMediaBatch(ctx, w.DB, live); err != nil {
+				return fmt.Errorf("reindex: %w", err)
+			}
+		}
+		result.Updated += len(live)
+		result.Skipped += len(batch) - len(live)
+
+		progress := Progress{Done: result.Updated + result.Skipped, Total: result.Matched}
+		if err := queue.RecordProgress(ctx, job.ID, progress); err != nil {
+			log.Printf("record bulk edit progress for job %d: %v", job.ID, err)
+		}
+	}
+
+	log.Printf("Bulk edit job %d updated %d media, skipped %d", job.ID, result.Updated, result.Skipped)
+	return river.RecordOutput(ctx, result)
+}
+
+// targets returns the deduplicated IDs and query matches in a stable order.
+func targets(args queue.BulkEditArgs) ([]string, error) {
+	ids := slices.Clone(args.IDs)
+	if args.Query != "" {
+		matches, err := search.SearchMediaIDs(args.Query)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, matches...)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// edit applies every requested edit to one batch.
+func edit(ctx context.Context, tx *ent.Client, args queue.BulkEditArgs, ids []string, addTags, removeTags []int, dateID int) error {
+	if len(addTags) > 0 || len(removeTags) > 0 {
+		if err := tx.Media.Update().
+			Where(media.IDIn(ids...)).
+			AddTagIDs(addTags...).
+			RemoveTagIDs(removeTags...).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("update tags: %w", err)
+		}
+	}
+	if args.SetDate != nil {
+		if _, err := tx.MediaDate.Delete().
+			Where(mediadate.DateIDEQ(dateID), mediadate.MediaIDIn(ids...)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("clear date: %w", err)
+		}
+		creates := make([]*ent.MediaDateCreate, len(ids))
+		for i, id := range ids {
+			creates[i] = tx.MediaDate.Create().SetMediaID(id).SetDateID(dateID).SetValue(args.SetDate.Value)
+		}
+		if err := tx.MediaDate.CreateBulk(creates...).Exec(ctx); err != nil {
+			return fmt.Errorf("set date: %w", err)
+		}
+	}
+	if err := tx.Media.Update().
+		Where(media.IDIn(ids...)).
+		AddVersion(1).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("bump version: %w", err)
+	}
+	if args.Delete {
+		if err := tx.Media.Update().
+			Where(media.IDIn(ids...)).
+			SetDeletedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("trash: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package centroidworker
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/mediavector"
+	"era/booru/ent/tag"
+	"era/booru/ent/tagvector"
+	"era/booru/internal/db"
+	"era/booru/internal/queue"
+
+	"github.com/jackc/pgx/v5"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/riverqueue/river"
+)
+
+const (
+	// minCentroidMedia is the number of embedded media a tag needs before
+	// its centroid means anything.
+	minCentroidMedia = 3
+	// centroidPageSize is the number of media vectors loaded at a time.
+	centroidPageSize = 500
+)
+
+// CentroidWorker recomputes the centroid of the active vision vectors of
+// every user tag and replaces the stored ones.
+type CentroidWorker struct {
+	river.WorkerDefaults[queue.TagCentroidsArgs]
+	DB *ent.Client
+}
+
+// Timeout allows scanning every media vector; River's default is one minute.
+func (w *CentroidWorker) Timeout(*river.Job[queue.TagCentroidsArgs]) time.Duration {
+	return time.Hour
+}
+
+func (w *CentroidWorker) Work(ctx context.Context, job *river.Job[queue.TagCentroidsArgs]) error {
+	vt, err := db.ActiveVisionVector(ctx, w.DB)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	sums := newCentroidSums()
+	last := ""
+	for {
+		page, err := w.DB.MediaVector.Query().
+			Where(
+				mediavector.VectorIDEQ(vt.ID),
+				mediavector.MediaIDGT(last),
+				mediavector.HasMediaWith(media.DeletedAtIsNil()),
+			).
+			WithMedia(func(q *ent.MediaQuery) {
+				q.WithTags(func(tq *ent.TagQuery) {
+					tq.Where(tag.TypeEQ(tag.TypeUserTag)).Select(tag.FieldID)
+				})
+			}).
+			Order(ent.Asc(mediavector.FieldMediaID)).
+			Limit(centroidPageSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, mv := range page {
+			vec := mv.Value.Slice()
+			for _, t := range mv.Edges.Media.Edges.Tags {
+				sums.add(t.ID, vec)
+			}
+		}
+		last = page[len(page)-1].MediaID
+	}
+	centroids := sums.centroids(minCentroidMedia)
+
+	tx, err := w.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.TagVector.Delete().Where(tagvector.VectorIDEQ(vt.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	creates := make([]*ent.TagVectorCreate, 0, centroidPageSize)
+	flush := func() error {
+		if len(creates) == 0 {
+			return nil
+		}
+		err := tx.TagVector.CreateBulk(creates...).Exec(ctx)
+		creates = creates[:0]
+		return err
+	}
+	for tagID, c := range centroids {
+		creates = append(creates, tx.TagVector.Create().
+			SetTagID(tagID).
+			SetVectorID(vt.ID).
+			SetValue(pgvector.NewVector(c)).
+			SetMediaCount(sums.counts[tagID]))
+		if len(creates) == centroidPageSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	log.Printf("Computed centroids of %d tags (job %d)", len(centroids), job.ID)
+	return nil
+}
+
+// centroidSums accumulates the vectors of the media of every tag.
+type centroidSums struct {
+	sums   map[int][]float64
+	counts map[int]int
+}
+
+func newCentroidSums() *centroidSums {
+	return &centroidSums{sums: map[int][]float64{}, counts: map[int]int{}}
+}
+
+// add adds vec to the sum of tagID. Vectors whose dimension differs from the
+// first one of the tag are skipped.
+func (c *centroidSums) add(tagID int, vec []float32) {
+	sum, ok := c.sums[tagID]
+	if !ok {
+		sum = make([]float64, len(vec))
+		c.sums[tagID] = sum
+	}
+	if len(sum) != len(vec) || len(vec) == 0 {
+		return
+	}
+	for i, v := range vec {
+		sum[i] += float64(v)
+	}
+	c.counts[tagID]++
+}
+
+// centroids returns the L2-normalised mean of every tag with at least min
+// vectors. Normalising makes the dot product with a media vector its cosine
+// similarity.
+func (c *centroidSums) centroids(min int) map[int][]float32 {
+	out := make(map[int][]float32)
+	for tagID, sum := range c.sums {
+		if c.counts[tagID] < min {
+			continue
+		}
+		var norm float64
+		for _, v := range sum {
+			norm += v * v
+		}
+		if norm == 0 {
+			continue
+		}
+		norm = math.Sqrt(norm)
+		vec := make([]float32, len(sum))
+		for i, v := range sum {
+			vec[i] = float32(v / norm)
+		}
+		out[tagID] = vec
+	}
+	return out
+}
+
+// Schedule enqueues a recomputation every interval until ctx is done. Like
+// fsck it is scheduled by the server rather than River's leader.
+func Schedule(ctx context.Context, client *river.Client[pgx.Tx], interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := queue.Enqueue(ctx, client, queue.TagCentroidsArgs{}); err != nil {
+				log.Printf("enqueue tag centroids: %v", err)
+			}
+		}
+	}
+}
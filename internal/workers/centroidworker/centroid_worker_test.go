@@ -0,0 +1,28 @@
+package centroidworker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCentroids(t *testing.T) {
+	sums := newCentroidSums()
+	sums.add(1, []float32{1, 0})
+	sums.add(1, []float32{0, 1})
+	sums.add(1, []float32{1, 2, 3}) // wrong dimension, skipped
+	sums.add(2, []float32{1, 0})
+
+	got := sums.centroids(2)
+	if len(got) != 1 {
+		t.Fatalf("expected only tag 1 to have enough vectors, got %v", got)
+	}
+	if sums.counts[1] != 2 {
+		t.Fatalf("expected 2 vectors for tag 1, got %d", sums.counts[1])
+	}
+	want := float32(1 / math.Sqrt2)
+	for i, v := range got[1] {
+		if math.Abs(float64(v-want)) > 1e-6 {
+			t.Fatalf("component %d: expected %f, got %f", i, want, v)
+		}
+	}
+}
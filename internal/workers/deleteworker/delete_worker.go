@@ -0,0 +1,89 @@
+package deleteworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/rendition"
+	"era/booru/internal/queue"
+	"era/booru/internal/search"
+	"era/booru/internal/storage"
+
+	"github.com/riverqueue/river"
+)
+
+// DeleteWorker permanently removes a media item from the trash. It runs in the server,
+// which owns the Bleve index.
+type DeleteWorker struct {
+	river.WorkerDefaults[queue.DeleteArgs]
+	DB      *ent.Client
+	Storage storage.Backend
+}
+
+// Step is the outcome of one part of a deletion, recorded as job output.
+type Step struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// Every step is idempotent, so a retry simply runs all of them again:
+//
+//  1. The database row goes first, in one statement that cascades to tags,
+//     dates, vectors and renditions. If it fails nothing else is touched.
+//  2. The index document and derived objects are removed next.
+//  3. The original goes last and only once everything else is gone, so a
+//     partly failed deletion keeps the object it can be retried from.
+func (w *DeleteWorker) Work(ctx context.Context, job *river.Job[queue.DeleteArgs]) error {
+	id := job.Args.ID
+
+	// Only trashed media is deleted; a restore between enqueueing and running
+	// the job wins.
+	err := w.DB.Media.DeleteOneID(id).Where(media.DeletedAtNotNil()).Exec(ctx)
+	if ent.IsNotFound(err) {
+		restored, existErr := w.DB.Media.Query().Where(media.IDEQ(id)).Exist(ctx)
+		if existErr != nil {
+			return existErr
+		}
+		if restored {
+			log.Printf("Media %s was restored, not deleting", id)
+			return nil
+		}
+	} else if err != nil {
+		return fmt.Errorf("delete media %s: %w", id, err)
+	}
+
+	steps := []Step{{Name: "database"}}
+	var errs []error
+	run := func(name string, fn func() error) {
+		step := Step{Name: name}
+		if err := fn(); err != nil {
+			step.Error = err.Error()
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+		steps = append(steps, step)
+	}
+
+	run("index", func() error { return search.DeleteMedia(id) })
+	run("preview", func() error { return w.Storage.Remove(ctx, w.Storage.PreviewBucket(), id) })
+	for _, format := range []rendition.Format{rendition.FormatMp4, rendition.FormatWebm} {
+		key := storage.RenditionKey(id, string(format))
+		run("rendition "+string(format), func() error { return w.Storage.Remove(ctx, w.Storage.PreviewBucket(), key) })
+	}
+	if len(errs) == 0 {
+		run("original", func() error { return w.Storage.Remove(ctx, w.Storage.Bucket(), id) })
+	}
+
+	if err := river.RecordOutput(ctx, steps); err != nil {
+		log.Printf("record delete output for %s: %v", id, err)
+	}
+	if len(errs) > 0 {
+		// River keeps the error per attempt and retries the job.
+		return fmt.Errorf("delete media %s partly failed: %w", id, errors.Join(errs...))
+	}
+	log.Printf("Deleted media %s", id)
+	return nil
+}
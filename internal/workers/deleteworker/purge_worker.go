@@ -0,0 +1,65 @@
+package deleteworker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/internal/queue"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// purgeInterval is how often the server looks for expired trash.
+const purgeInterval = time.Hour
+
+// PurgeWorker queues the deletion of media trashed longer than Retention ago.
+type PurgeWorker struct {
+	river.WorkerDefaults[queue.PurgeTrashArgs]
+	DB        *ent.Client
+	Retention time.Duration
+}
+
+func (w *PurgeWorker) Work(ctx context.Context, job *river.Job[queue.PurgeTrashArgs]) error {
+	if w.Retention <= 0 {
+		return nil
+	}
+	ids, err := w.DB.Media.Query().
+		Where(media.DeletedAtLT(time.Now().Add(-w.Retention))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := queue.WorkerEnqueue(ctx, queue.DeleteArgs{ID: id}); err != nil {
+			return err
+		}
+	}
+	if len(ids) > 0 {
+		log.Printf("Purging %d media from the trash", len(ids))
+	}
+	return nil
+}
+
+// SchedulePurge enqueues a purge every hour until ctx is done. Like fsck it
+// is scheduled by the server rather than River's leader.
+func SchedulePurge(ctx context.Context, client *river.Client[pgx.Tx], retention time.Duration) {
+	if retention <= 0 {
+		return
+	}
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		if err := queue.Enqueue(ctx, client, queue.PurgeTrashArgs{}); err != nil {
+			log.Printf("enqueue trash purge: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
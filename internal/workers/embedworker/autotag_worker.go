@@ -0,0 +1,119 @@
+package embedworker
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"era/booru/ent"
+	"era/booru/ent/autotag"
+	"era/booru/ent/mediavector"
+	"era/booru/ent/tagsuggestion"
+	"era/booru/internal/db"
+	embed "era/booru/internal/embeddings"
+	"era/booru/internal/queue"
+
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/riverqueue/river"
+)
+
+// AutoTagWorker suggests tags for a media item by comparing its vision
+// embedding with the text embeddings of the auto-tagger vocabulary.
+type AutoTagWorker struct {
+	river.WorkerDefaults[queue.AutoTagArgs]
+	DB *ent.Client
+	// Vector is the vision vector of the loaded model, whose text tower
+	// embeds the prompts.
+	Vector *ent.Vector
+}
+
+func (w *AutoTagWorker) Work(ctx context.Context, job *river.Job[queue.AutoTagArgs]) error {
+	if job.Args.Model != "" && job.Args.Model != w.Vector.Model {
+		return river.JobSnooze(otherModelSnooze)
+	}
+	mv, err := w.DB.MediaVector.Query().
+		Where(
+			mediavector.MediaIDEQ(job.Args.ID),
+			mediavector.VectorIDEQ(w.Vector.ID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return river.JobCancel(fmt.Errorf("media %s has no %s embedding: %w", job.Args.ID, w.Vector.Name, err))
+	}
+	if err != nil {
+		return err
+	}
+
+	candidates, err := w.vocabulary(ctx)
+	if err != nil {
+		return err
+	}
+	scores := scoreAutoTags(mv.Value.Slice(), candidates)
+	n, err := db.SuggestTags(ctx, w.DB, job.Args.ID, tagsuggestion.SourceZeroShot, scores)
+	if ent.IsNotFound(err) {
+		return river.JobCancel(fmt.Errorf("media %s not found: %w", job.Args.ID, err))
+	}
+	if err != nil {
+		return err
+	}
+	logEmbedQueueDepth(ctx, fmt.Sprintf("Auto-tagged %s with %d suggestions (job %d)", job.Args.ID, n, job.ID))
+	return nil
+}
+
+// vocabulary returns all auto-tagger candidates, embedding the prompts that
+// have no embedding of the loaded model yet.
+func (w *AutoTagWorker) vocabulary(ctx context.Context) ([]*ent.AutoTag, error) {
+	candidates, err := w.DB.AutoTag.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range candidates {
+		if c.Embedding != nil && c.Model == w.Vector.Model {
+			continue
+		}
+		vec, err := embed.TextEmbedding(c.Prompt)
+		if err != nil {
+			return nil, fmt.Errorf("embed prompt of auto tag %d: %w", c.ID, err)
+		}
+		if len(vec) == 0 {
+			return nil, river.JobCancel(fmt.Errorf("no text model to embed auto tag %d", c.ID))
+		}
+		v := pgvector.NewVector(vec)
+		c.Embedding = &v
+		// The prompt may have changed meanwhile; its new embedding is then
+		// computed by the next job.
+		if _, err := w.DB.AutoTag.Update().
+			Where(autotag.IDEQ(c.ID), autotag.PromptEQ(c.Prompt)).
+			SetEmbedding(v).
+			SetModel(w.Vector.Model).
+			Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return candidates, nil
+}
+
+// scoreAutoTags returns the cosine similarity of vec with every candidate
+// that reaches its threshold, by tag ID. Both sides are L2-normalised, so
+// the similarity is their dot product.
+func scoreAutoTags(vec []float32, candidates []*ent.AutoTag) map[int]float64 {
+	scores := make(map[int]float64)
+	for _, c := range candidates {
+		if c.Embedding == nil {
+			continue
+		}
+		text := c.Embedding.Slice()
+		if len(text) != len(vec) {
+			log.Printf("Auto tag %d has %d dimensions, image has %d; skipping", c.ID, len(text), len(vec))
+			continue
+		}
+		var dot float64
+		for i := range vec {
+			dot += float64(vec[i]) * float64(text[i])
+		}
+		if dot >= c.Threshold {
+			scores[c.TagID] = dot
+		}
+	}
+	return scores
+}
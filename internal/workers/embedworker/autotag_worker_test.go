@@ -0,0 +1,27 @@
+package embedworker
+
+import (
+	"math"
+	"testing"
+
+	"era/booru/ent"
+
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+func TestScoreAutoTags(t *testing.T) {
+	vec := func(v ...float32) *pgvector.Vector {
+		out := pgvector.NewVector(v)
+		return &out
+	}
+	candidates := []*ent.AutoTag{
+		{ID: 1, TagID: 10, Threshold: 0.5, Embedding: vec(1, 0)},
+		{ID: 2, TagID: 20, Threshold: 0.97, Embedding: vec(0.6, 0.8)},
+		{ID: 3, TagID: 30, Threshold: 0, Embedding: nil},
+		{ID: 4, TagID: 40, Threshold: 0, Embedding: vec(1, 0, 0)},
+	}
+	scores := scoreAutoTags([]float32{0.8, 0.6}, candidates)
+	if len(scores) != 1 || math.Abs(scores[10]-0.8) > 1e-6 {
+		t.Fatalf("expected only tag 10 with score 0.8, got %v", scores)
+	}
+}
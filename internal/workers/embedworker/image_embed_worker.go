@@ -22,10 +22,9 @@ import (
 	"era/booru/internal/config"
 	"era/booru/internal/db"
 	embed "era/booru/internal/embeddings"
-	"era/booru/internal/minio"
 	"era/booru/internal/queue"
+	"era/booru/internal/storage"
 
-	mc "github.com/minio/minio-go/v7"
 	pgvector "github.com/pgvector/pgvector-go"
 	"github.com/riverqueue/river"
 )
@@ -33,16 +32,25 @@ import (
 // ImageEmbedWorker generates vision embeddings for images.
 type ImageEmbedWorker struct {
 	river.WorkerDefaults[queue.EmbedArgs]
-	Minio *minio.Client
-	DB    *ent.Client
-	Cfg   *config.Config
+	Storage storage.Backend
+	DB      *ent.Client
+	Cfg     *config.Config
+	// Vector is the vision vector of the loaded model.
+	Vector *ent.Vector
 }
 
+// otherModelSnooze is how long a job for another model waits for a worker
+// that runs it.
+const otherModelSnooze = time.Minute
+
 func (w *ImageEmbedWorker) Work(ctx context.Context, job *river.Job[queue.EmbedArgs]) error {
+	if job.Args.Model != "" && job.Args.Model != w.Vector.Model {
+		return river.JobSnooze(otherModelSnooze)
+	}
 	log.Printf("Generating embedding for bucket %s, key %s", job.Args.Bucket, job.Args.Key)
 	bucket := job.Args.Bucket
 	if bucket == "" {
-		bucket = w.Minio.Bucket
+		bucket = w.Storage.Bucket()
 	}
 
 	media, err := w.DB.Media.Get(ctx, job.Args.Key)
@@ -64,29 +72,28 @@ func (w *ImageEmbedWorker) Work(ctx context.Context, job *river.Job[queue.EmbedA
 			log.Printf("Failed to generate video embedding: %v", err)
 			return classifyEmbeddingError(err, job.Args.Key)
 		}
-	} else {
-		obj, err := w.Minio.GetObject(ctx, bucket, job.Args.Key, mc.GetObjectOptions{})
-		if err != nil {
-			log.Printf("Failed to get object from MinIO: %v", err)
-			return classifyObjectError(err, job.Args.Key)
-		}
-		defer obj.Close()
-
-		data, err := io.ReadAll(obj)
+	} else if config.SupportedAudioFormats[format] {
+		// Audio is embedded through its cover art, which the media worker
+		// stored as the preview.
+		vec, err = w.imageEmbedding(ctx, w.Storage.PreviewBucket(), job.Args.Key)
 		if err != nil {
-			log.Printf("Failed to read image object: %v", err)
 			return err
 		}
-
-		vec, err = embed.VisionEmbedding(data)
+	} else if media.Frames != nil && *media.Frames > 1 {
+		vec, err = w.animationEmbedding(ctx, bucket, job.Args.Key, media)
 		if err != nil {
-			log.Printf("Failed to generate embedding: %v", err)
+			log.Printf("Failed to generate animation embedding: %v", err)
 			return classifyEmbeddingError(err, job.Args.Key)
 		}
+	} else {
+		vec, err = w.imageEmbedding(ctx, bucket, job.Args.Key)
+		if err != nil {
+			return err
+		}
 	}
 
 	pgv := pgvector.NewVector(vec)
-	if err := db.SetMediaVectors(ctx, w.DB, job.Args.Key, []db.VectorValue{{Name: "vision", Value: pgv}}); err != nil {
+	if err := db.SetMediaVector(ctx, w.DB, job.Args.Key, w.Vector.ID, pgv); err != nil {
 		log.Printf("Failed to save embedding to database: %v", err)
 		return err
 	}
@@ -94,21 +101,48 @@ func (w *ImageEmbedWorker) Work(ctx context.Context, job *river.Job[queue.EmbedA
 	if err := queue.WorkerEnqueue(ctx, queue.IndexArgs{ID: job.Args.Key}); err != nil {
 		log.Printf("Failed to enqueue reindex for %s: %v", job.Args.Key, err)
 	}
+	if hasVocabulary, err := w.DB.AutoTag.Query().Exist(ctx); err != nil {
+		log.Printf("Failed to check auto tag vocabulary: %v", err)
+	} else if hasVocabulary {
+		if err := queue.WorkerEnqueue(ctx, queue.AutoTagArgs{ID: job.Args.Key, Model: w.Vector.Model}); err != nil {
+			log.Printf("Failed to enqueue auto tagging for %s: %v", job.Args.Key, err)
+		}
+	}
 
 	logEmbedQueueDepth(ctx, fmt.Sprintf("Successfully generated and saved embedding for key %s (job %d)", job.Args.Key, job.ID))
 	return nil
 }
 
+// imageEmbedding embeds a still image object. Returned errors are already
+// classified for river.
+func (w *ImageEmbedWorker) imageEmbedding(ctx context.Context, bucket, key string) ([]float32, error) {
+	obj, err := w.Storage.Get(ctx, bucket, key)
+	if err != nil {
+		log.Printf("Failed to get object from storage: %v", err)
+		return nil, classifyObjectError(err, key)
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		log.Printf("Failed to read image object: %v", err)
+		return nil, classifyObjectError(err, key)
+	}
+
+	vec, err := embed.VisionEmbedding(data)
+	if err != nil {
+		log.Printf("Failed to generate embedding: %v", err)
+		return nil, classifyEmbeddingError(err, key)
+	}
+	return vec, nil
+}
+
 func classifyObjectError(err error, key string) error {
 	if err == nil {
 		return nil
 	}
-	var resp mc.ErrorResponse
-	if errors.As(err, &resp) {
-		switch resp.Code {
-		case "NoSuchKey":
-			return river.JobCancel(fmt.Errorf("object %s missing: %w", key, err))
-		}
+	if errors.Is(err, storage.ErrNotExist) {
+		return river.JobCancel(fmt.Errorf("object %s missing: %w", key, err))
 	}
 	return err
 }
@@ -263,6 +297,94 @@ func (w *ImageEmbedWorker) videoEmbedding(ctx context.Context, bucket, key strin
 	return vec, nil
 }
 
+// animationEmbedding averages the embeddings of frames sampled evenly across
+// an animated image. libvips can address GIF and WebP frames as pages; APNG is
+// only exposed by ffmpeg, so it goes through the video sampling path.
+func (w *ImageEmbedWorker) animationEmbedding(ctx context.Context, bucket, key string, media *ent.Media) ([]float32, error) {
+	if strings.ToLower(media.Format) == "png" {
+		return w.videoEmbedding(ctx, bucket, key, media)
+	}
+
+	startTime := time.Now()
+
+	frames := int(*media.Frames)
+	duration := 0
+	if media.Duration != nil && *media.Duration > 0 {
+		duration = int(*media.Duration)
+	}
+	pages := animationSampleIndices(frames, videoSampleCount(duration))
+	if len(pages) == 0 {
+		return nil, fmt.Errorf("invalid sample count computed for animation %s", key)
+	}
+
+	obj, err := w.Storage.Get(ctx, bucket, key)
+	if err != nil {
+		return nil, classifyObjectError(err, key)
+	}
+	data, err := io.ReadAll(obj)
+	obj.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read animation object: %w", err)
+	}
+
+	concurrency := runtime.NumCPU()
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	if concurrency > len(pages) {
+		concurrency = len(pages)
+	}
+
+	vectors := make([][]float32, len(pages))
+	errs := make([]error, len(pages))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, page := range pages {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i, page int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			vectors[i], errs[i] = embed.VisionEmbeddingPage(data, page)
+		}(i, page)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("failed to embed frame %d: %w", pages[i], err)
+		}
+	}
+
+	avg, err := averageVectors(vectors)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Animation embedding for %s: processed %d of %d frames in %d ms", key, len(pages), frames, time.Since(startTime).Milliseconds())
+	return avg, nil
+}
+
+// animationSampleIndices picks up to samples frame indices spread evenly over
+// frameCount frames, taking the middle frame of each segment.
+func animationSampleIndices(frameCount, samples int) []int {
+	if frameCount <= 0 || samples <= 0 {
+		return nil
+	}
+	if samples > frameCount {
+		samples = frameCount
+	}
+	indices := make([]int, samples)
+	for i := range indices {
+		idx := int((float64(i) + 0.5) * float64(frameCount) / float64(samples))
+		if idx >= frameCount {
+			idx = frameCount - 1
+		}
+		indices[i] = idx
+	}
+	return indices
+}
+
 func streamVideoFrames(ctx context.Context, src string, durationSeconds, samples int) (<-chan []byte, func() error, error) {
 	if samples <= 0 {
 		return nil, nil, fmt.Errorf("invalid frame sample count")
@@ -406,7 +528,7 @@ func readRawVideoFrames(r *bufio.Reader, frameSize, samples int, out chan<- []by
 }
 
 func (w *ImageEmbedWorker) cachedVideoPath(ctx context.Context, bucket, key string) (string, func(), error) {
-	obj, err := w.Minio.GetObject(ctx, bucket, key, mc.GetObjectOptions{})
+	obj, err := w.Storage.Get(ctx, bucket, key)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to fetch video object: %w", err)
 	}
@@ -46,3 +46,28 @@ func TestFormatTimestampFloorsMilliseconds(t *testing.T) {
 		t.Fatalf("expected %s, got %s", expected, got)
 	}
 }
+
+func TestAnimationSampleIndicesSpreadAcrossFrames(t *testing.T) {
+	got := animationSampleIndices(20, 5)
+	expected := []int{2, 6, 10, 14, 18}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d indices, got %v", len(expected), got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("unexpected index at %d: expected %d, got %d", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestAnimationSampleIndicesCappedByFrameCount(t *testing.T) {
+	got := animationSampleIndices(3, 5)
+	if len(got) != 3 {
+		t.Fatalf("expected one index per frame, got %v", got)
+	}
+	for i, idx := range got {
+		if idx != i {
+			t.Fatalf("expected index %d at position %d, got %d", i, i, idx)
+		}
+	}
+}
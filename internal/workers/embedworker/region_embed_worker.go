@@ -0,0 +1,92 @@
+package embedworker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"era/booru/ent"
+	"era/booru/ent/note"
+	"era/booru/internal/config"
+	embed "era/booru/internal/embeddings"
+	"era/booru/internal/queue"
+	"era/booru/internal/storage"
+
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/riverqueue/river"
+)
+
+// RegionEmbedWorker embeds the region of a note with the vision model, for
+// region-level similarity search.
+type RegionEmbedWorker struct {
+	river.WorkerDefaults[queue.EmbedRegionArgs]
+	Storage storage.Backend
+	DB      *ent.Client
+	// Vector is the vision vector of the loaded model.
+	Vector *ent.Vector
+}
+
+func (w *RegionEmbedWorker) Work(ctx context.Context, job *river.Job[queue.EmbedRegionArgs]) error {
+	if job.Args.Model != "" && job.Args.Model != w.Vector.Model {
+		return river.JobSnooze(otherModelSnooze)
+	}
+	n, err := w.DB.Note.Query().
+		Where(note.IDEQ(job.Args.NoteID)).
+		WithMedia().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return river.JobCancel(fmt.Errorf("note %d not found: %w", job.Args.NoteID, err))
+	}
+	if err != nil {
+		return err
+	}
+	key := n.MediaID
+
+	// Videos and audio are embedded through their preview image, which is
+	// what the note was drawn on.
+	bucket := w.Storage.Bucket()
+	format := strings.ToLower(n.Edges.Media.Format)
+	if config.SupportedVideoFormats[format] || config.SupportedAudioFormats[format] {
+		bucket = w.Storage.PreviewBucket()
+	}
+	obj, err := w.Storage.Get(ctx, bucket, key)
+	if err != nil {
+		return classifyObjectError(err, key)
+	}
+	defer obj.Close()
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return classifyObjectError(err, key)
+	}
+
+	vec, err := embed.VisionEmbeddingRegion(data, n.X, n.Y, n.Width, n.Height)
+	if err != nil {
+		return classifyEmbeddingError(err, key)
+	}
+	if len(vec) == 0 {
+		return river.JobCancel(fmt.Errorf("no vision model to embed note %d", n.ID))
+	}
+
+	// The region may have moved while it was embedded; only store the
+	// embedding if it still matches.
+	updated, err := w.DB.Note.Update().
+		Where(
+			note.IDEQ(n.ID),
+			note.XEQ(n.X), note.YEQ(n.Y),
+			note.WidthEQ(n.Width), note.HeightEQ(n.Height),
+		).
+		SetEmbedding(pgvector.NewVector(vec)).
+		SetEmbeddingModel(w.Vector.Model).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		log.Printf("Note %d changed while it was embedded; dropping the embedding", n.ID)
+		return nil
+	}
+	logEmbedQueueDepth(ctx, fmt.Sprintf("Embedded region of note %d (job %d)", n.ID, job.ID))
+	return nil
+}
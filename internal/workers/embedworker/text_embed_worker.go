@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	embed "era/booru/internal/embeddings"
 	"era/booru/internal/queue"
@@ -14,9 +15,17 @@ import (
 // TextEmbedWorker generates embeddings for text queries.
 type TextEmbedWorker struct {
 	river.WorkerDefaults[queue.EmbedTextArgs]
+	// Model identifies the loaded model.
+	Model string
 }
 
+// otherModelTextSnooze is short since a search request waits for the result.
+const otherModelTextSnooze = time.Second
+
 func (w *TextEmbedWorker) Work(ctx context.Context, job *river.Job[queue.EmbedTextArgs]) error {
+	if job.Args.Model != "" && job.Args.Model != w.Model {
+		return river.JobSnooze(otherModelTextSnooze)
+	}
 	vec, err := embed.TextEmbedding(job.Args.Text)
 	if err != nil {
 		log.Printf("Failed to generate text embedding: %v", err)
@@ -0,0 +1,348 @@
+package fsckworker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/ent/media"
+	"era/booru/ent/vector"
+	"era/booru/internal/config"
+	"era/booru/internal/db"
+	"era/booru/internal/queue"
+	"era/booru/internal/search"
+	"era/booru/internal/storage"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+)
+
+// maxDriftKeys bounds how many keys a report lists per category so the job
+// output stays small on badly drifted installs.
+const maxDriftKeys = 100
+
+// Drift lists the keys found in one inconsistency category.
+type Drift struct {
+	Count    int      `json:"count"`
+	Keys     []string `json:"keys"`
+	Repaired int      `json:"repaired,omitempty"`
+}
+
+func (d *Drift) add(key string) {
+	d.Count++
+	if len(d.Keys) < maxDriftKeys {
+		d.Keys = append(d.Keys, key)
+	}
+}
+
+// Report is recorded as the output of every fsck job.
+type Report struct {
+	Repair     bool      `json:"repair"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+
+	// Postgres rows whose original object is gone.
+	MissingOriginals Drift `json:"missing_originals"`
+	// Originals that were never processed into a Postgres row.
+	UnprocessedObjects Drift `json:"unprocessed_objects"`
+	// Bleve documents for media that no longer exists or is trashed.
+	StaleIndexDocs Drift `json:"stale_index_docs"`
+	// Live media rows missing from Bleve.
+	MissingIndexDocs Drift `json:"missing_index_docs"`
+	// Media without a vision embedding.
+	MissingVectors Drift `json:"missing_vectors"`
+	// Preview objects and renditions without an original.
+	OrphanPreviews Drift `json:"orphan_previews"`
+}
+
+// FsckWorker compares storage, Postgres and Bleve and optionally repairs the
+// drift. It runs in the server, which owns the Bleve index.
+type FsckWorker struct {
+	river.WorkerDefaults[queue.FsckArgs]
+	DB      *ent.Client
+	Storage storage.Backend
+}
+
+// Timeout allows walking large buckets; River's default is one minute.
+func (w *FsckWorker) Timeout(*river.Job[queue.FsckArgs]) time.Duration {
+	return time.Hour
+}
+
+func (w *FsckWorker) Work(ctx context.Context, job *river.Job[queue.FsckArgs]) error {
+	report := Report{Repair: job.Args.Repair, StartedAt: time.Now()}
+
+	rows, err := w.DB.Media.Query().Select(media.FieldID, media.FieldFormat, media.FieldDeletedAt).All(ctx)
+	if err != nil {
+		return err
+	}
+	// Trashed media keeps its objects but has no index document.
+	formats := make(map[string]string, len(rows))
+	live := make(map[string]string, len(rows))
+	for _, m := range rows {
+		formats[m.ID] = m.Format
+		if m.DeletedAt == nil {
+			live[m.ID] = m.Format
+		}
+	}
+
+	originals := map[string]bool{}
+	for obj := range w.Storage.List(ctx, w.Storage.Bucket()) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		originals[obj.Key] = true
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := w.checkOriginals(ctx, &report, formats, live, originals); err != nil {
+		return err
+	}
+	if err := w.checkIndex(ctx, &report, live); err != nil {
+		return err
+	}
+	if err := w.checkVectors(ctx, &report); err != nil {
+		return err
+	}
+	if err := w.checkPreviews(ctx, &report, originals); err != nil {
+		return err
+	}
+
+	report.FinishedAt = time.Now()
+	log.Printf("fsck finished (repair=%v): %d missing originals, %d unprocessed objects, %d stale index docs, %d missing index docs, %d missing vectors, %d orphan previews",
+		report.Repair, report.MissingOriginals.Count, report.UnprocessedObjects.Count, report.StaleIndexDocs.Count,
+		report.MissingIndexDocs.Count, report.MissingVectors.Count, report.OrphanPreviews.Count)
+	return river.RecordOutput(ctx, report)
+}
+
+func (w *FsckWorker) checkOriginals(ctx context.Context, report *Report, formats, live map[string]string, originals map[string]bool) error {
+	for _, id := range sortedKeys(formats) {
+		if originals[id] {
+			continue
+		}
+		report.MissingOriginals.add(id)
+		if !report.Repair {
+			continue
+		}
+		// Nothing can be served or reprocessed without the original. Live
+		// media goes to the trash, where it can still be restored once the
+		// object is recovered, and the index job removes the Bleve document.
+		// Trashed media is deleted by the delete job, which also removes the
+		// derived objects.
+		if _, ok := live[id]; !ok {
+			if err := queue.WorkerEnqueue(ctx, queue.DeleteArgs{ID: id}); err != nil {
+				log.Printf("fsck: enqueue delete %s: %v", id, err)
+				continue
+			}
+			report.MissingOriginals.Repaired++
+			continue
+		}
+		err := w.DB.Media.UpdateOneID(id).
+			Where(media.DeletedAtIsNil()).
+			SetDeletedAt(time.Now()).
+			Exec(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			log.Printf("fsck: trash media %s: %v", id, err)
+			continue
+		}
+		if err := queue.WorkerEnqueue(ctx, queue.IndexArgs{ID: id}); err != nil {
+			log.Printf("fsck: enqueue index %s: %v", id, err)
+		}
+		report.MissingOriginals.Repaired++
+	}
+
+	deleting, err := queue.PendingDeletes(ctx, river.ClientFromContext[pgx.Tx](ctx))
+	if err != nil {
+		return err
+	}
+	for _, id := range deleting {
+		delete(originals, id)
+	}
+
+	for _, key := range sortedKeys(originals) {
+		if _, ok := formats[key]; ok {
+			continue
+		}
+		report.UnprocessedObjects.add(key)
+		if !report.Repair {
+			continue
+		}
+		info, err := w.Storage.Stat(ctx, w.Storage.Bucket(), key)
+		if err != nil {
+			log.Printf("fsck: stat %s: %v", key, err)
+			continue
+		}
+		args := queue.ProcessArgs{Bucket: w.Storage.Bucket(), Key: key, ContentType: info.ContentType}
+		if err := queue.WorkerEnqueue(ctx, args); err != nil {
+			log.Printf("fsck: enqueue process %s: %v", key, err)
+			continue
+		}
+		report.UnprocessedObjects.Repaired++
+	}
+	return nil
+}
+
+func (w *FsckWorker) checkIndex(ctx context.Context, report *Report, formats map[string]string) error {
+	ids, err := search.DocumentIDs()
+	if err != nil {
+		return err
+	}
+	indexed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		indexed[id] = true
+		if _, ok := formats[id]; ok {
+			continue
+		}
+		report.StaleIndexDocs.add(id)
+		if !report.Repair {
+			continue
+		}
+		if err := search.DeleteMedia(id); err != nil {
+			log.Printf("fsck: delete index doc %s: %v", id, err)
+			continue
+		}
+		report.StaleIndexDocs.Repaired++
+	}
+
+	for _, id := range sortedKeys(formats) {
+		if indexed[id] {
+			continue
+		}
+		report.MissingIndexDocs.add(id)
+		if !report.Repair {
+			continue
+		}
+		if err := queue.WorkerEnqueue(ctx, queue.IndexArgs{ID: id}); err != nil {
+			log.Printf("fsck: enqueue index %s: %v", id, err)
+			continue
+		}
+		report.MissingIndexDocs.Repaired++
+	}
+	return nil
+}
+
+func (w *FsckWorker) checkVectors(ctx context.Context, report *Report) error {
+	// Audio is only embedded when it carries cover art, so a missing vector
+	// is expected there.
+	audio := make([]string, 0, len(config.SupportedAudioFormats))
+	for f := range config.SupportedAudioFormats {
+		audio = append(audio, f)
+	}
+	vt, err := db.ActiveVisionVector(ctx, w.DB)
+	if ent.IsNotFound(err) {
+		// Nothing was ever embedded, so there is no model to compare with.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ids, err := w.DB.Media.Query().
+		Where(
+			media.Not(media.HasVectorsWith(vector.IDEQ(vt.ID))),
+			media.FormatNotIn(audio...),
+			media.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(media.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		report.MissingVectors.add(id)
+		if !report.Repair {
+			continue
+		}
+		args := queue.EmbedArgs{Bucket: w.Storage.Bucket(), Key: id, Model: vt.Model}
+		if err := queue.WorkerEnqueue(ctx, args); err != nil {
+			log.Printf("fsck: enqueue embed %s: %v", id, err)
+			continue
+		}
+		report.MissingVectors.Repaired++
+	}
+	return nil
+}
+
+func (w *FsckWorker) checkPreviews(ctx context.Context, report *Report, originals map[string]bool) error {
+	var orphans []string
+	for obj := range w.Storage.List(ctx, w.Storage.PreviewBucket()) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		if !originals[previewOriginal(obj.Key)] {
+			orphans = append(orphans, obj.Key)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	slices.Sort(orphans)
+	for _, key := range orphans {
+		if report.Repair {
+			// The originals were listed when the job started; one uploaded
+			// since then already has its preview.
+			_, err := w.Storage.Stat(ctx, w.Storage.Bucket(), previewOriginal(key))
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, storage.ErrNotExist) {
+				log.Printf("fsck: stat original of preview %s: %v", key, err)
+				continue
+			}
+		}
+		report.OrphanPreviews.add(key)
+		if !report.Repair {
+			continue
+		}
+		if err := w.Storage.Remove(ctx, w.Storage.PreviewBucket(), key); err != nil {
+			log.Printf("fsck: remove preview %s: %v", key, err)
+			continue
+		}
+		report.OrphanPreviews.Repaired++
+	}
+	return nil
+}
+
+// previewOriginal maps a preview bucket key to the original it was made from.
+// Previews share the original's key; renditions are "renditions/<id>.<ext>".
+func previewOriginal(key string) string {
+	if rest, ok := strings.CutPrefix(key, "renditions/"); ok {
+		id, _, _ := strings.Cut(rest, ".")
+		return id
+	}
+	return key
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Schedule enqueues a check every interval until ctx is done. River's
+// periodic jobs are enqueued by whichever client holds leadership, which may
+// be a worker process that cannot run fsck, so the server schedules its own.
+func Schedule(ctx context.Context, client *river.Client[pgx.Tx], interval time.Duration, repair bool) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := queue.Enqueue(ctx, client, queue.FsckArgs{Repair: repair}); err != nil {
+				log.Printf("enqueue fsck: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package fsckworker
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPreviewOriginal(t *testing.T) {
+	cases := map[string]string{
+		"0123abcd":                "0123abcd",
+		"renditions/0123abcd.mp4": "0123abcd",
+		"renditions/0123abcd":     "0123abcd",
+	}
+	for key, want := range cases {
+		if got := previewOriginal(key); got != want {
+			t.Errorf("previewOriginal(%q) = %q; want %q", key, got, want)
+		}
+	}
+}
+
+func TestDriftCapsKeys(t *testing.T) {
+	var d Drift
+	for i := 0; i < maxDriftKeys+5; i++ {
+		d.add(fmt.Sprint(i))
+	}
+	if d.Count != maxDriftKeys+5 || len(d.Keys) != maxDriftKeys {
+		t.Fatalf("got count %d with %d keys", d.Count, len(d.Keys))
+	}
+}
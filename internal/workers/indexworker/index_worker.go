@@ -24,6 +24,11 @@ type IndexWorker struct {
 func (w *IndexWorker) Work(ctx context.Context, job *river.Job[queue.IndexArgs]) error {
 	mobj, err := w.DB.Media.Query().Where(media.IDEQ(job.Args.ID)).
 		WithTags().
+		WithPools().
+		WithFavorites().
+		WithComments().
+		WithSources().
+		WithMediaFields(func(q *ent.MediaFieldQuery) { q.WithMetaField() }).
 		WithDates(func(q *ent.DateQuery) { q.WithMediaDates() }).
 		WithVectors(func(q *ent.VectorQuery) {
 			q.WithMediaVectors(func(mvq *ent.MediaVectorQuery) {
@@ -31,8 +36,10 @@ func (w *IndexWorker) Work(ctx context.Context, job *river.Job[queue.IndexArgs])
 			})
 		}).
 		Only(ctx)
-	if ent.IsNotFound(err) {
-		log.Printf("Media with ID %s not found, deleting from index", job.Args.ID)
+	// Trashed media stays out of search until it is restored.
+	trashed := err == nil && mobj.DeletedAt != nil
+	if ent.IsNotFound(err) || trashed {
+		log.Printf("Media with ID %s not found or trashed, deleting from index", job.Args.ID)
 		if err := search.DeleteMedia(job.Args.ID); err != nil {
 			return err
 		}
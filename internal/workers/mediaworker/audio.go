@@ -0,0 +1,118 @@
+package mediaworker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"era/booru/internal/config"
+	"era/booru/internal/queue"
+
+	"github.com/riverqueue/river"
+)
+
+// Size of the waveform preview rendered for audio without embedded cover art.
+const (
+	waveformWidth  = 640
+	waveformHeight = 240
+)
+
+// audioTagFields are the container tags copied to meta tags such as "artist:foo".
+var audioTagFields = []string{"artist", "album", "genre", "title"}
+
+func (w *ProcessWorker) processAudio(ctx context.Context, bucket, key string) (string, error) {
+	src := w.Storage.SourceURL(bucket, key)
+
+	probe, err := runProbe(ctx, src)
+	if err != nil {
+		return "", err
+	}
+
+	var audio, cover *probeStream
+	for i := range probe.Streams {
+		s := &probe.Streams[i]
+		switch {
+		case s.CodecType == "audio" && audio == nil:
+			audio = s
+		case s.CodecType == "video" && s.Disposition.AttachedPic == 1 && cover == nil:
+			cover = s
+		}
+	}
+	if audio == nil {
+		return "", river.JobCancel(fmt.Errorf("no audio stream found in %s", key))
+	}
+
+	hasAudio := true
+	info := mediaInfo{
+		Format:     audioFormat(probe.Format.FormatName, audio.CodecName),
+		Duration:   probe.durationSeconds("audio"),
+		Bitrate:    probe.bitRate(),
+		MetaTags:   audioMetaTags(probe),
+		AudioCodec: audio.CodecName,
+		HasAudio:   &hasAudio,
+	}
+
+	// Embedded cover art becomes the preview; otherwise render a waveform so
+	// the grid always has something to show.
+	if cover != nil {
+		info.Width, info.Height = cover.Width, cover.Height
+		err = w.writePreview(ctx, key,
+			"-i", src, "-y", "-loglevel", "error",
+			"-map", fmt.Sprintf("0:%d", cover.Index), "-frames:v", "1", "-vf", "scale=320:-2",
+			"-q:v", "3", "-f", "image2", "pipe:1")
+	} else {
+		info.Width, info.Height = waveformWidth, waveformHeight
+		err = w.writePreview(ctx, key,
+			"-i", src, "-y", "-loglevel", "error",
+			"-filter_complex", fmt.Sprintf("showwavespic=s=%dx%d:colors=white", waveformWidth, waveformHeight),
+			"-frames:v", "1", "-q:v", "3", "-f", "image2", "pipe:1")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := w.saveMediaToDB(ctx, key, info); err != nil {
+		return "", err
+	}
+	log.Printf("Saved audio %s to database with format %s, duration %ds, bitrate %d", key, info.Format, info.Duration, info.Bitrate)
+
+	// Only cover art carries anything the vision model can embed.
+	if cover != nil {
+		if err := queue.WorkerEnqueue(ctx, queue.EmbedArgs{Bucket: bucket, Key: key}); err != nil {
+			log.Printf("Failed to enqueue embed job for %s: %v", key, err)
+			return "", err
+		}
+	}
+
+	return key, nil
+}
+
+// audioFormat maps ffprobe's container name to one of SupportedAudioFormats.
+// Opus is usually wrapped in Ogg, so the codec decides between the two.
+func audioFormat(formatName, codec string) string {
+	if codec == "opus" {
+		return "opus"
+	}
+	for _, name := range strings.Split(formatName, ",") {
+		if config.SupportedAudioFormats[name] {
+			return name
+		}
+	}
+	return formatName
+}
+
+// audioMetaTags turns ID3/Vorbis comment fields into meta tags like
+// "artist:daft_punk". Values are lowercased and spaces become underscores so
+// they survive the whitespace-separated query syntax.
+func audioMetaTags(probe *probeResult) []string {
+	tags := make([]string, 0, len(audioTagFields))
+	for _, field := range audioTagFields {
+		value := strings.ToLower(strings.Join(strings.Fields(probe.tag(field)), "_"))
+		if value == "" {
+			continue
+		}
+		tags = append(tags, field+":"+value)
+	}
+	return tags
+}
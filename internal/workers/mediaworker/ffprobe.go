@@ -0,0 +1,180 @@
+package mediaworker
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// probeStream is the subset of an ffprobe stream entry used during processing.
+type probeStream struct {
+	Index        int               `json:"index"`
+	CodecName    string            `json:"codec_name"`
+	CodecType    string            `json:"codec_type"`
+	Width        int               `json:"width"`
+	Height       int               `json:"height"`
+	Duration     string            `json:"duration"`
+	AvgFrameRate string            `json:"avg_frame_rate"`
+	RFrameRate   string            `json:"r_frame_rate"`
+	Tags         map[string]string `json:"tags"`
+	SideDataList []struct {
+		Rotation float64 `json:"rotation"`
+	} `json:"side_data_list"`
+	Disposition struct {
+		AttachedPic int `json:"attached_pic"`
+	} `json:"disposition"`
+}
+
+// frameRate returns the stream's average frame rate, falling back to the
+// base rate for containers that do not report an average.
+func (s *probeStream) frameRate() float64 {
+	if fps := parseRate(s.AvgFrameRate); fps > 0 {
+		return fps
+	}
+	return parseRate(s.RFrameRate)
+}
+
+// rotation returns the clockwise display rotation in degrees, normalised to
+// 0, 90, 180 or 270. Newer ffmpeg releases report it as display matrix side
+// data (counter-clockwise), older ones as a "rotate" tag (clockwise).
+func (s *probeStream) rotation() int {
+	deg := 0
+	found := false
+	for _, sd := range s.SideDataList {
+		if sd.Rotation != 0 {
+			deg = -int(math.Round(sd.Rotation))
+			found = true
+			break
+		}
+	}
+	if !found {
+		if v, err := strconv.Atoi(s.Tags["rotate"]); err == nil {
+			deg = v
+		}
+	}
+	deg %= 360
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// parseRate parses ffprobe rationals such as "30000/1001".
+func parseRate(raw string) float64 {
+	num, den, ok := strings.Cut(raw, "/")
+	if !ok {
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return 0
+		}
+		return f
+	}
+	n, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0
+	}
+	d, err := strconv.ParseFloat(den, 64)
+	if err != nil || d == 0 {
+		return 0
+	}
+	return math.Round(n/d*1000) / 1000
+}
+
+// probeResult mirrors the JSON printed by `ffprobe -show_streams -show_format`.
+type probeResult struct {
+	Streams []probeStream `json:"streams"`
+	Format  struct {
+		FormatName string            `json:"format_name"`
+		Duration   string            `json:"duration"`
+		BitRate    string            `json:"bit_rate"`
+		Tags       map[string]string `json:"tags"`
+	} `json:"format"`
+}
+
+func runProbe(ctx context.Context, src string) (*probeResult, error) {
+	out, err := exec.CommandContext(ctx, "ffprobe", "-v", "quiet", "-print_format", "json", "-show_streams", "-show_format", src).Output()
+	if err != nil {
+		return nil, err
+	}
+	var probe probeResult
+	if err := json.Unmarshal(out, &probe); err != nil {
+		return nil, err
+	}
+	return &probe, nil
+}
+
+// roundSeconds parses an ffprobe duration string and rounds it to whole seconds.
+func roundSeconds(raw string) int {
+	if raw == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0
+	}
+	return int(f + 0.5)
+}
+
+// durationSeconds returns the longest stream duration of the given codec type,
+// falling back to the container duration.
+func (p *probeResult) durationSeconds(codecType string) int {
+	duration := 0
+	for _, s := range p.Streams {
+		if s.CodecType != codecType {
+			continue
+		}
+		if d := roundSeconds(s.Duration); d > duration {
+			duration = d
+		}
+	}
+	if duration == 0 {
+		duration = roundSeconds(p.Format.Duration)
+	}
+	return duration
+}
+
+// firstStream returns the first stream of the given codec type that is not
+// embedded cover art, or nil.
+func (p *probeResult) firstStream(codecType string) *probeStream {
+	for i := range p.Streams {
+		s := &p.Streams[i]
+		if s.CodecType == codecType && s.Disposition.AttachedPic == 0 {
+			return s
+		}
+	}
+	return nil
+}
+
+// bitRate returns the container bitrate in bits per second, or 0 if unknown.
+func (p *probeResult) bitRate() int {
+	n, err := strconv.Atoi(p.Format.BitRate)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// tag looks up a metadata tag case-insensitively, first on the container and
+// then on the streams (Ogg and Opus keep their comments on the audio stream).
+func (p *probeResult) tag(name string) string {
+	lookup := func(tags map[string]string) string {
+		for k, v := range tags {
+			if strings.EqualFold(k, name) {
+				return strings.TrimSpace(v)
+			}
+		}
+		return ""
+	}
+	if v := lookup(p.Format.Tags); v != "" {
+		return v
+	}
+	for _, s := range p.Streams {
+		if v := lookup(s.Tags); v != "" {
+			return v
+		}
+	}
+	return ""
+}
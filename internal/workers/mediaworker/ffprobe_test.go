@@ -0,0 +1,74 @@
+package mediaworker
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const sampleProbe = `{
+	"streams": [
+		{"index": 0, "codec_name": "h264", "codec_type": "video", "width": 1920, "height": 1080,
+		 "avg_frame_rate": "30000/1001", "r_frame_rate": "30/1",
+		 "side_data_list": [{"side_data_type": "Display Matrix", "rotation": -90}]},
+		{"index": 1, "codec_name": "aac", "codec_type": "audio", "duration": "12.6",
+		 "tags": {"TITLE": "Night Drive"}},
+		{"index": 2, "codec_name": "mjpeg", "codec_type": "video", "width": 500, "height": 500,
+		 "disposition": {"attached_pic": 1}}
+	],
+	"format": {"format_name": "mov,mp4,m4a", "duration": "12.612", "bit_rate": "4500000",
+		"tags": {"artist": "The Midnight"}}
+}`
+
+func TestProbeResultParsing(t *testing.T) {
+	var probe probeResult
+	if err := json.Unmarshal([]byte(sampleProbe), &probe); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	video := probe.firstStream("video")
+	if video == nil || video.CodecName != "h264" {
+		t.Fatalf("expected h264 video stream, got %+v", video)
+	}
+	if fps := video.frameRate(); fps != 29.97 {
+		t.Fatalf("expected 29.97 fps, got %v", fps)
+	}
+	if rot := video.rotation(); rot != 90 {
+		t.Fatalf("expected 90 degree rotation, got %d", rot)
+	}
+	if d := probe.durationSeconds("audio"); d != 13 {
+		t.Fatalf("expected 13s audio duration, got %d", d)
+	}
+	if br := probe.bitRate(); br != 4500000 {
+		t.Fatalf("unexpected bitrate %d", br)
+	}
+
+	tags := audioMetaTags(&probe)
+	expected := []string{"artist:the_midnight", "title:night_drive"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("expected tags %v, got %v", expected, tags)
+	}
+}
+
+func TestRotationFromLegacyTag(t *testing.T) {
+	s := probeStream{Tags: map[string]string{"rotate": "-90"}}
+	if rot := s.rotation(); rot != 270 {
+		t.Fatalf("expected 270, got %d", rot)
+	}
+}
+
+func TestAudioFormat(t *testing.T) {
+	cases := []struct {
+		format, codec, want string
+	}{
+		{"ogg", "opus", "opus"},
+		{"ogg", "vorbis", "ogg"},
+		{"mp3", "mp3", "mp3"},
+		{"wav", "pcm_s16le", "wav"},
+	}
+	for _, c := range cases {
+		if got := audioFormat(c.format, c.codec); got != c.want {
+			t.Fatalf("audioFormat(%q, %q) = %q, want %q", c.format, c.codec, got, c.want)
+		}
+	}
+}
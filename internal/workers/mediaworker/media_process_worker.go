@@ -2,51 +2,70 @@ package mediaworker
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"image"
 	"io"
 	"log"
 	"os/exec"
-	"strconv"
 	"strings"
 	"time"
 
 	"era/booru/ent"
 	"era/booru/internal/config"
 	"era/booru/internal/db"
-	"era/booru/internal/minio"
 	"era/booru/internal/processing"
 	"era/booru/internal/queue"
+	"era/booru/internal/storage"
 
-	mc "github.com/minio/minio-go/v7"
 	"github.com/riverqueue/river"
 )
 
 // ProcessWorker processes uploaded media objects.
 type ProcessWorker struct {
 	river.WorkerDefaults[queue.ProcessArgs]
-	Minio *minio.Client
-	DB    *ent.Client
-	Cfg   *config.Config
+	Storage storage.Backend
+	DB      *ent.Client
+	Cfg     *config.Config
 }
 
 func (w *ProcessWorker) Work(ctx context.Context, job *river.Job[queue.ProcessArgs]) error {
 	log.Printf("Processing task started for bucket %s, key %s, content type %s", job.Args.Bucket, job.Args.Key, job.Args.ContentType)
 	bucket := job.Args.Bucket
 	if bucket == "" {
-		bucket = w.Minio.Bucket
+		bucket = w.Storage.Bucket()
 	}
 	if strings.HasPrefix(job.Args.ContentType, "video/") {
 		_, err := w.processVideo(ctx, bucket, job.Args.Key)
 		return err
 	}
+	if strings.HasPrefix(job.Args.ContentType, "audio/") {
+		_, err := w.processAudio(ctx, bucket, job.Args.Key)
+		return err
+	}
 	_, err := w.processImage(ctx, bucket, job.Args.Key)
 	return err
 }
 
-func (w *ProcessWorker) saveMediaToDB(ctx context.Context, key, format string, width, height, duration int) error {
+// mediaInfo holds the metadata extracted from an uploaded object.
+type mediaInfo struct {
+	Format   string
+	Width    int
+	Height   int
+	Duration int // seconds, 0 for still images
+	Frames   int // frame count for animated images, 0 otherwise
+	Bitrate  int // bits per second for video and audio, 0 if unknown
+	MetaTags []string
+
+	// Stream details for video and audio; left empty for images.
+	VideoCodec string
+	AudioCodec string
+	FPS        float64
+	HasAudio   *bool
+	Rotation   int
+}
+
+func (w *ProcessWorker) saveMediaToDB(ctx context.Context, key string, info mediaInfo) error {
 	tx, err := w.DB.Tx(ctx)
 	if err != nil {
 		return err
@@ -58,20 +77,49 @@ func (w *ProcessWorker) saveMediaToDB(ctx context.Context, key, format string, w
 	if err != nil {
 		return err
 	}
+	tagIDs := []int{tagme.ID}
+	for _, name := range info.MetaTags {
+		tg, err := db.FindOrCreateMetaTag(ctx, tx.Client(), name)
+		if err != nil {
+			return err
+		}
+		tagIDs = append(tagIDs, tg.ID)
+	}
 
 	mediaCreate := tx.Media.Create().
 		SetID(key).
-		SetFormat(format).
-		SetWidth(int16(width)).
-		SetHeight(int16(height))
+		SetFormat(info.Format).
+		SetWidth(int16(info.Width)).
+		SetHeight(int16(info.Height))
 
-	// Only set duration for videos
-	if duration > 0 {
-		mediaCreate = mediaCreate.SetDuration(int16(duration))
+	// Only set duration for videos and animations
+	if info.Duration > 0 {
+		mediaCreate = mediaCreate.SetDuration(int32(info.Duration))
+	}
+	if info.Frames > 1 {
+		mediaCreate = mediaCreate.SetFrames(int32(info.Frames))
+	}
+	if info.Bitrate > 0 {
+		mediaCreate = mediaCreate.SetBitrate(info.Bitrate)
+	}
+	if info.VideoCodec != "" {
+		mediaCreate = mediaCreate.SetVideoCodec(info.VideoCodec)
+	}
+	if info.AudioCodec != "" {
+		mediaCreate = mediaCreate.SetAudioCodec(info.AudioCodec)
+	}
+	if info.FPS > 0 {
+		mediaCreate = mediaCreate.SetFps(info.FPS)
+	}
+	if info.HasAudio != nil {
+		mediaCreate = mediaCreate.SetHasAudio(*info.HasAudio)
+	}
+	if info.Rotation != 0 {
+		mediaCreate = mediaCreate.SetRotation(int16(info.Rotation))
 	}
 
 	// Add tags during creation instead of after
-	mediaCreate = mediaCreate.AddTagIDs(tagme.ID)
+	mediaCreate = mediaCreate.AddTagIDs(tagIDs...)
 
 	mediaObj, err := mediaCreate.Save(ctx)
 	if err != nil {
@@ -109,7 +157,7 @@ func (w *ProcessWorker) saveMediaToDB(ctx context.Context, key, format string, w
 
 // Simplified processImage function
 func (w *ProcessWorker) processImage(ctx context.Context, bucket, key string) (string, error) {
-	rc, err := w.Minio.GetObject(ctx, bucket, key, mc.GetObjectOptions{})
+	rc, err := w.Storage.Get(ctx, bucket, key)
 	if err != nil {
 		return "", err
 	}
@@ -129,12 +177,23 @@ func (w *ProcessWorker) processImage(ctx context.Context, bucket, key string) (s
 		return "", err
 	}
 
+	info := mediaInfo{Format: meta.Format, Width: meta.Width, Height: meta.Height}
+	anim, err := processing.DetectAnimation(data, meta.Format)
+	if err != nil {
+		// A broken animation header should not block ingesting the first frame.
+		log.Printf("Failed to detect animation for %s: %v", key, err)
+	} else if anim.Animated() {
+		info.Frames = anim.Frames
+		info.Duration = animationSeconds(anim.Duration)
+		info.MetaTags = append(info.MetaTags, "animated")
+	}
+
 	// Use common database save function
-	if err := w.saveMediaToDB(ctx, key, meta.Format, meta.Width, meta.Height, 0); err != nil {
+	if err := w.saveMediaToDB(ctx, key, info); err != nil {
 		log.Printf("Failed to save media to database: %v", err)
 		return "", err
 	}
-	log.Printf("Saved media %s to database with format %s, width %d, height %d", key, meta.Format, meta.Width, meta.Height)
+	log.Printf("Saved media %s to database with format %s, width %d, height %d, frames %d", key, meta.Format, meta.Width, meta.Height, info.Frames)
 
 	if err := queue.WorkerEnqueue(ctx, queue.EmbedArgs{Bucket: bucket, Key: key}); err != nil {
 		log.Printf("Failed to enqueue embed job for %s: %v", key, err)
@@ -145,34 +204,45 @@ func (w *ProcessWorker) processImage(ctx context.Context, bucket, key string) (s
 	return key, nil
 }
 
-// Simplified processVideo function
-func (w *ProcessWorker) processVideo(ctx context.Context, bucket, key string) (string, error) {
-	scheme := "http://"
-	if w.Cfg.MinioSSL {
-		scheme = "https://"
+// animationSeconds rounds an animation's playback time to whole seconds. Short
+// loops still report at least one second so they are distinguishable from stills.
+func animationSeconds(d time.Duration) int {
+	secs := int(d.Round(time.Second) / time.Second)
+	if secs < 1 && d > 0 {
+		secs = 1
 	}
-	src := fmt.Sprintf("%s%s/%s/%s", scheme, w.Cfg.MinioInternalEndpoint, strings.TrimPrefix(bucket, "/"), strings.TrimPrefix(key, "/"))
+	return secs
+}
 
-	probeOut, err := exec.Command("ffprobe", "-v", "quiet", "-print_format", "json", "-show_streams", "-show_format", src).Output()
+// writePreview runs ffmpeg with the given arguments and stores the JPEG it
+// writes to stdout as the preview for key. ffmpeg is killed when the upload
+// fails or ctx is done, and always reaped.
+func (w *ProcessWorker) writePreview(ctx context.Context, key string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	stdout, err := cmd.StdoutPipe()
 	if err != nil {
-		return "", err
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
 	}
-	var probe struct {
-		Streams []struct {
-			Width     int    `json:"width"`
-			Height    int    `json:"height"`
-			Duration  string `json:"duration"`
-			CodecType string `json:"codec_type"`
-		} `json:"streams"`
-		Format struct {
-			FormatName string `json:"format_name"`
-			Duration   string `json:"duration"`
-		} `json:"format"`
-	}
-	if err := json.Unmarshal(probeOut, &probe); err != nil {
+	if _, err = storage.PutPreviewJpeg(ctx, w.Storage, key, stdout); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return err
+	}
+	return cmd.Wait()
+}
+
+// Simplified processVideo function
+func (w *ProcessWorker) processVideo(ctx context.Context, bucket, key string) (string, error) {
+	src := w.Storage.SourceURL(bucket, key)
+
+	probe, err := runProbe(ctx, src)
+	if err != nil {
 		return "", err
 	}
-	width, height, duration := 0, 0, 0
+	width, height := 0, 0
 	for _, s := range probe.Streams {
 		if s.CodecType == "video" {
 			if s.Width > width {
@@ -181,20 +251,9 @@ func (w *ProcessWorker) processVideo(ctx context.Context, bucket, key string) (s
 			if s.Height > height {
 				height = s.Height
 			}
-			if s.Duration != "" {
-				if f, err := strconv.ParseFloat(s.Duration, 64); err == nil {
-					if int(f+0.5) > duration {
-						duration = int(f + 0.5)
-					}
-				}
-			}
-		}
-	}
-	if duration == 0 && probe.Format.Duration != "" {
-		if f, err := strconv.ParseFloat(probe.Format.Duration, 64); err == nil {
-			duration = int(f + 0.5)
 		}
 	}
+	duration := probe.durationSeconds("video")
 	format := probe.Format.FormatName
 	for f := range config.SupportedVideoFormats {
 		if strings.Contains(format, f) {
@@ -203,33 +262,43 @@ func (w *ProcessWorker) processVideo(ctx context.Context, bucket, key string) (s
 		}
 	}
 
-	cmd := exec.Command("ffmpeg",
-		"-ss", "00:00:02", "-i", src, "-y", "-loglevel", "error",
-		"-vframes", "1", "-vf", "scale=320:-2",
-		"-q:v", "3", "-f", "image2", "pipe:1")
-	stdout, err := cmd.StdoutPipe()
-	if err != nil {
-		return "", err
+	audio := probe.firstStream("audio")
+	hasAudio := audio != nil
+	info := mediaInfo{
+		Format:   format,
+		Width:    width,
+		Height:   height,
+		Duration: duration,
+		Bitrate:  probe.bitRate(),
+		HasAudio: &hasAudio,
 	}
-	if err := cmd.Start(); err != nil {
-		return "", err
+	if video := probe.firstStream("video"); video != nil {
+		info.VideoCodec = video.CodecName
+		info.FPS = video.frameRate()
+		info.Rotation = video.rotation()
+		// Players apply the rotation, so store the dimensions as displayed.
+		if info.Rotation == 90 || info.Rotation == 270 {
+			info.Width, info.Height = info.Height, info.Width
+		}
 	}
-	previewKey := key
-	if _, err = w.Minio.PutPreviewJpeg(ctx, previewKey, stdout); err != nil {
-		return "", err
+	if audio != nil {
+		info.AudioCodec = audio.CodecName
 	}
-	if err := cmd.Wait(); err != nil {
+
+	if err := w.writePreview(ctx, key,
+		"-ss", "00:00:02", "-i", src, "-y", "-loglevel", "error",
+		"-vframes", "1", "-vf", "scale=320:-2",
+		"-q:v", "3", "-f", "image2", "pipe:1"); err != nil {
 		return "", err
 	}
 
-	obj, err := w.Minio.GetObject(ctx, bucket, key, mc.GetObjectOptions{})
-	if err != nil {
+	// Use common database save function
+	if err := w.saveMediaToDB(ctx, key, info); err != nil {
 		return "", err
 	}
-	defer obj.Close()
 
-	// Use common database save function
-	if err := w.saveMediaToDB(ctx, key, format, width, height, duration); err != nil {
+	if err := w.enqueueTranscode(ctx, key, info); err != nil {
+		log.Printf("Failed to enqueue transcode job for %s: %v", key, err)
 		return "", err
 	}
 
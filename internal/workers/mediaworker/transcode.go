@@ -0,0 +1,225 @@
+package mediaworker
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"era/booru/ent"
+	"era/booru/ent/rendition"
+	"era/booru/internal/config"
+	"era/booru/internal/queue"
+	"era/booru/internal/storage"
+
+	"github.com/riverqueue/river"
+)
+
+// TranscodeWorker converts videos into a rendition every browser can play.
+type TranscodeWorker struct {
+	river.WorkerDefaults[queue.TranscodeArgs]
+	Storage storage.Backend
+	DB      *ent.Client
+	Cfg     *config.Config
+}
+
+// Timeout allows long videos to finish; River's default is one minute.
+func (w *TranscodeWorker) Timeout(*river.Job[queue.TranscodeArgs]) time.Duration {
+	return 2 * time.Hour
+}
+
+// How often transcoding progress is written to the database.
+const progressInterval = 2 * time.Second
+
+// transcodeArgs holds the ffmpeg encoder settings for each rendition format.
+var transcodeArgs = map[string][]string{
+	"mp4": {
+		"-c:v", "libx264", "-preset", "veryfast", "-crf", "23", "-pix_fmt", "yuv420p",
+		"-c:a", "aac", "-b:a", "128k",
+		"-movflags", "+faststart", "-f", "mp4",
+	},
+	"webm": {
+		"-c:v", "libvpx-vp9", "-crf", "32", "-b:v", "0", "-row-mt", "1",
+		"-c:a", "libopus", "-b:a", "96k",
+		"-f", "webm",
+	},
+}
+
+func (w *TranscodeWorker) Work(ctx context.Context, job *river.Job[queue.TranscodeArgs]) error {
+	key, format := job.Args.Key, job.Args.Format
+	if _, ok := transcodeArgs[format]; !ok {
+		return river.JobCancel(fmt.Errorf("unsupported rendition format %q", format))
+	}
+	item, err := w.DB.Media.Get(ctx, key)
+	if ent.IsNotFound(err) {
+		return river.JobCancel(err)
+	}
+	if err != nil {
+		return err
+	}
+
+	r, err := w.startRendition(ctx, key, rendition.Format(format))
+	if err != nil {
+		return err
+	}
+	log.Printf("Transcoding %s to %s", key, format)
+
+	size, err := w.transcode(ctx, r, item.Duration)
+	if err != nil {
+		if uerr := w.DB.Rendition.UpdateOne(r).
+			SetStatus(rendition.StatusFailed).
+			SetError(err.Error()).
+			Exec(context.WithoutCancel(ctx)); uerr != nil {
+			log.Printf("mark rendition %s/%s failed: %v", key, format, uerr)
+		}
+		return err
+	}
+
+	return w.DB.Rendition.UpdateOne(r).
+		SetStatus(rendition.StatusReady).
+		SetProgress(1).
+		SetSize(size).
+		SetError("").
+		Exec(ctx)
+}
+
+// startRendition resets or creates the rendition row for a new attempt.
+func (w *TranscodeWorker) startRendition(ctx context.Context, key string, format rendition.Format) (*ent.Rendition, error) {
+	r, err := w.DB.Rendition.Query().
+		Where(rendition.MediaID(key), rendition.FormatEQ(format)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return w.DB.Rendition.Create().
+			SetMediaID(key).
+			SetFormat(format).
+			SetStatus(rendition.StatusProcessing).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.Update().
+		SetStatus(rendition.StatusProcessing).
+		SetProgress(0).
+		SetError("").
+		Save(ctx)
+}
+
+// transcode runs ffmpeg into a temporary file, uploads the result and returns
+// its size in bytes.
+func (w *TranscodeWorker) transcode(ctx context.Context, r *ent.Rendition, duration *int32) (int64, error) {
+	format := string(r.Format)
+	tmp, err := os.CreateTemp("", "rendition-*."+format)
+	if err != nil {
+		return 0, err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+
+	src := w.Storage.SourceURL(w.Storage.Bucket(), r.MediaID)
+	args := []string{"-i", src, "-y", "-loglevel", "error",
+		"-map", "0:v:0", "-map", "0:a:0?",
+		"-progress", "pipe:1", "-nostats"}
+	args = append(args, transcodeArgs[format]...)
+	args = append(args, tmp.Name())
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return 0, err
+	}
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	total := time.Duration(0)
+	if duration != nil {
+		total = time.Duration(*duration) * time.Second
+	}
+	w.trackProgress(ctx, r, stdout, total)
+
+	if err := cmd.Wait(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return 0, fmt.Errorf("ffmpeg: %w: %s", err, msg)
+		}
+		return 0, fmt.Errorf("ffmpeg: %w", err)
+	}
+
+	info, err := storage.PutRendition(ctx, w.Storage, r.MediaID, format, tmp.Name())
+	if err != nil {
+		return 0, err
+	}
+	return info.Size, nil
+}
+
+// trackProgress consumes ffmpeg's -progress output and periodically stores
+// the completed fraction on the rendition.
+func (w *TranscodeWorker) trackProgress(ctx context.Context, r *ent.Rendition, out io.Reader, total time.Duration) {
+	var last time.Time
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		progress, ok := parseProgress(scanner.Text(), total)
+		if !ok || time.Since(last) < progressInterval {
+			continue
+		}
+		last = time.Now()
+		if err := w.DB.Rendition.UpdateOne(r).SetProgress(progress).Exec(ctx); err != nil {
+			log.Printf("update rendition progress %s: %v", r.MediaID, err)
+		}
+	}
+	// Drain the rest so ffmpeg never blocks on a full pipe.
+	io.Copy(io.Discard, out)
+}
+
+// parseProgress interprets a single key=value line of ffmpeg -progress output
+// and returns the completed fraction of total.
+func parseProgress(line string, total time.Duration) (float64, bool) {
+	key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+	if !ok || total <= 0 {
+		return 0, false
+	}
+	switch key {
+	case "out_time_us", "out_time_ms":
+		// Both keys are reported in microseconds.
+		us, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || us < 0 {
+			return 0, false
+		}
+		p := float64(time.Duration(us)*time.Microsecond) / float64(total)
+		// Leave the last percent for the upload.
+		return min(p, 0.99), true
+	}
+	return 0, false
+}
+
+// browserPlayable reports whether the major browsers can play a video with the
+// given container and codecs without transcoding.
+func browserPlayable(format, videoCodec, audioCodec string) bool {
+	var videoOK, audioOK bool
+	switch format {
+	case "mp4":
+		videoOK = videoCodec == "h264"
+		audioOK = audioCodec == "" || audioCodec == "aac" || audioCodec == "mp3"
+	case "webm":
+		videoOK = videoCodec == "vp8" || videoCodec == "vp9" || videoCodec == "av1"
+		audioOK = audioCodec == "" || audioCodec == "vorbis" || audioCodec == "opus"
+	}
+	return videoOK && audioOK
+}
+
+// enqueueTranscode schedules a rendition when transcoding is enabled and the
+// uploaded video cannot be played as is.
+func (w *ProcessWorker) enqueueTranscode(ctx context.Context, key string, info mediaInfo) error {
+	if !w.Cfg.TranscodeVideos || browserPlayable(info.Format, info.VideoCodec, info.AudioCodec) {
+		return nil
+	}
+	return queue.WorkerEnqueue(ctx, queue.TranscodeArgs{Key: key, Format: w.Cfg.TranscodeFormat})
+}
@@ -0,0 +1,52 @@
+package mediaworker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseProgress(t *testing.T) {
+	total := 10 * time.Second
+	cases := []struct {
+		line string
+		want float64
+		ok   bool
+	}{
+		{"out_time_us=2500000", 0.25, true},
+		{"out_time_ms=5000000", 0.5, true},
+		{"out_time_us=12000000", 0.99, true},
+		{"out_time_us=N/A", 0, false},
+		{"frame=120", 0, false},
+		{"progress=continue", 0, false},
+	}
+	for _, tc := range cases {
+		got, ok := parseProgress(tc.line, total)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("parseProgress(%q) = %v, %v; want %v, %v", tc.line, got, ok, tc.want, tc.ok)
+		}
+	}
+	if _, ok := parseProgress("out_time_us=1000000", 0); ok {
+		t.Fatalf("expected no progress without a known duration")
+	}
+}
+
+func TestBrowserPlayable(t *testing.T) {
+	cases := []struct {
+		format, video, audio string
+		want                 bool
+	}{
+		{"mp4", "h264", "aac", true},
+		{"mp4", "h264", "", true},
+		{"mp4", "hevc", "aac", false},
+		{"mp4", "h264", "ac3", false},
+		{"webm", "vp9", "opus", true},
+		{"webm", "av1", "", true},
+		{"mkv", "h264", "aac", false},
+		{"avi", "mpeg4", "mp3", false},
+	}
+	for _, tc := range cases {
+		if got := browserPlayable(tc.format, tc.video, tc.audio); got != tc.want {
+			t.Errorf("browserPlayable(%q, %q, %q) = %v; want %v", tc.format, tc.video, tc.audio, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/embed.proto
+
+package embedpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Embedder_Text_FullMethodName  = "/embed.Embedder/Text"
+	Embedder_Image_FullMethodName = "/embed.Embedder/Image"
+)
+
+// EmbedderClient is the client API for Embedder service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EmbedderClient interface {
+	Text(ctx context.Context, in *TextRequest, opts ...grpc.CallOption) (*Vector, error)
+	Image(ctx context.Context, in *ImageRequest, opts ...grpc.CallOption) (*Vector, error)
+}
+
+type embedderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmbedderClient(cc grpc.ClientConnInterface) EmbedderClient {
+	return &embedderClient{cc}
+}
+
+func (c *embedderClient) Text(ctx context.Context, in *TextRequest, opts ...grpc.CallOption) (*Vector, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vector)
+	err := c.cc.Invoke(ctx, Embedder_Text_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *embedderClient) Image(ctx context.Context, in *ImageRequest, opts ...grpc.CallOption) (*Vector, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vector)
+	err := c.cc.Invoke(ctx, Embedder_Image_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmbedderServer is the server API for Embedder service.
+// All implementations must embed UnimplementedEmbedderServer
+// for forward compatibility.
+type EmbedderServer interface {
+	Text(context.Context, *TextRequest) (*Vector, error)
+	Image(context.Context, *ImageRequest) (*Vector, error)
+	mustEmbedUnimplementedEmbedderServer()
+}
+
+// UnimplementedEmbedderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmbedderServer struct{}
+
+func (UnimplementedEmbedderServer) Text(context.Context, *TextRequest) (*Vector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Text not implemented")
+}
+func (UnimplementedEmbedderServer) Image(context.Context, *ImageRequest) (*Vector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Image not implemented")
+}
+func (UnimplementedEmbedderServer) mustEmbedUnimplementedEmbedderServer() {}
+func (UnimplementedEmbedderServer) testEmbeddedByValue()                  {}
+
+// UnsafeEmbedderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmbedderServer will
+// result in compilation errors.
+type UnsafeEmbedderServer interface {
+	mustEmbedUnimplementedEmbedderServer()
+}
+
+func RegisterEmbedderServer(s grpc.ServiceRegistrar, srv EmbedderServer) {
+	// If the following call pancis, it indicates UnimplementedEmbedderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Embedder_ServiceDesc, srv)
+}
+
+func _Embedder_Text_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmbedderServer).Text(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Embedder_Text_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmbedderServer).Text(ctx, req.(*TextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Embedder_Image_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmbedderServer).Image(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Embedder_Image_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmbedderServer).Image(ctx, req.(*ImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Embedder_ServiceDesc is the grpc.ServiceDesc for Embedder service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Embedder_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "embed.Embedder",
+	HandlerType: (*EmbedderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Text",
+			Handler:    _Embedder_Text_Handler,
+		},
+		{
+			MethodName: "Image",
+			Handler:    _Embedder_Image_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/embed.proto",
+}
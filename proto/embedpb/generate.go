@@ -0,0 +1,4 @@
+// Package embedpb holds the generated code of proto/embed.proto.
+package embedpb
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=module=era/booru --go-grpc_out=../.. --go-grpc_opt=module=era/booru proto/embed.proto
@@ -1,4 +1,23 @@
-import type { MediaItem, MediaDetail, TagCount } from './types/media';
+import type {
+	MediaItem,
+	MediaComment,
+	MediaNote,
+	FieldType,
+	FieldValue,
+	MetaField,
+	AutoTag,
+	TagSuggestion,
+	NeighborTag,
+	SimilarTag,
+	MediaDetail,
+	MediaRating,
+	MediaRevision,
+	Pool,
+	PoolDetail,
+	TagCount,
+	TrashedMedia,
+	VisionVector
+} from './types/media';
 import { buildSearchParams } from './utils/searchParams';
 
 const apiBase = '/api';
@@ -8,6 +27,11 @@ export interface MediaPreviewsResponse {
 	total: number;
 }
 
+export interface TrashResponse {
+	media: TrashedMedia[];
+	total: number;
+}
+
 export interface HiddenTagFilter {
 	id: number;
 	value: string;
@@ -31,9 +55,10 @@ export async function fetchMediaPreviews(
 	query: string,
 	page: number,
 	pageSize: number,
-	vectorQuery?: string | null
+	vectorQuery?: string | null,
+	sort?: string
 ): Promise<MediaPreviewsResponse> {
-	const params = buildSearchParams({ page, pageSize, query, vectorQuery });
+	const params = buildSearchParams({ page, pageSize, query, vectorQuery, sort });
 	const res = await fetch(`${apiBase}/media/previews?${params.toString()}`);
 	return handleJson(res);
 }
@@ -48,11 +73,325 @@ export async function deleteMedia(id: string): Promise<void> {
 	if (!res.ok) throw new Error(`HTTP ${res.status}`);
 }
 
-export async function updateMediaTags(id: string, tags: string[]): Promise<void> {
+export async function fetchTrash(page: number, pageSize: number): Promise<TrashResponse> {
+	const params = new URLSearchParams({ page: String(page), page_size: String(pageSize) });
+	const res = await fetch(`${apiBase}/trash?${params}`);
+	return handleJson(res);
+}
+
+export async function restoreMedia(id: string): Promise<void> {
+	const res = await fetch(`${apiBase}/trash/${id}/restore`, { method: 'POST' });
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function purgeMedia(id: string): Promise<void> {
+	const res = await fetch(`${apiBase}/trash/${id}`, { method: 'DELETE' });
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export class VersionConflictError extends Error {
+	constructor() {
+		super('media was modified by someone else');
+	}
+}
+
+export async function updateMediaTags(id: string, tags: string[], version: number): Promise<void> {
+	const res = await fetch(`${apiBase}/media/${id}/tags`, {
+		method: 'POST',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ tags, version })
+	});
+	if (res.status === 409) throw new VersionConflictError();
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function editMediaTags(id: string, add: string[], remove: string[]): Promise<void> {
 	const res = await fetch(`${apiBase}/media/${id}/tags`, {
+		method: 'PATCH',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ add, remove })
+	});
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export interface MediaReactions {
+	fav_count: number;
+	score: number;
+	favorited: boolean;
+	vote: number;
+}
+
+export async function setFavorite(id: string, favorite: boolean): Promise<MediaReactions> {
+	const res = await fetch(`${apiBase}/media/${id}/favorite`, { method: favorite ? 'PUT' : 'DELETE' });
+	return handleJson(res);
+}
+
+export async function voteMedia(id: string, value: -1 | 0 | 1): Promise<MediaReactions> {
+	const res = await fetch(`${apiBase}/media/${id}/vote`, {
+		method: 'PUT',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ value })
+	});
+	return handleJson(res);
+}
+
+export async function setMediaRating(id: string, rating: MediaRating | null): Promise<void> {
+	const res = await fetch(`${apiBase}/media/${id}/rating`, {
+		method: 'PUT',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ rating: rating ?? '' })
+	});
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function updateMediaText(
+	id: string,
+	text: { title?: string; description?: string }
+): Promise<{ title: string; description: string }> {
+	const res = await fetch(`${apiBase}/media/${id}`, {
+		method: 'PATCH',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify(text)
+	});
+	return handleJson(res);
+}
+
+export async function fetchFields(): Promise<MetaField[]> {
+	const res = await fetch(`${apiBase}/fields`);
+	const body = await handleJson<{ fields: MetaField[] }>(res);
+	return body.fields;
+}
+
+export async function createField(field: {
+	name: string;
+	type: FieldType;
+	values?: string[];
+	description?: string;
+}): Promise<MetaField> {
+	const res = await fetch(`${apiBase}/fields`, {
+		method: 'POST',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify(field)
+	});
+	if (!res.ok) {
+		const body = await res.json().catch(() => ({}));
+		throw new Error(body.error ?? `HTTP ${res.status}`);
+	}
+	return res.json();
+}
+
+export async function deleteField(id: number): Promise<void> {
+	const res = await fetch(`${apiBase}/fields/${id}`, { method: 'DELETE' });
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function fetchAutoTags(): Promise<AutoTag[]> {
+	const res = await fetch(`${apiBase}/autotags`);
+	const body = await handleJson<{ autotags: AutoTag[] }>(res);
+	return body.autotags;
+}
+
+export async function createAutoTag(entry: {
+	tag: string;
+	prompt?: string;
+	threshold?: number;
+}): Promise<AutoTag> {
+	const res = await fetch(`${apiBase}/autotags`, {
+		method: 'POST',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify(entry)
+	});
+	if (!res.ok) {
+		const body = await res.json().catch(() => ({}));
+		throw new Error(body.error ?? `HTTP ${res.status}`);
+	}
+	return res.json();
+}
+
+export async function updateAutoTag(
+	id: number,
+	changes: { prompt?: string; threshold?: number }
+): Promise<AutoTag> {
+	const res = await fetch(`${apiBase}/autotags/${id}`, {
+		method: 'PATCH',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify(changes)
+	});
+	if (!res.ok) {
+		const body = await res.json().catch(() => ({}));
+		throw new Error(body.error ?? `HTTP ${res.status}`);
+	}
+	return res.json();
+}
+
+export async function deleteAutoTag(id: number): Promise<void> {
+	const res = await fetch(`${apiBase}/autotags/${id}`, { method: 'DELETE' });
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+/** Queues auto-tagging of every embedded item; returns the number of jobs. */
+export async function runAutoTagger(): Promise<number> {
+	const res = await fetch(`${apiBase}/autotags/run`, { method: 'POST' });
+	const body = await handleJson<{ queued: number }>(res);
+	return body.queued;
+}
+
+export async function fetchMediaSuggestions(id: string): Promise<TagSuggestion[]> {
+	const res = await fetch(`${apiBase}/media/${id}/suggestions`);
+	const body = await handleJson<{ suggestions: TagSuggestion[] }>(res);
+	return body.suggestions;
+}
+
+/** Tags of similar items; empty if the item has no embedding yet. */
+export async function fetchNeighborTags(id: string, limit = 10): Promise<NeighborTag[]> {
+	const res = await fetch(`${apiBase}/media/${id}/suggested-tags?limit=${limit}`);
+	if (res.status === 409) return [];
+	const body = await handleJson<{ suggestions: NeighborTag[] }>(res);
+	return body.suggestions;
+}
+
+export async function decideSuggestion(id: number, accept: boolean): Promise<TagSuggestion> {
+	const res = await fetch(`${apiBase}/suggestions/${id}/${accept ? 'accept' : 'reject'}`, {
+		method: 'POST'
+	});
+	return handleJson(res);
+}
+
+/** Sets field values of a media item; null removes a value. */
+export async function setMediaFields(
+	id: string,
+	fields: Record<string, FieldValue | null>
+): Promise<Record<string, FieldValue>> {
+	const res = await fetch(`${apiBase}/media/${id}/fields`, {
+		method: 'PUT',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ fields })
+	});
+	if (!res.ok) {
+		const body = await res.json().catch(() => ({}));
+		throw new Error(body.error ?? `HTTP ${res.status}`);
+	}
+	const body = await res.json();
+	return body.fields;
+}
+
+export async function setMediaSources(id: string, sources: string[]): Promise<string[]> {
+	const res = await fetch(`${apiBase}/media/${id}/sources`, {
+		method: 'PUT',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ sources })
+	});
+	const body = await handleJson<{ sources: string[] }>(res);
+	return body.sources;
+}
+
+export async function fetchComments(
+	id: string,
+	page: number,
+	pageSize: number
+): Promise<{ comments: MediaComment[]; total: number }> {
+	const res = await fetch(`${apiBase}/media/${id}/comments?page=${page}&page_size=${pageSize}`);
+	return handleJson(res);
+}
+
+export async function createComment(id: string, body: string): Promise<MediaComment> {
+	const res = await fetch(`${apiBase}/media/${id}/comments`, {
+		method: 'POST',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ body })
+	});
+	return handleJson(res);
+}
+
+export async function updateComment(id: number, body: string): Promise<MediaComment> {
+	const res = await fetch(`${apiBase}/comments/${id}`, {
+		method: 'PATCH',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ body })
+	});
+	return handleJson(res);
+}
+
+export async function deleteComment(id: number): Promise<void> {
+	const res = await fetch(`${apiBase}/comments/${id}`, { method: 'DELETE' });
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function fetchNotes(id: string): Promise<MediaNote[]> {
+	const res = await fetch(`${apiBase}/media/${id}/notes`);
+	const body = await handleJson<{ notes: MediaNote[] }>(res);
+	return body.notes;
+}
+
+export async function createNote(
+	id: string,
+	note: Pick<MediaNote, 'kind' | 'x' | 'y' | 'width' | 'height' | 'text'> & {
+		tag?: string;
+		embed?: boolean;
+	}
+): Promise<MediaNote> {
+	const res = await fetch(`${apiBase}/media/${id}/notes`, {
+		method: 'POST',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify(note)
+	});
+	return handleJson(res);
+}
+
+export async function deleteNote(id: number): Promise<void> {
+	const res = await fetch(`${apiBase}/notes/${id}`, { method: 'DELETE' });
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function fetchMediaRevisions(id: string): Promise<MediaRevision[]> {
+	const res = await fetch(`${apiBase}/media/${id}/revisions`);
+	const body = await handleJson<{ revisions: MediaRevision[] }>(res);
+	return body.revisions;
+}
+
+export async function revertMediaRevision(id: string, revision: number): Promise<void> {
+	const res = await fetch(`${apiBase}/media/${id}/revisions/${revision}/revert`, { method: 'POST' });
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function fetchPools(page = 1, pageSize = 60): Promise<{ pools: Pool[]; total: number }> {
+	const res = await fetch(`${apiBase}/pools?page=${page}&page_size=${pageSize}`);
+	return handleJson(res);
+}
+
+export async function fetchPool(id: number): Promise<PoolDetail> {
+	const res = await fetch(`${apiBase}/pools/${id}`);
+	return handleJson(res);
+}
+
+export async function createPool(name: string, description = ''): Promise<{ id: number }> {
+	const res = await fetch(`${apiBase}/pools`, {
 		method: 'POST',
 		headers: { 'Content-Type': 'application/json' },
-		body: JSON.stringify({ tags })
+		body: JSON.stringify({ name, description })
+	});
+	return handleJson(res);
+}
+
+export async function addPoolMedia(id: number, ids: string[], position?: number): Promise<void> {
+	const res = await fetch(`${apiBase}/pools/${id}/media`, {
+		method: 'POST',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ ids, position })
+	});
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function removePoolMedia(id: number, mediaId: string): Promise<void> {
+	const res = await fetch(`${apiBase}/pools/${id}/media/${mediaId}`, { method: 'DELETE' });
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function reorderPool(id: number, ids: string[]): Promise<void> {
+	const res = await fetch(`${apiBase}/pools/${id}/order`, {
+		method: 'PUT',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ ids })
 	});
 	if (!res.ok) throw new Error(`HTTP ${res.status}`);
 }
@@ -101,6 +440,31 @@ export async function importMediaTags(file: File): Promise<void> {
 	if (!res.ok) throw new Error(`HTTP ${res.status}`);
 }
 
+export async function fetchVisionVectors(): Promise<VisionVector[]> {
+	const res = await fetch(`${apiBase}/admin/vectors`);
+	const body = await handleJson<{ vectors: VisionVector[] }>(res);
+	return body.vectors;
+}
+
+export async function setActiveVisionVector(name: string): Promise<void> {
+	const res = await fetch(`${apiBase}/admin/vectors/active`, {
+		method: 'PUT',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ name })
+	});
+	if (!res.ok) throw new Error(`HTTP ${res.status}`);
+}
+
+export async function reembedMedia(model: string): Promise<number> {
+	const res = await fetch(`${apiBase}/admin/reembed`, {
+		method: 'POST',
+		headers: { 'Content-Type': 'application/json' },
+		body: JSON.stringify({ model })
+	});
+	const body = await handleJson<{ queued: number }>(res);
+	return body.queued;
+}
+
 export async function fetchTags(): Promise<TagCount[]> {
 	const res = await fetch(`${apiBase}/tags`);
 	const data = await handleJson<{ tags: TagCount[] }>(res);
@@ -114,11 +478,29 @@ export async function fetchTagSuggestions(prefix: string): Promise<TagCount[]> {
 	return data.tags;
 }
 
+/** Tags similar to tag; empty until its centroid has been computed. */
+export async function fetchSimilarTags(tag: string, limit = 20): Promise<SimilarTag[]> {
+	const res = await fetch(`${apiBase}/tags/similar?tag=${encodeURIComponent(tag)}&limit=${limit}`);
+	if (res.status === 409) return [];
+	const body = await handleJson<{ tags: SimilarTag[] }>(res);
+	return body.tags;
+}
+
+/** Media without tag that look most like the media with it. */
+export async function fetchLikelyMissingTag(tag: string, page = 1, pageSize = 30): Promise<MediaItem[]> {
+	const res = await fetch(
+		`${apiBase}/tags/missing?tag=${encodeURIComponent(tag)}&page=${page}&page_size=${pageSize}`
+	);
+	if (res.status === 409) return [];
+	const body = await handleJson<{ media: MediaItem[] }>(res);
+	return body.media;
+}
+
 export async function fetchSimilarMedia(
 	vector: number[],
 	limit: number,
 	exclude?: string,
-	name = 'vision'
+	name?: string
 ): Promise<MediaItem[]> {
 	const res = await fetch(`${apiBase}/media/similar`, {
 		method: 'POST',
@@ -9,13 +9,176 @@ export interface MediaItem {
 	width: number;
 	height: number;
 	format: string;
+	title?: string;
 }
 
 export interface MediaDetail extends MediaItem {
+	/** Sent back when replacing tags; a stale version fails with 409. */
+	version: number;
+	preview_url: string;
+	/** Browser-playable rendition of a video, or the original URL. */
+	playable_url?: string;
+	renditions?: Rendition[];
+	duration?: number | null;
+	bitrate?: number | null;
 	size: number;
 	tags: TagCount[];
 	dates: MediaDate[];
 	vectors?: MediaVector[];
+	deleted_at?: string | null;
+	pools?: PoolMembership[];
+	rating?: MediaRating | null;
+	score: number;
+	fav_count: number;
+	/** Whether the current user favorited the item. */
+	favorited: boolean;
+	/** The current user's vote: 1, -1 or 0 for none. */
+	vote: number;
+	comment_count: number;
+	/** URLs the item was obtained from. */
+	sources: string[];
+	title: string;
+	description: string;
+	/** Custom field values by field name. */
+	fields: Record<string, FieldValue>;
+}
+
+export type FieldType = 'int' | 'float' | 'string' | 'enum' | 'bool';
+export type FieldValue = number | string | boolean;
+
+/** An admin-defined metadata field, searchable as name=value or name>value. */
+export interface MetaField {
+	id: number;
+	name: string;
+	type: FieldType;
+	values?: string[];
+	description: string;
+}
+
+/** A tag proposed by the auto-tagger, pending until accepted or rejected. */
+export interface TagSuggestion {
+	id: number;
+	media_id: string;
+	tag: string;
+	score: number;
+	source: 'zero_shot';
+	status: 'pending' | 'accepted' | 'rejected';
+}
+
+/** A tag voted for by the nearest neighbours of an item. */
+export interface NeighborTag {
+	tag: string;
+	/** Similarity-weighted share of the neighbours with the tag, 0..1. */
+	confidence: number;
+	votes: number;
+}
+
+/** A tag whose media look alike another tag's, by their mean embeddings. */
+export interface SimilarTag {
+	tag: string;
+	similarity: number;
+	media_count: number;
+}
+
+/** A candidate tag of the zero-shot auto-tagger. */
+export interface AutoTag {
+	id: number;
+	tag: string;
+	prompt: string;
+	/** Minimum cosine similarity between image and prompt. */
+	threshold: number;
+	embedded: boolean;
+}
+
+/** Embeddings of one model and dimension; search uses the active one. */
+export interface VisionVector {
+	name: string;
+	model: string;
+	dimension: number;
+	media_count: number;
+	active: boolean;
+}
+
+export type NoteKind = 'note' | 'box' | 'crop';
+
+/** A rectangular annotation; coordinates are fractions of the image size. */
+export interface MediaNote {
+	id: number;
+	media_id: string;
+	kind: NoteKind;
+	x: number;
+	y: number;
+	width: number;
+	height: number;
+	text: string;
+	tag?: string;
+	author: string;
+	embedded: boolean;
+	created_at: string;
+	updated_at: string;
+}
+
+export interface MediaComment {
+	id: number;
+	media_id: string;
+	author: string;
+	/** Markdown source. */
+	body: string;
+	/** Rendered body, with raw HTML escaped. */
+	html: string;
+	created_at: string;
+	updated_at: string;
+	edited: boolean;
+}
+
+export type MediaRating = 'safe' | 'questionable' | 'explicit';
+
+export interface PoolMembership {
+	id: number;
+	name: string;
+	position: number;
+}
+
+export interface Pool {
+	id: number;
+	name: string;
+	description: string;
+	cover_id?: string | null;
+	cover_url?: string;
+	count: number;
+	created_at: string;
+	updated_at: string;
+}
+
+export interface PoolDetail extends Pool {
+	media: (MediaItem & { position: number })[];
+}
+
+export interface MediaRevision {
+	id: number;
+	action: string;
+	actor: string;
+	version: number;
+	created_at: string;
+	tags_before: string[];
+	tags_after: string[];
+	dates_before: Record<string, string>;
+	dates_after: Record<string, string>;
+}
+
+export interface TrashedMedia extends MediaItem {
+	deleted_at: string;
+	/** When the item is purged; absent if the trash is kept forever. */
+	purge_at?: string;
+}
+
+export interface Rendition {
+	format: 'mp4' | 'webm';
+	status: 'pending' | 'processing' | 'ready' | 'failed';
+	progress: number;
+	size?: number | null;
+	url?: string;
+	error?: string;
 }
 
 export interface MediaVector {
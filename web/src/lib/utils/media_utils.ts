@@ -1,3 +1,7 @@
 export function isFormatVideo(format: string): boolean {
 	return ['mp4', 'webm', 'avi'].includes(format.toLowerCase());
 }
+
+export function isFormatAudio(format: string): boolean {
+	return ['mp3', 'flac', 'ogg', 'opus', 'wav'].includes(format.toLowerCase());
+}
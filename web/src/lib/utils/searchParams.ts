@@ -3,13 +3,16 @@ export interface SearchParamsOptions {
 	pageSize: number;
 	query?: string;
 	vectorQuery?: string | null | undefined;
+	/** "score" or "favorites"; upload order when unset. */
+	sort?: string;
 }
 
 export function buildSearchParams({
 	page,
 	pageSize,
 	query,
-	vectorQuery
+	vectorQuery,
+	sort
 }: SearchParamsOptions): URLSearchParams {
 	const params = new URLSearchParams({
 		page: String(page),
@@ -26,6 +29,10 @@ export function buildSearchParams({
 		params.set('vector_q', vectorText);
 	}
 
+	if (sort && sort !== 'upload') {
+		params.set('sort', sort);
+	}
+
 	return params;
 }
 